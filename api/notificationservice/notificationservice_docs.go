@@ -18,114 +18,90 @@ const docTemplatenotificationservice = `{
     "host": "{{.Host}}",
     "basePath": "{{.BasePath}}",
     "paths": {
-        "/notification-channel/mail": {
-            "get": {
+        "/dead-letters": {
+            "put": {
                 "security": [
                     {
                         "KeycloakAuth": []
                     }
                 ],
-                "description": "List mail notification channels by type",
+                "description": "Returns a list of deliveries which failed permanently matching the provided filters",
+                "consumes": [
+                    "application/json"
+                ],
                 "produces": [
                     "application/json"
                 ],
                 "tags": [
-                    "mail-channel"
+                    "dead-letter"
                 ],
-                "summary": "List Mail Channels",
+                "summary": "List Dead Letters",
                 "parameters": [
                     {
-                        "type": "string",
-                        "description": "Channel type",
-                        "name": "type",
-                        "in": "query"
+                        "description": "filters, paging and sorting",
+                        "name": "MatchCriterias",
+                        "in": "body",
+                        "required": true,
+                        "schema": {
+                            "$ref": "#/definitions/query.ResultSelector"
+                        }
                     }
                 ],
                 "responses": {
                     "200": {
                         "description": "OK",
                         "schema": {
-                            "type": "array",
-                            "items": {
-                                "$ref": "#/definitions/maildto.MailNotificationChannelRequest"
-                            }
-                        }
-                    },
-                    "500": {
-                        "description": "Internal Server Error",
-                        "schema": {
-                            "type": "object",
-                            "additionalProperties": {
-                                "type": "string"
+                            "$ref": "#/definitions/query.ResponseListWithMetadata-models_DeadLetter"
+                        },
+                        "headers": {
+                            "api-version": {
+                                "type": "string",
+                                "description": "API version"
                             }
                         }
                     }
                 }
-            },
-            "post": {
+            }
+        },
+        "/dead-letters/options": {
+            "get": {
                 "security": [
                     {
                         "KeycloakAuth": []
                     }
                 ],
-                "description": "Create a new mail notification channel",
-                "consumes": [
-                    "application/json"
-                ],
+                "description": "Get filter options for listing dead letters",
                 "produces": [
                     "application/json"
                 ],
                 "tags": [
-                    "mail-channel"
-                ],
-                "summary": "Create Mail Channel",
-                "parameters": [
-                    {
-                        "description": "Mail channel to add",
-                        "name": "MailChannel",
-                        "in": "body",
-                        "required": true,
-                        "schema": {
-                            "$ref": "#/definitions/maildto.MailNotificationChannelRequest"
-                        }
-                    }
+                    "dead-letter"
                 ],
+                "summary": "Dead letter filter options",
                 "responses": {
-                    "201": {
-                        "description": "Created",
-                        "schema": {
-                            "$ref": "#/definitions/maildto.MailNotificationChannelRequest"
-                        }
-                    },
-                    "400": {
-                        "description": "Bad Request",
-                        "schema": {
-                            "type": "object",
-                            "additionalProperties": {
-                                "type": "string"
-                            }
-                        }
-                    },
-                    "500": {
-                        "description": "Internal Server Error",
+                    "200": {
+                        "description": "OK",
                         "schema": {
-                            "type": "object",
-                            "additionalProperties": {
-                                "type": "string"
+                            "$ref": "#/definitions/query.ResponseWithMetadata-array_query_FilterOption"
+                        },
+                        "headers": {
+                            "api-version": {
+                                "type": "string",
+                                "description": "API version"
                             }
                         }
                     }
                 }
             }
         },
-        "/notification-channel/mail/check": {
+        "/dead-letters/replay": {
             "post": {
                 "security": [
                     {
                         "KeycloakAuth": []
                     }
                 ],
-                "description": "Check if a mail server is reachable",
+                "description": "Delivers the given dead letters again. Unknown IDs are skipped, e.g. if they have been replayed in the meantime.",
                 "consumes": [
                     "application/json"
                 ],
@@ -133,117 +109,128 @@ const docTemplatenotificationservice = `{
                     "application/json"
                 ],
                 "tags": [
-                    "mail-channel"
+                    "dead-letter"
                 ],
-                "summary": "Check mail server",
+                "summary": "Replay Dead Letters",
                 "parameters": [
                     {
-                        "description": "Mail server to check",
-                        "name": "MailServerConfig",
+                        "description": "IDs of the dead letters to replay",
+                        "name": "ReplayDeadLettersRequest",
                         "in": "body",
                         "required": true,
                         "schema": {
-                            "$ref": "#/definitions/maildto.CheckMailServerRequest"
+                            "$ref": "#/definitions/notificationcontroller.ReplayDeadLettersRequest"
                         }
                     }
                 ],
                 "responses": {
-                    "204": {
-                        "description": "Mail server reachable"
+                    "200": {
+                        "description": "OK",
+                        "schema": {
+                            "$ref": "#/definitions/query.ResponseWithMetadata-notificationcontroller_ReplayDeadLettersResponse"
+                        },
+                        "headers": {
+                            "api-version": {
+                                "type": "string",
+                                "description": "API version"
+                            }
+                        }
                     },
                     "400": {
-                        "description": "Bad Request",
+                        "description": "invalid ids",
                         "schema": {
-                            "type": "object",
-                            "additionalProperties": {
-                                "type": "string"
+                            "$ref": "#/definitions/errorResponses.ErrorResponse"
+                        },
+                        "headers": {
+                            "api-version": {
+                                "type": "string",
+                                "description": "API version"
                             }
                         }
-                    },
-                    "422": {
-                        "description": "Mail server error"
                     }
                 }
             }
         },
-        "/notification-channel/mail/{id}": {
-            "put": {
+        "/dead-letters/{id}": {
+            "get": {
                 "security": [
                     {
                         "KeycloakAuth": []
                     }
                 ],
-                "description": "Update an existing mail notification channel",
-                "consumes": [
-                    "application/json"
-                ],
+                "description": "Returns the dead letter including the notification and the last error",
                 "produces": [
                     "application/json"
                 ],
                 "tags": [
-                    "mail-channel"
+                    "dead-letter"
                 ],
-                "summary": "Update Mail Channel",
+                "summary": "Get Dead Letter",
                 "parameters": [
                     {
                         "type": "string",
-                        "description": "Mail channel ID",
+                        "description": "unique ID of the dead letter",
                         "name": "id",
                         "in": "path",
                         "required": true
-                    },
-                    {
-                        "description": "Mail channel to update",
-                        "name": "MailChannel",
-                        "in": "body",
-                        "required": true,
-                        "schema": {
-                            "$ref": "#/definitions/maildto.MailNotificationChannelRequest"
-                        }
                     }
                 ],
                 "responses": {
                     "200": {
                         "description": "OK",
                         "schema": {
-                            "$ref": "#/definitions/maildto.MailNotificationChannelRequest"
+                            "$ref": "#/definitions/query.ResponseWithMetadata-models_DeadLetter"
+                        },
+                        "headers": {
+                            "api-version": {
+                                "type": "string",
+                                "description": "API version"
+                            }
                         }
                     },
                     "400": {
-                        "description": "Bad Request",
+                        "description": "invalid id",
                         "schema": {
-                            "type": "object",
-                            "additionalProperties": {
-                                "type": "string"
+                            "$ref": "#/definitions/errorResponses.ErrorResponse"
+                        },
+                        "headers": {
+                            "api-version": {
+                                "type": "string",
+                                "description": "API version"
                             }
                         }
                     },
-                    "500": {
-                        "description": "Internal Server Error",
+                    "404": {
+                        "description": "dead letter not found",
                         "schema": {
-                            "type": "object",
-                            "additionalProperties": {
-                                "type": "string"
+                            "$ref": "#/definitions/errorResponses.ErrorResponse"
+                        },
+                        "headers": {
+                            "api-version": {
+                                "type": "string",
+                                "description": "API version"
                             }
                         }
                     }
                 }
-            },
-            "delete": {
+            }
+        },
+        "/dead-letters/{id}/replay": {
+            "post": {
                 "security": [
                     {
                         "KeycloakAuth": []
                     }
                 ],
-                "description": "Delete a mail notification channel",
+                "description": "Delivers the dead letter again, e.g. after the channel has been fixed. It is removed from the dead letters and retried like a new delivery.",
                 "tags": [
-                    "mail-channel"
+                    "dead-letter"
                 ],
-                "summary": "Delete Mail Channel",
+                "summary": "Replay Dead Letter",
                 "parameters": [
                     {
                         "type": "string",
-                        "description": "Mail channel ID",
+                        "description": "unique ID of the dead letter",
                         "name": "id",
                         "in": "path",
                         "required": true
@@ -251,28 +238,49 @@ const docTemplatenotificationservice = `{
                 ],
                 "responses": {
                     "204": {
-                        "description": "Deleted successfully"
+                        "description": "dead letter replayed",
+                        "headers": {
+                            "api-version": {
+                                "type": "string",
+                                "description": "API version"
+                            }
+                        }
                     },
-                    "500": {
-                        "description": "Internal Server Error",
+                    "400": {
+                        "description": "invalid id",
                         "schema": {
-                            "type": "object",
-                            "additionalProperties": {
-                                "type": "string"
+                            "$ref": "#/definitions/errorResponses.ErrorResponse"
+                        },
+                        "headers": {
+                            "api-version": {
+                                "type": "string",
+                                "description": "API version"
+                            }
+                        }
+                    },
+                    "404": {
+                        "description": "dead letter not found",
+                        "schema": {
+                            "$ref": "#/definitions/errorResponses.ErrorResponse"
+                        },
+                        "headers": {
+                            "api-version": {
+                                "type": "string",
+                                "description": "API version"
                             }
                         }
                     }
                 }
             }
         },
-        "/notification-channel/mail/{id}/check": {
-            "post": {
+        "/deliveries": {
+            "put": {
                 "security": [
                     {
                         "KeycloakAuth": []
                     }
                 ],
-                "description": "Check if a mail server is reachable",
+                "description": "Returns a list of attempts to forward notifications to channels matching the provided filters",
                 "consumes": [
                     "application/json"
                 ],
@@ -280,116 +288,120 @@ const docTemplatenotificationservice = `{
                     "application/json"
                 ],
                 "tags": [
-                    "mail-channel"
+                    "notification"
                 ],
-                "summary": "Check mail server",
+                "summary": "List Deliveries",
                 "parameters": [
                     {
-                        "description": "Mail server to check",
-                        "name": "MailServerConfig",
+                        "description": "filters, paging and sorting",
+                        "name": "MatchCriterias",
                         "in": "body",
                         "required": true,
                         "schema": {
-                            "$ref": "#/definitions/maildto.CheckMailServerEntityRequest"
+                            "$ref": "#/definitions/query.ResultSelector"
                         }
                     }
                 ],
                 "responses": {
-                    "204": {
-                        "description": "Mail server reachable"
-                    },
-                    "400": {
-                        "description": "Bad Request",
+                    "200": {
+                        "description": "OK",
                         "schema": {
-                            "type": "object",
-                            "additionalProperties": {
-                                "type": "string"
+                            "$ref": "#/definitions/query.ResponseListWithMetadata-models_DeliveryAttempt"
+                        },
+                        "headers": {
+                            "api-version": {
+                                "type": "string",
+                                "description": "API version"
                             }
                         }
-                    },
-                    "422": {
-                        "description": "Mail server error"
                     }
                 }
             }
         },
-        "/notification-channel/mattermost": {
+        "/deliveries/options": {
             "get": {
                 "security": [
                     {
                         "KeycloakAuth": []
                     }
                 ],
-                "description": "List mattermost notification channels",
+                "description": "Get filter options for listing deliveries",
                 "produces": [
                     "application/json"
                 ],
                 "tags": [
-                    "mattermost-channel"
+                    "notification"
                 ],
-                "summary": "List Mattermost Channels",
+                "summary": "Delivery filter options",
                 "responses": {
                     "200": {
                         "description": "OK",
                         "schema": {
-                            "type": "array",
-                            "items": {
-                                "$ref": "#/definitions/mattermostdto.MattermostNotificationChannelRequest"
-                            }
-                        }
-                    },
-                    "500": {
-                        "description": "Internal Server Error",
-                        "schema": {
-                            "type": "object",
-                            "additionalProperties": {
-                                "type": "string"
+                            "$ref": "#/definitions/query.ResponseWithMetadata-array_query_FilterOption"
+                        },
+                        "headers": {
+                            "api-version": {
+                                "type": "string",
+                                "description": "API version"
                             }
                         }
                     }
                 }
-            },
-            "post": {
+            }
+        },
+        "/deliveries/queues": {
+            "get": {
                 "security": [
                     {
                         "KeycloakAuth": []
                     }
                 ],
-                "description": "Create a new mattermost notification channel",
-                "consumes": [
-                    "application/json"
-                ],
+                "description": "Get the current depth of the queues of incoming notifications and of pending deliveries per channel type",
                 "produces": [
                     "application/json"
                 ],
                 "tags": [
-                    "mattermost-channel"
+                    "notification"
                 ],
-                "summary": "Create Mattermost Channel",
-                "parameters": [
-                    {
-                        "description": "Mattermost channel to add",
-                        "name": "MattermostChannel",
-                        "in": "body",
-                        "required": true,
+                "summary": "Delivery queues",
+                "responses": {
+                    "200": {
+                        "description": "OK",
                         "schema": {
-                            "$ref": "#/definitions/mattermostdto.MattermostNotificationChannelRequest"
+                            "$ref": "#/definitions/query.ResponseWithMetadata-models_WorkerPoolStats"
+                        },
+                        "headers": {
+                            "api-version": {
+                                "type": "string",
+                                "description": "API version"
+                            }
                         }
                     }
+                }
+            }
+        },
+        "/notification-channel/incident": {
+            "get": {
+                "security": [
+                    {
+                        "KeycloakAuth": []
+                    }
+                ],
+                "description": "List incident notification channels",
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "incident-channel"
                 ],
+                "summary": "List Incident Channels",
                 "responses": {
-                    "201": {
-                        "description": "Created",
-                        "schema": {
-                            "$ref": "#/definitions/mattermostdto.MattermostNotificationChannelRequest"
-                        }
-                    },
-                    "400": {
-                        "description": "Bad Request",
+                    "200": {
+                        "description": "OK",
                         "schema": {
-                            "type": "object",
-                            "additionalProperties": {
-                                "type": "string"
+                            "type": "array",
+                            "items": {
+                                "$ref": "#/definitions/incidentdto.IncidentNotificationChannelResponse"
                             }
                         }
                     },
@@ -403,16 +415,14 @@ const docTemplatenotificationservice = `{
                         }
                     }
                 }
-            }
-        },
-        "/notification-channel/mattermost/check": {
+            },
             "post": {
                 "security": [
                     {
                         "KeycloakAuth": []
                     }
                 ],
-                "description": "Check if a mattermost server is able to send a test message",
+                "description": "Create a new incident notification channel, the routing key is required",
                 "consumes": [
                     "application/json"
                 ],
@@ -420,12 +430,26 @@ const docTemplatenotificationservice = `{
                     "application/json"
                 ],
                 "tags": [
-                    "mattermost-channel"
+                    "incident-channel"
+                ],
+                "summary": "Create Incident Channel",
+                "parameters": [
+                    {
+                        "description": "Incident channel to add",
+                        "name": "IncidentChannel",
+                        "in": "body",
+                        "required": true,
+                        "schema": {
+                            "$ref": "#/definitions/incidentdto.IncidentNotificationChannelRequest"
+                        }
+                    }
                 ],
-                "summary": "Check mattermost server",
                 "responses": {
-                    "204": {
-                        "description": "Mattermost server test message sent successfully"
+                    "201": {
+                        "description": "Created",
+                        "schema": {
+                            "$ref": "#/definitions/incidentdto.IncidentNotificationChannelResponse"
+                        }
                     },
                     "400": {
                         "description": "Bad Request",
@@ -435,18 +459,27 @@ const docTemplatenotificationservice = `{
                                 "type": "string"
                             }
                         }
+                    },
+                    "500": {
+                        "description": "Internal Server Error",
+                        "schema": {
+                            "type": "object",
+                            "additionalProperties": {
+                                "type": "string"
+                            }
+                        }
                     }
                 }
             }
         },
-        "/notification-channel/mattermost/{id}": {
+        "/notification-channel/incident/{id}": {
             "put": {
                 "security": [
                     {
                         "KeycloakAuth": []
                     }
                 ],
-                "description": "Update an existing mattermost notification channel",
+                "description": "Update an existing incident notification channel",
                 "consumes": [
                     "application/json"
                 ],
@@ -454,24 +487,24 @@ const docTemplatenotificationservice = `{
                     "application/json"
                 ],
                 "tags": [
-                    "mattermost-channel"
+                    "incident-channel"
                 ],
-                "summary": "Update Mattermost Channel",
+                "summary": "Update Incident Channel",
                 "parameters": [
                     {
                         "type": "string",
-                        "description": "Mattermost channel ID",
+                        "description": "Incident channel ID",
                         "name": "id",
                         "in": "path",
                         "required": true
                     },
                     {
-                        "description": "Mattermost channel to update",
-                        "name": "MattermostChannel",
+                        "description": "Incident channel to update",
+                        "name": "IncidentChannel",
                         "in": "body",
                         "required": true,
                         "schema": {
-                            "$ref": "#/definitions/mattermostdto.MattermostNotificationChannelRequest"
+                            "$ref": "#/definitions/incidentdto.IncidentNotificationChannelRequest"
                         }
                     }
                 ],
@@ -479,7 +512,7 @@ const docTemplatenotificationservice = `{
                     "200": {
                         "description": "OK",
                         "schema": {
-                            "$ref": "#/definitions/mattermostdto.MattermostNotificationChannelRequest"
+                            "$ref": "#/definitions/incidentdto.IncidentNotificationChannelResponse"
                         }
                     },
                     "400": {
@@ -517,15 +550,15 @@ const docTemplatenotificationservice = `{
                         "KeycloakAuth": []
                     }
                 ],
-                "description": "Delete a mattermost notification channel",
+                "description": "Delete a incident notification channel",
                 "tags": [
-                    "mattermost-channel"
+                    "incident-channel"
                 ],
-                "summary": "Delete Mattermost Channel",
+                "summary": "Delete Incident Channel",
                 "parameters": [
                     {
                         "type": "string",
-                        "description": "Mattermost channel ID",
+                        "description": "Incident channel ID",
                         "name": "id",
                         "in": "path",
                         "required": true
@@ -556,21 +589,21 @@ const docTemplatenotificationservice = `{
                 }
             }
         },
-        "/notification-channel/teams": {
+        "/notification-channel/mail": {
             "get": {
                 "security": [
                     {
                         "KeycloakAuth": []
                     }
                 ],
-                "description": "List teams notification channels by type",
+                "description": "List mail notification channels by type",
                 "produces": [
                     "application/json"
                 ],
                 "tags": [
-                    "teams-channel"
+                    "mail-channel"
                 ],
-                "summary": "List Teams Channels",
+                "summary": "List Mail Channels",
                 "parameters": [
                     {
                         "type": "string",
@@ -585,7 +618,7 @@ const docTemplatenotificationservice = `{
                         "schema": {
                             "type": "array",
                             "items": {
-                                "$ref": "#/definitions/teamsdto.TeamsNotificationChannelRequest"
+                                "$ref": "#/definitions/maildto.MailNotificationChannelRequest"
                             }
                         }
                     },
@@ -606,7 +639,7 @@ const docTemplatenotificationservice = `{
                         "KeycloakAuth": []
                     }
                 ],
-                "description": "Create a new teams notification channel",
+                "description": "Create a new mail notification channel",
                 "consumes": [
                     "application/json"
                 ],
@@ -614,17 +647,17 @@ const docTemplatenotificationservice = `{
                     "application/json"
                 ],
                 "tags": [
-                    "teams-channel"
+                    "mail-channel"
                 ],
-                "summary": "Create Teams Channel",
+                "summary": "Create Mail Channel",
                 "parameters": [
                     {
-                        "description": "Teams channel to add",
-                        "name": "TeamsChannel",
+                        "description": "Mail channel to add",
+                        "name": "MailChannel",
                         "in": "body",
                         "required": true,
                         "schema": {
-                            "$ref": "#/definitions/teamsdto.TeamsNotificationChannelRequest"
+                            "$ref": "#/definitions/maildto.MailNotificationChannelRequest"
                         }
                     }
                 ],
@@ -632,7 +665,7 @@ const docTemplatenotificationservice = `{
                     "201": {
                         "description": "Created",
                         "schema": {
-                            "$ref": "#/definitions/teamsdto.TeamsNotificationChannelRequest"
+                            "$ref": "#/definitions/maildto.MailNotificationChannelRequest"
                         }
                     },
                     "400": {
@@ -656,14 +689,14 @@ const docTemplatenotificationservice = `{
                 }
             }
         },
-        "/notification-channel/teams/check": {
+        "/notification-channel/mail/check": {
             "post": {
                 "security": [
                     {
                         "KeycloakAuth": []
                     }
                 ],
-                "description": "Check if a Teams server is able to send a test message",
+                "description": "Check if a mail server is reachable",
                 "consumes": [
                     "application/json"
                 ],
@@ -671,21 +704,23 @@ const docTemplatenotificationservice = `{
                     "application/json"
                 ],
                 "tags": [
-                    "teams-channel"
+                    "mail-channel"
                 ],
-                "summary": "Check Teams server",
+                "summary": "Check mail server",
                 "parameters": [
                     {
-                        "type": "string",
-                        "description": "Teams channel ID",
-                        "name": "id",
-                        "in": "path",
-                        "required": true
+                        "description": "Mail server to check",
+                        "name": "MailServerConfig",
+                        "in": "body",
+                        "required": true,
+                        "schema": {
+                            "$ref": "#/definitions/maildto.CheckMailServerRequest"
+                        }
                     }
                 ],
                 "responses": {
                     "204": {
-                        "description": "Teams server test message sent successfully"
+                        "description": "Mail server reachable"
                     },
                     "400": {
                         "description": "Bad Request",
@@ -695,18 +730,21 @@ const docTemplatenotificationservice = `{
                                 "type": "string"
                             }
                         }
+                    },
+                    "422": {
+                        "description": "Mail server error"
                     }
                 }
             }
         },
-        "/notification-channel/teams/{id}": {
+        "/notification-channel/mail/{id}": {
             "put": {
                 "security": [
                     {
                         "KeycloakAuth": []
                     }
                 ],
-                "description": "Update an existing teams notification channel",
+                "description": "Update an existing mail notification channel",
                 "consumes": [
                     "application/json"
                 ],
@@ -714,24 +752,24 @@ const docTemplatenotificationservice = `{
                     "application/json"
                 ],
                 "tags": [
-                    "teams-channel"
+                    "mail-channel"
                 ],
-                "summary": "Update Teams Channel",
+                "summary": "Update Mail Channel",
                 "parameters": [
                     {
                         "type": "string",
-                        "description": "Teams channel ID",
+                        "description": "Mail channel ID",
                         "name": "id",
                         "in": "path",
                         "required": true
                     },
                     {
-                        "description": "Teams channel to update",
-                        "name": "TeamsChannel",
+                        "description": "Mail channel to update",
+                        "name": "MailChannel",
                         "in": "body",
                         "required": true,
                         "schema": {
-                            "$ref": "#/definitions/teamsdto.TeamsNotificationChannelRequest"
+                            "$ref": "#/definitions/maildto.MailNotificationChannelRequest"
                         }
                     }
                 ],
@@ -739,7 +777,7 @@ const docTemplatenotificationservice = `{
                     "200": {
                         "description": "OK",
                         "schema": {
-                            "$ref": "#/definitions/teamsdto.TeamsNotificationChannelRequest"
+                            "$ref": "#/definitions/maildto.MailNotificationChannelRequest"
                         }
                     },
                     "400": {
@@ -751,15 +789,6 @@ const docTemplatenotificationservice = `{
                             }
                         }
                     },
-                    "404": {
-                        "description": "Not Found",
-                        "schema": {
-                            "type": "object",
-                            "additionalProperties": {
-                                "type": "string"
-                            }
-                        }
-                    },
                     "500": {
                         "description": "Internal Server Error",
                         "schema": {
@@ -777,15 +806,15 @@ const docTemplatenotificationservice = `{
                         "KeycloakAuth": []
                     }
                 ],
-                "description": "Delete a teams notification channel",
+                "description": "Delete a mail notification channel",
                 "tags": [
-                    "teams-channel"
+                    "mail-channel"
                 ],
-                "summary": "Delete Teams Channel",
+                "summary": "Delete Mail Channel",
                 "parameters": [
                     {
                         "type": "string",
-                        "description": "Teams channel ID",
+                        "description": "Mail channel ID",
                         "name": "id",
                         "in": "path",
                         "required": true
@@ -795,15 +824,6 @@ const docTemplatenotificationservice = `{
                     "204": {
                         "description": "Deleted successfully"
                     },
-                    "404": {
-                        "description": "Not Found",
-                        "schema": {
-                            "type": "object",
-                            "additionalProperties": {
-                                "type": "string"
-                            }
-                        }
-                    },
                     "500": {
                         "description": "Internal Server Error",
                         "schema": {
@@ -816,14 +836,14 @@ const docTemplatenotificationservice = `{
                 }
             }
         },
-        "/notifications": {
-            "put": {
+        "/notification-channel/mail/{id}/check": {
+            "post": {
                 "security": [
                     {
                         "KeycloakAuth": []
                     }
                 ],
-                "description": "Returns a list of notifications matching the provided filters",
+                "description": "Check if a mail server is reachable",
                 "consumes": [
                     "application/json"
                 ],
@@ -831,118 +851,184 @@ const docTemplatenotificationservice = `{
                     "application/json"
                 ],
                 "tags": [
-                    "notification"
+                    "mail-channel"
                 ],
-                "summary": "List Notifications",
+                "summary": "Check mail server",
                 "parameters": [
                     {
-                        "description": "filters, paging and sorting",
-                        "name": "MatchCriterias",
+                        "description": "Mail server to check",
+                        "name": "MailServerConfig",
                         "in": "body",
                         "required": true,
                         "schema": {
-                            "$ref": "#/definitions/query.ResultSelector"
+                            "$ref": "#/definitions/maildto.CheckMailServerEntityRequest"
                         }
                     }
                 ],
                 "responses": {
-                    "200": {
-                        "description": "OK",
+                    "204": {
+                        "description": "Mail server reachable"
+                    },
+                    "400": {
+                        "description": "Bad Request",
                         "schema": {
-                            "$ref": "#/definitions/query.ResponseListWithMetadata-models_Notification"
-                        },
-                        "headers": {
-                            "api-version": {
-                                "type": "string",
-                                "description": "API version"
+                            "type": "object",
+                            "additionalProperties": {
+                                "type": "string"
                             }
                         }
+                    },
+                    "422": {
+                        "description": "Mail server error"
                     }
                 }
-            },
-            "post": {
+            }
+        },
+        "/notification-channel/matrix": {
+            "get": {
                 "security": [
                     {
                         "KeycloakAuth": []
                     }
                 ],
-                "description": "Create a new notification. It will always be stored by the notification service and it will possibly also trigger actions like sending mails, depending on the cofigured rules.",
-                "consumes": [
-                    "application/json"
-                ],
+                "description": "List Matrix notification channels",
                 "produces": [
                     "application/json"
                 ],
                 "tags": [
-                    "notification"
+                    "matrix-channel"
                 ],
-                "summary": "Create Notification",
-                "parameters": [
-                    {
-                        "description": "notification to add",
-                        "name": "Notification",
-                        "in": "body",
-                        "required": true,
+                "summary": "List Matrix Channels",
+                "responses": {
+                    "200": {
+                        "description": "OK",
                         "schema": {
-                            "$ref": "#/definitions/models.Notification"
+                            "type": "array",
+                            "items": {
+                                "$ref": "#/definitions/matrixdto.MatrixNotificationChannelResponse"
+                            }
                         }
-                    }
+                    },
+                    "500": {
+                        "description": "Internal Server Error",
+                        "schema": {
+                            "type": "object",
+                            "additionalProperties": {
+                                "type": "string"
+                            }
+                        }
+                    }
+                }
+            },
+            "post": {
+                "security": [
+                    {
+                        "KeycloakAuth": []
+                    }
+                ],
+                "description": "Create a new Matrix notification channel posting to a room",
+                "consumes": [
+                    "application/json"
+                ],
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "matrix-channel"
+                ],
+                "summary": "Create Matrix Channel",
+                "parameters": [
+                    {
+                        "description": "Matrix channel to add",
+                        "name": "MatrixChannel",
+                        "in": "body",
+                        "required": true,
+                        "schema": {
+                            "$ref": "#/definitions/matrixdto.MatrixNotificationChannelRequest"
+                        }
+                    }
                 ],
                 "responses": {
                     "201": {
                         "description": "Created",
                         "schema": {
-                            "$ref": "#/definitions/query.ResponseWithMetadata-models_Notification"
-                        },
-                        "headers": {
-                            "api-version": {
-                                "type": "string",
-                                "description": "API version"
+                            "$ref": "#/definitions/matrixdto.MatrixNotificationChannelResponse"
+                        }
+                    },
+                    "400": {
+                        "description": "Bad Request",
+                        "schema": {
+                            "type": "object",
+                            "additionalProperties": {
+                                "type": "string"
+                            }
+                        }
+                    },
+                    "500": {
+                        "description": "Internal Server Error",
+                        "schema": {
+                            "type": "object",
+                            "additionalProperties": {
+                                "type": "string"
                             }
                         }
                     }
                 }
             }
         },
-        "/notifications/options": {
-            "get": {
+        "/notification-channel/matrix/check": {
+            "post": {
                 "security": [
                     {
                         "KeycloakAuth": []
                     }
                 ],
-                "description": "Get filter options for listing notifications",
+                "description": "Check if a test message can be posted to the Matrix room",
+                "consumes": [
+                    "application/json"
+                ],
                 "produces": [
                     "application/json"
                 ],
                 "tags": [
-                    "notification"
+                    "matrix-channel"
+                ],
+                "summary": "Check Matrix room",
+                "parameters": [
+                    {
+                        "description": "Matrix room to check",
+                        "name": "MatrixChannel",
+                        "in": "body",
+                        "required": true,
+                        "schema": {
+                            "$ref": "#/definitions/matrixdto.MatrixNotificationChannelCheckRequest"
+                        }
+                    }
                 ],
-                "summary": "Notification filter options",
                 "responses": {
-                    "200": {
-                        "description": "OK",
+                    "204": {
+                        "description": "Matrix test message sent successfully"
+                    },
+                    "400": {
+                        "description": "Bad Request",
                         "schema": {
-                            "$ref": "#/definitions/query.ResponseWithMetadata-array_query_FilterOption"
-                        },
-                        "headers": {
-                            "api-version": {
-                                "type": "string",
-                                "description": "API version"
+                            "type": "object",
+                            "additionalProperties": {
+                                "type": "string"
                             }
                         }
                     }
                 }
             }
         },
-        "/origins/{serviceID}": {
+        "/notification-channel/matrix/{id}": {
             "put": {
                 "security": [
                     {
                         "KeycloakAuth": []
                     }
                 ],
-                "description": "Registers a set of origins in the given service. Replaces origins of this service if they already existed. The origins can be ulitized to set trigger conditions for actions.",
+                "description": "Update an existing Matrix notification channel",
                 "consumes": [
                     "application/json"
                 ],
@@ -950,98 +1036,139 @@ const docTemplatenotificationservice = `{
                     "application/json"
                 ],
                 "tags": [
-                    "origin"
+                    "matrix-channel"
                 ],
-                "summary": "Register Origins",
+                "summary": "Update Matrix Channel",
                 "parameters": [
                     {
                         "type": "string",
-                        "description": "serviceID of the calling service, needs to be unique among all services registering origins",
-                        "name": "serviceID",
+                        "description": "Matrix channel ID",
+                        "name": "id",
                         "in": "path",
                         "required": true
                     },
                     {
-                        "description": "origins provided by the calling service",
-                        "name": "origins",
+                        "description": "Matrix channel to update",
+                        "name": "MatrixChannel",
                         "in": "body",
                         "required": true,
                         "schema": {
-                            "type": "array",
-                            "items": {
-                                "$ref": "#/definitions/models.Origin"
-                            }
+                            "$ref": "#/definitions/matrixdto.MatrixNotificationChannelRequest"
                         }
                     }
                 ],
                 "responses": {
-                    "204": {
-                        "description": "origins registered",
-                        "headers": {
-                            "api-version": {
-                                "type": "string",
-                                "description": "API version"
-                            }
+                    "200": {
+                        "description": "OK",
+                        "schema": {
+                            "$ref": "#/definitions/matrixdto.MatrixNotificationChannelResponse"
                         }
                     },
                     "400": {
                         "description": "Bad Request",
                         "schema": {
-                            "$ref": "#/definitions/errorResponses.ErrorResponse"
-                        },
-                        "headers": {
-                            "api-version": {
-                                "type": "string",
-                                "description": "API version"
+                            "type": "object",
+                            "additionalProperties": {
+                                "type": "string"
+                            }
+                        }
+                    },
+                    "404": {
+                        "description": "Not Found",
+                        "schema": {
+                            "type": "object",
+                            "additionalProperties": {
+                                "type": "string"
                             }
                         }
                     },
                     "500": {
                         "description": "Internal Server Error",
                         "schema": {
-                            "$ref": "#/definitions/errorResponses.ErrorResponse"
-                        },
-                        "headers": {
-                            "api-version": {
-                                "type": "string",
-                                "description": "API version"
+                            "type": "object",
+                            "additionalProperties": {
+                                "type": "string"
+                            }
+                        }
+                    }
+                }
+            },
+            "delete": {
+                "security": [
+                    {
+                        "KeycloakAuth": []
+                    }
+                ],
+                "description": "Delete a Matrix notification channel",
+                "tags": [
+                    "matrix-channel"
+                ],
+                "summary": "Delete Matrix Channel",
+                "parameters": [
+                    {
+                        "type": "string",
+                        "description": "Matrix channel ID",
+                        "name": "id",
+                        "in": "path",
+                        "required": true
+                    }
+                ],
+                "responses": {
+                    "204": {
+                        "description": "Deleted successfully"
+                    },
+                    "404": {
+                        "description": "Not Found",
+                        "schema": {
+                            "type": "object",
+                            "additionalProperties": {
+                                "type": "string"
+                            }
+                        }
+                    },
+                    "500": {
+                        "description": "Internal Server Error",
+                        "schema": {
+                            "type": "object",
+                            "additionalProperties": {
+                                "type": "string"
                             }
                         }
                     }
                 }
             }
         },
-        "/rules": {
+        "/notification-channel/mattermost": {
             "get": {
                 "security": [
                     {
                         "KeycloakAuth": []
                     }
                 ],
-                "description": "Returns a list of rules matching the provided filters.",
-                "consumes": [
-                    "application/json"
-                ],
+                "description": "List mattermost notification channels",
                 "produces": [
                     "application/json"
                 ],
                 "tags": [
-                    "rule"
+                    "mattermost-channel"
                 ],
-                "summary": "List Rules",
+                "summary": "List Mattermost Channels",
                 "responses": {
                     "200": {
                         "description": "OK",
                         "schema": {
                             "type": "array",
                             "items": {
-                                "$ref": "#/definitions/models.Rule"
+                                "$ref": "#/definitions/mattermostdto.MattermostNotificationChannelRequest"
                             }
-                        },
-                        "headers": {
-                            "api-version": {
-                                "type": "string",
-                                "description": "API version"
+                        }
+                    },
+                    "500": {
+                        "description": "Internal Server Error",
+                        "schema": {
+                            "type": "object",
+                            "additionalProperties": {
+                                "type": "string"
                             }
                         }
                     }
@@ -1053,7 +1180,7 @@ const docTemplatenotificationservice = `{
                         "KeycloakAuth": []
                     }
                 ],
-                "description": "Create a new rule. A rule determines on which conditions which action is triggered.",
+                "description": "Create a new mattermost notification channel",
                 "consumes": [
                     "application/json"
                 ],
@@ -1061,17 +1188,17 @@ const docTemplatenotificationservice = `{
                     "application/json"
                 ],
                 "tags": [
-                    "rule"
+                    "mattermost-channel"
                 ],
-                "summary": "Create Rule",
+                "summary": "Create Mattermost Channel",
                 "parameters": [
                     {
-                        "description": "new rule",
-                        "name": "rule",
+                        "description": "Mattermost channel to add",
+                        "name": "MattermostChannel",
                         "in": "body",
                         "required": true,
                         "schema": {
-                            "$ref": "#/definitions/models.Rule"
+                            "$ref": "#/definitions/mattermostdto.MattermostNotificationChannelRequest"
                         }
                     }
                 ],
@@ -1079,50 +1206,38 @@ const docTemplatenotificationservice = `{
                     "201": {
                         "description": "Created",
                         "schema": {
-                            "$ref": "#/definitions/models.Rule"
-                        },
-                        "headers": {
-                            "api-version": {
-                                "type": "string",
-                                "description": "API version"
-                            }
+                            "$ref": "#/definitions/mattermostdto.MattermostNotificationChannelRequest"
                         }
                     },
                     "400": {
                         "description": "Bad Request",
                         "schema": {
-                            "$ref": "#/definitions/errorResponses.ErrorResponse"
-                        },
-                        "headers": {
-                            "api-version": {
-                                "type": "string",
-                                "description": "API version"
+                            "type": "object",
+                            "additionalProperties": {
+                                "type": "string"
                             }
                         }
                     },
-                    "422": {
-                        "description": "Unprocessable Entity",
+                    "500": {
+                        "description": "Internal Server Error",
                         "schema": {
-                            "$ref": "#/definitions/errorResponses.ErrorResponse"
-                        },
-                        "headers": {
-                            "api-version": {
-                                "type": "string",
-                                "description": "API version"
+                            "type": "object",
+                            "additionalProperties": {
+                                "type": "string"
                             }
                         }
                     }
                 }
             }
         },
-        "/rules/ruleoptions": {
-            "get": {
+        "/notification-channel/mattermost/check": {
+            "post": {
                 "security": [
                     {
                         "KeycloakAuth": []
                     }
                 ],
-                "description": "Returns all required options to create a new alert rule",
+                "description": "Check if a mattermost server is able to send a test message",
                 "consumes": [
                     "application/json"
                 ],
@@ -1130,33 +1245,33 @@ const docTemplatenotificationservice = `{
                     "application/json"
                 ],
                 "tags": [
-                    "rule"
+                    "mattermost-channel"
                 ],
-                "summary": "Options to create a new alert rule",
+                "summary": "Check mattermost server",
                 "responses": {
-                    "200": {
-                        "description": "OK",
+                    "204": {
+                        "description": "Mattermost server test message sent successfully"
+                    },
+                    "400": {
+                        "description": "Bad Request",
                         "schema": {
-                            "$ref": "#/definitions/models.RuleOptions"
-                        },
-                        "headers": {
-                            "api-version": {
-                                "type": "string",
-                                "description": "API version"
+                            "type": "object",
+                            "additionalProperties": {
+                                "type": "string"
                             }
                         }
                     }
                 }
             }
         },
-        "/rules/{id}": {
+        "/notification-channel/mattermost/{id}": {
             "put": {
                 "security": [
                     {
                         "KeycloakAuth": []
                     }
                 ],
-                "description": "Update/replace a rule.",
+                "description": "Update an existing mattermost notification channel",
                 "consumes": [
                     "application/json"
                 ],
@@ -1164,24 +1279,24 @@ const docTemplatenotificationservice = `{
                     "application/json"
                 ],
                 "tags": [
-                    "rule"
+                    "mattermost-channel"
                 ],
-                "summary": "Update Rule",
+                "summary": "Update Mattermost Channel",
                 "parameters": [
                     {
                         "type": "string",
-                        "description": "unique ID of the rule",
+                        "description": "Mattermost channel ID",
                         "name": "id",
                         "in": "path",
                         "required": true
                     },
                     {
-                        "description": "updated rule",
-                        "name": "rule",
+                        "description": "Mattermost channel to update",
+                        "name": "MattermostChannel",
                         "in": "body",
                         "required": true,
                         "schema": {
-                            "$ref": "#/definitions/models.Rule"
+                            "$ref": "#/definitions/mattermostdto.MattermostNotificationChannelRequest"
                         }
                     }
                 ],
@@ -1189,36 +1304,33 @@ const docTemplatenotificationservice = `{
                     "200": {
                         "description": "OK",
                         "schema": {
-                            "$ref": "#/definitions/models.Rule"
-                        },
-                        "headers": {
-                            "api-version": {
-                                "type": "string",
-                                "description": "API version"
-                            }
+                            "$ref": "#/definitions/mattermostdto.MattermostNotificationChannelRequest"
                         }
                     },
                     "400": {
                         "description": "Bad Request",
                         "schema": {
-                            "$ref": "#/definitions/errorResponses.ErrorResponse"
-                        },
-                        "headers": {
-                            "api-version": {
-                                "type": "string",
-                                "description": "API version"
+                            "type": "object",
+                            "additionalProperties": {
+                                "type": "string"
                             }
                         }
                     },
                     "404": {
                         "description": "Not Found",
                         "schema": {
-                            "$ref": "#/definitions/errorResponses.ErrorResponse"
-                        },
-                        "headers": {
-                            "api-version": {
-                                "type": "string",
-                                "description": "API version"
+                            "type": "object",
+                            "additionalProperties": {
+                                "type": "string"
+                            }
+                        }
+                    },
+                    "500": {
+                        "description": "Internal Server Error",
+                        "schema": {
+                            "type": "object",
+                            "additionalProperties": {
+                                "type": "string"
                             }
                         }
                     }
@@ -1230,15 +1342,15 @@ const docTemplatenotificationservice = `{
                         "KeycloakAuth": []
                     }
                 ],
-                "description": "Delete a rule.",
+                "description": "Delete a mattermost notification channel",
                 "tags": [
-                    "rule"
+                    "mattermost-channel"
                 ],
-                "summary": "Delete Rule",
+                "summary": "Delete Mattermost Channel",
                 "parameters": [
                     {
                         "type": "string",
-                        "description": "unique ID of the rule",
+                        "description": "Mattermost channel ID",
                         "name": "id",
                         "in": "path",
                         "required": true
@@ -1246,136 +1358,2581 @@ const docTemplatenotificationservice = `{
                 ],
                 "responses": {
                     "204": {
-                        "description": "deleted",
-                        "headers": {
-                            "api-version": {
-                                "type": "string",
-                                "description": "API version"
+                        "description": "Deleted successfully"
+                    },
+                    "404": {
+                        "description": "Not Found",
+                        "schema": {
+                            "type": "object",
+                            "additionalProperties": {
+                                "type": "string"
                             }
                         }
                     },
-                    "400": {
-                        "description": "invalid id",
+                    "500": {
+                        "description": "Internal Server Error",
                         "schema": {
-                            "$ref": "#/definitions/errorResponses.ErrorResponse"
-                        },
-                        "headers": {
-                            "api-version": {
-                                "type": "string",
-                                "description": "API version"
+                            "type": "object",
+                            "additionalProperties": {
+                                "type": "string"
                             }
                         }
                     }
                 }
             }
         },
-        "/rulse/{id}": {
+        "/notification-channel/push": {
             "get": {
                 "security": [
                     {
                         "KeycloakAuth": []
                     }
                 ],
-                "description": "Returns the rule",
+                "description": "List push notification channels",
                 "produces": [
                     "application/json"
                 ],
                 "tags": [
-                    "rule"
+                    "push-channel"
                 ],
-                "summary": "Get a rule by id",
+                "summary": "List Push Channels",
+                "responses": {
+                    "200": {
+                        "description": "OK",
+                        "schema": {
+                            "type": "array",
+                            "items": {
+                                "$ref": "#/definitions/pushdto.PushNotificationChannelResponse"
+                            }
+                        }
+                    },
+                    "500": {
+                        "description": "Internal Server Error",
+                        "schema": {
+                            "type": "object",
+                            "additionalProperties": {
+                                "type": "string"
+                            }
+                        }
+                    }
+                }
+            },
+            "post": {
+                "security": [
+                    {
+                        "KeycloakAuth": []
+                    }
+                ],
+                "description": "Create a new push notification channel for a ntfy or Gotify server",
+                "consumes": [
+                    "application/json"
+                ],
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "push-channel"
+                ],
+                "summary": "Create Push Channel",
                 "parameters": [
                     {
-                        "type": "string",
-                        "description": "unique id of the rule",
-                        "name": "id",
-                        "in": "path",
-                        "required": true
+                        "description": "Push channel to add",
+                        "name": "PushChannel",
+                        "in": "body",
+                        "required": true,
+                        "schema": {
+                            "$ref": "#/definitions/pushdto.PushNotificationChannelRequest"
+                        }
                     }
                 ],
                 "responses": {
-                    "200": {
-                        "description": "OK",
+                    "201": {
+                        "description": "Created",
                         "schema": {
-                            "$ref": "#/definitions/models.Rule"
-                        },
-                        "headers": {
-                            "api-version": {
-                                "type": "string",
-                                "description": "API version"
-                            }
+                            "$ref": "#/definitions/pushdto.PushNotificationChannelResponse"
                         }
                     },
                     "400": {
-                        "description": "invalid id",
+                        "description": "Bad Request",
                         "schema": {
-                            "$ref": "#/definitions/errorResponses.ErrorResponse"
-                        },
-                        "headers": {
-                            "api-version": {
-                                "type": "string",
-                                "description": "API version"
+                            "type": "object",
+                            "additionalProperties": {
+                                "type": "string"
                             }
                         }
                     },
-                    "404": {
-                        "description": "Not Found",
+                    "500": {
+                        "description": "Internal Server Error",
                         "schema": {
-                            "$ref": "#/definitions/errorResponses.ErrorResponse"
-                        },
-                        "headers": {
-                            "api-version": {
-                                "type": "string",
-                                "description": "API version"
+                            "type": "object",
+                            "additionalProperties": {
+                                "type": "string"
                             }
                         }
                     }
                 }
             }
-        }
-    },
-    "definitions": {
-        "errorResponses.ErrorResponse": {
-            "type": "object",
-            "properties": {
-                "details": {
-                    "type": "string"
-                },
-                "errors": {
-                    "type": "object",
-                    "additionalProperties": {
-                        "type": "string"
+        },
+        "/notification-channel/push/check": {
+            "post": {
+                "security": [
+                    {
+                        "KeycloakAuth": []
+                    }
+                ],
+                "description": "Check if a test message can be published to the ntfy or Gotify server",
+                "consumes": [
+                    "application/json"
+                ],
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "push-channel"
+                ],
+                "summary": "Check push server",
+                "parameters": [
+                    {
+                        "description": "Push server to check",
+                        "name": "PushChannel",
+                        "in": "body",
+                        "required": true,
+                        "schema": {
+                            "$ref": "#/definitions/pushdto.PushNotificationChannelCheckRequest"
+                        }
+                    }
+                ],
+                "responses": {
+                    "204": {
+                        "description": "Push test message sent successfully"
+                    },
+                    "400": {
+                        "description": "Bad Request",
+                        "schema": {
+                            "type": "object",
+                            "additionalProperties": {
+                                "type": "string"
+                            }
+                        }
                     }
-                },
-                "title": {
-                    "type": "string"
-                },
-                "type": {
-                    "type": "string"
                 }
             }
         },
-        "filter.CompareOperator": {
-            "type": "string",
-            "enum": [
-                "beginsWith",
-                "doesNotBeginWith",
-                "contains",
-                "doesNotContain",
-                "textContains",
-                "isNumberEqualTo",
-                "isNumberNotEqualTo",
-                "isEqualTo",
-                "isNotEqualTo",
-                "isIpEqualTo",
-                "isIpNotEqualTo",
-                "isStringEqualTo",
-                "isStringNotEqualTo",
-                "isStringCaseInsensitiveEqualTo",
-                "isGreaterThan",
-                "isGreaterThanOrEqualTo",
-                "isLessThan",
-                "isLessThanOrEqualTo",
+        "/notification-channel/push/{id}": {
+            "put": {
+                "security": [
+                    {
+                        "KeycloakAuth": []
+                    }
+                ],
+                "description": "Update an existing push notification channel",
+                "consumes": [
+                    "application/json"
+                ],
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "push-channel"
+                ],
+                "summary": "Update Push Channel",
+                "parameters": [
+                    {
+                        "type": "string",
+                        "description": "Push channel ID",
+                        "name": "id",
+                        "in": "path",
+                        "required": true
+                    },
+                    {
+                        "description": "Push channel to update",
+                        "name": "PushChannel",
+                        "in": "body",
+                        "required": true,
+                        "schema": {
+                            "$ref": "#/definitions/pushdto.PushNotificationChannelRequest"
+                        }
+                    }
+                ],
+                "responses": {
+                    "200": {
+                        "description": "OK",
+                        "schema": {
+                            "$ref": "#/definitions/pushdto.PushNotificationChannelResponse"
+                        }
+                    },
+                    "400": {
+                        "description": "Bad Request",
+                        "schema": {
+                            "type": "object",
+                            "additionalProperties": {
+                                "type": "string"
+                            }
+                        }
+                    },
+                    "404": {
+                        "description": "Not Found",
+                        "schema": {
+                            "type": "object",
+                            "additionalProperties": {
+                                "type": "string"
+                            }
+                        }
+                    },
+                    "500": {
+                        "description": "Internal Server Error",
+                        "schema": {
+                            "type": "object",
+                            "additionalProperties": {
+                                "type": "string"
+                            }
+                        }
+                    }
+                }
+            },
+            "delete": {
+                "security": [
+                    {
+                        "KeycloakAuth": []
+                    }
+                ],
+                "description": "Delete a push notification channel",
+                "tags": [
+                    "push-channel"
+                ],
+                "summary": "Delete Push Channel",
+                "parameters": [
+                    {
+                        "type": "string",
+                        "description": "Push channel ID",
+                        "name": "id",
+                        "in": "path",
+                        "required": true
+                    }
+                ],
+                "responses": {
+                    "204": {
+                        "description": "Deleted successfully"
+                    },
+                    "404": {
+                        "description": "Not Found",
+                        "schema": {
+                            "type": "object",
+                            "additionalProperties": {
+                                "type": "string"
+                            }
+                        }
+                    },
+                    "500": {
+                        "description": "Internal Server Error",
+                        "schema": {
+                            "type": "object",
+                            "additionalProperties": {
+                                "type": "string"
+                            }
+                        }
+                    }
+                }
+            }
+        },
+        "/notification-channel/slack": {
+            "get": {
+                "security": [
+                    {
+                        "KeycloakAuth": []
+                    }
+                ],
+                "description": "List slack notification channels",
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "slack-channel"
+                ],
+                "summary": "List Slack Channels",
+                "responses": {
+                    "200": {
+                        "description": "OK",
+                        "schema": {
+                            "type": "array",
+                            "items": {
+                                "$ref": "#/definitions/slackdto.SlackNotificationChannelRequest"
+                            }
+                        }
+                    },
+                    "500": {
+                        "description": "Internal Server Error",
+                        "schema": {
+                            "type": "object",
+                            "additionalProperties": {
+                                "type": "string"
+                            }
+                        }
+                    }
+                }
+            },
+            "post": {
+                "security": [
+                    {
+                        "KeycloakAuth": []
+                    }
+                ],
+                "description": "Create a new slack notification channel",
+                "consumes": [
+                    "application/json"
+                ],
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "slack-channel"
+                ],
+                "summary": "Create Slack Channel",
+                "parameters": [
+                    {
+                        "description": "Slack channel to add",
+                        "name": "SlackChannel",
+                        "in": "body",
+                        "required": true,
+                        "schema": {
+                            "$ref": "#/definitions/slackdto.SlackNotificationChannelRequest"
+                        }
+                    }
+                ],
+                "responses": {
+                    "201": {
+                        "description": "Created",
+                        "schema": {
+                            "$ref": "#/definitions/slackdto.SlackNotificationChannelRequest"
+                        }
+                    },
+                    "400": {
+                        "description": "Bad Request",
+                        "schema": {
+                            "type": "object",
+                            "additionalProperties": {
+                                "type": "string"
+                            }
+                        }
+                    },
+                    "500": {
+                        "description": "Internal Server Error",
+                        "schema": {
+                            "type": "object",
+                            "additionalProperties": {
+                                "type": "string"
+                            }
+                        }
+                    }
+                }
+            }
+        },
+        "/notification-channel/slack/check": {
+            "post": {
+                "security": [
+                    {
+                        "KeycloakAuth": []
+                    }
+                ],
+                "description": "Check if a test message can be sent to the slack webhook",
+                "consumes": [
+                    "application/json"
+                ],
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "slack-channel"
+                ],
+                "summary": "Check slack webhook",
+                "responses": {
+                    "204": {
+                        "description": "Slack test message sent successfully"
+                    },
+                    "400": {
+                        "description": "Bad Request",
+                        "schema": {
+                            "type": "object",
+                            "additionalProperties": {
+                                "type": "string"
+                            }
+                        }
+                    }
+                }
+            }
+        },
+        "/notification-channel/slack/{id}": {
+            "put": {
+                "security": [
+                    {
+                        "KeycloakAuth": []
+                    }
+                ],
+                "description": "Update an existing slack notification channel",
+                "consumes": [
+                    "application/json"
+                ],
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "slack-channel"
+                ],
+                "summary": "Update Slack Channel",
+                "parameters": [
+                    {
+                        "type": "string",
+                        "description": "Slack channel ID",
+                        "name": "id",
+                        "in": "path",
+                        "required": true
+                    },
+                    {
+                        "description": "Slack channel to update",
+                        "name": "SlackChannel",
+                        "in": "body",
+                        "required": true,
+                        "schema": {
+                            "$ref": "#/definitions/slackdto.SlackNotificationChannelRequest"
+                        }
+                    }
+                ],
+                "responses": {
+                    "200": {
+                        "description": "OK",
+                        "schema": {
+                            "$ref": "#/definitions/slackdto.SlackNotificationChannelRequest"
+                        }
+                    },
+                    "400": {
+                        "description": "Bad Request",
+                        "schema": {
+                            "type": "object",
+                            "additionalProperties": {
+                                "type": "string"
+                            }
+                        }
+                    },
+                    "404": {
+                        "description": "Not Found",
+                        "schema": {
+                            "type": "object",
+                            "additionalProperties": {
+                                "type": "string"
+                            }
+                        }
+                    },
+                    "500": {
+                        "description": "Internal Server Error",
+                        "schema": {
+                            "type": "object",
+                            "additionalProperties": {
+                                "type": "string"
+                            }
+                        }
+                    }
+                }
+            },
+            "delete": {
+                "security": [
+                    {
+                        "KeycloakAuth": []
+                    }
+                ],
+                "description": "Delete a slack notification channel",
+                "tags": [
+                    "slack-channel"
+                ],
+                "summary": "Delete Slack Channel",
+                "parameters": [
+                    {
+                        "type": "string",
+                        "description": "Slack channel ID",
+                        "name": "id",
+                        "in": "path",
+                        "required": true
+                    }
+                ],
+                "responses": {
+                    "204": {
+                        "description": "Deleted successfully"
+                    },
+                    "404": {
+                        "description": "Not Found",
+                        "schema": {
+                            "type": "object",
+                            "additionalProperties": {
+                                "type": "string"
+                            }
+                        }
+                    },
+                    "500": {
+                        "description": "Internal Server Error",
+                        "schema": {
+                            "type": "object",
+                            "additionalProperties": {
+                                "type": "string"
+                            }
+                        }
+                    }
+                }
+            }
+        },
+        "/notification-channel/syslog": {
+            "get": {
+                "security": [
+                    {
+                        "KeycloakAuth": []
+                    }
+                ],
+                "description": "List syslog notification channels",
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "syslog-channel"
+                ],
+                "summary": "List Syslog Channels",
+                "responses": {
+                    "200": {
+                        "description": "OK",
+                        "schema": {
+                            "type": "array",
+                            "items": {
+                                "$ref": "#/definitions/syslogdto.SyslogNotificationChannelResponse"
+                            }
+                        }
+                    },
+                    "500": {
+                        "description": "Internal Server Error",
+                        "schema": {
+                            "type": "object",
+                            "additionalProperties": {
+                                "type": "string"
+                            }
+                        }
+                    }
+                }
+            },
+            "post": {
+                "security": [
+                    {
+                        "KeycloakAuth": []
+                    }
+                ],
+                "description": "Create a new syslog notification channel",
+                "consumes": [
+                    "application/json"
+                ],
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "syslog-channel"
+                ],
+                "summary": "Create Syslog Channel",
+                "parameters": [
+                    {
+                        "description": "Syslog channel to add",
+                        "name": "SyslogChannel",
+                        "in": "body",
+                        "required": true,
+                        "schema": {
+                            "$ref": "#/definitions/syslogdto.SyslogNotificationChannelRequest"
+                        }
+                    }
+                ],
+                "responses": {
+                    "201": {
+                        "description": "Created",
+                        "schema": {
+                            "$ref": "#/definitions/syslogdto.SyslogNotificationChannelResponse"
+                        }
+                    },
+                    "400": {
+                        "description": "Bad Request",
+                        "schema": {
+                            "type": "object",
+                            "additionalProperties": {
+                                "type": "string"
+                            }
+                        }
+                    },
+                    "500": {
+                        "description": "Internal Server Error",
+                        "schema": {
+                            "type": "object",
+                            "additionalProperties": {
+                                "type": "string"
+                            }
+                        }
+                    }
+                }
+            }
+        },
+        "/notification-channel/syslog/check": {
+            "post": {
+                "security": [
+                    {
+                        "KeycloakAuth": []
+                    }
+                ],
+                "description": "Check if a connection to the syslog server can be established with the transport",
+                "consumes": [
+                    "application/json"
+                ],
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "syslog-channel"
+                ],
+                "summary": "Check syslog server",
+                "parameters": [
+                    {
+                        "description": "Syslog server to check",
+                        "name": "SyslogChannel",
+                        "in": "body",
+                        "required": true,
+                        "schema": {
+                            "$ref": "#/definitions/syslogdto.SyslogNotificationChannelCheckRequest"
+                        }
+                    }
+                ],
+                "responses": {
+                    "204": {
+                        "description": "Syslog server is reachable"
+                    },
+                    "400": {
+                        "description": "Bad Request",
+                        "schema": {
+                            "type": "object",
+                            "additionalProperties": {
+                                "type": "string"
+                            }
+                        }
+                    },
+                    "422": {
+                        "description": "Unprocessable Entity",
+                        "schema": {
+                            "type": "object",
+                            "additionalProperties": {
+                                "type": "string"
+                            }
+                        }
+                    }
+                }
+            }
+        },
+        "/notification-channel/syslog/{id}": {
+            "put": {
+                "security": [
+                    {
+                        "KeycloakAuth": []
+                    }
+                ],
+                "description": "Update an existing syslog notification channel",
+                "consumes": [
+                    "application/json"
+                ],
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "syslog-channel"
+                ],
+                "summary": "Update Syslog Channel",
+                "parameters": [
+                    {
+                        "type": "string",
+                        "description": "Syslog channel ID",
+                        "name": "id",
+                        "in": "path",
+                        "required": true
+                    },
+                    {
+                        "description": "Syslog channel to update",
+                        "name": "SyslogChannel",
+                        "in": "body",
+                        "required": true,
+                        "schema": {
+                            "$ref": "#/definitions/syslogdto.SyslogNotificationChannelRequest"
+                        }
+                    }
+                ],
+                "responses": {
+                    "200": {
+                        "description": "OK",
+                        "schema": {
+                            "$ref": "#/definitions/syslogdto.SyslogNotificationChannelResponse"
+                        }
+                    },
+                    "400": {
+                        "description": "Bad Request",
+                        "schema": {
+                            "type": "object",
+                            "additionalProperties": {
+                                "type": "string"
+                            }
+                        }
+                    },
+                    "404": {
+                        "description": "Not Found",
+                        "schema": {
+                            "type": "object",
+                            "additionalProperties": {
+                                "type": "string"
+                            }
+                        }
+                    },
+                    "500": {
+                        "description": "Internal Server Error",
+                        "schema": {
+                            "type": "object",
+                            "additionalProperties": {
+                                "type": "string"
+                            }
+                        }
+                    }
+                }
+            },
+            "delete": {
+                "security": [
+                    {
+                        "KeycloakAuth": []
+                    }
+                ],
+                "description": "Delete a syslog notification channel",
+                "tags": [
+                    "syslog-channel"
+                ],
+                "summary": "Delete Syslog Channel",
+                "parameters": [
+                    {
+                        "type": "string",
+                        "description": "Syslog channel ID",
+                        "name": "id",
+                        "in": "path",
+                        "required": true
+                    }
+                ],
+                "responses": {
+                    "204": {
+                        "description": "Deleted successfully"
+                    },
+                    "404": {
+                        "description": "Not Found",
+                        "schema": {
+                            "type": "object",
+                            "additionalProperties": {
+                                "type": "string"
+                            }
+                        }
+                    },
+                    "500": {
+                        "description": "Internal Server Error",
+                        "schema": {
+                            "type": "object",
+                            "additionalProperties": {
+                                "type": "string"
+                            }
+                        }
+                    }
+                }
+            }
+        },
+        "/notification-channel/teams": {
+            "get": {
+                "security": [
+                    {
+                        "KeycloakAuth": []
+                    }
+                ],
+                "description": "List teams notification channels by type",
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "teams-channel"
+                ],
+                "summary": "List Teams Channels",
+                "parameters": [
+                    {
+                        "type": "string",
+                        "description": "Channel type",
+                        "name": "type",
+                        "in": "query"
+                    }
+                ],
+                "responses": {
+                    "200": {
+                        "description": "OK",
+                        "schema": {
+                            "type": "array",
+                            "items": {
+                                "$ref": "#/definitions/teamsdto.TeamsNotificationChannelRequest"
+                            }
+                        }
+                    },
+                    "500": {
+                        "description": "Internal Server Error",
+                        "schema": {
+                            "type": "object",
+                            "additionalProperties": {
+                                "type": "string"
+                            }
+                        }
+                    }
+                }
+            },
+            "post": {
+                "security": [
+                    {
+                        "KeycloakAuth": []
+                    }
+                ],
+                "description": "Create a new teams notification channel",
+                "consumes": [
+                    "application/json"
+                ],
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "teams-channel"
+                ],
+                "summary": "Create Teams Channel",
+                "parameters": [
+                    {
+                        "description": "Teams channel to add",
+                        "name": "TeamsChannel",
+                        "in": "body",
+                        "required": true,
+                        "schema": {
+                            "$ref": "#/definitions/teamsdto.TeamsNotificationChannelRequest"
+                        }
+                    }
+                ],
+                "responses": {
+                    "201": {
+                        "description": "Created",
+                        "schema": {
+                            "$ref": "#/definitions/teamsdto.TeamsNotificationChannelRequest"
+                        }
+                    },
+                    "400": {
+                        "description": "Bad Request",
+                        "schema": {
+                            "type": "object",
+                            "additionalProperties": {
+                                "type": "string"
+                            }
+                        }
+                    },
+                    "500": {
+                        "description": "Internal Server Error",
+                        "schema": {
+                            "type": "object",
+                            "additionalProperties": {
+                                "type": "string"
+                            }
+                        }
+                    }
+                }
+            }
+        },
+        "/notification-channel/teams/check": {
+            "post": {
+                "security": [
+                    {
+                        "KeycloakAuth": []
+                    }
+                ],
+                "description": "Check if a Teams server is able to send a test message",
+                "consumes": [
+                    "application/json"
+                ],
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "teams-channel"
+                ],
+                "summary": "Check Teams server",
+                "parameters": [
+                    {
+                        "type": "string",
+                        "description": "Teams channel ID",
+                        "name": "id",
+                        "in": "path",
+                        "required": true
+                    }
+                ],
+                "responses": {
+                    "204": {
+                        "description": "Teams server test message sent successfully"
+                    },
+                    "400": {
+                        "description": "Bad Request",
+                        "schema": {
+                            "type": "object",
+                            "additionalProperties": {
+                                "type": "string"
+                            }
+                        }
+                    }
+                }
+            }
+        },
+        "/notification-channel/teams/{id}": {
+            "put": {
+                "security": [
+                    {
+                        "KeycloakAuth": []
+                    }
+                ],
+                "description": "Update an existing teams notification channel",
+                "consumes": [
+                    "application/json"
+                ],
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "teams-channel"
+                ],
+                "summary": "Update Teams Channel",
+                "parameters": [
+                    {
+                        "type": "string",
+                        "description": "Teams channel ID",
+                        "name": "id",
+                        "in": "path",
+                        "required": true
+                    },
+                    {
+                        "description": "Teams channel to update",
+                        "name": "TeamsChannel",
+                        "in": "body",
+                        "required": true,
+                        "schema": {
+                            "$ref": "#/definitions/teamsdto.TeamsNotificationChannelRequest"
+                        }
+                    }
+                ],
+                "responses": {
+                    "200": {
+                        "description": "OK",
+                        "schema": {
+                            "$ref": "#/definitions/teamsdto.TeamsNotificationChannelRequest"
+                        }
+                    },
+                    "400": {
+                        "description": "Bad Request",
+                        "schema": {
+                            "type": "object",
+                            "additionalProperties": {
+                                "type": "string"
+                            }
+                        }
+                    },
+                    "404": {
+                        "description": "Not Found",
+                        "schema": {
+                            "type": "object",
+                            "additionalProperties": {
+                                "type": "string"
+                            }
+                        }
+                    },
+                    "500": {
+                        "description": "Internal Server Error",
+                        "schema": {
+                            "type": "object",
+                            "additionalProperties": {
+                                "type": "string"
+                            }
+                        }
+                    }
+                }
+            },
+            "delete": {
+                "security": [
+                    {
+                        "KeycloakAuth": []
+                    }
+                ],
+                "description": "Delete a teams notification channel",
+                "tags": [
+                    "teams-channel"
+                ],
+                "summary": "Delete Teams Channel",
+                "parameters": [
+                    {
+                        "type": "string",
+                        "description": "Teams channel ID",
+                        "name": "id",
+                        "in": "path",
+                        "required": true
+                    }
+                ],
+                "responses": {
+                    "204": {
+                        "description": "Deleted successfully"
+                    },
+                    "404": {
+                        "description": "Not Found",
+                        "schema": {
+                            "type": "object",
+                            "additionalProperties": {
+                                "type": "string"
+                            }
+                        }
+                    },
+                    "500": {
+                        "description": "Internal Server Error",
+                        "schema": {
+                            "type": "object",
+                            "additionalProperties": {
+                                "type": "string"
+                            }
+                        }
+                    }
+                }
+            }
+        },
+        "/notification-channel/webhook": {
+            "get": {
+                "security": [
+                    {
+                        "KeycloakAuth": []
+                    }
+                ],
+                "description": "List generic webhook notification channels",
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "webhook-channel"
+                ],
+                "summary": "List Webhook Channels",
+                "responses": {
+                    "200": {
+                        "description": "OK",
+                        "schema": {
+                            "type": "array",
+                            "items": {
+                                "$ref": "#/definitions/webhookdto.WebhookNotificationChannelResponse"
+                            }
+                        }
+                    },
+                    "500": {
+                        "description": "Internal Server Error",
+                        "schema": {
+                            "type": "object",
+                            "additionalProperties": {
+                                "type": "string"
+                            }
+                        }
+                    }
+                }
+            },
+            "post": {
+                "security": [
+                    {
+                        "KeycloakAuth": []
+                    }
+                ],
+                "description": "Create a new generic webhook notification channel",
+                "consumes": [
+                    "application/json"
+                ],
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "webhook-channel"
+                ],
+                "summary": "Create Webhook Channel",
+                "parameters": [
+                    {
+                        "description": "Webhook channel to add",
+                        "name": "WebhookChannel",
+                        "in": "body",
+                        "required": true,
+                        "schema": {
+                            "$ref": "#/definitions/webhookdto.WebhookNotificationChannelRequest"
+                        }
+                    }
+                ],
+                "responses": {
+                    "201": {
+                        "description": "Created",
+                        "schema": {
+                            "$ref": "#/definitions/webhookdto.WebhookNotificationChannelResponse"
+                        }
+                    },
+                    "400": {
+                        "description": "Bad Request",
+                        "schema": {
+                            "type": "object",
+                            "additionalProperties": {
+                                "type": "string"
+                            }
+                        }
+                    },
+                    "500": {
+                        "description": "Internal Server Error",
+                        "schema": {
+                            "type": "object",
+                            "additionalProperties": {
+                                "type": "string"
+                            }
+                        }
+                    }
+                }
+            }
+        },
+        "/notification-channel/webhook/check": {
+            "post": {
+                "security": [
+                    {
+                        "KeycloakAuth": []
+                    }
+                ],
+                "description": "Check if the sample notification can be sent to the generic webhook",
+                "consumes": [
+                    "application/json"
+                ],
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "webhook-channel"
+                ],
+                "summary": "Check generic webhook",
+                "parameters": [
+                    {
+                        "description": "Webhook to check",
+                        "name": "WebhookChannel",
+                        "in": "body",
+                        "required": true,
+                        "schema": {
+                            "$ref": "#/definitions/webhookdto.WebhookNotificationChannelCheckRequest"
+                        }
+                    }
+                ],
+                "responses": {
+                    "204": {
+                        "description": "Webhook test message sent successfully"
+                    },
+                    "400": {
+                        "description": "Bad Request",
+                        "schema": {
+                            "type": "object",
+                            "additionalProperties": {
+                                "type": "string"
+                            }
+                        }
+                    }
+                }
+            }
+        },
+        "/notification-channel/webhook/{id}": {
+            "put": {
+                "security": [
+                    {
+                        "KeycloakAuth": []
+                    }
+                ],
+                "description": "Update an existing generic webhook notification channel",
+                "consumes": [
+                    "application/json"
+                ],
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "webhook-channel"
+                ],
+                "summary": "Update Webhook Channel",
+                "parameters": [
+                    {
+                        "type": "string",
+                        "description": "Webhook channel ID",
+                        "name": "id",
+                        "in": "path",
+                        "required": true
+                    },
+                    {
+                        "description": "Webhook channel to update",
+                        "name": "WebhookChannel",
+                        "in": "body",
+                        "required": true,
+                        "schema": {
+                            "$ref": "#/definitions/webhookdto.WebhookNotificationChannelRequest"
+                        }
+                    }
+                ],
+                "responses": {
+                    "200": {
+                        "description": "OK",
+                        "schema": {
+                            "$ref": "#/definitions/webhookdto.WebhookNotificationChannelResponse"
+                        }
+                    },
+                    "400": {
+                        "description": "Bad Request",
+                        "schema": {
+                            "type": "object",
+                            "additionalProperties": {
+                                "type": "string"
+                            }
+                        }
+                    },
+                    "404": {
+                        "description": "Not Found",
+                        "schema": {
+                            "type": "object",
+                            "additionalProperties": {
+                                "type": "string"
+                            }
+                        }
+                    },
+                    "500": {
+                        "description": "Internal Server Error",
+                        "schema": {
+                            "type": "object",
+                            "additionalProperties": {
+                                "type": "string"
+                            }
+                        }
+                    }
+                }
+            },
+            "delete": {
+                "security": [
+                    {
+                        "KeycloakAuth": []
+                    }
+                ],
+                "description": "Delete a generic webhook notification channel",
+                "tags": [
+                    "webhook-channel"
+                ],
+                "summary": "Delete Webhook Channel",
+                "parameters": [
+                    {
+                        "type": "string",
+                        "description": "Webhook channel ID",
+                        "name": "id",
+                        "in": "path",
+                        "required": true
+                    }
+                ],
+                "responses": {
+                    "204": {
+                        "description": "Deleted successfully"
+                    },
+                    "404": {
+                        "description": "Not Found",
+                        "schema": {
+                            "type": "object",
+                            "additionalProperties": {
+                                "type": "string"
+                            }
+                        }
+                    },
+                    "500": {
+                        "description": "Internal Server Error",
+                        "schema": {
+                            "type": "object",
+                            "additionalProperties": {
+                                "type": "string"
+                            }
+                        }
+                    }
+                }
+            }
+        },
+        "/notifications": {
+            "put": {
+                "security": [
+                    {
+                        "KeycloakAuth": []
+                    }
+                ],
+                "description": "Returns a list of notifications matching the provided filters, together with their state for the current user",
+                "consumes": [
+                    "application/json"
+                ],
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "notification"
+                ],
+                "summary": "List Notifications",
+                "parameters": [
+                    {
+                        "description": "filters, paging and sorting",
+                        "name": "MatchCriterias",
+                        "in": "body",
+                        "required": true,
+                        "schema": {
+                            "$ref": "#/definitions/query.ResultSelector"
+                        }
+                    }
+                ],
+                "responses": {
+                    "200": {
+                        "description": "OK",
+                        "schema": {
+                            "$ref": "#/definitions/query.ResponseListWithMetadata-models_Notification"
+                        },
+                        "headers": {
+                            "api-version": {
+                                "type": "string",
+                                "description": "API version"
+                            }
+                        }
+                    }
+                }
+            },
+            "post": {
+                "security": [
+                    {
+                        "KeycloakAuth": []
+                    }
+                ],
+                "description": "Create a new notification. It is stored by the notification service together with the actions it triggers, like sending mails, depending on the configured rules. If the rules can not be evaluated, the notification is not stored and an error is returned, the request can be retried.\nA request can be safely retried by passing an idempotency key, either as header or in the body. If the calling service already created a notification with the same key within the idempotency window, the original notification is returned with status 200 instead of creating it again.",
+                "consumes": [
+                    "application/json"
+                ],
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "notification"
+                ],
+                "summary": "Create Notification",
+                "parameters": [
+                    {
+                        "type": "string",
+                        "description": "unique key of the request, takes precedence over the ` + "`" + `idempotencyKey` + "`" + ` field",
+                        "name": "Idempotency-Key",
+                        "in": "header"
+                    },
+                    {
+                        "description": "notification to add",
+                        "name": "Notification",
+                        "in": "body",
+                        "required": true,
+                        "schema": {
+                            "$ref": "#/definitions/models.Notification"
+                        }
+                    }
+                ],
+                "responses": {
+                    "200": {
+                        "description": "repeated request, the notification was created before",
+                        "schema": {
+                            "$ref": "#/definitions/query.ResponseWithMetadata-models_Notification"
+                        },
+                        "headers": {
+                            "api-version": {
+                                "type": "string",
+                                "description": "API version"
+                            }
+                        }
+                    },
+                    "201": {
+                        "description": "Created",
+                        "schema": {
+                            "$ref": "#/definitions/query.ResponseWithMetadata-models_Notification"
+                        },
+                        "headers": {
+                            "api-version": {
+                                "type": "string",
+                                "description": "API version"
+                            }
+                        }
+                    },
+                    "400": {
+                        "description": "invalid notification or idempotency key",
+                        "schema": {
+                            "$ref": "#/definitions/errorResponses.ErrorResponse"
+                        },
+                        "headers": {
+                            "api-version": {
+                                "type": "string",
+                                "description": "API version"
+                            }
+                        }
+                    },
+                    "500": {
+                        "description": "the notification could not be stored or the rules could not be evaluated, nothing was stored",
+                        "schema": {
+                            "$ref": "#/definitions/errorResponses.ErrorResponse"
+                        },
+                        "headers": {
+                            "api-version": {
+                                "type": "string",
+                                "description": "API version"
+                            }
+                        }
+                    },
+                    "503": {
+                        "description": "too many notifications are processed at the moment, try again later",
+                        "schema": {
+                            "$ref": "#/definitions/errorResponses.ErrorResponse"
+                        },
+                        "headers": {
+                            "api-version": {
+                                "type": "string",
+                                "description": "API version"
+                            }
+                        }
+                    }
+                }
+            }
+        },
+        "/notifications/options": {
+            "get": {
+                "security": [
+                    {
+                        "KeycloakAuth": []
+                    }
+                ],
+                "description": "Get filter options for listing notifications",
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "notification"
+                ],
+                "summary": "Notification filter options",
+                "responses": {
+                    "200": {
+                        "description": "OK",
+                        "schema": {
+                            "$ref": "#/definitions/query.ResponseWithMetadata-array_query_FilterOption"
+                        },
+                        "headers": {
+                            "api-version": {
+                                "type": "string",
+                                "description": "API version"
+                            }
+                        }
+                    }
+                }
+            }
+        },
+        "/notifications/read": {
+            "post": {
+                "security": [
+                    {
+                        "KeycloakAuth": []
+                    }
+                ],
+                "description": "Marks all notifications as read by the current user.",
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "notification"
+                ],
+                "summary": "Mark all Notifications as read",
+                "responses": {
+                    "200": {
+                        "description": "OK",
+                        "schema": {
+                            "$ref": "#/definitions/query.ResponseWithMetadata-notificationcontroller_MarkAllReadResponse"
+                        },
+                        "headers": {
+                            "api-version": {
+                                "type": "string",
+                                "description": "API version"
+                            }
+                        }
+                    }
+                }
+            }
+        },
+        "/notifications/unread-count": {
+            "get": {
+                "security": [
+                    {
+                        "KeycloakAuth": []
+                    }
+                ],
+                "description": "Returns the number of notifications which are not read by the current user, e.g. for a badge in the frontend.",
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "notification"
+                ],
+                "summary": "Unread Notifications count",
+                "responses": {
+                    "200": {
+                        "description": "OK",
+                        "schema": {
+                            "$ref": "#/definitions/query.ResponseWithMetadata-notificationcontroller_UnreadCountResponse"
+                        },
+                        "headers": {
+                            "api-version": {
+                                "type": "string",
+                                "description": "API version"
+                            }
+                        }
+                    }
+                }
+            }
+        },
+        "/notifications/{id}/acknowledge": {
+            "post": {
+                "security": [
+                    {
+                        "KeycloakAuth": []
+                    }
+                ],
+                "description": "Mark a notification as acknowledged by the current user. This stops the escalation of an urgent notification. Acknowledging a notification again keeps the first acknowledgement.",
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "notification"
+                ],
+                "summary": "Acknowledge Notification",
+                "parameters": [
+                    {
+                        "type": "string",
+                        "description": "unique ID of the notification",
+                        "name": "id",
+                        "in": "path",
+                        "required": true
+                    }
+                ],
+                "responses": {
+                    "200": {
+                        "description": "OK",
+                        "schema": {
+                            "$ref": "#/definitions/query.ResponseWithMetadata-models_Notification"
+                        },
+                        "headers": {
+                            "api-version": {
+                                "type": "string",
+                                "description": "API version"
+                            }
+                        }
+                    },
+                    "400": {
+                        "description": "invalid ID",
+                        "schema": {
+                            "$ref": "#/definitions/errorResponses.ErrorResponse"
+                        },
+                        "headers": {
+                            "api-version": {
+                                "type": "string",
+                                "description": "API version"
+                            }
+                        }
+                    },
+                    "404": {
+                        "description": "notification not found",
+                        "schema": {
+                            "$ref": "#/definitions/errorResponses.ErrorResponse"
+                        },
+                        "headers": {
+                            "api-version": {
+                                "type": "string",
+                                "description": "API version"
+                            }
+                        }
+                    }
+                }
+            }
+        },
+        "/notifications/{id}/deliveries": {
+            "get": {
+                "security": [
+                    {
+                        "KeycloakAuth": []
+                    }
+                ],
+                "description": "Returns all attempts to forward the notification to a channel, oldest first",
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "notification"
+                ],
+                "summary": "List deliveries of a notification",
+                "parameters": [
+                    {
+                        "type": "string",
+                        "description": "unique ID of the notification",
+                        "name": "id",
+                        "in": "path",
+                        "required": true
+                    }
+                ],
+                "responses": {
+                    "200": {
+                        "description": "OK",
+                        "schema": {
+                            "$ref": "#/definitions/query.ResponseWithMetadata-array_models_DeliveryAttempt"
+                        },
+                        "headers": {
+                            "api-version": {
+                                "type": "string",
+                                "description": "API version"
+                            }
+                        }
+                    },
+                    "400": {
+                        "description": "invalid id",
+                        "schema": {
+                            "$ref": "#/definitions/errorResponses.ErrorResponse"
+                        },
+                        "headers": {
+                            "api-version": {
+                                "type": "string",
+                                "description": "API version"
+                            }
+                        }
+                    }
+                }
+            }
+        },
+        "/notifications/{id}/dismiss": {
+            "post": {
+                "security": [
+                    {
+                        "KeycloakAuth": []
+                    }
+                ],
+                "description": "Marks the notification as dismissed by the current user, e.g. to hide it in the frontend. A dismissed notification is also read.",
+                "tags": [
+                    "notification"
+                ],
+                "summary": "Dismiss Notification",
+                "parameters": [
+                    {
+                        "type": "string",
+                        "description": "unique ID of the notification",
+                        "name": "id",
+                        "in": "path",
+                        "required": true
+                    }
+                ],
+                "responses": {
+                    "204": {
+                        "description": "notification dismissed",
+                        "headers": {
+                            "api-version": {
+                                "type": "string",
+                                "description": "API version"
+                            }
+                        }
+                    },
+                    "400": {
+                        "description": "invalid ID",
+                        "schema": {
+                            "$ref": "#/definitions/errorResponses.ErrorResponse"
+                        },
+                        "headers": {
+                            "api-version": {
+                                "type": "string",
+                                "description": "API version"
+                            }
+                        }
+                    },
+                    "404": {
+                        "description": "notification not found",
+                        "schema": {
+                            "$ref": "#/definitions/errorResponses.ErrorResponse"
+                        },
+                        "headers": {
+                            "api-version": {
+                                "type": "string",
+                                "description": "API version"
+                            }
+                        }
+                    }
+                }
+            }
+        },
+        "/notifications/{id}/read": {
+            "post": {
+                "security": [
+                    {
+                        "KeycloakAuth": []
+                    }
+                ],
+                "description": "Marks the notification as read by the current user. Marking it again keeps the time it was read first.",
+                "tags": [
+                    "notification"
+                ],
+                "summary": "Mark Notification as read",
+                "parameters": [
+                    {
+                        "type": "string",
+                        "description": "unique ID of the notification",
+                        "name": "id",
+                        "in": "path",
+                        "required": true
+                    }
+                ],
+                "responses": {
+                    "204": {
+                        "description": "notification marked as read",
+                        "headers": {
+                            "api-version": {
+                                "type": "string",
+                                "description": "API version"
+                            }
+                        }
+                    },
+                    "400": {
+                        "description": "invalid ID",
+                        "schema": {
+                            "$ref": "#/definitions/errorResponses.ErrorResponse"
+                        },
+                        "headers": {
+                            "api-version": {
+                                "type": "string",
+                                "description": "API version"
+                            }
+                        }
+                    },
+                    "404": {
+                        "description": "notification not found",
+                        "schema": {
+                            "$ref": "#/definitions/errorResponses.ErrorResponse"
+                        },
+                        "headers": {
+                            "api-version": {
+                                "type": "string",
+                                "description": "API version"
+                            }
+                        }
+                    }
+                }
+            }
+        },
+        "/notifications/{id}/resend": {
+            "post": {
+                "security": [
+                    {
+                        "KeycloakAuth": []
+                    }
+                ],
+                "description": "Forward a stored notification again, either to a channel or to the action of a rule (regardless of its trigger). The deliveries are done asynchronously and can be followed in the delivery log.",
+                "consumes": [
+                    "application/json"
+                ],
+                "tags": [
+                    "notification"
+                ],
+                "summary": "Re-send Notification",
+                "parameters": [
+                    {
+                        "type": "string",
+                        "description": "unique ID of the notification",
+                        "name": "id",
+                        "in": "path",
+                        "required": true
+                    },
+                    {
+                        "description": "channel or rule to forward the notification to",
+                        "name": "ResendTarget",
+                        "in": "body",
+                        "required": true,
+                        "schema": {
+                            "$ref": "#/definitions/models.ResendTarget"
+                        }
+                    }
+                ],
+                "responses": {
+                    "202": {
+                        "description": "deliveries scheduled",
+                        "headers": {
+                            "api-version": {
+                                "type": "string",
+                                "description": "API version"
+                            }
+                        }
+                    },
+                    "400": {
+                        "description": "invalid target",
+                        "schema": {
+                            "$ref": "#/definitions/errorResponses.ErrorResponse"
+                        },
+                        "headers": {
+                            "api-version": {
+                                "type": "string",
+                                "description": "API version"
+                            }
+                        }
+                    },
+                    "404": {
+                        "description": "notification not found",
+                        "schema": {
+                            "$ref": "#/definitions/errorResponses.ErrorResponse"
+                        },
+                        "headers": {
+                            "api-version": {
+                                "type": "string",
+                                "description": "API version"
+                            }
+                        }
+                    }
+                }
+            }
+        },
+        "/origins/{serviceID}": {
+            "put": {
+                "security": [
+                    {
+                        "KeycloakAuth": []
+                    }
+                ],
+                "description": "Registers a set of origins in the given service. Replaces origins of this service if they already existed. The origins can be ulitized to set trigger conditions for actions. With a URL template, forwarded messages link to the resource of the notification.",
+                "consumes": [
+                    "application/json"
+                ],
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "origin"
+                ],
+                "summary": "Register Origins",
+                "parameters": [
+                    {
+                        "type": "string",
+                        "description": "serviceID of the calling service, needs to be unique among all services registering origins",
+                        "name": "serviceID",
+                        "in": "path",
+                        "required": true
+                    },
+                    {
+                        "description": "origins provided by the calling service",
+                        "name": "origins",
+                        "in": "body",
+                        "required": true,
+                        "schema": {
+                            "type": "array",
+                            "items": {
+                                "$ref": "#/definitions/models.Origin"
+                            }
+                        }
+                    }
+                ],
+                "responses": {
+                    "204": {
+                        "description": "origins registered",
+                        "headers": {
+                            "api-version": {
+                                "type": "string",
+                                "description": "API version"
+                            }
+                        }
+                    },
+                    "400": {
+                        "description": "Bad Request",
+                        "schema": {
+                            "$ref": "#/definitions/errorResponses.ErrorResponse"
+                        },
+                        "headers": {
+                            "api-version": {
+                                "type": "string",
+                                "description": "API version"
+                            }
+                        }
+                    },
+                    "500": {
+                        "description": "Internal Server Error",
+                        "schema": {
+                            "$ref": "#/definitions/errorResponses.ErrorResponse"
+                        },
+                        "headers": {
+                            "api-version": {
+                                "type": "string",
+                                "description": "API version"
+                            }
+                        }
+                    }
+                }
+            }
+        },
+        "/rules": {
+            "get": {
+                "security": [
+                    {
+                        "KeycloakAuth": []
+                    }
+                ],
+                "description": "Returns a list of rules matching the provided filters.",
+                "consumes": [
+                    "application/json"
+                ],
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "rule"
+                ],
+                "summary": "List Rules",
+                "responses": {
+                    "200": {
+                        "description": "OK",
+                        "schema": {
+                            "type": "array",
+                            "items": {
+                                "$ref": "#/definitions/models.Rule"
+                            }
+                        },
+                        "headers": {
+                            "api-version": {
+                                "type": "string",
+                                "description": "API version"
+                            }
+                        }
+                    }
+                }
+            },
+            "post": {
+                "security": [
+                    {
+                        "KeycloakAuth": []
+                    }
+                ],
+                "description": "Create a new rule. A rule determines on which conditions which action is triggered.",
+                "consumes": [
+                    "application/json"
+                ],
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "rule"
+                ],
+                "summary": "Create Rule",
+                "parameters": [
+                    {
+                        "description": "new rule",
+                        "name": "rule",
+                        "in": "body",
+                        "required": true,
+                        "schema": {
+                            "$ref": "#/definitions/models.Rule"
+                        }
+                    }
+                ],
+                "responses": {
+                    "201": {
+                        "description": "Created",
+                        "schema": {
+                            "$ref": "#/definitions/models.Rule"
+                        },
+                        "headers": {
+                            "api-version": {
+                                "type": "string",
+                                "description": "API version"
+                            }
+                        }
+                    },
+                    "400": {
+                        "description": "Bad Request",
+                        "schema": {
+                            "$ref": "#/definitions/errorResponses.ErrorResponse"
+                        },
+                        "headers": {
+                            "api-version": {
+                                "type": "string",
+                                "description": "API version"
+                            }
+                        }
+                    },
+                    "422": {
+                        "description": "Unprocessable Entity",
+                        "schema": {
+                            "$ref": "#/definitions/errorResponses.ErrorResponse"
+                        },
+                        "headers": {
+                            "api-version": {
+                                "type": "string",
+                                "description": "API version"
+                            }
+                        }
+                    }
+                }
+            }
+        },
+        "/rules/ruleoptions": {
+            "get": {
+                "security": [
+                    {
+                        "KeycloakAuth": []
+                    }
+                ],
+                "description": "Returns all required options to create a new alert rule",
+                "consumes": [
+                    "application/json"
+                ],
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "rule"
+                ],
+                "summary": "Options to create a new alert rule",
+                "responses": {
+                    "200": {
+                        "description": "OK",
+                        "schema": {
+                            "$ref": "#/definitions/models.RuleOptions"
+                        },
+                        "headers": {
+                            "api-version": {
+                                "type": "string",
+                                "description": "API version"
+                            }
+                        }
+                    }
+                }
+            }
+        },
+        "/rules/{id}": {
+            "put": {
+                "security": [
+                    {
+                        "KeycloakAuth": []
+                    }
+                ],
+                "description": "Update/replace a rule.",
+                "consumes": [
+                    "application/json"
+                ],
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "rule"
+                ],
+                "summary": "Update Rule",
+                "parameters": [
+                    {
+                        "type": "string",
+                        "description": "unique ID of the rule",
+                        "name": "id",
+                        "in": "path",
+                        "required": true
+                    },
+                    {
+                        "description": "updated rule",
+                        "name": "rule",
+                        "in": "body",
+                        "required": true,
+                        "schema": {
+                            "$ref": "#/definitions/models.Rule"
+                        }
+                    }
+                ],
+                "responses": {
+                    "200": {
+                        "description": "OK",
+                        "schema": {
+                            "$ref": "#/definitions/models.Rule"
+                        },
+                        "headers": {
+                            "api-version": {
+                                "type": "string",
+                                "description": "API version"
+                            }
+                        }
+                    },
+                    "400": {
+                        "description": "Bad Request",
+                        "schema": {
+                            "$ref": "#/definitions/errorResponses.ErrorResponse"
+                        },
+                        "headers": {
+                            "api-version": {
+                                "type": "string",
+                                "description": "API version"
+                            }
+                        }
+                    },
+                    "404": {
+                        "description": "Not Found",
+                        "schema": {
+                            "$ref": "#/definitions/errorResponses.ErrorResponse"
+                        },
+                        "headers": {
+                            "api-version": {
+                                "type": "string",
+                                "description": "API version"
+                            }
+                        }
+                    }
+                }
+            },
+            "delete": {
+                "security": [
+                    {
+                        "KeycloakAuth": []
+                    }
+                ],
+                "description": "Delete a rule.",
+                "tags": [
+                    "rule"
+                ],
+                "summary": "Delete Rule",
+                "parameters": [
+                    {
+                        "type": "string",
+                        "description": "unique ID of the rule",
+                        "name": "id",
+                        "in": "path",
+                        "required": true
+                    }
+                ],
+                "responses": {
+                    "204": {
+                        "description": "deleted",
+                        "headers": {
+                            "api-version": {
+                                "type": "string",
+                                "description": "API version"
+                            }
+                        }
+                    },
+                    "400": {
+                        "description": "invalid id",
+                        "schema": {
+                            "$ref": "#/definitions/errorResponses.ErrorResponse"
+                        },
+                        "headers": {
+                            "api-version": {
+                                "type": "string",
+                                "description": "API version"
+                            }
+                        }
+                    }
+                }
+            }
+        },
+        "/rulse/{id}": {
+            "get": {
+                "security": [
+                    {
+                        "KeycloakAuth": []
+                    }
+                ],
+                "description": "Returns the rule",
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "rule"
+                ],
+                "summary": "Get a rule by id",
+                "parameters": [
+                    {
+                        "type": "string",
+                        "description": "unique id of the rule",
+                        "name": "id",
+                        "in": "path",
+                        "required": true
+                    }
+                ],
+                "responses": {
+                    "200": {
+                        "description": "OK",
+                        "schema": {
+                            "$ref": "#/definitions/models.Rule"
+                        },
+                        "headers": {
+                            "api-version": {
+                                "type": "string",
+                                "description": "API version"
+                            }
+                        }
+                    },
+                    "400": {
+                        "description": "invalid id",
+                        "schema": {
+                            "$ref": "#/definitions/errorResponses.ErrorResponse"
+                        },
+                        "headers": {
+                            "api-version": {
+                                "type": "string",
+                                "description": "API version"
+                            }
+                        }
+                    },
+                    "404": {
+                        "description": "Not Found",
+                        "schema": {
+                            "$ref": "#/definitions/errorResponses.ErrorResponse"
+                        },
+                        "headers": {
+                            "api-version": {
+                                "type": "string",
+                                "description": "API version"
+                            }
+                        }
+                    }
+                }
+            }
+        },
+        "/templates": {
+            "get": {
+                "security": [
+                    {
+                        "KeycloakAuth": []
+                    }
+                ],
+                "description": "Returns the message template of each channel type. Channel types without a changed template return the default template.",
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "template"
+                ],
+                "summary": "List message templates",
+                "responses": {
+                    "200": {
+                        "description": "OK",
+                        "schema": {
+                            "type": "array",
+                            "items": {
+                                "$ref": "#/definitions/models.MessageTemplate"
+                            }
+                        },
+                        "headers": {
+                            "api-version": {
+                                "type": "string",
+                                "description": "API version"
+                            }
+                        }
+                    }
+                }
+            }
+        },
+        "/templates/validate": {
+            "post": {
+                "security": [
+                    {
+                        "KeycloakAuth": []
+                    }
+                ],
+                "description": "Checks that the template can be parsed and rendered, the rendered template is returned as preview.\nWithout a notification in the request a sample notification is used.",
+                "consumes": [
+                    "application/json"
+                ],
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "template"
+                ],
+                "summary": "Validate message template",
+                "parameters": [
+                    {
+                        "description": "template to validate",
+                        "name": "template",
+                        "in": "body",
+                        "required": true,
+                        "schema": {
+                            "$ref": "#/definitions/templatecontroller.TemplateValidationRequest"
+                        }
+                    }
+                ],
+                "responses": {
+                    "200": {
+                        "description": "OK",
+                        "schema": {
+                            "$ref": "#/definitions/models.TemplatePreview"
+                        },
+                        "headers": {
+                            "api-version": {
+                                "type": "string",
+                                "description": "API version"
+                            }
+                        }
+                    },
+                    "400": {
+                        "description": "invalid template",
+                        "schema": {
+                            "$ref": "#/definitions/errorResponses.ErrorResponse"
+                        },
+                        "headers": {
+                            "api-version": {
+                                "type": "string",
+                                "description": "API version"
+                            }
+                        }
+                    }
+                }
+            }
+        },
+        "/templates/{channelType}": {
+            "get": {
+                "security": [
+                    {
+                        "KeycloakAuth": []
+                    }
+                ],
+                "description": "Returns the message template of the channel type.",
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "template"
+                ],
+                "summary": "Get message template",
+                "parameters": [
+                    {
+                        "enum": [
+                            "mail",
+                            "mattermost",
+                            "teams",
+                            "slack",
+                            "webhook",
+                            "syslog",
+                            "incident",
+                            "push",
+                            "matrix"
+                        ],
+                        "type": "string",
+                        "description": "channel type",
+                        "name": "channelType",
+                        "in": "path",
+                        "required": true
+                    }
+                ],
+                "responses": {
+                    "200": {
+                        "description": "OK",
+                        "schema": {
+                            "$ref": "#/definitions/models.MessageTemplate"
+                        },
+                        "headers": {
+                            "api-version": {
+                                "type": "string",
+                                "description": "API version"
+                            }
+                        }
+                    },
+                    "404": {
+                        "description": "unknown channel type",
+                        "schema": {
+                            "$ref": "#/definitions/errorResponses.ErrorResponse"
+                        },
+                        "headers": {
+                            "api-version": {
+                                "type": "string",
+                                "description": "API version"
+                            }
+                        }
+                    }
+                }
+            },
+            "put": {
+                "security": [
+                    {
+                        "KeycloakAuth": []
+                    }
+                ],
+                "description": "Replaces the message template of the channel type. It applies to all rules which don't override it. The template must be valid, see the validation endpoint.",
+                "consumes": [
+                    "application/json"
+                ],
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "template"
+                ],
+                "summary": "Update message template",
+                "parameters": [
+                    {
+                        "enum": [
+                            "mail",
+                            "mattermost",
+                            "teams",
+                            "slack",
+                            "webhook",
+                            "syslog",
+                            "incident",
+                            "push",
+                            "matrix"
+                        ],
+                        "type": "string",
+                        "description": "channel type",
+                        "name": "channelType",
+                        "in": "path",
+                        "required": true
+                    },
+                    {
+                        "description": "new template",
+                        "name": "template",
+                        "in": "body",
+                        "required": true,
+                        "schema": {
+                            "$ref": "#/definitions/models.MessageTemplate"
+                        }
+                    }
+                ],
+                "responses": {
+                    "200": {
+                        "description": "OK",
+                        "schema": {
+                            "$ref": "#/definitions/models.MessageTemplate"
+                        },
+                        "headers": {
+                            "api-version": {
+                                "type": "string",
+                                "description": "API version"
+                            }
+                        }
+                    },
+                    "400": {
+                        "description": "Bad Request",
+                        "schema": {
+                            "$ref": "#/definitions/errorResponses.ErrorResponse"
+                        },
+                        "headers": {
+                            "api-version": {
+                                "type": "string",
+                                "description": "API version"
+                            }
+                        }
+                    },
+                    "404": {
+                        "description": "unknown channel type",
+                        "schema": {
+                            "$ref": "#/definitions/errorResponses.ErrorResponse"
+                        },
+                        "headers": {
+                            "api-version": {
+                                "type": "string",
+                                "description": "API version"
+                            }
+                        }
+                    }
+                }
+            },
+            "delete": {
+                "security": [
+                    {
+                        "KeycloakAuth": []
+                    }
+                ],
+                "description": "Restores the default message template of the channel type.",
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "template"
+                ],
+                "summary": "Reset message template",
+                "parameters": [
+                    {
+                        "enum": [
+                            "mail",
+                            "mattermost",
+                            "teams",
+                            "slack",
+                            "webhook",
+                            "syslog",
+                            "incident",
+                            "push",
+                            "matrix"
+                        ],
+                        "type": "string",
+                        "description": "channel type",
+                        "name": "channelType",
+                        "in": "path",
+                        "required": true
+                    }
+                ],
+                "responses": {
+                    "200": {
+                        "description": "OK",
+                        "schema": {
+                            "$ref": "#/definitions/models.MessageTemplate"
+                        },
+                        "headers": {
+                            "api-version": {
+                                "type": "string",
+                                "description": "API version"
+                            }
+                        }
+                    },
+                    "404": {
+                        "description": "unknown channel type",
+                        "schema": {
+                            "$ref": "#/definitions/errorResponses.ErrorResponse"
+                        },
+                        "headers": {
+                            "api-version": {
+                                "type": "string",
+                                "description": "API version"
+                            }
+                        }
+                    }
+                }
+            }
+        }
+    },
+    "definitions": {
+        "errorResponses.ErrorResponse": {
+            "type": "object",
+            "properties": {
+                "details": {
+                    "type": "string"
+                },
+                "errors": {
+                    "type": "object",
+                    "additionalProperties": {
+                        "type": "string"
+                    }
+                },
+                "title": {
+                    "type": "string"
+                },
+                "type": {
+                    "type": "string"
+                }
+            }
+        },
+        "filter.CompareOperator": {
+            "type": "string",
+            "enum": [
+                "beginsWith",
+                "doesNotBeginWith",
+                "contains",
+                "doesNotContain",
+                "textContains",
+                "isNumberEqualTo",
+                "isNumberNotEqualTo",
+                "isEqualTo",
+                "isNotEqualTo",
+                "isIpEqualTo",
+                "isIpNotEqualTo",
+                "isStringEqualTo",
+                "isStringNotEqualTo",
+                "isStringCaseInsensitiveEqualTo",
+                "isGreaterThan",
+                "isGreaterThanOrEqualTo",
+                "isLessThan",
+                "isLessThanOrEqualTo",
                 "beforeDate",
                 "afterDate",
                 "betweenDates",
@@ -1528,9 +4085,60 @@ const docTemplatenotificationservice = `{
                 }
             }
         },
+        "incidentdto.IncidentNotificationChannelRequest": {
+            "type": "object",
+            "properties": {
+                "channelName": {
+                    "type": "string"
+                },
+                "description": {
+                    "type": "string"
+                },
+                "eventsUrl": {
+                    "type": "string"
+                },
+                "routingKey": {
+                    "type": "string"
+                }
+            }
+        },
+        "incidentdto.IncidentNotificationChannelResponse": {
+            "type": "object",
+            "properties": {
+                "channelName": {
+                    "type": "string"
+                },
+                "description": {
+                    "type": "string"
+                },
+                "eventsUrl": {
+                    "type": "string"
+                },
+                "hasRoutingKey": {
+                    "type": "boolean"
+                },
+                "id": {
+                    "type": "string"
+                }
+            }
+        },
         "maildto.CheckMailServerEntityRequest": {
             "type": "object",
             "properties": {
+                "authMethod": {
+                    "type": "string",
+                    "default": "password",
+                    "enum": [
+                        "password",
+                        "xoauth2"
+                    ]
+                },
+                "caCertificate": {
+                    "type": "string"
+                },
+                "certFingerprint": {
+                    "type": "string"
+                },
                 "domain": {
                     "type": "string"
                 },
@@ -1538,9 +4146,20 @@ const docTemplatenotificationservice = `{
                     "type": "boolean",
                     "default": false
                 },
-                "isTlsEnforced": {
-                    "type": "boolean",
-                    "default": false
+                "oauthClientId": {
+                    "type": "string"
+                },
+                "oauthClientSecret": {
+                    "type": "string"
+                },
+                "oauthScopes": {
+                    "type": "array",
+                    "items": {
+                        "type": "string"
+                    }
+                },
+                "oauthTokenUrl": {
+                    "type": "string"
                 },
                 "password": {
                     "type": "string"
@@ -1548,6 +4167,26 @@ const docTemplatenotificationservice = `{
                 "port": {
                     "type": "integer"
                 },
+                "tlsMinVersion": {
+                    "type": "string",
+                    "default": "1.2",
+                    "enum": [
+                        "1.0",
+                        "1.1",
+                        "1.2",
+                        "1.3"
+                    ]
+                },
+                "tlsMode": {
+                    "type": "string",
+                    "default": "starttls",
+                    "enum": [
+                        "implicit",
+                        "starttls",
+                        "opportunistic",
+                        "none"
+                    ]
+                },
                 "username": {
                     "type": "string"
                 }
@@ -1556,6 +4195,20 @@ const docTemplatenotificationservice = `{
         "maildto.CheckMailServerRequest": {
             "type": "object",
             "properties": {
+                "authMethod": {
+                    "type": "string",
+                    "default": "password",
+                    "enum": [
+                        "password",
+                        "xoauth2"
+                    ]
+                },
+                "caCertificate": {
+                    "type": "string"
+                },
+                "certFingerprint": {
+                    "type": "string"
+                },
                 "domain": {
                     "type": "string"
                 },
@@ -1563,9 +4216,20 @@ const docTemplatenotificationservice = `{
                     "type": "boolean",
                     "default": false
                 },
-                "isTlsEnforced": {
-                    "type": "boolean",
-                    "default": false
+                "oauthClientId": {
+                    "type": "string"
+                },
+                "oauthClientSecret": {
+                    "type": "string"
+                },
+                "oauthScopes": {
+                    "type": "array",
+                    "items": {
+                        "type": "string"
+                    }
+                },
+                "oauthTokenUrl": {
+                    "type": "string"
                 },
                 "password": {
                     "type": "string"
@@ -1573,6 +4237,26 @@ const docTemplatenotificationservice = `{
                 "port": {
                     "type": "integer"
                 },
+                "tlsMinVersion": {
+                    "type": "string",
+                    "default": "1.2",
+                    "enum": [
+                        "1.0",
+                        "1.1",
+                        "1.2",
+                        "1.3"
+                    ]
+                },
+                "tlsMode": {
+                    "type": "string",
+                    "default": "starttls",
+                    "enum": [
+                        "implicit",
+                        "starttls",
+                        "opportunistic",
+                        "none"
+                    ]
+                },
                 "username": {
                     "type": "string"
                 }
@@ -1581,6 +4265,20 @@ const docTemplatenotificationservice = `{
         "maildto.MailNotificationChannelRequest": {
             "type": "object",
             "properties": {
+                "authMethod": {
+                    "type": "string",
+                    "default": "password",
+                    "enum": [
+                        "password",
+                        "xoauth2"
+                    ]
+                },
+                "caCertificate": {
+                    "type": "string"
+                },
+                "certFingerprint": {
+                    "type": "string"
+                },
                 "channelName": {
                     "type": "string"
                 },
@@ -1594,16 +4292,27 @@ const docTemplatenotificationservice = `{
                     "type": "boolean",
                     "default": false
                 },
-                "isTlsEnforced": {
-                    "type": "boolean",
-                    "default": false
-                },
                 "maxEmailAttachmentSizeMb": {
                     "type": "integer"
                 },
                 "maxEmailIncludeSizeMb": {
                     "type": "integer"
                 },
+                "oauthClientId": {
+                    "type": "string"
+                },
+                "oauthClientSecret": {
+                    "type": "string"
+                },
+                "oauthScopes": {
+                    "type": "array",
+                    "items": {
+                        "type": "string"
+                    }
+                },
+                "oauthTokenUrl": {
+                    "type": "string"
+                },
                 "password": {
                     "type": "string"
                 },
@@ -1613,20 +4322,109 @@ const docTemplatenotificationservice = `{
                 "senderEmailAddress": {
                     "type": "string"
                 },
+                "tlsMinVersion": {
+                    "type": "string",
+                    "default": "1.2",
+                    "enum": [
+                        "1.0",
+                        "1.1",
+                        "1.2",
+                        "1.3"
+                    ]
+                },
+                "tlsMode": {
+                    "type": "string",
+                    "default": "starttls",
+                    "enum": [
+                        "implicit",
+                        "starttls",
+                        "opportunistic",
+                        "none"
+                    ]
+                },
                 "username": {
                     "type": "string"
                 }
             }
         },
+        "matrixdto.MatrixNotificationChannelCheckRequest": {
+            "type": "object",
+            "properties": {
+                "accessToken": {
+                    "type": "string"
+                },
+                "homeserverUrl": {
+                    "type": "string"
+                },
+                "roomId": {
+                    "type": "string"
+                }
+            }
+        },
+        "matrixdto.MatrixNotificationChannelRequest": {
+            "type": "object",
+            "properties": {
+                "accessToken": {
+                    "type": "string"
+                },
+                "channelName": {
+                    "type": "string"
+                },
+                "description": {
+                    "type": "string"
+                },
+                "homeserverUrl": {
+                    "type": "string"
+                },
+                "roomId": {
+                    "type": "string"
+                }
+            }
+        },
+        "matrixdto.MatrixNotificationChannelResponse": {
+            "type": "object",
+            "properties": {
+                "channelName": {
+                    "type": "string"
+                },
+                "description": {
+                    "type": "string"
+                },
+                "hasAccessToken": {
+                    "type": "boolean"
+                },
+                "homeserverUrl": {
+                    "type": "string"
+                },
+                "id": {
+                    "type": "string"
+                },
+                "roomId": {
+                    "type": "string"
+                }
+            }
+        },
         "mattermostdto.MattermostNotificationChannelRequest": {
             "type": "object",
             "properties": {
+                "channel": {
+                    "description": "posts go to this channel instead of the one of the webhook, e.g. town-square or @username",
+                    "type": "string"
+                },
                 "channelName": {
                     "type": "string"
                 },
                 "description": {
                     "type": "string"
                 },
+                "iconUrl": {
+                    "description": "posts are shown with this profile picture instead of the one of the webhook",
+                    "type": "string"
+                },
+                "username": {
+                    "description": "posts are shown with this username instead of the one of the webhook",
+                    "type": "string"
+                },
                 "webhookUrl": {
                     "type": "string"
                 }
@@ -1647,41 +4445,279 @@ const docTemplatenotificationservice = `{
                 }
             }
         },
-        "models.ChannelReference": {
+        "models.ChannelReference": {
+            "type": "object",
+            "required": [
+                "id"
+            ],
+            "properties": {
+                "id": {
+                    "type": "string"
+                },
+                "name": {
+                    "type": "string",
+                    "readOnly": true
+                },
+                "type": {
+                    "allOf": [
+                        {
+                            "$ref": "#/definitions/models.ChannelType"
+                        }
+                    ],
+                    "readOnly": true
+                }
+            }
+        },
+        "models.ChannelType": {
+            "type": "string",
+            "enum": [
+                "mail",
+                "mattermost",
+                "teams",
+                "slack",
+                "webhook",
+                "syslog",
+                "incident",
+                "push",
+                "matrix"
+            ],
+            "x-enum-varnames": [
+                "ChannelTypeMail",
+                "ChannelTypeMattermost",
+                "ChannelTypeTeams",
+                "ChannelTypeSlack",
+                "ChannelTypeWebhook",
+                "ChannelTypeSyslog",
+                "ChannelTypeIncident",
+                "ChannelTypePush",
+                "ChannelTypeMatrix"
+            ]
+        },
+        "models.DeadLetter": {
+            "type": "object",
+            "properties": {
+                "attempts": {
+                    "type": "integer"
+                },
+                "channel": {
+                    "$ref": "#/definitions/models.ChannelReference"
+                },
+                "deadLetteredAt": {
+                    "type": "string",
+                    "format": "date-time"
+                },
+                "id": {
+                    "type": "string",
+                    "readOnly": true
+                },
+                "lastError": {
+                    "type": "string"
+                },
+                "notification": {
+                    "description": "only populated when inspecting a single dead letter",
+                    "allOf": [
+                        {
+                            "$ref": "#/definitions/models.Notification"
+                        }
+                    ]
+                },
+                "notificationID": {
+                    "type": "string"
+                },
+                "recipient": {
+                    "type": "string"
+                },
+                "ruleID": {
+                    "description": "rule which caused the delivery, empty if not caused by a rule",
+                    "type": "string"
+                }
+            }
+        },
+        "models.Delivery": {
+            "type": "object",
+            "properties": {
+                "digestMaxCount": {
+                    "description": "digest mode only: optional, the digest is sent earlier once this number of notifications is collected",
+                    "type": "integer"
+                },
+                "digestWindowMinutes": {
+                    "description": "digest mode only: maximum time to collect notifications",
+                    "type": "integer"
+                },
+                "mode": {
+                    "enum": [
+                        "immediate",
+                        "digest"
+                    ],
+                    "allOf": [
+                        {
+                            "$ref": "#/definitions/models.DeliveryMode"
+                        }
+                    ]
+                },
+                "suppressionWindowMinutes": {
+                    "description": "optional, if not set the globally configured suppression window applies",
+                    "type": "integer"
+                }
+            }
+        },
+        "models.DeliveryAttempt": {
+            "type": "object",
+            "properties": {
+                "attempt": {
+                    "description": "starts with 1 for the first attempt",
+                    "type": "integer"
+                },
+                "channel": {
+                    "$ref": "#/definitions/models.ChannelReference"
+                },
+                "error": {
+                    "description": "reason of the failure",
+                    "type": "string"
+                },
+                "id": {
+                    "type": "string",
+                    "readOnly": true
+                },
+                "notificationID": {
+                    "type": "string"
+                },
+                "outcome": {
+                    "enum": [
+                        "success",
+                        "failure",
+                        "dropped"
+                    ],
+                    "allOf": [
+                        {
+                            "$ref": "#/definitions/models.DeliveryOutcome"
+                        }
+                    ]
+                },
+                "recipient": {
+                    "type": "string"
+                },
+                "ruleID": {
+                    "description": "rule which caused the delivery, empty if not caused by a rule",
+                    "type": "string"
+                },
+                "timestamp": {
+                    "type": "string",
+                    "format": "date-time"
+                }
+            }
+        },
+        "models.DeliveryMode": {
+            "type": "string",
+            "enum": [
+                "immediate",
+                "digest"
+            ],
+            "x-enum-comments": {
+                "DeliveryModeDigest": "notifications are collected and forwarded together as one digest message",
+                "DeliveryModeImmediate": "each notification is forwarded on its own"
+            },
+            "x-enum-varnames": [
+                "DeliveryModeImmediate",
+                "DeliveryModeDigest"
+            ]
+        },
+        "models.DeliveryOutcome": {
+            "type": "string",
+            "enum": [
+                "success",
+                "failure",
+                "dropped"
+            ],
+            "x-enum-comments": {
+                "DeliveryOutcomeDropped": "delivery failed, it will not be retried anymore",
+                "DeliveryOutcomeFailure": "delivery failed, it will be retried"
+            },
+            "x-enum-varnames": [
+                "DeliveryOutcomeSuccess",
+                "DeliveryOutcomeFailure",
+                "DeliveryOutcomeDropped"
+            ]
+        },
+        "models.EscalationStep": {
+            "type": "object",
+            "properties": {
+                "action": {
+                    "description": "the channel name and type are read-only, they are resolved when the step is executed",
+                    "allOf": [
+                        {
+                            "$ref": "#/definitions/models.Action"
+                        }
+                    ]
+                },
+                "delayMinutes": {
+                    "type": "integer"
+                }
+            }
+        },
+        "models.MaintenanceWindow": {
+            "type": "object",
+            "properties": {
+                "comment": {
+                    "type": "string"
+                },
+                "end": {
+                    "type": "string",
+                    "format": "date-time"
+                },
+                "start": {
+                    "type": "string",
+                    "format": "date-time"
+                }
+            }
+        },
+        "models.MessageTemplate": {
             "type": "object",
-            "required": [
-                "id"
-            ],
             "properties": {
-                "id": {
+                "body": {
                     "type": "string"
                 },
-                "name": {
-                    "type": "string",
-                    "readOnly": true
-                },
-                "type": {
+                "channelType": {
                     "allOf": [
                         {
                             "$ref": "#/definitions/models.ChannelType"
                         }
                     ],
                     "readOnly": true
+                },
+                "isDefault": {
+                    "description": "true if the template was not changed by an admin",
+                    "type": "boolean",
+                    "readOnly": true
+                },
+                "subject": {
+                    "type": "string"
                 }
             }
         },
-        "models.ChannelType": {
-            "type": "string",
-            "enum": [
-                "mail",
-                "mattermost",
-                "teams"
-            ],
-            "x-enum-varnames": [
-                "ChannelTypeMail",
-                "ChannelTypeMattermost",
-                "ChannelTypeTeams"
-            ]
+        "models.Mute": {
+            "type": "object",
+            "properties": {
+                "maintenanceWindows": {
+                    "type": "array",
+                    "items": {
+                        "$ref": "#/definitions/models.MaintenanceWindow"
+                    }
+                },
+                "quietHours": {
+                    "type": "array",
+                    "items": {
+                        "$ref": "#/definitions/models.QuietHours"
+                    }
+                },
+                "summary": {
+                    "type": "boolean"
+                },
+                "timeZone": {
+                    "description": "IANA time zone of the quiet hours, e.g. ` + "`" + `Europe/Berlin` + "`" + `, defaults to UTC",
+                    "type": "string"
+                }
+            }
         },
         "models.Notification": {
             "type": "object",
@@ -1693,6 +4729,17 @@ const docTemplatenotificationservice = `{
                 "title"
             ],
             "properties": {
+                "acknowledgedAt": {
+                    "description": "set once a user acknowledged the notification, this stops its escalation",
+                    "type": "string",
+                    "format": "date-time",
+                    "readOnly": true
+                },
+                "acknowledgedBy": {
+                    "description": "ID of the user",
+                    "type": "string",
+                    "readOnly": true
+                },
                 "customFields": {
                     "description": "can contain arbitrary structured information about the event",
                     "type": "object",
@@ -1705,6 +4752,11 @@ const docTemplatenotificationservice = `{
                     "type": "string",
                     "readOnly": true
                 },
+                "idempotencyKey": {
+                    "description": "IdempotencyKey can be set by the caller to safely retry the creation, alternatively it is taken from the ` + "`" + `Idempotency-Key` + "`" + ` header.\nA repeated request with the same key within the idempotency window returns the original notification.",
+                    "type": "string",
+                    "maxLength": 255
+                },
                 "level": {
                     "enum": [
                         "info",
@@ -1730,12 +4782,60 @@ const docTemplatenotificationservice = `{
                     "description": "together with class it can be used to provide a link to the origin, e.g. ` + "`" + `\u003cid of react sbom object\u003e` + "`" + `",
                     "type": "string"
                 },
+                "recovered": {
+                    "description": "Recovered is set by the origin when the problem reported earlier for the resource is over.\nIncident channels resolve the incident of the resource instead of opening one.",
+                    "type": "boolean"
+                },
+                "repeats": {
+                    "description": "Repeats counts the repeats of this notification which were not forwarded, as they arrived within the suppression window of a rule.",
+                    "type": "integer",
+                    "readOnly": true
+                },
                 "timestamp": {
                     "type": "string",
                     "format": "date-time"
                 },
                 "title": {
                     "type": "string"
+                },
+                "userState": {
+                    "description": "state of the notification for the requesting user, only set when listing notifications",
+                    "allOf": [
+                        {
+                            "$ref": "#/definitions/models.NotificationUserState"
+                        }
+                    ],
+                    "readOnly": true
+                }
+            }
+        },
+        "models.NotificationUserState": {
+            "type": "object",
+            "properties": {
+                "acknowledgedAt": {
+                    "description": "set if the user acknowledged the notification",
+                    "type": "string",
+                    "format": "date-time"
+                },
+                "dismissedAt": {
+                    "type": "string",
+                    "format": "date-time"
+                },
+                "readAt": {
+                    "type": "string",
+                    "format": "date-time"
+                },
+                "readState": {
+                    "enum": [
+                        "unread",
+                        "read",
+                        "dismissed"
+                    ],
+                    "allOf": [
+                        {
+                            "$ref": "#/definitions/models.ReadState"
+                        }
+                    ]
                 }
             }
         },
@@ -1758,6 +4858,11 @@ const docTemplatenotificationservice = `{
                     "description": "service in which this origin is defined",
                     "type": "string",
                     "readOnly": true
+                },
+                "urlTemplate": {
+                    "description": "UrlTemplate is used to link messages to the resource of the notification, ` + "`" + `{resourceID}` + "`" + ` is replaced with the ` + "`" + `originResourceID` + "`" + ` of the notification.\nEither a path relative to the public base URL of OpenSight, e.g. ` + "`" + `/vi/sbom/{resourceID}` + "`" + `, or an absolute http(s) URL. Optional, without it messages contain no link.",
+                    "type": "string",
+                    "maxLength": 2048
                 }
             }
         },
@@ -1780,6 +4885,72 @@ const docTemplatenotificationservice = `{
                 }
             }
         },
+        "models.QueueStats": {
+            "type": "object",
+            "properties": {
+                "capacity": {
+                    "description": "maximum number of waiting jobs",
+                    "type": "integer"
+                },
+                "depth": {
+                    "description": "number of jobs waiting for a worker",
+                    "type": "integer"
+                },
+                "workers": {
+                    "type": "integer"
+                }
+            }
+        },
+        "models.QuietHours": {
+            "type": "object",
+            "properties": {
+                "end": {
+                    "description": "local time in format ` + "`" + `HH:MM` + "`" + `",
+                    "type": "string",
+                    "example": "06:00"
+                },
+                "start": {
+                    "description": "local time in format ` + "`" + `HH:MM` + "`" + `",
+                    "type": "string",
+                    "example": "22:00"
+                },
+                "weekdays": {
+                    "description": "days on which the range starts, every day if empty",
+                    "type": "array",
+                    "items": {
+                        "$ref": "#/definitions/models.Weekday"
+                    }
+                }
+            }
+        },
+        "models.ReadState": {
+            "type": "string",
+            "enum": [
+                "unread",
+                "read",
+                "dismissed"
+            ],
+            "x-enum-varnames": [
+                "ReadStateUnread",
+                "ReadStateRead",
+                "ReadStateDismissed"
+            ]
+        },
+        "models.ResendTarget": {
+            "type": "object",
+            "properties": {
+                "channelID": {
+                    "type": "string"
+                },
+                "recipient": {
+                    "description": "only together with a channel, e.g. for mail a comma separated list of mail adresses",
+                    "type": "string"
+                },
+                "ruleID": {
+                    "type": "string"
+                }
+            }
+        },
         "models.Rule": {
             "type": "object",
             "required": [
@@ -1794,6 +4965,9 @@ const docTemplatenotificationservice = `{
                 "active": {
                     "type": "boolean"
                 },
+                "delivery": {
+                    "$ref": "#/definitions/models.Delivery"
+                },
                 "errors": {
                     "description": "populated if the rule is invalid, this can be useful to highlight rules which need action from the user.",
                     "allOf": [
@@ -1803,13 +4977,31 @@ const docTemplatenotificationservice = `{
                     ],
                     "readOnly": true
                 },
+                "escalation": {
+                    "description": "executed one after the other for urgent notifications which are not acknowledged in time",
+                    "type": "array",
+                    "items": {
+                        "$ref": "#/definitions/models.EscalationStep"
+                    }
+                },
                 "id": {
                     "type": "string",
                     "readOnly": true
                 },
+                "mute": {
+                    "$ref": "#/definitions/models.Mute"
+                },
                 "name": {
                     "type": "string"
                 },
+                "template": {
+                    "description": "optional, replaces the message template of the channel type for this rule",
+                    "allOf": [
+                        {
+                            "$ref": "#/definitions/models.TemplateOverride"
+                        }
+                    ]
+                },
                 "trigger": {
                     "$ref": "#/definitions/models.Trigger"
                 }
@@ -1859,6 +5051,28 @@ const docTemplatenotificationservice = `{
                 }
             }
         },
+        "models.TemplateOverride": {
+            "type": "object",
+            "properties": {
+                "body": {
+                    "type": "string"
+                },
+                "subject": {
+                    "type": "string"
+                }
+            }
+        },
+        "models.TemplatePreview": {
+            "type": "object",
+            "properties": {
+                "body": {
+                    "type": "string"
+                },
+                "subject": {
+                    "type": "string"
+                }
+            }
+        },
         "models.Trigger": {
             "type": "object",
             "required": [
@@ -1886,6 +5100,80 @@ const docTemplatenotificationservice = `{
                 "type": "string"
             }
         },
+        "models.Weekday": {
+            "type": "string",
+            "enum": [
+                "monday",
+                "tuesday",
+                "wednesday",
+                "thursday",
+                "friday",
+                "saturday",
+                "sunday"
+            ],
+            "x-enum-varnames": [
+                "Monday",
+                "Tuesday",
+                "Wednesday",
+                "Thursday",
+                "Friday",
+                "Saturday",
+                "Sunday"
+            ]
+        },
+        "models.WorkerPoolStats": {
+            "type": "object",
+            "properties": {
+                "delivery": {
+                    "description": "by channel type",
+                    "type": "object",
+                    "additionalProperties": {
+                        "$ref": "#/definitions/models.QueueStats"
+                    }
+                },
+                "intake": {
+                    "$ref": "#/definitions/models.QueueStats"
+                }
+            }
+        },
+        "notificationcontroller.MarkAllReadResponse": {
+            "type": "object",
+            "properties": {
+                "marked": {
+                    "description": "number of notifications which were unread before",
+                    "type": "integer"
+                }
+            }
+        },
+        "notificationcontroller.ReplayDeadLettersRequest": {
+            "type": "object",
+            "properties": {
+                "ids": {
+                    "type": "array",
+                    "items": {
+                        "type": "string"
+                    }
+                }
+            }
+        },
+        "notificationcontroller.ReplayDeadLettersResponse": {
+            "type": "object",
+            "properties": {
+                "replayed": {
+                    "description": "number of replayed dead letters, unknown IDs are skipped",
+                    "type": "integer"
+                }
+            }
+        },
+        "notificationcontroller.UnreadCountResponse": {
+            "type": "object",
+            "properties": {
+                "unread": {
+                    "description": "number of notifications not read by the current user",
+                    "type": "integer"
+                }
+            }
+        },
         "notifications.Level": {
             "type": "string",
             "enum": [
@@ -1923,14 +5211,106 @@ const docTemplatenotificationservice = `{
                 "index": {
                     "type": "integer"
                 },
-                "size": {
-                    "type": "integer"
+                "size": {
+                    "type": "integer"
+                },
+                "totalDisplayableResults": {
+                    "type": "integer"
+                },
+                "totalResults": {
+                    "type": "integer"
+                }
+            }
+        },
+        "pushdto.PushNotificationChannelCheckRequest": {
+            "type": "object",
+            "properties": {
+                "accessToken": {
+                    "type": "string"
+                },
+                "server": {
+                    "type": "string",
+                    "enum": [
+                        "ntfy",
+                        "gotify"
+                    ]
+                },
+                "serverUrl": {
+                    "type": "string"
+                },
+                "tags": {
+                    "type": "array",
+                    "items": {
+                        "type": "string"
+                    }
+                },
+                "topic": {
+                    "type": "string"
+                }
+            }
+        },
+        "pushdto.PushNotificationChannelRequest": {
+            "type": "object",
+            "properties": {
+                "accessToken": {
+                    "type": "string"
+                },
+                "channelName": {
+                    "type": "string"
+                },
+                "description": {
+                    "type": "string"
+                },
+                "server": {
+                    "type": "string",
+                    "enum": [
+                        "ntfy",
+                        "gotify"
+                    ]
+                },
+                "serverUrl": {
+                    "type": "string"
+                },
+                "tags": {
+                    "type": "array",
+                    "items": {
+                        "type": "string"
+                    }
+                },
+                "topic": {
+                    "type": "string"
+                }
+            }
+        },
+        "pushdto.PushNotificationChannelResponse": {
+            "type": "object",
+            "properties": {
+                "channelName": {
+                    "type": "string"
+                },
+                "description": {
+                    "type": "string"
+                },
+                "hasAccessToken": {
+                    "type": "boolean"
+                },
+                "id": {
+                    "type": "string"
+                },
+                "server": {
+                    "type": "string"
                 },
-                "totalDisplayableResults": {
-                    "type": "integer"
+                "serverUrl": {
+                    "type": "string"
                 },
-                "totalResults": {
-                    "type": "integer"
+                "tags": {
+                    "type": "array",
+                    "items": {
+                        "type": "string"
+                    }
+                },
+                "topic": {
+                    "type": "string"
                 }
             }
         },
@@ -1994,6 +5374,34 @@ const docTemplatenotificationservice = `{
                 }
             }
         },
+        "query.ResponseListWithMetadata-models_DeadLetter": {
+            "type": "object",
+            "properties": {
+                "data": {
+                    "type": "array",
+                    "items": {
+                        "$ref": "#/definitions/models.DeadLetter"
+                    }
+                },
+                "metadata": {
+                    "$ref": "#/definitions/query.Metadata"
+                }
+            }
+        },
+        "query.ResponseListWithMetadata-models_DeliveryAttempt": {
+            "type": "object",
+            "properties": {
+                "data": {
+                    "type": "array",
+                    "items": {
+                        "$ref": "#/definitions/models.DeliveryAttempt"
+                    }
+                },
+                "metadata": {
+                    "$ref": "#/definitions/query.Metadata"
+                }
+            }
+        },
         "query.ResponseListWithMetadata-models_Notification": {
             "type": "object",
             "required": [
@@ -2012,6 +5420,20 @@ const docTemplatenotificationservice = `{
                 }
             }
         },
+        "query.ResponseWithMetadata-array_models_DeliveryAttempt": {
+            "type": "object",
+            "properties": {
+                "data": {
+                    "type": "array",
+                    "items": {
+                        "$ref": "#/definitions/models.DeliveryAttempt"
+                    }
+                },
+                "metadata": {
+                    "$ref": "#/definitions/query.Metadata"
+                }
+            }
+        },
         "query.ResponseWithMetadata-array_query_FilterOption": {
             "type": "object",
             "required": [
@@ -2030,6 +5452,17 @@ const docTemplatenotificationservice = `{
                 }
             }
         },
+        "query.ResponseWithMetadata-models_DeadLetter": {
+            "type": "object",
+            "properties": {
+                "data": {
+                    "$ref": "#/definitions/models.DeadLetter"
+                },
+                "metadata": {
+                    "$ref": "#/definitions/query.Metadata"
+                }
+            }
+        },
         "query.ResponseWithMetadata-models_Notification": {
             "type": "object",
             "required": [
@@ -2045,6 +5478,50 @@ const docTemplatenotificationservice = `{
                 }
             }
         },
+        "query.ResponseWithMetadata-models_WorkerPoolStats": {
+            "type": "object",
+            "properties": {
+                "data": {
+                    "$ref": "#/definitions/models.WorkerPoolStats"
+                },
+                "metadata": {
+                    "$ref": "#/definitions/query.Metadata"
+                }
+            }
+        },
+        "query.ResponseWithMetadata-notificationcontroller_MarkAllReadResponse": {
+            "type": "object",
+            "properties": {
+                "data": {
+                    "$ref": "#/definitions/notificationcontroller.MarkAllReadResponse"
+                },
+                "metadata": {
+                    "$ref": "#/definitions/query.Metadata"
+                }
+            }
+        },
+        "query.ResponseWithMetadata-notificationcontroller_ReplayDeadLettersResponse": {
+            "type": "object",
+            "properties": {
+                "data": {
+                    "$ref": "#/definitions/notificationcontroller.ReplayDeadLettersResponse"
+                },
+                "metadata": {
+                    "$ref": "#/definitions/query.Metadata"
+                }
+            }
+        },
+        "query.ResponseWithMetadata-notificationcontroller_UnreadCountResponse": {
+            "type": "object",
+            "properties": {
+                "data": {
+                    "$ref": "#/definitions/notificationcontroller.UnreadCountResponse"
+                },
+                "metadata": {
+                    "$ref": "#/definitions/query.Metadata"
+                }
+            }
+        },
         "query.ResultSelector": {
             "type": "object",
             "properties": {
@@ -2059,6 +5536,20 @@ const docTemplatenotificationservice = `{
                 }
             }
         },
+        "slackdto.SlackNotificationChannelRequest": {
+            "type": "object",
+            "properties": {
+                "channelName": {
+                    "type": "string"
+                },
+                "description": {
+                    "type": "string"
+                },
+                "webhookUrl": {
+                    "type": "string"
+                }
+            }
+        },
         "sorting.Request": {
             "type": "object",
             "properties": {
@@ -2083,6 +5574,85 @@ const docTemplatenotificationservice = `{
                 "NoDirection"
             ]
         },
+        "syslogdto.SyslogNotificationChannelCheckRequest": {
+            "type": "object",
+            "properties": {
+                "host": {
+                    "type": "string"
+                },
+                "port": {
+                    "type": "integer"
+                },
+                "transport": {
+                    "type": "string",
+                    "enum": [
+                        "udp",
+                        "tcp",
+                        "tls"
+                    ]
+                }
+            }
+        },
+        "syslogdto.SyslogNotificationChannelRequest": {
+            "type": "object",
+            "properties": {
+                "appName": {
+                    "type": "string"
+                },
+                "channelName": {
+                    "type": "string"
+                },
+                "description": {
+                    "type": "string"
+                },
+                "facility": {
+                    "type": "integer"
+                },
+                "host": {
+                    "type": "string"
+                },
+                "port": {
+                    "type": "integer"
+                },
+                "transport": {
+                    "type": "string",
+                    "enum": [
+                        "udp",
+                        "tcp",
+                        "tls"
+                    ]
+                }
+            }
+        },
+        "syslogdto.SyslogNotificationChannelResponse": {
+            "type": "object",
+            "properties": {
+                "appName": {
+                    "type": "string"
+                },
+                "channelName": {
+                    "type": "string"
+                },
+                "description": {
+                    "type": "string"
+                },
+                "facility": {
+                    "type": "integer"
+                },
+                "host": {
+                    "type": "string"
+                },
+                "id": {
+                    "type": "string"
+                },
+                "port": {
+                    "type": "integer"
+                },
+                "transport": {
+                    "type": "string"
+                }
+            }
+        },
         "teamsdto.TeamsNotificationChannelRequest": {
             "type": "object",
             "properties": {
@@ -2096,6 +5666,119 @@ const docTemplatenotificationservice = `{
                     "type": "string"
                 }
             }
+        },
+        "templatecontroller.TemplateValidationRequest": {
+            "type": "object",
+            "properties": {
+                "body": {
+                    "type": "string"
+                },
+                "notification": {
+                    "description": "optional, the template is rendered with this notification instead of a sample notification",
+                    "allOf": [
+                        {
+                            "$ref": "#/definitions/models.Notification"
+                        }
+                    ]
+                },
+                "subject": {
+                    "type": "string"
+                }
+            }
+        },
+        "webhookdto.WebhookNotificationChannelCheckRequest": {
+            "type": "object",
+            "properties": {
+                "bodyTemplate": {
+                    "type": "string"
+                },
+                "headers": {
+                    "type": "object",
+                    "additionalProperties": {
+                        "type": "string"
+                    }
+                },
+                "method": {
+                    "type": "string",
+                    "enum": [
+                        "POST",
+                        "PUT",
+                        "PATCH"
+                    ]
+                },
+                "secret": {
+                    "type": "string"
+                },
+                "webhookUrl": {
+                    "type": "string"
+                }
+            }
+        },
+        "webhookdto.WebhookNotificationChannelRequest": {
+            "type": "object",
+            "properties": {
+                "bodyTemplate": {
+                    "type": "string"
+                },
+                "channelName": {
+                    "type": "string"
+                },
+                "description": {
+                    "type": "string"
+                },
+                "headers": {
+                    "type": "object",
+                    "additionalProperties": {
+                        "type": "string"
+                    }
+                },
+                "method": {
+                    "type": "string",
+                    "enum": [
+                        "POST",
+                        "PUT",
+                        "PATCH"
+                    ]
+                },
+                "secret": {
+                    "type": "string"
+                },
+                "webhookUrl": {
+                    "type": "string"
+                }
+            }
+        },
+        "webhookdto.WebhookNotificationChannelResponse": {
+            "type": "object",
+            "properties": {
+                "bodyTemplate": {
+                    "type": "string"
+                },
+                "channelName": {
+                    "type": "string"
+                },
+                "description": {
+                    "type": "string"
+                },
+                "hasSecret": {
+                    "type": "boolean"
+                },
+                "headers": {
+                    "type": "object",
+                    "additionalProperties": {
+                        "type": "string"
+                    }
+                },
+                "id": {
+                    "type": "string"
+                },
+                "method": {
+                    "type": "string"
+                },
+                "webhookUrl": {
+                    "type": "string"
+                }
+            }
         }
     },
     "securityDefinitions": {
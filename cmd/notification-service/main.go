@@ -19,13 +19,20 @@ import (
 	"github.com/greenbone/opensight-notification-service/pkg/services/notificationchannelservice"
 	"github.com/greenbone/opensight-notification-service/pkg/services/ruleservice"
 	"github.com/greenbone/opensight-notification-service/pkg/web/errmap"
+	"github.com/greenbone/opensight-notification-service/pkg/web/incidentcontroller"
 	"github.com/greenbone/opensight-notification-service/pkg/web/mailcontroller"
+	"github.com/greenbone/opensight-notification-service/pkg/web/matrixcontroller"
+	"github.com/greenbone/opensight-notification-service/pkg/web/pushcontroller"
 	"github.com/greenbone/opensight-notification-service/pkg/web/rulecontroller"
+	"github.com/greenbone/opensight-notification-service/pkg/web/slackcontroller"
+	"github.com/greenbone/opensight-notification-service/pkg/web/syslogcontroller"
 	"github.com/greenbone/opensight-notification-service/pkg/web/teamscontroller"
+	"github.com/greenbone/opensight-notification-service/pkg/web/webhookcontroller"
 	"github.com/jmoiron/sqlx"
 
 	"github.com/go-playground/validator"
 	"github.com/greenbone/opensight-notification-service/pkg/jobs/checkmailconnectivity"
+	"github.com/greenbone/opensight-notification-service/pkg/jobs/escalatenotifications"
 	"github.com/greenbone/opensight-notification-service/pkg/web/mattermostcontroller"
 	"github.com/kelseyhightower/envconfig"
 	"github.com/rs/zerolog/log"
@@ -37,13 +44,16 @@ import (
 	"github.com/greenbone/opensight-notification-service/pkg/repository/notificationrepository"
 	"github.com/greenbone/opensight-notification-service/pkg/repository/originrepository"
 	"github.com/greenbone/opensight-notification-service/pkg/repository/rulerepository"
+	"github.com/greenbone/opensight-notification-service/pkg/repository/templaterepository"
 	"github.com/greenbone/opensight-notification-service/pkg/services/healthservice"
 	"github.com/greenbone/opensight-notification-service/pkg/services/notificationservice"
 	"github.com/greenbone/opensight-notification-service/pkg/services/originservice"
+	"github.com/greenbone/opensight-notification-service/pkg/services/templateservice"
 	"github.com/greenbone/opensight-notification-service/pkg/web"
 	"github.com/greenbone/opensight-notification-service/pkg/web/healthcontroller"
 	"github.com/greenbone/opensight-notification-service/pkg/web/notificationcontroller"
 	"github.com/greenbone/opensight-notification-service/pkg/web/origincontroller"
+	"github.com/greenbone/opensight-notification-service/pkg/web/templatecontroller"
 )
 
 func main() {
@@ -115,6 +125,22 @@ func run(config config.Config) error {
 	if err != nil {
 		return fmt.Errorf("error creating Notification Repository: %w", err)
 	}
+	sendTaskRepository, err := notificationrepository.NewSendTaskRepository(pgClient)
+	if err != nil {
+		return fmt.Errorf("error creating Send Task Repository: %w", err)
+	}
+	deliveryAttemptRepository, err := notificationrepository.NewDeliveryAttemptRepository(pgClient)
+	if err != nil {
+		return fmt.Errorf("error creating Delivery Attempt Repository: %w", err)
+	}
+	deadLetterRepository, err := notificationrepository.NewDeadLetterRepository(pgClient)
+	if err != nil {
+		return fmt.Errorf("error creating Dead Letter Repository: %w", err)
+	}
+	escalationRepository, err := notificationrepository.NewEscalationRepository(pgClient)
+	if err != nil {
+		return fmt.Errorf("error creating Escalation Repository: %w", err)
+	}
 	originsRepository, err := originrepository.NewOriginRepository(pgClient)
 	if err != nil {
 		return err
@@ -123,6 +149,10 @@ func run(config config.Config) error {
 	if err != nil {
 		return fmt.Errorf("error creating Rule Repository: %w", err)
 	}
+	templateRepository, err := templaterepository.NewTemplateRepository(pgClient)
+	if err != nil {
+		return fmt.Errorf("error creating Template Repository: %w", err)
+	}
 
 	// Encrypt
 	manager := security.NewEncryptManager()
@@ -133,10 +163,29 @@ func run(config config.Config) error {
 		return fmt.Errorf("error creating Notification Channel Repository: %w", err)
 	}
 
+	var mailLayout *notificationchannelservice.MailLayout
+	if config.MailLayout.Enabled {
+		mailLayout, err = notificationchannelservice.NewMailLayout(notificationchannelservice.MailLayoutConfig{
+			TemplateFile: config.MailLayout.TemplateFile,
+			Header:       config.MailLayout.Header,
+			Footer:       config.MailLayout.Footer,
+			LogoUrl:      config.MailLayout.LogoUrl,
+		})
+		if err != nil {
+			return fmt.Errorf("error creating mail layout: %w", err)
+		}
+	}
+
 	notificationTransport := http.Client{Timeout: 30 * time.Second}
-	mailService := notificationchannelservice.NewMailService()
+	mailService := notificationchannelservice.NewMailService(mailLayout, &notificationTransport)
 	mattermostService := notificationchannelservice.NewMattermostService(&notificationTransport)
 	teamsService := notificationchannelservice.NewTeamsService(&notificationTransport)
+	slackService := notificationchannelservice.NewSlackService(&notificationTransport)
+	outboundWebhookService := notificationchannelservice.NewOutboundWebhookService(&notificationTransport)
+	syslogService := notificationchannelservice.NewSyslogService(nil)
+	incidentService := notificationchannelservice.NewIncidentService(&notificationTransport)
+	pushService := notificationchannelservice.NewPushService(&notificationTransport)
+	matrixService := notificationchannelservice.NewMatrixService(&notificationTransport)
 	notificationChannelService := notificationchannelservice.NewNotificationChannelService(notificationChannelRepository)
 	mailChannelService := notificationchannelservice.NewMailChannelService(
 		notificationChannelService, mailService, config.ChannelLimit.EMailLimit)
@@ -144,19 +193,53 @@ func run(config config.Config) error {
 		notificationChannelService, config.ChannelLimit.MattermostLimit, mattermostService)
 	teamsChannelService := notificationchannelservice.NewTeamsChannelService(
 		notificationChannelService, config.ChannelLimit.TeamsLimit, teamsService)
-	originService := originservice.NewOriginService(originsRepository)
+	slackChannelService := notificationchannelservice.NewSlackChannelService(
+		notificationChannelService, config.ChannelLimit.SlackLimit, slackService)
+	webhookChannelService := notificationchannelservice.NewWebhookChannelService(
+		notificationChannelService, config.ChannelLimit.WebhookLimit, outboundWebhookService)
+	syslogChannelService := notificationchannelservice.NewSyslogChannelService(
+		notificationChannelService, config.ChannelLimit.SyslogLimit, syslogService)
+	incidentChannelService := notificationchannelservice.NewIncidentChannelService(
+		notificationChannelService, config.ChannelLimit.IncidentLimit)
+	pushChannelService := notificationchannelservice.NewPushChannelService(
+		notificationChannelService, config.ChannelLimit.PushLimit, pushService)
+	matrixChannelService := notificationchannelservice.NewMatrixChannelService(
+		notificationChannelService, config.ChannelLimit.MatrixLimit, matrixService)
+	originService := originservice.NewOriginService(originsRepository, config.PublicBaseUrl)
 	ruleService, err := ruleservice.NewRuleService(
 		ruleRepository, notificationChannelRepository, originsRepository, config.RuleLimit)
 	if err != nil {
 		return fmt.Errorf("failed to initialize origin service: %w", err)
 	}
+	templateService := templateservice.NewTemplateService(templateRepository)
 	notificationService := notificationservice.NewNotificationService(
 		notificationRepository,
+		sendTaskRepository,
+		deliveryAttemptRepository,
+		deadLetterRepository,
+		escalationRepository,
 		ruleService,
 		notificationChannelService,
+		templateService,
+		originService,
 		mailService,
 		mattermostService,
 		teamsService,
+		slackService,
+		outboundWebhookService,
+		syslogService,
+		incidentService,
+		pushService,
+		matrixService,
+		notificationservice.WorkerPoolConfig{
+			RuleWorkers:       config.WorkerPool.RuleWorkers,
+			IntakeQueueSize:   config.WorkerPool.IntakeQueueSize,
+			DeliveryWorkers:   config.WorkerPool.DeliveryWorkers,
+			DeliveryQueueSize: config.WorkerPool.DeliveryQueueSize,
+			Backpressure:      notificationservice.Backpressure(config.WorkerPool.Backpressure),
+		},
+		config.IdempotencyWindow,
+		config.SuppressionWindow,
 	)
 	healthService := healthservice.NewHealthService(pgClient)
 
@@ -172,6 +255,13 @@ func run(config config.Config) error {
 	if err != nil {
 		return fmt.Errorf("error creating mail connectivity check job: %w", err)
 	}
+	_, err = scheduler.NewJob(
+		gocron.DurationJob(1*time.Minute),
+		gocron.NewTask(escalatenotifications.NewJob(notificationService)),
+	)
+	if err != nil {
+		return fmt.Errorf("error creating escalation job: %w", err)
+	}
 	scheduler.Start()
 
 	registry := errmap.NewRegistry()
@@ -185,13 +275,20 @@ func run(config config.Config) error {
 
 	// instantiate controllers
 	notificationServiceRouter := router.Group("/api/notification-service")
-	notificationcontroller.AddNotificationController(notificationServiceRouter, notificationService, authMiddleware)
+	notificationcontroller.AddNotificationController(notificationServiceRouter, notificationService, authMiddleware, registry)
 	mailcontroller.NewMailController(notificationServiceRouter, notificationChannelService, mailChannelService, authMiddleware, registry)
 	mailcontroller.AddCheckMailServerController(notificationServiceRouter, mailChannelService, authMiddleware, registry)
 	mattermostcontroller.NewMattermostController(notificationServiceRouter, notificationChannelService, mattermostChannelService, authMiddleware, registry)
 	teamscontroller.NewTeamsController(notificationServiceRouter, notificationChannelRepository, teamsChannelService, authMiddleware, registry)
+	slackcontroller.NewSlackController(notificationServiceRouter, notificationChannelService, slackChannelService, authMiddleware, registry)
+	webhookcontroller.NewWebhookController(notificationServiceRouter, notificationChannelService, webhookChannelService, authMiddleware, registry)
+	syslogcontroller.NewSyslogController(notificationServiceRouter, notificationChannelService, syslogChannelService, authMiddleware, registry)
+	incidentcontroller.NewIncidentController(notificationServiceRouter, notificationChannelService, incidentChannelService, authMiddleware, registry)
+	pushcontroller.NewPushController(notificationServiceRouter, notificationChannelService, pushChannelService, authMiddleware, registry)
+	matrixcontroller.NewMatrixController(notificationServiceRouter, notificationChannelService, matrixChannelService, authMiddleware, registry)
 	origincontroller.NewOriginController(notificationServiceRouter, originService, authMiddleware)
 	rulecontroller.NewRuleController(notificationServiceRouter, ruleService, authMiddleware, registry)
+	templatecontroller.NewTemplateController(notificationServiceRouter, templateService, authMiddleware, registry)
 
 	// health router
 	rootRouter := router.Group("/")
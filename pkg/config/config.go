@@ -19,12 +19,42 @@ type Config struct {
 	RuleLimit             int                   `envconfig:"RULE_LIMIT" default:"100"`
 	ChannelLimit          ChannelLimits         `envconfig:"CHANNELLIMIT"`
 	DatabaseEncryptionKey DatabaseEncryptionKey `envconfig:"DATABASE_ENCRYPTION_KEY"`
+	WorkerPool            WorkerPool            `envconfig:"WORKERPOOL"`
+	IdempotencyWindow     time.Duration         `validate:"min=0" envconfig:"IDEMPOTENCY_WINDOW" default:"24h"` // time in which a repeated notification with the same idempotency key is not created again
+	SuppressionWindow     time.Duration         `validate:"min=0" envconfig:"SUPPRESSION_WINDOW" default:"0"`   // default time in which repeats of a forwarded notification are not forwarded, rules can override it, zero disables the suppression
+	MailLayout            MailLayout            `envconfig:"MAIL_LAYOUT"`
+	PublicBaseUrl         string                `validate:"omitempty,url" envconfig:"PUBLIC_BASE_URL"` // URL under which OpenSight is reachable for recipients, used for links in messages to paths of origins
 }
 
 type ChannelLimits struct {
 	EMailLimit      int `envconfig:"EMAIL_LIMIT" default:"1"`
 	MattermostLimit int `envconfig:"MATTERMOST_LIMIT" default:"20"`
 	TeamsLimit      int `envconfig:"TEAMS_LIMIT" default:"20"`
+	SlackLimit      int `envconfig:"SLACK_LIMIT" default:"20"`
+	WebhookLimit    int `envconfig:"WEBHOOK_LIMIT" default:"20"`
+	SyslogLimit     int `envconfig:"SYSLOG_LIMIT" default:"20"`
+	IncidentLimit   int `envconfig:"INCIDENT_LIMIT" default:"20"`
+	PushLimit       int `envconfig:"PUSH_LIMIT" default:"20"`
+	MatrixLimit     int `envconfig:"MATRIX_LIMIT" default:"20"`
+}
+
+// WorkerPool bounds the concurrent processing of incoming notifications and deliveries.
+type WorkerPool struct {
+	RuleWorkers       int    `validate:"min=1" envconfig:"RULE_WORKERS" default:"4"`
+	IntakeQueueSize   int    `validate:"min=1" envconfig:"INTAKE_QUEUE_SIZE" default:"100"`
+	DeliveryWorkers   int    `validate:"min=1" envconfig:"DELIVERY_WORKERS" default:"4"`
+	DeliveryQueueSize int    `validate:"min=1" envconfig:"DELIVERY_QUEUE_SIZE" default:"500"`
+	Backpressure      string `validate:"oneof=reject block" envconfig:"BACKPRESSURE" default:"reject"` // behavior if the intake queue is full
+}
+
+// MailLayout configures the optional branded layout of mails. The HTML part shows the message between
+// header and footer together with a badge for origin and level. Without it, mails contain only the message.
+type MailLayout struct {
+	Enabled      bool   `envconfig:"ENABLED" default:"false"`
+	TemplateFile string `envconfig:"TEMPLATE_FILE"` // html/template file replacing the built-in layout, optional
+	Header       string `envconfig:"HEADER" default:"Greenbone OpenSight"`
+	Footer       string `envconfig:"FOOTER" default:"This message was sent by the Greenbone OpenSight notification service."`
+	LogoUrl      string `envconfig:"LOGO_URL"` // URL of an image shown in the header, optional
 }
 
 type Http struct {
@@ -5,7 +5,8 @@
 package entities
 
 type Origin struct {
-	Name      string
-	Class     string
-	ServiceID string // read-only
+	Name        string
+	Class       string
+	ServiceID   string // read-only
+	UrlTemplate string
 }
@@ -2,6 +2,7 @@ package checkmailconnectivity
 
 import (
 	"context"
+	"errors"
 	"fmt"
 	"time"
 
@@ -32,16 +33,30 @@ func NewJob(
 
 		for _, channel := range mailChannels {
 			if err := checkChannelConnectivity(mailChannelService, channel); err != nil {
+				title := "Mailserver not reachable"
+				detail := fmt.Sprintf("Mailserver:%s not reachable: %s", *channel.Domain, err)
+				// an access token which can't be requested is a problem of the identity provider or the client credentials
+				if errors.Is(err, notificationchannelservice.ErrMailOAuthToken) {
+					title = "Mailserver authentication failed"
+					detail = fmt.Sprintf("Mailserver:%s access token could not be requested: %s", *channel.Domain, err)
+				}
+
+				authMethod := helper.SafeDereference(channel.MailAuthMethod)
+				if authMethod == "" {
+					authMethod = models.MailAuthPassword
+				}
+
 				_, err := notificationService.CreateNotification(context.Background(), models.Notification{
 					Origin:    "Communication service",
 					Timestamp: time.Now().UTC().Format(time.RFC3339),
-					Title:     "Mailserver not reachable",
-					Detail:    fmt.Sprintf("Mailserver:%s not reachable: %s", *channel.Domain, err),
+					Title:     title,
+					Detail:    detail,
 					Level:     "info",
 					CustomFields: map[string]any{
-						"Domain":   helper.SafeDereference(channel.Domain),
-						"Port":     helper.SafeDereference(channel.Port),
-						"Username": helper.SafeDereference(channel.Username),
+						"Domain":     helper.SafeDereference(channel.Domain),
+						"Port":       helper.SafeDereference(channel.Port),
+						"Username":   helper.SafeDereference(channel.Username),
+						"AuthMethod": authMethod,
 					},
 				})
 				if err != nil {
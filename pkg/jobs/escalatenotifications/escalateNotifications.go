@@ -0,0 +1,20 @@
+package escalatenotifications
+
+import (
+	"context"
+	"time"
+
+	"github.com/greenbone/opensight-notification-service/pkg/services/notificationservice"
+)
+
+const escalationTimeout = 5 * time.Minute
+
+// NewJob returns a job executing the due escalation steps of unacknowledged urgent notifications.
+func NewJob(notificationService notificationservice.NotificationService) func() error {
+	return func() error {
+		ctx, cancel := context.WithTimeout(context.Background(), escalationTimeout)
+		defer cancel()
+
+		return notificationService.EscalateNotifications(ctx)
+	}
+}
@@ -0,0 +1,276 @@
+// SPDX-FileCopyrightText: 2026 Greenbone AG <https://greenbone.net>
+//
+// SPDX-License-Identifier: AGPL-3.0-or-later
+
+// Package markdown renders the CommonMark text of notifications for the different channel types,
+// so a notification looks the same in every channel and can not inject markup.
+package markdown
+
+import (
+	"bytes"
+	"fmt"
+	"html"
+	"slices"
+	"strings"
+
+	"github.com/microcosm-cc/bluemonday"
+	"github.com/yuin/goldmark"
+	"github.com/yuin/goldmark/ast"
+	"github.com/yuin/goldmark/extension"
+	extensionast "github.com/yuin/goldmark/extension/ast"
+	goldmarkhtml "github.com/yuin/goldmark/renderer/html"
+	"github.com/yuin/goldmark/text"
+	"github.com/yuin/goldmark/util"
+)
+
+// converter parses CommonMark with the GitHub extensions (tables, strikethrough, autolinks).
+// Raw HTML is omitted from the rendered HTML, as the unsafe option is not set.
+var converter = goldmark.New(
+	goldmark.WithExtensions(extension.GFM),
+	goldmark.WithRendererOptions(goldmarkhtml.WithHardWraps()),
+)
+
+// sanitizer removes everything from the rendered HTML which is not safe in a mail, e.g. scripts and event handlers
+var sanitizer = bluemonday.UGCPolicy()
+
+var lineBreakReplacer = strings.NewReplacer(
+	"\r\n", "\n",
+	`\\n`, `\n`, // escaped line breaks are kept as literal text
+	`\n`, "\n",
+)
+
+var escapeReplacer = strings.NewReplacer(
+	`\`, `\\`,
+	"*", `\*`,
+	"_", `\_`,
+	"`", "\\`",
+	"~", `\~`,
+)
+
+// linkTitleReplacer escapes the brackets of a link title in addition to the emphasis characters
+var linkTitleReplacer = strings.NewReplacer(
+	`\`, `\\`,
+	"*", `\*`,
+	"_", `\_`,
+	"`", "\\`",
+	"~", `\~`,
+	"[", `\[`,
+	"]", `\]`,
+)
+
+// linkDestinationReplacer percent-encodes the characters which would end a link destination,
+// the URL stays the same for the browser
+var linkDestinationReplacer = strings.NewReplacer(
+	" ", "%20",
+	"(", "%28",
+	")", "%29",
+	"<", "%3C",
+	">", "%3E",
+	"\t", "%09",
+	"\r", "%0D",
+	"\n", "%0A",
+)
+
+// Normalize converts the line breaks origins send as literal \n into real line breaks.
+// A literal \\n is kept as \n text.
+func Normalize(markdown string) string {
+	return lineBreakReplacer.Replace(markdown)
+}
+
+// ToHTML renders the CommonMark text as sanitized HTML. Line breaks within a paragraph
+// are kept as HTML line breaks, raw HTML in the text is dropped.
+func ToHTML(markdown string) string {
+	var buf bytes.Buffer
+	if err := converter.Convert([]byte(Normalize(markdown)), &buf); err != nil {
+		// the in-memory conversion does not fail, nevertheless never send the text unescaped
+		return "<p>" + html.EscapeString(markdown) + "</p>"
+	}
+	return sanitizer.Sanitize(buf.String())
+}
+
+// ToMarkdown prepares the CommonMark text for channels which render markdown themselves, like
+// MS Teams and Mattermost. Raw HTML is dropped as in ToHTML, and line breaks within a paragraph
+// become paragraph breaks, as MS Teams ignores single line breaks.
+func ToMarkdown(markdown string) string {
+	source := []byte(Normalize(markdown))
+	document := converter.Parser().Parse(text.NewReader(source))
+
+	type edit struct {
+		start, stop int
+		replacement string
+	}
+	var edits []edit
+	remove := func(segments ...text.Segment) {
+		for _, segment := range segments {
+			edits = append(edits, edit{start: segment.Start, stop: segment.Stop})
+		}
+	}
+
+	_ = ast.Walk(document, func(node ast.Node, entering bool) (ast.WalkStatus, error) {
+		if !entering {
+			return ast.WalkContinue, nil
+		}
+		switch node := node.(type) {
+		case *ast.HTMLBlock:
+			remove(node.Lines().Sliced(0, node.Lines().Len())...)
+			if node.HasClosure() {
+				remove(node.ClosureLine)
+			}
+			return ast.WalkSkipChildren, nil
+		case *ast.RawHTML:
+			remove(node.Segments.Sliced(0, node.Segments.Len())...)
+		case *ast.Text:
+			if node.SoftLineBreak() {
+				edits = append(edits, edit{start: node.Segment.Stop, stop: node.Segment.Stop, replacement: "\n"})
+			}
+		}
+		return ast.WalkContinue, nil
+	})
+
+	slices.SortStableFunc(edits, func(a, b edit) int {
+		return a.start - b.start
+	})
+
+	var b strings.Builder
+	position := 0
+	for _, e := range edits {
+		if e.start < position {
+			continue
+		}
+		b.Write(source[position:e.start])
+		b.WriteString(e.replacement)
+		position = e.stop
+	}
+	b.Write(source[position:])
+	return b.String()
+}
+
+// ToText renders the CommonMark text as plain text, e.g. for the text part of a mail. The formatting
+// is dropped, list markers and code blocks are kept and links are followed by their URL.
+func ToText(markdown string) string {
+	source := []byte(Normalize(markdown))
+	document := converter.Parser().Parse(text.NewReader(source))
+	return blocksToText(document, source)
+}
+
+// blocksToText renders the child blocks of the node as plain text, separated by empty lines
+func blocksToText(parent ast.Node, source []byte) string {
+	var blocks []string
+	for node := parent.FirstChild(); node != nil; node = node.NextSibling() {
+		if block := blockToText(node, source); block != "" {
+			blocks = append(blocks, block)
+		}
+	}
+	return strings.Join(blocks, "\n\n")
+}
+
+func blockToText(node ast.Node, source []byte) string {
+	switch node := node.(type) {
+	case *ast.Paragraph, *ast.TextBlock, *ast.Heading:
+		return strings.TrimSpace(inlinesToText(node, source))
+	case *ast.List:
+		var items []string
+		number := node.Start
+		for item := node.FirstChild(); item != nil; item = item.NextSibling() {
+			marker := "- "
+			if node.IsOrdered() {
+				marker = fmt.Sprintf("%d. ", number)
+				number++
+			}
+			items = append(items, prefixLines(blocksToText(item, source), marker, strings.Repeat(" ", len(marker))))
+		}
+		if node.IsTight {
+			return strings.Join(items, "\n")
+		}
+		return strings.Join(items, "\n\n")
+	case *ast.Blockquote:
+		return prefixLines(blocksToText(node, source), "> ", "> ")
+	case *ast.FencedCodeBlock, *ast.CodeBlock:
+		var b strings.Builder
+		lines := node.Lines()
+		for i := 0; i < lines.Len(); i++ {
+			segment := lines.At(i)
+			b.Write(segment.Value(source))
+		}
+		return strings.TrimRight(b.String(), "\n")
+	case *ast.ThematicBreak:
+		return "---"
+	case *ast.HTMLBlock:
+		return "" // raw HTML is dropped
+	case *extensionast.Table:
+		var rows []string
+		for row := node.FirstChild(); row != nil; row = row.NextSibling() {
+			var cells []string
+			for cell := row.FirstChild(); cell != nil; cell = cell.NextSibling() {
+				cells = append(cells, strings.TrimSpace(inlinesToText(cell, source)))
+			}
+			rows = append(rows, strings.Join(cells, " | "))
+		}
+		return strings.Join(rows, "\n")
+	default:
+		return blocksToText(node, source)
+	}
+}
+
+func inlinesToText(parent ast.Node, source []byte) string {
+	var b strings.Builder
+	for node := parent.FirstChild(); node != nil; node = node.NextSibling() {
+		switch node := node.(type) {
+		case *ast.Text:
+			value := node.Value(source)
+			if !node.IsRaw() {
+				value = util.UnescapePunctuations(util.ResolveNumericReferences(util.ResolveEntityNames(value)))
+			}
+			b.Write(value)
+			if node.SoftLineBreak() || node.HardLineBreak() {
+				b.WriteString("\n")
+			}
+		case *ast.String:
+			b.Write(node.Value)
+		case *ast.RawHTML:
+			// raw HTML is dropped
+		case *ast.AutoLink:
+			b.Write(node.URL(source))
+		case *ast.Link:
+			label := inlinesToText(node, source)
+			b.WriteString(label)
+			if destination := string(node.Destination); destination != label {
+				b.WriteString(" (" + destination + ")")
+			}
+		default:
+			b.WriteString(inlinesToText(node, source))
+		}
+	}
+	return b.String()
+}
+
+// prefixLines prefixes the first line of the text with first and all following lines with others
+func prefixLines(text, first, others string) string {
+	lines := strings.Split(text, "\n")
+	for i, line := range lines {
+		prefix := others
+		if i == 0 {
+			prefix = first
+		}
+		if line == "" {
+			prefix = strings.TrimRight(prefix, " ")
+		}
+		lines[i] = prefix + line
+	}
+	return strings.Join(lines, "\n")
+}
+
+// EscapeText escapes the characters of a plain text, e.g. the subject, which would
+// otherwise be rendered as emphasis or code when embedded into markdown.
+func EscapeText(plainText string) string {
+	return escapeReplacer.Replace(plainText)
+}
+
+// Link renders a markdown link to the URL, e.g. of the origin, it is empty without URL.
+// Spaces and parentheses of templated URLs are percent-encoded, so they don't end the link.
+func Link(title, url string) string {
+	if url == "" {
+		return ""
+	}
+	return fmt.Sprintf("[%s](%s)", linkTitleReplacer.Replace(title), linkDestinationReplacer.Replace(url))
+}
@@ -0,0 +1,199 @@
+// SPDX-FileCopyrightText: 2026 Greenbone AG <https://greenbone.net>
+//
+// SPDX-License-Identifier: AGPL-3.0-or-later
+
+package markdown
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestToHTML(t *testing.T) {
+	tests := map[string]struct {
+		markdown string
+		want     string
+	}{
+		"plain text": {
+			markdown: "A new vulnerability was found.",
+			want:     "<p>A new vulnerability was found.</p>\n",
+		},
+		"formatting": {
+			markdown: "**Host** `10.0.0.1` is *affected*\n\n- CVE-1\n- CVE-2",
+			want:     "<p><strong>Host</strong> <code>10.0.0.1</code> is <em>affected</em></p>\n<ul>\n<li>CVE-1</li>\n<li>CVE-2</li>\n</ul>\n",
+		},
+		"line breaks": {
+			markdown: "first line\nsecond line\\nthird line\\\\nstill third line",
+			want:     "<p>first line<br>\nsecond line<br>\nthird line\\nstill third line</p>\n",
+		},
+		"raw html is dropped": {
+			markdown: "<script>alert(1)</script>\n\nclick <a href=\"https://evil.example.com\">here</a>",
+			want:     "\n<p>click here</p>\n",
+		},
+		"unsafe link": {
+			markdown: "[details](javascript:alert(1))",
+			want:     "<p>details</p>\n",
+		},
+		"link": {
+			markdown: "[details](https://example.com/finding/1)",
+			want:     "<p><a href=\"https://example.com/finding/1\" rel=\"nofollow\">details</a></p>\n",
+		},
+		"escaped characters": {
+			markdown: "1 < 2 & 3 > 2",
+			want:     "<p>1 &lt; 2 &amp; 3 &gt; 2</p>\n",
+		},
+	}
+	for name, tt := range tests {
+		t.Run(name, func(t *testing.T) {
+			assert.Equal(t, tt.want, ToHTML(tt.markdown))
+		})
+	}
+}
+
+func TestToMarkdown(t *testing.T) {
+	tests := map[string]struct {
+		markdown string
+		want     string
+	}{
+		"plain text": {
+			markdown: "A new vulnerability was found.",
+			want:     "A new vulnerability was found.",
+		},
+		"line breaks become paragraphs": {
+			markdown: "first line\nsecond line\\nthird line\n\nnext paragraph",
+			want:     "first line\n\nsecond line\n\nthird line\n\nnext paragraph",
+		},
+		"escaped line break": {
+			markdown: "C:\\\\new",
+			want:     "C:\\new",
+		},
+		"code block is kept": {
+			markdown: "```\nline 1\nline 2\n```",
+			want:     "```\nline 1\nline 2\n```",
+		},
+		"raw html is dropped": {
+			markdown: "<div>\n<b>block</b>\n</div>\n\ntext with <b>inline</b> html",
+			want:     "\ntext with inline html",
+		},
+	}
+	for name, tt := range tests {
+		t.Run(name, func(t *testing.T) {
+			assert.Equal(t, tt.want, ToMarkdown(tt.markdown))
+		})
+	}
+}
+
+func TestToText(t *testing.T) {
+	tests := map[string]struct {
+		markdown string
+		want     string
+	}{
+		"plain text": {
+			markdown: "A new vulnerability was found.",
+			want:     "A new vulnerability was found.",
+		},
+		"formatting is dropped": {
+			markdown: "# Finding\n\n**Host** `10.0.0.1` is *affected* \\* &amp; ~~fixed~~",
+			want:     "Finding\n\nHost 10.0.0.1 is affected * & fixed",
+		},
+		"lists": {
+			markdown: "- CVE-1\n- CVE-2\n  continued\n\n3. third\n4. fourth",
+			want:     "- CVE-1\n- CVE-2\n  continued\n\n3. third\n4. fourth",
+		},
+		"links": {
+			markdown: "[details](https://example.com/finding/1) and <https://example.com>",
+			want:     "details (https://example.com/finding/1) and https://example.com",
+		},
+		"code block and quote": {
+			markdown: "```\nline 1\n  line 2\n```\n\n> quoted\n> text",
+			want:     "line 1\n  line 2\n\n> quoted\n> text",
+		},
+		"table": {
+			markdown: "| Host | Score |\n| --- | --- |\n| a | 9.8 |",
+			want:     "Host | Score\na | 9.8",
+		},
+		"raw html is dropped": {
+			markdown: "<div>block</div>\n\nclick <a href=\"https://evil.example.com\">here</a>",
+			want:     "click here",
+		},
+	}
+	for name, tt := range tests {
+		t.Run(name, func(t *testing.T) {
+			assert.Equal(t, tt.want, ToText(tt.markdown))
+		})
+	}
+}
+
+func TestEscapeText(t *testing.T) {
+	assert.Equal(t, `\*\*not bold\*\* \_x\_ \`+"`"+`code\`+"`", EscapeText("**not bold** _x_ `code`"))
+}
+
+func TestLink(t *testing.T) {
+	tests := map[string]struct {
+		title string
+		url   string
+		want  string
+	}{
+		"plain url":              {title: "Open", url: "https://example.com/a?b=1", want: "[Open](https://example.com/a?b=1)"},
+		"parentheses and spaces": {title: "Open", url: "https://example.com/a (1)", want: "[Open](https://example.com/a%20%281%29)"},
+		"line break":             {title: "Open", url: "https://example.com/a\n<b>", want: "[Open](https://example.com/a%0A%3Cb%3E)"},
+		"brackets in title":      {title: "[Open] *now*", url: "https://example.com", want: `[\[Open\] \*now\*](https://example.com)`},
+		"without url":            {title: "Open", url: "", want: ""},
+	}
+	for name, tt := range tests {
+		t.Run(name, func(t *testing.T) {
+			assert.Equal(t, tt.want, Link(tt.title, tt.url))
+		})
+	}
+}
+
+func TestLink_RendersWholeUrl(t *testing.T) {
+	assert.Equal(t, "<p><a href=\"https://example.com/a%20%281%29\" rel=\"nofollow\">Open</a></p>\n",
+		ToHTML(Link("Open", "https://example.com/a (1)")))
+}
+
+func TestToSlack(t *testing.T) {
+	tests := map[string]struct {
+		markdown string
+		want     string
+	}{
+		"plain text": {
+			markdown: "A new vulnerability was found.",
+			want:     "A new vulnerability was found.",
+		},
+		"formatting": {
+			markdown: "# Finding\n\n**Host** `10.0.0.1` is *affected* ~~fixed~~",
+			want:     "*Finding*\n\n*Host* `10.0.0.1` is _affected_ ~fixed~",
+		},
+		"lists": {
+			markdown: "- CVE-1\n- CVE-2\n\n3. third\n4. fourth",
+			want:     "• CVE-1\n• CVE-2\n\n3. third\n4. fourth",
+		},
+		"links": {
+			markdown: "[details](https://example.com/finding/1) and <https://example.com>",
+			want:     "<https://example.com/finding/1|details> and <https://example.com>",
+		},
+		"unsafe link": {
+			markdown: "[details](javascript:alert(1))",
+			want:     "details",
+		},
+		"mentions and special characters are escaped": {
+			markdown: "<!channel> 1 < 2 & <@U123>",
+			want:     "&lt;!channel&gt; 1 &lt; 2 &amp; &lt;@U123&gt;",
+		},
+		"code block and quote": {
+			markdown: "```\nif a < b {\n```\n\n> quoted\n> text",
+			want:     "```\nif a &lt; b {\n```\n\n> quoted\n> text",
+		},
+		"table": {
+			markdown: "| Host | Score |\n| --- | --- |\n| a | 9.8 |",
+			want:     "*Host | Score*\na | 9.8",
+		},
+	}
+	for name, tt := range tests {
+		t.Run(name, func(t *testing.T) {
+			assert.Equal(t, tt.want, ToSlack(tt.markdown))
+		})
+	}
+}
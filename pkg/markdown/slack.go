@@ -0,0 +1,162 @@
+// SPDX-FileCopyrightText: 2026 Greenbone AG <https://greenbone.net>
+//
+// SPDX-License-Identifier: AGPL-3.0-or-later
+
+package markdown
+
+import (
+	"fmt"
+	"strings"
+
+	"github.com/yuin/goldmark/ast"
+	extensionast "github.com/yuin/goldmark/extension/ast"
+	"github.com/yuin/goldmark/text"
+	"github.com/yuin/goldmark/util"
+)
+
+// slackEscapeReplacer escapes the characters Slack uses for links and mentions
+var slackEscapeReplacer = strings.NewReplacer("&", "&amp;", "<", "&lt;", ">", "&gt;")
+
+// ToSlack renders the CommonMark text in the mrkdwn format of Slack, which uses its own syntax
+// for emphasis and links. Raw HTML is dropped, and text can not mention users or channels.
+// For details see: https://api.slack.com/reference/surfaces/formatting
+func ToSlack(markdown string) string {
+	source := []byte(Normalize(markdown))
+	document := converter.Parser().Parse(text.NewReader(source))
+	return blocksToSlack(document, source)
+}
+
+// EscapeSlack escapes a plain text, e.g. the subject, for a mrkdwn text
+func EscapeSlack(plainText string) string {
+	return slackEscapeReplacer.Replace(plainText)
+}
+
+func blocksToSlack(parent ast.Node, source []byte) string {
+	var blocks []string
+	for node := parent.FirstChild(); node != nil; node = node.NextSibling() {
+		if block := blockToSlack(node, source); block != "" {
+			blocks = append(blocks, block)
+		}
+	}
+	return strings.Join(blocks, "\n\n")
+}
+
+func blockToSlack(node ast.Node, source []byte) string {
+	switch node := node.(type) {
+	case *ast.Paragraph, *ast.TextBlock:
+		return strings.TrimSpace(inlinesToSlack(node, source))
+	case *ast.Heading:
+		return emphasize(strings.TrimSpace(inlinesToSlack(node, source)), "*")
+	case *ast.List:
+		var items []string
+		number := node.Start
+		for item := node.FirstChild(); item != nil; item = item.NextSibling() {
+			marker := "• "
+			if node.IsOrdered() {
+				marker = fmt.Sprintf("%d. ", number)
+				number++
+			}
+			items = append(items, prefixLines(blocksToSlack(item, source), marker, "    "))
+		}
+		if node.IsTight {
+			return strings.Join(items, "\n")
+		}
+		return strings.Join(items, "\n\n")
+	case *ast.Blockquote:
+		return prefixLines(blocksToSlack(node, source), "> ", "> ")
+	case *ast.FencedCodeBlock, *ast.CodeBlock:
+		var b strings.Builder
+		lines := node.Lines()
+		for i := 0; i < lines.Len(); i++ {
+			segment := lines.At(i)
+			b.Write(segment.Value(source))
+		}
+		return "```\n" + EscapeSlack(strings.TrimRight(b.String(), "\n")) + "\n```"
+	case *ast.ThematicBreak:
+		return "---"
+	case *ast.HTMLBlock:
+		return "" // raw HTML is dropped
+	case *extensionast.Table:
+		var rows []string
+		for row := node.FirstChild(); row != nil; row = row.NextSibling() {
+			var cells []string
+			for cell := row.FirstChild(); cell != nil; cell = cell.NextSibling() {
+				cells = append(cells, strings.TrimSpace(inlinesToSlack(cell, source)))
+			}
+			line := strings.Join(cells, " | ")
+			if _, isHeader := row.(*extensionast.TableHeader); isHeader {
+				line = emphasize(line, "*")
+			}
+			rows = append(rows, line)
+		}
+		return strings.Join(rows, "\n")
+	default:
+		return blocksToSlack(node, source)
+	}
+}
+
+func inlinesToSlack(parent ast.Node, source []byte) string {
+	var b strings.Builder
+	for node := parent.FirstChild(); node != nil; node = node.NextSibling() {
+		switch node := node.(type) {
+		case *ast.Text:
+			value := node.Value(source)
+			if !node.IsRaw() {
+				value = util.UnescapePunctuations(util.ResolveNumericReferences(util.ResolveEntityNames(value)))
+			}
+			b.WriteString(EscapeSlack(string(value)))
+			if node.SoftLineBreak() || node.HardLineBreak() {
+				b.WriteString("\n")
+			}
+		case *ast.String:
+			b.WriteString(EscapeSlack(string(node.Value)))
+		case *ast.RawHTML:
+			// raw HTML is dropped
+		case *ast.CodeSpan:
+			b.WriteString(emphasize(inlinesToSlack(node, source), "`"))
+		case *ast.Emphasis:
+			marker := "_"
+			if node.Level == 2 {
+				marker = "*"
+			}
+			b.WriteString(emphasize(inlinesToSlack(node, source), marker))
+		case *extensionast.Strikethrough:
+			b.WriteString(emphasize(inlinesToSlack(node, source), "~"))
+		case *ast.AutoLink:
+			b.WriteString(slackLink(string(node.URL(source)), ""))
+		case *ast.Link:
+			b.WriteString(slackLink(string(node.Destination), inlinesToSlack(node, source)))
+		case *ast.Image:
+			b.WriteString(slackLink(string(node.Destination), inlinesToSlack(node, source)))
+		default:
+			b.WriteString(inlinesToSlack(node, source))
+		}
+	}
+	return b.String()
+}
+
+// emphasize encloses the text with the marker, Slack does not render markers around whitespace
+func emphasize(text, marker string) string {
+	trimmed := strings.TrimSpace(text)
+	if trimmed == "" {
+		return text
+	}
+	return marker + trimmed + marker
+}
+
+// slackLink renders a link as <url|label>, links with other schemes than http(s) and mailto are kept as label
+func slackLink(destination, label string) string {
+	if !isSlackLinkScheme(destination) {
+		return label
+	}
+	destination = strings.NewReplacer("|", "%7C", " ", "%20").Replace(EscapeSlack(destination))
+	if label == "" || label == destination {
+		return "<" + destination + ">"
+	}
+	return "<" + destination + "|" + strings.ReplaceAll(label, "|", "¦") + ">"
+}
+
+func isSlackLinkScheme(destination string) bool {
+	lower := strings.ToLower(destination)
+	return strings.HasPrefix(lower, "https://") || strings.HasPrefix(lower, "http://") || strings.HasPrefix(lower, "mailto:")
+}
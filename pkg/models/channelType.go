@@ -6,9 +6,25 @@ const (
 	ChannelTypeMail       ChannelType = "mail"
 	ChannelTypeMattermost ChannelType = "mattermost"
 	ChannelTypeTeams      ChannelType = "teams"
+	ChannelTypeSlack      ChannelType = "slack"
+	ChannelTypeWebhook    ChannelType = "webhook"
+	ChannelTypeSyslog     ChannelType = "syslog"
+	ChannelTypeIncident   ChannelType = "incident"
+	ChannelTypePush       ChannelType = "push"
+	ChannelTypeMatrix     ChannelType = "matrix"
 )
 
-var AllowedChannels = []ChannelType{ChannelTypeMail, ChannelTypeMattermost, ChannelTypeTeams}
+var AllowedChannels = []ChannelType{
+	ChannelTypeMail,
+	ChannelTypeMattermost,
+	ChannelTypeTeams,
+	ChannelTypeSlack,
+	ChannelTypeWebhook,
+	ChannelTypeSyslog,
+	ChannelTypeIncident,
+	ChannelTypePush,
+	ChannelTypeMatrix,
+}
 
 // HasRecipient returns true if the channel type requires/supports an explicit recipient.
 func (ct ChannelType) HasRecipient() bool {
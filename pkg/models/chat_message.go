@@ -0,0 +1,71 @@
+// SPDX-FileCopyrightText: 2026 Greenbone AG <https://greenbone.net>
+//
+// SPDX-License-Identifier: AGPL-3.0-or-later
+
+package models
+
+import (
+	"fmt"
+	"slices"
+	"strconv"
+
+	"github.com/greenbone/opensight-golang-libraries/pkg/notifications"
+)
+
+// maxMessageFields limits the custom fields shown in chat messages, so the messages stay compact
+const maxMessageFields = 10
+
+// OpenLinkTitle is the label of links to the origin resource
+const OpenLinkTitle = "Open in OpenSight"
+
+// ChatMessage is a notification prepared for chat channels like MS Teams, Mattermost and Slack.
+// Each channel type renders the details in its own structured format.
+type ChatMessage struct {
+	Title       string // plain text
+	Text        string // markdown
+	Level       notifications.Level
+	Origin      string
+	OriginClass string
+	Timestamp   string
+	Fields      []MessageField // selected custom fields of the notification
+	Link        string         // link to the origin resource, optional
+}
+
+// MessageField is a named value shown in a message.
+type MessageField struct {
+	Name  string
+	Value string
+}
+
+// MessageFields selects the custom fields which are shown in messages. Only fields with a
+// plain value are shown sorted by name, as nested values can not be displayed compactly.
+func (n *Notification) MessageFields() []MessageField {
+	names := make([]string, 0, len(n.CustomFields))
+	for name := range n.CustomFields {
+		names = append(names, name)
+	}
+	slices.Sort(names)
+
+	var fields []MessageField
+	for _, name := range names {
+		var value string
+		switch v := n.CustomFields[name].(type) {
+		case string:
+			value = v
+		case float64:
+			value = strconv.FormatFloat(v, 'f', -1, 64)
+		case bool, int, int64:
+			value = fmt.Sprint(v)
+		default:
+			continue
+		}
+		if value == "" {
+			continue
+		}
+		fields = append(fields, MessageField{Name: name, Value: value})
+		if len(fields) == maxMessageFields {
+			break
+		}
+	}
+	return fields
+}
@@ -0,0 +1,20 @@
+// SPDX-FileCopyrightText: 2026 Greenbone AG <https://greenbone.net>
+//
+// SPDX-License-Identifier: AGPL-3.0-or-later
+
+package models
+
+import "time"
+
+// DeadLetter is a delivery which failed permanently. It is kept until it is replayed.
+type DeadLetter struct {
+	ID             string           `json:"id" readonly:"true"`
+	NotificationID string           `json:"notificationID"`
+	Notification   *Notification    `json:"notification,omitempty"` // only populated when inspecting a single dead letter
+	RuleID         string           `json:"ruleID,omitempty"`       // rule which caused the delivery, empty if not caused by a rule
+	Channel        ChannelReference `json:"channel"`
+	Recipient      string           `json:"recipient,omitempty"`
+	Attempts       int              `json:"attempts"`
+	LastError      string           `json:"lastError"`
+	DeadLetteredAt time.Time        `json:"deadLetteredAt" format:"date-time"`
+}
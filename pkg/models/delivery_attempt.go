@@ -0,0 +1,28 @@
+// SPDX-FileCopyrightText: 2026 Greenbone AG <https://greenbone.net>
+//
+// SPDX-License-Identifier: AGPL-3.0-or-later
+
+package models
+
+import "time"
+
+type DeliveryOutcome string
+
+const (
+	DeliveryOutcomeSuccess DeliveryOutcome = "success"
+	DeliveryOutcomeFailure DeliveryOutcome = "failure" // delivery failed, it will be retried
+	DeliveryOutcomeDropped DeliveryOutcome = "dropped" // delivery failed, it will not be retried anymore
+)
+
+// DeliveryAttempt records the outcome of one attempt to forward a notification to a channel.
+type DeliveryAttempt struct {
+	ID             string           `json:"id" readonly:"true"`
+	NotificationID string           `json:"notificationID"`
+	RuleID         string           `json:"ruleID,omitempty"` // rule which caused the delivery, empty if not caused by a rule
+	Channel        ChannelReference `json:"channel"`
+	Recipient      string           `json:"recipient,omitempty"`
+	Attempt        int              `json:"attempt"` // starts with 1 for the first attempt
+	Timestamp      time.Time        `json:"timestamp" format:"date-time"`
+	Outcome        DeliveryOutcome  `json:"outcome" enums:"success,failure,dropped"`
+	Error          string           `json:"error,omitempty"` // reason of the failure
+}
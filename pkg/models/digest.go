@@ -0,0 +1,32 @@
+// SPDX-FileCopyrightText: 2026 Greenbone AG <https://greenbone.net>
+//
+// SPDX-License-Identifier: AGPL-3.0-or-later
+
+package models
+
+import "github.com/greenbone/opensight-golang-libraries/pkg/notifications"
+
+// DigestMessage summarizes several notifications in one message.
+// Each channel type renders the table in its own markup.
+type DigestMessage struct {
+	Title   string
+	Summary string              // short text shown above the table
+	Level   notifications.Level // most severe level of the notifications
+	Columns []string
+	Rows    [][]string
+	Links   []string // links to the origin resources of the rows, nil if no row has a link
+}
+
+// DigestLinkColumn is the title of the column which is added to the table if the digest has links
+const DigestLinkColumn = "Link"
+
+// DigestLinkTitle is the label of the links in the table
+const DigestLinkTitle = "Open"
+
+// Link returns the link of the row, empty if the row has none.
+func (d DigestMessage) Link(row int) string {
+	if row < len(d.Links) {
+		return d.Links[row]
+	}
+	return ""
+}
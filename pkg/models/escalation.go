@@ -0,0 +1,66 @@
+// SPDX-FileCopyrightText: 2026 Greenbone AG <https://greenbone.net>
+//
+// SPDX-License-Identifier: AGPL-3.0-or-later
+
+package models
+
+import (
+	"fmt"
+	"strings"
+	"time"
+
+	"github.com/greenbone/opensight-notification-service/pkg/translation"
+	"github.com/greenbone/opensight-notification-service/pkg/validation"
+)
+
+const (
+	MaxEscalationSteps        = 10
+	MaxEscalationDelayMinutes = 7 * 24 * 60
+)
+
+// EscalationStep forwards an urgent notification to a further channel, if it was not acknowledged
+// within the delay after the previous step. The first step follows the action of the rule.
+type EscalationStep struct {
+	DelayMinutes int    `json:"delayMinutes"`
+	Action       Action `json:"action"` // the channel name and type are read-only, they are resolved when the step is executed
+}
+
+// Delay returns the time to wait for an acknowledgement before the step is executed.
+func (s EscalationStep) Delay() time.Duration {
+	return time.Duration(s.DelayMinutes) * time.Minute
+}
+
+// Escalation is the pending escalation of a notification according to the escalation steps of a rule.
+// It is removed once the notification is acknowledged or the last step was executed.
+type Escalation struct {
+	ID             string
+	NotificationID string
+	RuleID         string
+	Step           int       // index of the next escalation step of the rule
+	DueAt          time.Time // execution time of the next step
+}
+
+func cleanupEscalation(steps []EscalationStep) {
+	for i := range steps {
+		steps[i].Action.Recipient = strings.TrimSpace(steps[i].Action.Recipient)
+	}
+}
+
+// validateEscalation adds the validation errors of the escalation steps to the given errors.
+func validateEscalation(steps []EscalationStep, errs ValidationErrors) {
+	if len(steps) > MaxEscalationSteps {
+		errs["escalation"] = translation.TooManyEscalationSteps
+		return
+	}
+
+	for i, step := range steps {
+		if step.DelayMinutes < 1 || step.DelayMinutes > MaxEscalationDelayMinutes {
+			errs[fmt.Sprintf("escalation[%d].delayMinutes", i)] = translation.InvalidEscalationDelay
+		}
+		if step.Action.Channel.ID == "" {
+			errs[fmt.Sprintf("escalation[%d].action.channel.id", i)] = translation.ChannelIsRequired
+		} else if validation.Validate.Var(step.Action.Channel.ID, "uuid4") != nil {
+			errs[fmt.Sprintf("escalation[%d].action.channel.id", i)] = translation.InvalidChannelID
+		}
+	}
+}
@@ -0,0 +1,55 @@
+// SPDX-FileCopyrightText: 2026 Greenbone AG <https://greenbone.net>
+//
+// SPDX-License-Identifier: AGPL-3.0-or-later
+
+package models
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func Test_ValidateEscalation(t *testing.T) {
+	validChannel := ChannelReference{ID: "9b6b7e0c-2b3f-4f38-9f6e-4f2c2d3a1b0e"}
+
+	tests := map[string]struct {
+		steps   []EscalationStep
+		wantErr ValidationErrors
+	}{
+		"no steps": {
+			wantErr: ValidationErrors{},
+		},
+		"valid steps": {
+			steps: []EscalationStep{
+				{DelayMinutes: 15, Action: Action{Channel: validChannel, Recipient: "oncall@example.com"}},
+				{DelayMinutes: MaxEscalationDelayMinutes, Action: Action{Channel: validChannel}},
+			},
+			wantErr: ValidationErrors{},
+		},
+		"invalid steps": {
+			steps: []EscalationStep{
+				{DelayMinutes: 0, Action: Action{Channel: validChannel}},
+				{DelayMinutes: MaxEscalationDelayMinutes + 1, Action: Action{}},
+				{DelayMinutes: 5, Action: Action{Channel: ChannelReference{ID: "not-a-uuid"}}},
+			},
+			wantErr: ValidationErrors{
+				"escalation[0].delayMinutes":      "The escalation delay must be between 1 minute and 7 days.",
+				"escalation[1].delayMinutes":      "The escalation delay must be between 1 minute and 7 days.",
+				"escalation[1].action.channel.id": "A channel is required.",
+				"escalation[2].action.channel.id": "Channel ID must be a valid UUIDv4.",
+			},
+		},
+		"too many steps": {
+			steps:   make([]EscalationStep, MaxEscalationSteps+1),
+			wantErr: ValidationErrors{"escalation": "Too many escalation steps, at most 10 are allowed."},
+		},
+	}
+	for name, tt := range tests {
+		t.Run(name, func(t *testing.T) {
+			errs := make(ValidationErrors)
+			validateEscalation(tt.steps, errs)
+			assert.Equal(t, tt.wantErr, errs)
+		})
+	}
+}
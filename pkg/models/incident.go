@@ -0,0 +1,14 @@
+// SPDX-FileCopyrightText: 2026 Greenbone AG <https://greenbone.net>
+//
+// SPDX-License-Identifier: AGPL-3.0-or-later
+
+package models
+
+// DefaultEventsUrl is the events API of PagerDuty, other on-call tools offer compatible endpoints
+const DefaultEventsUrl = "https://events.pagerduty.com/v2/enqueue"
+
+// Actions of events sent to the events API (PagerDuty Events API v2)
+const (
+	EventActionTrigger = "trigger"
+	EventActionResolve = "resolve"
+)
@@ -0,0 +1,17 @@
+// SPDX-FileCopyrightText: 2026 Greenbone AG <https://greenbone.net>
+//
+// SPDX-License-Identifier: AGPL-3.0-or-later
+
+package models
+
+// Authentication methods of mail channels. XOAUTH2 authenticates with an access token, which is
+// requested from the token endpoint of the identity provider with the client credentials flow.
+const (
+	MailAuthPassword = "password"
+	MailAuthXOAuth2  = "xoauth2"
+)
+
+var AllowedMailAuthMethods = []string{MailAuthPassword, MailAuthXOAuth2}
+
+// MaxOAuthScopes limits the scopes requested for the access token of a mail channel
+const MaxOAuthScopes = 10
@@ -0,0 +1,17 @@
+// SPDX-FileCopyrightText: 2026 Greenbone AG <https://greenbone.net>
+//
+// SPDX-License-Identifier: AGPL-3.0-or-later
+
+package models
+
+import "github.com/greenbone/opensight-golang-libraries/pkg/notifications"
+
+// MailMessage is the content of a mail. It is sent as multipart/alternative message,
+// so clients which can not or do not want to show HTML display the plain text part.
+type MailMessage struct {
+	Subject  string
+	HTMLBody string // sanitized HTML, it is sent as it is
+	TextBody string
+	Origin   string              // shown in the branded layout, optional
+	Level    notifications.Level // shown as badge in the branded layout, optional
+}
@@ -0,0 +1,27 @@
+// SPDX-FileCopyrightText: 2026 Greenbone AG <https://greenbone.net>
+//
+// SPDX-License-Identifier: AGPL-3.0-or-later
+
+package models
+
+// TLS modes of mail channels. Implicit TLS connects with TLS, usually on port 465, STARTTLS upgrades
+// the plain connection, usually on port 587. Opportunistic STARTTLS falls back to plain text if the
+// server doesn't offer it.
+const (
+	MailTlsImplicit      = "implicit"
+	MailTlsStartTls      = "starttls"
+	MailTlsOpportunistic = "opportunistic"
+	MailTlsNone          = "none"
+)
+
+var AllowedMailTlsModes = []string{MailTlsImplicit, MailTlsStartTls, MailTlsOpportunistic, MailTlsNone}
+
+// Minimum TLS versions of mail channels
+const (
+	MailTlsVersion10 = "1.0"
+	MailTlsVersion11 = "1.1"
+	MailTlsVersion12 = "1.2"
+	MailTlsVersion13 = "1.3"
+)
+
+var AllowedMailTlsVersions = []string{MailTlsVersion10, MailTlsVersion11, MailTlsVersion12, MailTlsVersion13}
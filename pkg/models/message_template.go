@@ -0,0 +1,206 @@
+// SPDX-FileCopyrightText: 2026 Greenbone AG <https://greenbone.net>
+//
+// SPDX-License-Identifier: AGPL-3.0-or-later
+
+package models
+
+import (
+	"fmt"
+	"strings"
+	"text/template"
+
+	"github.com/greenbone/opensight-golang-libraries/pkg/notifications"
+	"github.com/greenbone/opensight-notification-service/pkg/translation"
+)
+
+const (
+	MaxTemplateSubjectLength = 1000
+	MaxTemplateBodyLength    = 20000
+)
+
+const (
+	defaultSubjectTemplate = `{{with .LevelIcon}}{{.}} {{end}}{{.Title}} [{{.Origin}}]`
+	defaultBodyTemplate    = `{{.Detail}}`
+)
+
+// MessageTemplate determines the subject and body of the messages forwarded to channels of a type.
+// Both are Go templates (see https://pkg.go.dev/text/template) which have access to all fields of
+// the notification, e.g. `{{.Title}}` or `{{.OriginResourceID}}`, and to its custom fields,
+// e.g. `{{.CustomFields.host}}`. Custom fields which might be missing should be wrapped in `{{with}}`.
+// `{{.LevelIcon}}` is a colored icon matching the level of the notification.
+type MessageTemplate struct {
+	ChannelType ChannelType `json:"channelType" readonly:"true"`
+	Subject     string      `json:"subject"`
+	Body        string      `json:"body"`
+	IsDefault   bool        `json:"isDefault" readonly:"true"` // true if the template was not changed by an admin
+}
+
+// TemplateOverride replaces the template of the channel type for the messages of a single rule.
+// Empty fields are taken from the template of the channel type.
+type TemplateOverride struct {
+	Subject string `json:"subject,omitempty"`
+	Body    string `json:"body,omitempty"`
+}
+
+// TemplateData is passed to the message templates when rendering a notification.
+type TemplateData struct {
+	Notification
+	LevelIcon string
+}
+
+// NewTemplateData returns the data to render the notification.
+func NewTemplateData(notification Notification) TemplateData {
+	return TemplateData{Notification: notification, LevelIcon: LevelIcon(notification.Level)}
+}
+
+// LevelIcon returns a colored icon matching the level, it helps to spot severe notifications at a glance.
+func LevelIcon(level notifications.Level) string {
+	switch level {
+	case notifications.LevelUrgent, notifications.LevelError:
+		return "🔴"
+	case notifications.LevelWarning:
+		return "🟡"
+	case notifications.LevelInfo:
+		return "🔵"
+	}
+	return ""
+}
+
+// LevelColor returns the color matching the level as hex value, e.g. for badges and color bars.
+func LevelColor(level notifications.Level) string {
+	switch level {
+	case notifications.LevelUrgent:
+		return "#8E0000"
+	case notifications.LevelError:
+		return "#D32F2F"
+	case notifications.LevelWarning:
+		return "#F9A825"
+	case notifications.LevelInfo:
+		return "#1976D2"
+	}
+	return "#757575"
+}
+
+// TemplatePreview is a template rendered with a notification.
+type TemplatePreview struct {
+	Subject string `json:"subject"`
+	Body    string `json:"body"`
+}
+
+// DefaultMessageTemplate returns the template which is used as long as no admin changed it.
+func DefaultMessageTemplate(channelType ChannelType) MessageTemplate {
+	return MessageTemplate{
+		ChannelType: channelType,
+		Subject:     defaultSubjectTemplate,
+		Body:        defaultBodyTemplate,
+		IsDefault:   true,
+	}
+}
+
+// WithOverride returns the template with the non-empty fields of the override applied.
+func (t MessageTemplate) WithOverride(override *TemplateOverride) MessageTemplate {
+	if override == nil {
+		return t
+	}
+	if override.Subject != "" {
+		t.Subject = override.Subject
+		t.IsDefault = false
+	}
+	if override.Body != "" {
+		t.Body = override.Body
+		t.IsDefault = false
+	}
+	return t
+}
+
+// Render renders subject and body of the template with the given data.
+func (t MessageTemplate) Render(data TemplateData) (TemplatePreview, error) {
+	subject, err := renderTemplate("subject", t.Subject, data)
+	if err != nil {
+		return TemplatePreview{}, err
+	}
+	body, err := renderTemplate("body", t.Body, data)
+	if err != nil {
+		return TemplatePreview{}, err
+	}
+	return TemplatePreview{Subject: strings.TrimSpace(subject), Body: body}, nil
+}
+
+// Validate checks that subject and body can be parsed and rendered with a sample notification.
+func (t MessageTemplate) Validate() ValidationErrors {
+	errs := make(ValidationErrors)
+
+	if t.Subject == "" {
+		errs["subject"] = translation.TemplateSubjectIsRequired
+	}
+	if t.Body == "" {
+		errs["body"] = translation.TemplateBodyIsRequired
+	}
+	validateTemplateFields(TemplateOverride{Subject: t.Subject, Body: t.Body}, "", errs)
+
+	if len(errs) > 0 {
+		return errs
+	}
+	return nil
+}
+
+// validateTemplateFields adds the validation errors of the non-empty template fields to the given errors.
+func validateTemplateFields(fields TemplateOverride, prefix string, errs ValidationErrors) {
+	data := SampleTemplateData()
+
+	if fields.Subject != "" {
+		if len(fields.Subject) > MaxTemplateSubjectLength {
+			errs[prefix+"subject"] = translation.TemplateSubjectTooLong
+		} else if msg := checkTemplate("subject", fields.Subject, data); msg != "" {
+			errs[prefix+"subject"] = msg
+		}
+	}
+	if fields.Body != "" {
+		if len(fields.Body) > MaxTemplateBodyLength {
+			errs[prefix+"body"] = translation.TemplateBodyTooLong
+		} else if msg := checkTemplate("body", fields.Body, data); msg != "" {
+			errs[prefix+"body"] = msg
+		}
+	}
+}
+
+// checkTemplate returns the translated issue of the template, empty if it is valid.
+func checkTemplate(name, text string, data TemplateData) string {
+	tmpl, err := template.New(name).Parse(text)
+	if err != nil {
+		return translation.InvalidTemplateSyntax
+	}
+	if err := tmpl.Execute(&strings.Builder{}, data); err != nil {
+		return translation.TemplateRenderFailed
+	}
+	return ""
+}
+
+func renderTemplate(name, text string, data TemplateData) (string, error) {
+	tmpl, err := template.New(name).Parse(text)
+	if err != nil {
+		return "", fmt.Errorf("failed to parse %s template: %w", name, err)
+	}
+
+	var b strings.Builder
+	err = tmpl.Execute(&b, data)
+	if err != nil {
+		return "", fmt.Errorf("failed to render %s template: %w", name, err)
+	}
+	return b.String(), nil
+}
+
+// SampleTemplateData returns a notification with all fields populated, it is used to validate templates.
+func SampleTemplateData() TemplateData {
+	return NewTemplateData(Notification{
+		Id:               "00000000-0000-4000-8000-000000000000",
+		Origin:           "SBOM - React",
+		OriginClass:      "/vi/SBOM",
+		OriginResourceID: "sbom-react",
+		Timestamp:        "2026-01-01T12:00:00Z",
+		Title:            "New vulnerability found",
+		Detail:           "A new vulnerability was found in a dependency.",
+		Level:            notifications.LevelWarning,
+		CustomFields:     map[string]any{},
+	})
+}
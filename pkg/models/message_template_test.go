@@ -0,0 +1,103 @@
+// SPDX-FileCopyrightText: 2026 Greenbone AG <https://greenbone.net>
+//
+// SPDX-License-Identifier: AGPL-3.0-or-later
+
+package models
+
+import (
+	"strings"
+	"testing"
+
+	"github.com/greenbone/opensight-golang-libraries/pkg/notifications"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func Test_MessageTemplate_Render(t *testing.T) {
+	notification := Notification{
+		Origin:           "SBOM - React",
+		OriginResourceID: "sbom-react",
+		Title:            "New vulnerability",
+		Detail:           "Details",
+		Level:            notifications.LevelError,
+		CustomFields:     map[string]any{"host": "10.0.0.1"},
+	}
+
+	tests := map[string]struct {
+		template    MessageTemplate
+		wantPreview TemplatePreview
+		wantErr     bool
+	}{
+		"default template": {
+			template:    DefaultMessageTemplate(ChannelTypeMail),
+			wantPreview: TemplatePreview{Subject: "🔴 New vulnerability [SBOM - React]", Body: "Details"},
+		},
+		"custom fields and resource id": {
+			template: MessageTemplate{
+				Subject: "{{.OriginResourceID}}: {{.Title}}",
+				Body:    "{{.Detail}}{{with .CustomFields.host}} on {{.}}{{end}}{{with .CustomFields.missing}} ({{.}}){{end}}",
+			},
+			wantPreview: TemplatePreview{Subject: "sbom-react: New vulnerability", Body: "Details on 10.0.0.1"},
+		},
+		"unknown field": {
+			template: MessageTemplate{Subject: "{{.Unknown}}", Body: "{{.Detail}}"},
+			wantErr:  true,
+		},
+	}
+	for name, tt := range tests {
+		t.Run(name, func(t *testing.T) {
+			preview, err := tt.template.Render(NewTemplateData(notification))
+			if tt.wantErr {
+				require.Error(t, err)
+				return
+			}
+			require.NoError(t, err)
+			assert.Equal(t, tt.wantPreview, preview)
+		})
+	}
+}
+
+func Test_MessageTemplate_Validate(t *testing.T) {
+	tests := map[string]struct {
+		template MessageTemplate
+		wantErr  ValidationErrors
+	}{
+		"valid": {
+			template: DefaultMessageTemplate(ChannelTypeTeams),
+		},
+		"missing fields": {
+			template: MessageTemplate{},
+			wantErr: ValidationErrors{
+				"subject": "A subject template is required.",
+				"body":    "A body template is required.",
+			},
+		},
+		"parse and render errors": {
+			template: MessageTemplate{Subject: "{{.Title", Body: "{{.NoSuchField}}"},
+			wantErr: ValidationErrors{
+				"subject": "The template can not be parsed, please check the syntax.",
+				"body":    "The template can not be rendered, please check the referenced fields.",
+			},
+		},
+		"too long": {
+			template: MessageTemplate{Subject: strings.Repeat("a", MaxTemplateSubjectLength+1), Body: "{{.Detail}}"},
+			wantErr:  ValidationErrors{"subject": "The subject template must not be longer than 1000 characters."},
+		},
+	}
+	for name, tt := range tests {
+		t.Run(name, func(t *testing.T) {
+			assert.Equal(t, tt.wantErr, tt.template.Validate())
+		})
+	}
+}
+
+func Test_MessageTemplate_WithOverride(t *testing.T) {
+	template := DefaultMessageTemplate(ChannelTypeMattermost)
+
+	assert.Equal(t, template, template.WithOverride(nil))
+
+	overridden := template.WithOverride(&TemplateOverride{Subject: "{{.Title}}"})
+	assert.Equal(t, "{{.Title}}", overridden.Subject)
+	assert.Equal(t, template.Body, overridden.Body)
+	assert.False(t, overridden.IsDefault)
+}
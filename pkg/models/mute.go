@@ -0,0 +1,163 @@
+// SPDX-FileCopyrightText: 2026 Greenbone AG <https://greenbone.net>
+//
+// SPDX-License-Identifier: AGPL-3.0-or-later
+
+package models
+
+import (
+	"fmt"
+	"slices"
+	"time"
+	_ "time/tzdata" // time zones of quiet hours must be available independent of the system
+
+	"github.com/greenbone/opensight-golang-libraries/pkg/notifications"
+	"github.com/greenbone/opensight-notification-service/pkg/translation"
+)
+
+// maxMuteExtensions limits how often adjoining mute windows are chained to determine the end of the muted period
+const maxMuteExtensions = 32
+
+// Mute determines when the forwarding of a rule is muted, e.g. at night or during an announced maintenance.
+// Urgent notifications are never muted. Muted notifications are dropped, or if `summary` is set,
+// they are collected and forwarded as one digest once the muted period is over.
+type Mute struct {
+	TimeZone           string              `json:"timeZone,omitempty"` // IANA time zone of the quiet hours, e.g. `Europe/Berlin`, defaults to UTC
+	QuietHours         []QuietHours        `json:"quietHours,omitempty"`
+	MaintenanceWindows []MaintenanceWindow `json:"maintenanceWindows,omitempty"`
+	Summary            bool                `json:"summary,omitempty"`
+}
+
+// QuietHours is a daily recurring time range. If the end is not after the start, the range ends on the next day.
+type QuietHours struct {
+	Weekdays []Weekday `json:"weekdays,omitempty"`    // days on which the range starts, every day if empty
+	Start    string    `json:"start" example:"22:00"` // local time in format `HH:MM`
+	End      string    `json:"end" example:"06:00"`   // local time in format `HH:MM`
+}
+
+// MaintenanceWindow is a one-off time range.
+type MaintenanceWindow struct {
+	Start   time.Time `json:"start" format:"date-time"`
+	End     time.Time `json:"end" format:"date-time"`
+	Comment string    `json:"comment,omitempty"`
+}
+
+type Weekday string
+
+const (
+	Monday    Weekday = "monday"
+	Tuesday   Weekday = "tuesday"
+	Wednesday Weekday = "wednesday"
+	Thursday  Weekday = "thursday"
+	Friday    Weekday = "friday"
+	Saturday  Weekday = "saturday"
+	Sunday    Weekday = "sunday"
+)
+
+var weekdays = map[Weekday]time.Weekday{
+	Monday:    time.Monday,
+	Tuesday:   time.Tuesday,
+	Wednesday: time.Wednesday,
+	Thursday:  time.Thursday,
+	Friday:    time.Friday,
+	Saturday:  time.Saturday,
+	Sunday:    time.Sunday,
+}
+
+const quietHoursLayout = "15:04"
+
+// MutedUntil returns whether the forwarding of the notification is muted at the given time,
+// and if so, the end of the muted period. Adjoining or overlapping windows form one period.
+func (m Mute) MutedUntil(level notifications.Level, now time.Time) (until time.Time, muted bool) {
+	if level == notifications.LevelUrgent {
+		return time.Time{}, false
+	}
+
+	location, err := time.LoadLocation(m.TimeZone) // empty time zone is UTC
+	if err != nil {
+		location = time.UTC // invalid rules are deactivated, see [Rule.Validate]
+	}
+
+	until = now
+	for range maxMuteExtensions {
+		end, ok := m.windowEnd(until, location)
+		if !ok {
+			break
+		}
+		until, muted = end, true
+	}
+	if !muted {
+		return time.Time{}, false
+	}
+	return until, true
+}
+
+// windowEnd returns the latest end of the windows active at the given time.
+func (m Mute) windowEnd(t time.Time, location *time.Location) (end time.Time, active bool) {
+	for _, window := range m.MaintenanceWindows {
+		if !t.Before(window.Start) && t.Before(window.End) && window.End.After(end) {
+			end, active = window.End, true
+		}
+	}
+	for _, quietHours := range m.QuietHours {
+		windowEnd, ok := quietHours.end(t, location)
+		if ok && windowEnd.After(end) {
+			end, active = windowEnd, true
+		}
+	}
+	return end, active
+}
+
+// end returns the end of the quiet hours if they are active at the given time.
+func (q QuietHours) end(t time.Time, location *time.Location) (time.Time, bool) {
+	start, errStart := time.Parse(quietHoursLayout, q.Start)
+	end, errEnd := time.Parse(quietHoursLayout, q.End)
+	if errStart != nil || errEnd != nil {
+		return time.Time{}, false
+	}
+
+	local := t.In(location)
+	// quiet hours which started on the previous day can still be active
+	for _, dayOffset := range []int{0, -1} {
+		day := time.Date(local.Year(), local.Month(), local.Day()+dayOffset, 0, 0, 0, 0, location)
+		if len(q.Weekdays) > 0 && !slices.ContainsFunc(q.Weekdays, func(w Weekday) bool { return weekdays[w] == day.Weekday() }) {
+			continue
+		}
+
+		windowStart := time.Date(day.Year(), day.Month(), day.Day(), start.Hour(), start.Minute(), 0, 0, location)
+		windowEnd := time.Date(day.Year(), day.Month(), day.Day(), end.Hour(), end.Minute(), 0, 0, location)
+		if !windowEnd.After(windowStart) {
+			windowEnd = windowEnd.AddDate(0, 0, 1)
+		}
+		if !t.Before(windowStart) && t.Before(windowEnd) {
+			return windowEnd, true
+		}
+	}
+	return time.Time{}, false
+}
+
+// validate adds the validation errors of the mute settings to the given errors.
+func (m Mute) validate(errs ValidationErrors) {
+	if _, err := time.LoadLocation(m.TimeZone); err != nil || m.TimeZone == "Local" {
+		errs["mute.timeZone"] = translation.InvalidTimeZone
+	}
+
+	for i, quietHours := range m.QuietHours {
+		if _, err := time.Parse(quietHoursLayout, quietHours.Start); err != nil {
+			errs[fmt.Sprintf("mute.quietHours[%d].start", i)] = translation.InvalidTimeOfDay
+		}
+		if _, err := time.Parse(quietHoursLayout, quietHours.End); err != nil {
+			errs[fmt.Sprintf("mute.quietHours[%d].end", i)] = translation.InvalidTimeOfDay
+		}
+		for j, weekday := range quietHours.Weekdays {
+			if _, ok := weekdays[weekday]; !ok {
+				errs[fmt.Sprintf("mute.quietHours[%d].weekdays[%d]", i, j)] = translation.InvalidWeekday
+			}
+		}
+	}
+
+	for i, window := range m.MaintenanceWindows {
+		if !window.End.After(window.Start) {
+			errs[fmt.Sprintf("mute.maintenanceWindows[%d].end", i)] = translation.MaintenanceWindowEndBeforeStart
+		}
+	}
+}
@@ -0,0 +1,125 @@
+// SPDX-FileCopyrightText: 2026 Greenbone AG <https://greenbone.net>
+//
+// SPDX-License-Identifier: AGPL-3.0-or-later
+
+package models
+
+import (
+	"testing"
+	"time"
+
+	"github.com/greenbone/opensight-golang-libraries/pkg/notifications"
+	"github.com/stretchr/testify/assert"
+)
+
+func Test_MuteMutedUntil(t *testing.T) {
+	berlin, err := time.LoadLocation("Europe/Berlin")
+	if err != nil {
+		t.Fatal(err)
+	}
+	// Wednesday
+	wednesday := func(hour, minute int) time.Time { return time.Date(2024, 1, 3, hour, minute, 0, 0, berlin) }
+
+	nightly := QuietHours{Start: "22:00", End: "06:00"}
+
+	tests := map[string]struct {
+		mute      Mute
+		level     notifications.Level
+		now       time.Time
+		wantMuted bool
+		wantUntil time.Time
+	}{
+		"no windows": {
+			now: wednesday(23, 0),
+		},
+		"quiet hours before midnight": {
+			mute:      Mute{TimeZone: "Europe/Berlin", QuietHours: []QuietHours{nightly}},
+			now:       wednesday(23, 0),
+			wantMuted: true,
+			wantUntil: time.Date(2024, 1, 4, 6, 0, 0, 0, berlin),
+		},
+		"quiet hours after midnight": {
+			mute:      Mute{TimeZone: "Europe/Berlin", QuietHours: []QuietHours{nightly}},
+			now:       wednesday(5, 59),
+			wantMuted: true,
+			wantUntil: wednesday(6, 0),
+		},
+		"end of quiet hours is not muted": {
+			mute: Mute{TimeZone: "Europe/Berlin", QuietHours: []QuietHours{nightly}},
+			now:  wednesday(6, 0),
+		},
+		"quiet hours apply in their time zone": {
+			mute: Mute{TimeZone: "UTC", QuietHours: []QuietHours{nightly}},
+			now:  wednesday(22, 30), // 21:30 UTC
+		},
+		"urgent notifications are never muted": {
+			mute:  Mute{TimeZone: "Europe/Berlin", QuietHours: []QuietHours{nightly}},
+			level: notifications.LevelUrgent,
+			now:   wednesday(23, 0),
+		},
+		"quiet hours only on the given weekdays": {
+			mute: Mute{TimeZone: "Europe/Berlin", QuietHours: []QuietHours{{Weekdays: []Weekday{Saturday, Sunday}, Start: "00:00", End: "00:00"}}},
+			now:  wednesday(12, 0),
+		},
+		"quiet hours which started on the previous weekday": {
+			mute:      Mute{TimeZone: "Europe/Berlin", QuietHours: []QuietHours{{Weekdays: []Weekday{Tuesday}, Start: "22:00", End: "06:00"}}},
+			now:       wednesday(1, 0),
+			wantMuted: true,
+			wantUntil: wednesday(6, 0),
+		},
+		"maintenance window": {
+			mute:      Mute{MaintenanceWindows: []MaintenanceWindow{{Start: wednesday(10, 0), End: wednesday(14, 0)}}},
+			now:       wednesday(12, 0),
+			wantMuted: true,
+			wantUntil: wednesday(14, 0),
+		},
+		"adjoining windows form one muted period": {
+			mute: Mute{
+				TimeZone:           "Europe/Berlin",
+				QuietHours:         []QuietHours{nightly},
+				MaintenanceWindows: []MaintenanceWindow{{Start: wednesday(5, 0), End: wednesday(8, 0)}},
+			},
+			now:       wednesday(1, 0),
+			wantMuted: true,
+			wantUntil: wednesday(8, 0),
+		},
+	}
+
+	for name, tt := range tests {
+		t.Run(name, func(t *testing.T) {
+			level := tt.level
+			if level == "" {
+				level = notifications.LevelInfo
+			}
+
+			gotUntil, gotMuted := tt.mute.MutedUntil(level, tt.now)
+
+			assert.Equal(t, tt.wantMuted, gotMuted)
+			assert.True(t, tt.wantUntil.Equal(gotUntil), "want %s, got %s", tt.wantUntil, gotUntil)
+		})
+	}
+}
+
+func Test_MuteValidate(t *testing.T) {
+	start := time.Date(2024, 1, 1, 12, 0, 0, 0, time.UTC)
+
+	mute := Mute{
+		TimeZone: "Mars/Olympus_Mons",
+		QuietHours: []QuietHours{
+			{Start: "22:00", End: "06:00"},
+			{Weekdays: []Weekday{"someday"}, Start: "25:00", End: "6 am"},
+		},
+		MaintenanceWindows: []MaintenanceWindow{{Start: start, End: start}},
+	}
+
+	errs := make(ValidationErrors)
+	mute.validate(errs)
+
+	assert.Equal(t, ValidationErrors{
+		"mute.timeZone":                  "Invalid time zone, an IANA time zone like Europe/Berlin is expected.",
+		"mute.quietHours[1].start":       "Invalid time, the format HH:MM is expected.",
+		"mute.quietHours[1].end":         "Invalid time, the format HH:MM is expected.",
+		"mute.quietHours[1].weekdays[0]": "Invalid weekday.",
+		"mute.maintenanceWindows[0].end": "The end of the maintenance window must be after its start.",
+	}, errs)
+}
@@ -5,13 +5,17 @@
 package models
 
 import (
+	"crypto/sha256"
+	"encoding/hex"
+	"time"
+
 	"github.com/greenbone/opensight-golang-libraries/pkg/notifications"
 	"github.com/greenbone/opensight-notification-service/pkg/validation"
 )
 
-// Notification is sent by a backend service. It will always be stored by the
-// notification service and it will possibly also trigger actions like sending mails,
-// depending on the configured rules.
+// Notification is sent by a backend service. It is stored by the notification service
+// together with the actions it triggers, like sending mails, depending on the configured rules.
+// If the rules can not be evaluated, it is not stored and the creation fails.
 type Notification struct {
 	Id               string              `json:"id" readonly:"true"`
 	Origin           string              `json:"origin" validate:"required"` // name of the origin, e.g. `SBOM - React`
@@ -22,6 +26,54 @@ type Notification struct {
 	Detail           string              `json:"detail" validate:"required"`
 	Level            notifications.Level `json:"level" validate:"required" enums:"info,warning,error,urgent"`
 	CustomFields     map[string]any      `json:"customFields,omitempty"` // can contain arbitrary structured information about the event
+	// Recovered is set by the origin when the problem reported earlier for the resource is over.
+	// Incident channels resolve the incident of the resource instead of opening one.
+	Recovered bool `json:"recovered,omitempty"`
+	// IdempotencyKey can be set by the caller to safely retry the creation, alternatively it is taken from the `Idempotency-Key` header.
+	// A repeated request with the same key within the idempotency window returns the original notification.
+	IdempotencyKey string `json:"idempotencyKey,omitempty" validate:"max=255"`
+	// Repeats counts the repeats of this notification which were not forwarded, as they arrived within the suppression window of a rule.
+	Repeats int `json:"repeats,omitempty" readonly:"true"`
+	// set once a user acknowledged the notification, this stops its escalation
+	AcknowledgedAt *time.Time `json:"acknowledgedAt,omitempty" readonly:"true" format:"date-time"`
+	AcknowledgedBy string     `json:"acknowledgedBy,omitempty" readonly:"true"` // ID of the user
+	// state of the notification for the requesting user, only set when listing notifications
+	UserState *NotificationUserState `json:"userState,omitempty" readonly:"true"`
+}
+
+const maxIncidentKeyLength = 255
+
+// IdempotencyKey identifies a notification request of a calling service,
+// keys of different callers don't interfere with each other.
+type IdempotencyKey struct {
+	Caller string
+	Key    string
+}
+
+// DedupKey identifies repeats of the same notification, e.g. if an origin reports the same problem periodically.
+func (n *Notification) DedupKey() string {
+	hash := sha256.New()
+	for _, field := range []string{n.OriginClass, n.OriginResourceID, n.Title, string(n.Level)} {
+		hash.Write([]byte(field))
+		hash.Write([]byte{0}) // separator, so shifting characters between fields changes the key
+	}
+	if n.Recovered {
+		// a recovery is no repeat of the problem, only added if set to keep the keys of existing notifications
+		hash.Write([]byte("recovered"))
+	}
+	return hex.EncodeToString(hash.Sum(nil))
+}
+
+// IncidentKey identifies the incident of the resource in on-call tools, so the incident opened for a problem
+// is resolved by the recovery of the same resource. Keys longer than the 255 characters accepted by
+// events APIs are hashed.
+func (n *Notification) IncidentKey() string {
+	key := n.OriginClass + "/" + n.OriginResourceID
+	if len(key) > maxIncidentKeyLength {
+		hash := sha256.Sum256([]byte(key))
+		return hex.EncodeToString(hash[:])
+	}
+	return key
 }
 
 func (n *Notification) Validate() ValidationErrors {
@@ -11,10 +11,41 @@ type NotificationChannel struct {
 	Domain                   *string     `json:"domain,omitempty"`
 	Port                     *int        `json:"port,omitempty"`
 	IsAuthenticationRequired *bool       `json:"isAuthenticationRequired,omitempty"`
-	IsTlsEnforced            *bool       `json:"isTlsEnforced,omitempty"`
 	Username                 *string     `json:"username,omitempty"`
 	Password                 *string     `json:"password,omitempty"`
 	MaxEmailAttachmentSizeMb *int        `json:"maxEmailAttachmentSizeMb,omitempty"`
 	MaxEmailIncludeSizeMb    *int        `json:"maxEmailIncludeSizeMb,omitempty"`
 	SenderEmailAddress       *string     `json:"senderEmailAddress,omitempty"`
+	WebhookUsername          *string     `json:"webhookUsername,omitempty"` // overrides the username the webhook posts as
+	WebhookIconUrl           *string     `json:"webhookIconUrl,omitempty"`  // overrides the profile picture the webhook posts with
+	WebhookChannel           *string     `json:"webhookChannel,omitempty"`  // overrides the channel the webhook posts to
+	// settings of generic webhooks
+	WebhookMethod       *string           `json:"webhookMethod,omitempty"`       // HTTP method, POST if not set
+	WebhookHeaders      map[string]string `json:"webhookHeaders,omitempty"`      // extra HTTP headers, e.g. for authentication
+	WebhookBodyTemplate *string           `json:"webhookBodyTemplate,omitempty"` // template of the JSON body, the notification is sent as is if not set
+	WebhookSecret       *string           `json:"webhookSecret,omitempty"`       // shared secret to sign the requests with, unsigned if not set
+	// settings of syslog channels, the host and port of the collector are stored in Domain and Port
+	SyslogTransport *string `json:"syslogTransport,omitempty"` // udp, tcp or tls
+	SyslogFacility  *int    `json:"syslogFacility,omitempty"`
+	SyslogAppName   *string `json:"syslogAppName,omitempty"`
+	// settings of incident channels, the URL of the events API is stored in WebhookUrl
+	RoutingKey *string `json:"routingKey,omitempty"` // integration key of the service in the on-call tool
+	// settings of push channels, the URL of the push server is stored in WebhookUrl
+	PushServer  *string  `json:"pushServer,omitempty"`  // ntfy or gotify
+	PushTopic   *string  `json:"pushTopic,omitempty"`   // topic ntfy publishes to
+	PushTags    []string `json:"pushTags,omitempty"`    // tags added to each message, only shown by ntfy
+	AccessToken *string  `json:"accessToken,omitempty"` // access token of ntfy, app token of Gotify or access token of the Matrix user
+	// settings of Matrix channels, the URL of the homeserver is stored in WebhookUrl
+	MatrixRoomId *string `json:"matrixRoomId,omitempty"` // ID of the room the messages are posted to, e.g. !abc:example.org
+	// settings of mail channels authenticating with XOAUTH2, the mailbox is stored in Username
+	MailAuthMethod    *string  `json:"mailAuthMethod,omitempty"` // password or xoauth2, password if not set
+	OAuthTokenUrl     *string  `json:"oauthTokenUrl,omitempty"`  // token endpoint of the client credentials flow
+	OAuthClientId     *string  `json:"oauthClientId,omitempty"`
+	OAuthClientSecret *string  `json:"oauthClientSecret,omitempty"`
+	OAuthScopes       []string `json:"oauthScopes,omitempty"` // e.g. https://outlook.office365.com/.default
+	// TLS settings of mail channels
+	MailTlsMode         *string `json:"mailTlsMode,omitempty"`         // implicit, starttls, opportunistic or none
+	MailTlsMinVersion   *string `json:"mailTlsMinVersion,omitempty"`   // 1.0 to 1.3, 1.2 if not set
+	MailCaCertificate   *string `json:"mailCaCertificate,omitempty"`   // PEM encoded CA certificates trusted instead of the system ones
+	MailCertFingerprint *string `json:"mailCertFingerprint,omitempty"` // SHA-256 fingerprint the server certificate is pinned to
 }
@@ -0,0 +1,119 @@
+// SPDX-FileCopyrightText: 2026 Greenbone AG <https://greenbone.net>
+//
+// SPDX-License-Identifier: AGPL-3.0-or-later
+
+package models
+
+import (
+	"strings"
+	"testing"
+
+	"github.com/greenbone/opensight-golang-libraries/pkg/notifications"
+	"github.com/stretchr/testify/assert"
+)
+
+func Test_NotificationDedupKey(t *testing.T) {
+	notification := Notification{
+		Origin:           "Origin",
+		OriginClass:      "/serviceID/origin1",
+		OriginResourceID: "resource-1",
+		Timestamp:        "2024-01-01T00:00:00Z",
+		Title:            "Disk full",
+		Detail:           "The disk is full",
+		Level:            notifications.LevelError,
+	}
+
+	tests := map[string]struct {
+		modify    func(n *Notification)
+		wantEqual bool
+	}{
+		"same notification at a later time": {
+			modify:    func(n *Notification) { n.Timestamp = "2024-01-01T00:05:00Z" },
+			wantEqual: true,
+		},
+		"different detail and origin name": {
+			modify: func(n *Notification) {
+				n.Detail = "The disk is still full"
+				n.Origin = "Renamed Origin"
+			},
+			wantEqual: true,
+		},
+		"different origin class": {
+			modify: func(n *Notification) { n.OriginClass = "/serviceID/origin2" },
+		},
+		"different resource": {
+			modify: func(n *Notification) { n.OriginResourceID = "resource-2" },
+		},
+		"different title": {
+			modify: func(n *Notification) { n.Title = "Disk almost full" },
+		},
+		"different level": {
+			modify: func(n *Notification) { n.Level = notifications.LevelUrgent },
+		},
+		"characters shifted between fields": {
+			modify: func(n *Notification) {
+				n.OriginResourceID = "resource-1Disk"
+				n.Title = " full"
+			},
+		},
+		"recovery of the problem": {
+			modify: func(n *Notification) { n.Recovered = true },
+		},
+	}
+
+	for name, tt := range tests {
+		t.Run(name, func(t *testing.T) {
+			other := notification
+			tt.modify(&other)
+
+			if tt.wantEqual {
+				assert.Equal(t, notification.DedupKey(), other.DedupKey())
+			} else {
+				assert.NotEqual(t, notification.DedupKey(), other.DedupKey())
+			}
+		})
+	}
+}
+
+func Test_NotificationIncidentKey(t *testing.T) {
+	notification := Notification{
+		OriginClass:      "/serviceID/origin1",
+		OriginResourceID: "resource-1",
+		Title:            "Disk full",
+		Level:            notifications.LevelError,
+	}
+	recovery := notification
+	recovery.Title = "Disk no longer full"
+	recovery.Level = notifications.LevelInfo
+	recovery.Recovered = true
+
+	assert.Equal(t, "/serviceID/origin1/resource-1", notification.IncidentKey())
+	assert.Equal(t, notification.IncidentKey(), recovery.IncidentKey(), "the recovery resolves the incident of the problem")
+
+	long := notification
+	long.OriginResourceID = strings.Repeat("a", 300)
+	assert.Len(t, long.IncidentKey(), 64, "long keys are hashed")
+}
+
+func Test_NotificationMessageFields(t *testing.T) {
+	notification := Notification{
+		CustomFields: map[string]any{
+			"host":     "10.0.0.1",
+			"score":    9.8,
+			"count":    float64(12),
+			"fixed":    false,
+			"empty":    "",
+			"tags":     []any{"a", "b"},
+			"location": map[string]any{"city": "Osnabrück"},
+		},
+	}
+
+	assert.Equal(t, []MessageField{
+		{Name: "count", Value: "12"},
+		{Name: "fixed", Value: "false"},
+		{Name: "host", Value: "10.0.0.1"},
+		{Name: "score", Value: "9.8"},
+	}, notification.MessageFields())
+
+	assert.Empty(t, (&Notification{}).MessageFields())
+}
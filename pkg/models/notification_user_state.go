@@ -0,0 +1,26 @@
+// SPDX-FileCopyrightText: 2026 Greenbone AG <https://greenbone.net>
+//
+// SPDX-License-Identifier: AGPL-3.0-or-later
+
+package models
+
+import "time"
+
+// ReadState is the state of a notification for a user, a dismissed notification is also read.
+type ReadState string
+
+const (
+	ReadStateUnread    ReadState = "unread"
+	ReadStateRead      ReadState = "read"
+	ReadStateDismissed ReadState = "dismissed"
+)
+
+var AllowedReadStates = []ReadState{ReadStateUnread, ReadStateRead, ReadStateDismissed}
+
+// NotificationUserState is the state of a notification for one user, identified by the user ID of the access token.
+type NotificationUserState struct {
+	ReadState      ReadState  `json:"readState" enums:"unread,read,dismissed"`
+	ReadAt         *time.Time `json:"readAt,omitempty" format:"date-time"`
+	AcknowledgedAt *time.Time `json:"acknowledgedAt,omitempty" format:"date-time"` // set if the user acknowledged the notification
+	DismissedAt    *time.Time `json:"dismissedAt,omitempty" format:"date-time"`
+}
@@ -5,15 +5,29 @@
 package models
 
 import (
+	"fmt"
+	"net/url"
+	"strings"
+
 	"github.com/greenbone/opensight-notification-service/pkg/entities"
+	"github.com/greenbone/opensight-notification-service/pkg/translation"
 	"github.com/greenbone/opensight-notification-service/pkg/validation"
 )
 
+// OriginResourceIDPlaceholder is replaced with the resource ID of the notification in the URL template of its origin
+const OriginResourceIDPlaceholder = "{resourceID}"
+
+// MaxUrlTemplateLength is the maximum length of the URL template of an origin
+const MaxUrlTemplateLength = 2048
+
 // Origin of an event/notification.
 type Origin struct {
 	Name      string `json:"name" validate:"required"`  // human readable name representation
 	Class     string `json:"class" validate:"required"` // unique identifier
 	ServiceID string `json:"serviceID" readonly:"true"` // service in which this origin is defined
+	// UrlTemplate is used to link messages to the resource of the notification, `{resourceID}` is replaced with the `originResourceID` of the notification.
+	// Either a path relative to the public base URL of OpenSight, e.g. `/vi/sbom/{resourceID}`, or an absolute http(s) URL. Optional, without it messages contain no link.
+	UrlTemplate string `json:"urlTemplate,omitempty" maxLength:"2048"`
 }
 
 // ToEntity transforms the rest model to the entity for use in the service
@@ -28,5 +42,40 @@ func (o OriginList) Validate() ValidationErrors {
 	if err != nil {
 		return ValidationErrors{"$": err.Error()}
 	}
+
+	errs := make(ValidationErrors)
+	for i, origin := range o {
+		if origin.UrlTemplate == "" {
+			continue
+		}
+		if msg := checkUrlTemplate(origin.UrlTemplate); msg != "" {
+			errs[fmt.Sprintf("$[%d].urlTemplate", i)] = msg
+		}
+	}
+	if len(errs) > 0 {
+		return errs
+	}
 	return nil
 }
+
+// checkUrlTemplate returns the translated issue of the template, empty if it is an absolute http(s) URL or a path
+// relative to the public base URL.
+func checkUrlTemplate(urlTemplate string) string {
+	if len(urlTemplate) > MaxUrlTemplateLength {
+		return translation.UrlTemplateTooLong
+	}
+	u, err := url.Parse(strings.ReplaceAll(urlTemplate, OriginResourceIDPlaceholder, "id"))
+	if err != nil {
+		return translation.InvalidUrlTemplate
+	}
+	if u.IsAbs() {
+		if (u.Scheme != "http" && u.Scheme != "https") || u.Host == "" {
+			return translation.UrlTemplateMustBeHttp
+		}
+		return ""
+	}
+	if !strings.HasPrefix(urlTemplate, "/") || strings.HasPrefix(urlTemplate, "//") {
+		return translation.UrlTemplateMustBeUrlOrPath
+	}
+	return ""
+}
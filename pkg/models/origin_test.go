@@ -0,0 +1,54 @@
+// SPDX-FileCopyrightText: 2026 Greenbone AG <https://greenbone.net>
+//
+// SPDX-License-Identifier: AGPL-3.0-or-later
+
+package models
+
+import (
+	"strings"
+	"testing"
+
+	"github.com/greenbone/opensight-notification-service/pkg/translation"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestOriginList_Validate(t *testing.T) {
+	tests := map[string]struct {
+		urlTemplate string
+		wantErr     string
+	}{
+		"without template": {},
+		"relative path":    {urlTemplate: "/vi/sbom/{resourceID}"},
+		"absolute URL":     {urlTemplate: "https://scanner.example.com/reports/{resourceID}?tab=results"},
+		"relative path without leading slash": {
+			urlTemplate: "vi/sbom/{resourceID}",
+			wantErr:     translation.UrlTemplateMustBeUrlOrPath,
+		},
+		"protocol relative URL": {
+			urlTemplate: "//evil.example.com/{resourceID}",
+			wantErr:     translation.UrlTemplateMustBeUrlOrPath,
+		},
+		"unsupported scheme": {
+			urlTemplate: "javascript:alert(1)",
+			wantErr:     translation.UrlTemplateMustBeHttp,
+		},
+		"invalid URL": {
+			urlTemplate: "https://scanner.example.com/%zz/{resourceID}",
+			wantErr:     translation.InvalidUrlTemplate,
+		},
+		"too long": {
+			urlTemplate: "/vi/sbom/{resourceID}?q=" + strings.Repeat("a", MaxUrlTemplateLength),
+			wantErr:     translation.UrlTemplateTooLong,
+		},
+	}
+	for name, tt := range tests {
+		t.Run(name, func(t *testing.T) {
+			errs := OriginList{{Name: "Origin", Class: "/origin", UrlTemplate: tt.urlTemplate}}.Validate()
+			if tt.wantErr != "" {
+				assert.Equal(t, ValidationErrors{"$[0].urlTemplate": tt.wantErr}, errs)
+			} else {
+				assert.Empty(t, errs)
+			}
+		})
+	}
+}
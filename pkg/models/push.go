@@ -0,0 +1,17 @@
+// SPDX-FileCopyrightText: 2026 Greenbone AG <https://greenbone.net>
+//
+// SPDX-License-Identifier: AGPL-3.0-or-later
+
+package models
+
+// Self-hosted push servers supported by push channels. ntfy publishes to a topic,
+// Gotify publishes with the token of an application.
+const (
+	PushServerNtfy   = "ntfy"
+	PushServerGotify = "gotify"
+)
+
+var AllowedPushServers = []string{PushServerNtfy, PushServerGotify}
+
+// MaxPushTags limits the tags of a push channel, ntfy shows them as emojis or labels below the title
+const MaxPushTags = 10
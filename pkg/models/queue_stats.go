@@ -0,0 +1,18 @@
+// SPDX-FileCopyrightText: 2026 Greenbone AG <https://greenbone.net>
+//
+// SPDX-License-Identifier: AGPL-3.0-or-later
+
+package models
+
+// QueueStats shows the utilization of a work queue.
+type QueueStats struct {
+	Depth    int `json:"depth"`    // number of jobs waiting for a worker
+	Capacity int `json:"capacity"` // maximum number of waiting jobs
+	Workers  int `json:"workers"`
+}
+
+// WorkerPoolStats shows the utilization of the queues for processing incoming notifications and delivering them.
+type WorkerPoolStats struct {
+	Intake   QueueStats                 `json:"intake"`
+	Delivery map[ChannelType]QueueStats `json:"delivery"` // by channel type
+}
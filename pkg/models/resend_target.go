@@ -0,0 +1,52 @@
+// SPDX-FileCopyrightText: 2026 Greenbone AG <https://greenbone.net>
+//
+// SPDX-License-Identifier: AGPL-3.0-or-later
+
+package models
+
+import (
+	"strings"
+
+	"github.com/greenbone/opensight-notification-service/pkg/translation"
+	"github.com/greenbone/opensight-notification-service/pkg/validation"
+)
+
+// ResendTarget determines where a stored notification is sent again.
+// Either a channel (with recipient if required by the channel) or a rule has to be set,
+// in case of a rule the notification is forwarded to the action of the rule regardless of its trigger.
+type ResendTarget struct {
+	ChannelID string `json:"channelID,omitempty"`
+	Recipient string `json:"recipient,omitempty"` // only together with a channel, e.g. for mail a comma separated list of mail adresses
+	RuleID    string `json:"ruleID,omitempty"`
+}
+
+func (t *ResendTarget) Cleanup() {
+	t.ChannelID = strings.TrimSpace(t.ChannelID)
+	t.Recipient = strings.TrimSpace(t.Recipient)
+	t.RuleID = strings.TrimSpace(t.RuleID)
+}
+
+func (t *ResendTarget) Validate() ValidationErrors {
+	errs := make(ValidationErrors)
+
+	switch {
+	case t.ChannelID == "" && t.RuleID == "", t.ChannelID != "" && t.RuleID != "":
+		errs["$"] = translation.ChannelOrRuleIsRequired
+	case t.ChannelID != "":
+		if validation.Validate.Var(t.ChannelID, "uuid4") != nil {
+			errs["channelID"] = translation.InvalidChannelID
+		}
+	default:
+		if validation.Validate.Var(t.RuleID, "uuid4") != nil {
+			errs["ruleID"] = translation.InvalidID
+		}
+		if t.Recipient != "" {
+			errs["recipient"] = translation.RecipientRequiresChannel
+		}
+	}
+
+	if len(errs) > 0 {
+		return errs
+	}
+	return nil
+}
@@ -0,0 +1,54 @@
+// SPDX-FileCopyrightText: 2026 Greenbone AG <https://greenbone.net>
+//
+// SPDX-License-Identifier: AGPL-3.0-or-later
+
+package models
+
+import (
+	"testing"
+
+	"github.com/greenbone/opensight-notification-service/pkg/translation"
+	"github.com/stretchr/testify/require"
+)
+
+func Test_ResendTargetValidate(t *testing.T) {
+	validID := "0b9e6c4a-2f0e-4a8e-9c61-6c0d1c9a7e11"
+
+	tests := map[string]struct {
+		target ResendTarget
+		want   ValidationErrors
+	}{
+		"channel with recipient is valid": {
+			target: ResendTarget{ChannelID: validID, Recipient: "a@example.com"},
+		},
+		"rule is valid": {
+			target: ResendTarget{RuleID: validID},
+		},
+		"channel or rule is required": {
+			target: ResendTarget{},
+			want:   ValidationErrors{"$": translation.ChannelOrRuleIsRequired},
+		},
+		"channel and rule are mutually exclusive": {
+			target: ResendTarget{ChannelID: validID, RuleID: validID},
+			want:   ValidationErrors{"$": translation.ChannelOrRuleIsRequired},
+		},
+		"invalid channel id": {
+			target: ResendTarget{ChannelID: "invalid"},
+			want:   ValidationErrors{"channelID": translation.InvalidChannelID},
+		},
+		"invalid rule id": {
+			target: ResendTarget{RuleID: "invalid"},
+			want:   ValidationErrors{"ruleID": translation.InvalidID},
+		},
+		"recipient without channel": {
+			target: ResendTarget{RuleID: validID, Recipient: "a@example.com"},
+			want:   ValidationErrors{"recipient": translation.RecipientRequiresChannel},
+		},
+	}
+
+	for name, tt := range tests {
+		t.Run(name, func(t *testing.T) {
+			require.Equal(t, tt.want, tt.target.Validate())
+		})
+	}
+}
@@ -8,6 +8,7 @@ import (
 	"fmt"
 	"slices"
 	"strings"
+	"time"
 
 	"github.com/greenbone/opensight-golang-libraries/pkg/notifications"
 	"github.com/greenbone/opensight-notification-service/pkg/entities"
@@ -26,12 +27,16 @@ const (
 // Each incoming event is matched with the trigger conditions.
 // If the condition is fulfilled, the provided action is triggered.
 type Rule struct {
-	ID      string           `json:"id" readonly:"true"`
-	Name    string           `json:"name" validate:"required"`
-	Trigger Trigger          `json:"trigger" validate:"required"`
-	Action  Action           `json:"action" validate:"required"`
-	Active  bool             `json:"active"`
-	Errors  ValidationErrors `json:"errors,omitempty" readonly:"true"` // populated if the rule is invalid, this can be useful to highlight rules which need action from the user.
+	ID         string            `json:"id" readonly:"true"`
+	Name       string            `json:"name" validate:"required"`
+	Trigger    Trigger           `json:"trigger" validate:"required"`
+	Action     Action            `json:"action" validate:"required"`
+	Delivery   Delivery          `json:"delivery"`
+	Mute       Mute              `json:"mute"`
+	Escalation []EscalationStep  `json:"escalation,omitempty"` // executed one after the other for urgent notifications which are not acknowledged in time
+	Template   *TemplateOverride `json:"template,omitempty"`   // optional, replaces the message template of the channel type for this rule
+	Active     bool              `json:"active"`
+	Errors     ValidationErrors  `json:"errors,omitempty" readonly:"true"` // populated if the rule is invalid, this can be useful to highlight rules which need action from the user.
 }
 
 // RuleOptions Represents a list of all options required for the creation of a Rule
@@ -61,6 +66,70 @@ type Action struct {
 	Recipient string           `json:"recipient,omitempty"` // specific recipient if supported/required by the channel, e.g. for mail a comma separated list of mail adresses
 }
 
+// DeliveryMode determines when the action of a triggered rule is executed.
+type DeliveryMode string
+
+const (
+	DeliveryModeImmediate DeliveryMode = "immediate" // each notification is forwarded on its own
+	DeliveryModeDigest    DeliveryMode = "digest"    // notifications are collected and forwarded together as one digest message
+)
+
+const (
+	MaxDigestWindowMinutes      = 7 * 24 * 60
+	MaxDigestCount              = 1000
+	MaxSuppressionWindowMinutes = 7 * 24 * 60
+)
+
+// Delivery determines how the notifications triggering a rule are forwarded.
+// In digest mode the notifications are collected per channel and recipient until the window
+// has passed since the first of them, or until the count threshold is reached.
+//
+// Repeats of a notification (see [Notification.DedupKey]) within the suppression window are not forwarded,
+// they are only counted and the count is mentioned in the next forwarded message.
+type Delivery struct {
+	Mode                DeliveryMode `json:"mode" enums:"immediate,digest"`
+	DigestWindowMinutes int          `json:"digestWindowMinutes,omitempty"` // digest mode only: maximum time to collect notifications
+	DigestMaxCount      int          `json:"digestMaxCount,omitempty"`      // digest mode only: optional, the digest is sent earlier once this number of notifications is collected
+	// optional, if not set the globally configured suppression window applies
+	SuppressionWindowMinutes int `json:"suppressionWindowMinutes,omitempty"`
+}
+
+// DigestWindow returns the maximum time to collect notifications for a digest.
+func (d Delivery) DigestWindow() time.Duration {
+	return time.Duration(d.DigestWindowMinutes) * time.Minute
+}
+
+// SuppressionWindow returns the time in which repeats of a forwarded notification are suppressed, zero if not set.
+func (d Delivery) SuppressionWindow() time.Duration {
+	return time.Duration(d.SuppressionWindowMinutes) * time.Minute
+}
+
+// SplitRecipients returns one action per recipient, as the recipient can be a comma separated list.
+func (a Action) SplitRecipients() []Action {
+	if !a.Channel.Type.HasRecipient() {
+		return []Action{a}
+	}
+
+	var actions []Action
+	for recipient := range strings.SplitSeq(a.Recipient, `,`) {
+		action := a
+		action.Recipient = strings.TrimSpace(recipient)
+		actions = append(actions, action)
+	}
+	return actions
+}
+
+// RuleAction is the action of a rule which was triggered by a notification.
+type RuleAction struct {
+	RuleID      string
+	Action      Action
+	Delivery    Delivery
+	Suppression *Suppression // set if repeats of the notification are suppressed
+	// set if the rule is muted, the notification is then collected for a summary which is due at this time
+	CollectUntil time.Time
+	Escalation   []EscalationStep // escalation steps of the rule, only applied to urgent notifications
+}
+
 type OriginReference struct {
 	Name      string `json:"name" readonly:"true"`
 	Class     string `json:"class" validate:"required"`
@@ -100,6 +169,18 @@ func ToOriginReferences(origins []entities.Origin) []OriginReference {
 
 func (r *Rule) Cleanup() {
 	r.Name = strings.TrimSpace(r.Name)
+	cleanupEscalation(r.Escalation)
+	if r.Template != nil && *r.Template == (TemplateOverride{}) {
+		r.Template = nil
+	}
+
+	if r.Delivery.Mode == "" {
+		r.Delivery.Mode = DeliveryModeImmediate // default for clients not aware of digests
+	}
+	if r.Delivery.Mode == DeliveryModeImmediate {
+		r.Delivery.DigestWindowMinutes = 0
+		r.Delivery.DigestMaxCount = 0
+	}
 }
 
 // Validate checks if the rule is valid and returns validation errors if not.
@@ -142,6 +223,30 @@ func (r *Rule) Validate() ValidationErrors {
 		}
 	}
 
+	switch r.Delivery.Mode {
+	case "", DeliveryModeImmediate:
+	case DeliveryModeDigest:
+		if r.Delivery.DigestWindowMinutes < 1 || r.Delivery.DigestWindowMinutes > MaxDigestWindowMinutes {
+			errs["delivery.digestWindowMinutes"] = translation.InvalidDigestWindow
+		}
+		// a threshold of one would be the same as immediate delivery
+		if r.Delivery.DigestMaxCount != 0 && (r.Delivery.DigestMaxCount < 2 || r.Delivery.DigestMaxCount > MaxDigestCount) {
+			errs["delivery.digestMaxCount"] = translation.InvalidDigestMaxCount
+		}
+	default:
+		errs["delivery.mode"] = translation.InvalidDeliveryMode
+	}
+
+	if r.Delivery.SuppressionWindowMinutes < 0 || r.Delivery.SuppressionWindowMinutes > MaxSuppressionWindowMinutes {
+		errs["delivery.suppressionWindowMinutes"] = translation.InvalidSuppressionWindow
+	}
+
+	r.Mute.validate(errs)
+	validateEscalation(r.Escalation, errs)
+	if r.Template != nil {
+		validateTemplateFields(*r.Template, "template.", errs)
+	}
+
 	if len(errs) > 0 {
 		r.Errors = errs
 		return errs
@@ -150,7 +255,10 @@ func (r *Rule) Validate() ValidationErrors {
 	return nil
 }
 
-func (r *Rule) IsTriggered(notification Notification) bool {
+// IsTriggered checks if the notification triggers the rule at the given time.
+// A muted rule is only triggered if the muted notifications are collected for a summary, see [Mute].
+// Recoveries trigger rules of incident channels regardless of the level, see [Rule.ResolvesIncident].
+func (r *Rule) IsTriggered(notification Notification, now time.Time) bool {
 	if !r.Active {
 		return false
 	}
@@ -158,7 +266,24 @@ func (r *Rule) IsTriggered(notification Notification) bool {
 	originMatch := slices.ContainsFunc(r.Trigger.Origins, func(origin OriginReference) bool {
 		return origin.Class == OriginAllClass || origin.Class == notification.OriginClass
 	})
-	levelMatch := slices.Contains(r.Trigger.Levels, notification.Level)
+	if !originMatch {
+		return false
+	}
+	if r.ResolvesIncident(notification) {
+		return true
+	}
+
+	if !slices.Contains(r.Trigger.Levels, notification.Level) {
+		return false
+	}
+
+	_, muted := r.Mute.MutedUntil(notification.Level, now)
+	return !muted || r.Mute.Summary
+}
 
-	return originMatch && levelMatch
+// ResolvesIncident checks if the notification reports the recovery of a resource and the rule forwards to an incident
+// channel. Origins usually report the recovery at a lower level than the problem, the incident opened for the problem
+// must be resolved anyway, so neither the levels of the trigger nor the mute of the rule apply.
+func (r *Rule) ResolvesIncident(notification Notification) bool {
+	return notification.Recovered && r.Action.Channel.Type == ChannelTypeIncident
 }
@@ -6,6 +6,7 @@ package models
 
 import (
 	"testing"
+	"time"
 
 	"github.com/greenbone/opensight-golang-libraries/pkg/notifications"
 	"github.com/stretchr/testify/require"
@@ -22,6 +23,7 @@ func Test_RuleIsTriggered(t *testing.T) {
 		Detail:      "This is a test notification",
 		Level:       notifications.LevelInfo,
 	}
+	now := time.Date(2024, 1, 1, 12, 0, 0, 0, time.UTC)
 
 	tests := map[string]struct {
 		rule Rule
@@ -69,11 +71,107 @@ func Test_RuleIsTriggered(t *testing.T) {
 			}),
 			want: false,
 		},
+		"muted rule does not trigger": {
+			rule: ruleValid(func(r *Rule) {
+				r.Trigger.Origins = []OriginReference{{Class: notification.OriginClass}}
+				r.Mute = Mute{MaintenanceWindows: []MaintenanceWindow{{Start: now.Add(-time.Hour), End: now.Add(time.Hour)}}}
+			}),
+			want: false,
+		},
+		"muted rule triggers if muted notifications are summarized": {
+			rule: ruleValid(func(r *Rule) {
+				r.Trigger.Origins = []OriginReference{{Class: notification.OriginClass}}
+				r.Mute = Mute{
+					MaintenanceWindows: []MaintenanceWindow{{Start: now.Add(-time.Hour), End: now.Add(time.Hour)}},
+					Summary:            true,
+				}
+			}),
+			want: true,
+		},
+		"rule triggers outside of maintenance window": {
+			rule: ruleValid(func(r *Rule) {
+				r.Trigger.Origins = []OriginReference{{Class: notification.OriginClass}}
+				r.Mute = Mute{MaintenanceWindows: []MaintenanceWindow{{Start: now.Add(time.Hour), End: now.Add(2 * time.Hour)}}}
+			}),
+			want: true,
+		},
 	}
 
 	for name, tt := range tests {
 		t.Run(name, func(t *testing.T) {
-			got := tt.rule.IsTriggered(notification)
+			got := tt.rule.IsTriggered(notification, now)
+			require.Equal(t, tt.want, got)
+		})
+	}
+}
+
+func Test_RuleIsTriggered_Recovery(t *testing.T) {
+	// the problem was reported as urgent, the recovery comes with a lower level
+	recovery := Notification{
+		Origin:      "Test Origin",
+		OriginClass: "/serviceID/origin1",
+		Timestamp:   "2024-01-01T00:00:00Z",
+		Title:       "Test Notification",
+		Detail:      "The problem is over",
+		Level:       notifications.LevelInfo,
+		Recovered:   true,
+	}
+	now := time.Date(2024, 1, 1, 12, 0, 0, 0, time.UTC)
+	incidentRule := func(options ...func(*Rule)) Rule {
+		return ruleValid(append([]func(*Rule){func(r *Rule) {
+			r.Trigger = Trigger{
+				Origins: []OriginReference{{Class: recovery.OriginClass}},
+				Levels:  []notifications.Level{notifications.LevelUrgent},
+			}
+			r.Action.Channel.Type = ChannelTypeIncident
+		}}, options...)...)
+	}
+
+	tests := map[string]struct {
+		rule         Rule
+		notification Notification
+		want         bool
+	}{
+		"recovery triggers incident channel at any level": {
+			rule:         incidentRule(),
+			notification: recovery,
+			want:         true,
+		},
+		"recovery triggers muted incident channel": {
+			rule: incidentRule(func(r *Rule) {
+				r.Mute = Mute{MaintenanceWindows: []MaintenanceWindow{{Start: now.Add(-time.Hour), End: now.Add(time.Hour)}}}
+			}),
+			notification: recovery,
+			want:         true,
+		},
+		"recovery of other origin does not trigger incident channel": {
+			rule: incidentRule(func(r *Rule) {
+				r.Trigger.Origins = []OriginReference{{Class: "no-match"}}
+			}),
+			notification: recovery,
+			want:         false,
+		},
+		"recovery does not trigger other channels at other levels": {
+			rule: incidentRule(func(r *Rule) {
+				r.Action.Channel.Type = ChannelTypeMattermost
+			}),
+			notification: recovery,
+			want:         false,
+		},
+		"problem at other level does not trigger incident channel": {
+			rule: incidentRule(),
+			notification: func() Notification {
+				problem := recovery
+				problem.Recovered = false
+				return problem
+			}(),
+			want: false,
+		},
+	}
+
+	for name, tt := range tests {
+		t.Run(name, func(t *testing.T) {
+			got := tt.rule.IsTriggered(tt.notification, now)
 			require.Equal(t, tt.want, got)
 		})
 	}
@@ -103,3 +201,17 @@ func ruleValid(options ...func(*Rule)) Rule {
 
 	return rule
 }
+
+func Test_ActionSplitRecipients(t *testing.T) {
+	mailAction := Action{
+		Channel:   ChannelReference{ID: "mail", Type: ChannelTypeMail},
+		Recipient: "a@example.com, b@example.com",
+	}
+	teamsAction := Action{Channel: ChannelReference{ID: "teams", Type: ChannelTypeTeams}}
+
+	require.Equal(t, []Action{
+		{Channel: mailAction.Channel, Recipient: "a@example.com"},
+		{Channel: mailAction.Channel, Recipient: "b@example.com"},
+	}, mailAction.SplitRecipients())
+	require.Equal(t, []Action{teamsAction}, teamsAction.SplitRecipients())
+}
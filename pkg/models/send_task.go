@@ -0,0 +1,43 @@
+// SPDX-FileCopyrightText: 2026 Greenbone AG <https://greenbone.net>
+//
+// SPDX-License-Identifier: AGPL-3.0-or-later
+
+package models
+
+import "time"
+
+// SendTask is a pending delivery of a notification according to an action.
+// Send tasks are kept in a database outbox until the delivery succeeded or was given up,
+// so pending deliveries are not lost on restarts of the service.
+type SendTask struct {
+	ID            string
+	Notification  *Notification // avoid copies, as object can be quite large
+	RuleID        string        // rule which caused the send task, empty if not caused by a rule
+	Action        Action
+	Attempt       int       // number of already failed delivery attempts
+	NextExecution time.Time // earliest point in time for the next delivery attempt
+	// while claimed, the task is reserved for delivery by one worker, zero value means unclaimed
+	ClaimedUntil time.Time
+	// Collect is only set on creation, the notification is then added to the digest which is collected
+	// for the rule, channel and recipient instead of being forwarded on its own
+	Collect *Delivery
+	// Muted is only set on creation together with Collect, the rule is muted until NextExecution,
+	// so the digest the notification is added to is not sent before, even if it was opened earlier
+	Muted bool
+	// Digest contains the collected notifications if the task delivers a digest, `Notification` is the first of them
+	Digest []Notification
+	// Suppression is only set on creation, the task is dropped if it repeats a notification within the suppression window
+	Suppression *Suppression
+	// Repeats is the number of repeats of the notification which were suppressed since the last forwarded one
+	Repeats int
+	// EscalateAt is only set on creation, if the task is created the escalation of the notification by the rule
+	// is started in the same transaction, with the first step due at this time. Zero value means no escalation.
+	EscalateAt time.Time
+}
+
+// Suppression identifies repeats of a notification, which are not forwarded within the window after the first one.
+// Repeats are tracked per rule, channel and recipient.
+type Suppression struct {
+	DedupKey string
+	Window   time.Duration
+}
@@ -0,0 +1,30 @@
+// SPDX-FileCopyrightText: 2026 Greenbone AG <https://greenbone.net>
+//
+// SPDX-License-Identifier: AGPL-3.0-or-later
+
+package models
+
+// Transports of syslog channels. Messages are sent as datagrams over UDP (RFC 5426)
+// and with octet counting over TCP and TLS (RFC 6587, RFC 5425).
+const (
+	SyslogTransportUDP = "udp"
+	SyslogTransportTCP = "tcp"
+	SyslogTransportTLS = "tls"
+)
+
+var AllowedSyslogTransports = []string{SyslogTransportUDP, SyslogTransportTCP, SyslogTransportTLS}
+
+const (
+	// DefaultSyslogFacility is local0, which is commonly routed to the SIEM
+	DefaultSyslogFacility = 16
+	MaxSyslogFacility     = 23
+	DefaultSyslogAppName  = "opensight-notification"
+)
+
+// DefaultSyslogPort returns the port registered for the transport
+func DefaultSyslogPort(transport string) int {
+	if transport == SyslogTransportTLS {
+		return 6514
+	}
+	return 514
+}
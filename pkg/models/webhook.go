@@ -0,0 +1,80 @@
+// SPDX-FileCopyrightText: 2026 Greenbone AG <https://greenbone.net>
+//
+// SPDX-License-Identifier: AGPL-3.0-or-later
+
+package models
+
+import (
+	"encoding/json"
+	"fmt"
+	"strings"
+	"text/template"
+
+	"github.com/greenbone/opensight-notification-service/pkg/translation"
+)
+
+// Headers of the requests to generic webhooks as defined by https://www.standardwebhooks.com, so
+// receivers can use the libraries of the spec to verify the requests. The ID is the same for retries
+// of a delivery, the timestamp allows to reject replayed requests. The signature is only sent if the
+// channel has a secret.
+const (
+	WebhookIdHeader        = "Webhook-Id"
+	WebhookTimestampHeader = "Webhook-Timestamp"
+	WebhookSignatureHeader = "Webhook-Signature"
+)
+
+// WebhookSecretPrefix marks secrets in the format of Standard Webhooks, the key follows base64 encoded
+const WebhookSecretPrefix = "whsec_"
+
+// webhookBodyFuncs are available in the body templates of generic webhooks. As text/template
+// does not escape the output, values are inserted into the JSON with `{{json .Title}}`.
+var webhookBodyFuncs = template.FuncMap{
+	"json": func(value any) (string, error) {
+		encoded, err := json.Marshal(value)
+		return string(encoded), err
+	},
+}
+
+// RenderWebhookBody renders the JSON body of a generic webhook. Without template the notification
+// is sent as is. The template is a Go template like the message templates, which has to render valid JSON,
+// e.g. `{"summary": {{json .Title}}, "severity": {{json .Level}}}`.
+func RenderWebhookBody(bodyTemplate string, notification Notification) ([]byte, error) {
+	if bodyTemplate == "" {
+		return json.Marshal(notification)
+	}
+
+	tmpl, err := template.New("webhook body").Funcs(webhookBodyFuncs).Parse(bodyTemplate)
+	if err != nil {
+		return nil, fmt.Errorf("failed to parse webhook body template: %w", err)
+	}
+
+	var b strings.Builder
+	if err := tmpl.Execute(&b, NewTemplateData(notification)); err != nil {
+		return nil, fmt.Errorf("failed to render webhook body template: %w", err)
+	}
+	if !json.Valid([]byte(b.String())) {
+		return nil, fmt.Errorf("webhook body template rendered invalid JSON")
+	}
+	return []byte(b.String()), nil
+}
+
+// ValidateWebhookBodyTemplate returns the translated issue of the body template, empty if it is valid.
+// The template is rendered with a sample notification to check that the result is valid JSON.
+func ValidateWebhookBodyTemplate(bodyTemplate string) string {
+	if len(bodyTemplate) > MaxTemplateBodyLength {
+		return translation.TemplateBodyTooLong
+	}
+
+	tmpl, err := template.New("webhook body").Funcs(webhookBodyFuncs).Parse(bodyTemplate)
+	if err != nil {
+		return translation.InvalidTemplateSyntax
+	}
+	var b strings.Builder
+	if err := tmpl.Execute(&b, SampleTemplateData()); err != nil {
+		return translation.TemplateRenderFailed
+	}
+	if !json.Valid([]byte(b.String())) {
+		return translation.InvalidWebhookBodyTemplate
+	}
+	return ""
+}
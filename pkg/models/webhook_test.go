@@ -0,0 +1,94 @@
+// SPDX-FileCopyrightText: 2026 Greenbone AG <https://greenbone.net>
+//
+// SPDX-License-Identifier: AGPL-3.0-or-later
+
+package models
+
+import (
+	"strings"
+	"testing"
+
+	"github.com/greenbone/opensight-golang-libraries/pkg/notifications"
+	"github.com/greenbone/opensight-notification-service/pkg/translation"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func Test_RenderWebhookBody(t *testing.T) {
+	notification := Notification{
+		Id:           "00000000-0000-4000-8000-000000000001",
+		Origin:       "SBOM - React",
+		Timestamp:    "2026-01-01T12:00:00Z",
+		Title:        `New "vulnerability"`,
+		Detail:       "Details",
+		Level:        notifications.LevelError,
+		CustomFields: map[string]any{"host": "10.0.0.1"},
+	}
+
+	tests := map[string]struct {
+		template string
+		wantBody string
+		wantErr  bool
+	}{
+		"notification as is": {
+			wantBody: `{"id":"00000000-0000-4000-8000-000000000001","origin":"SBOM - React","originClass":"","timestamp":"2026-01-01T12:00:00Z","title":"New \"vulnerability\"","detail":"Details","level":"error","customFields":{"host":"10.0.0.1"}}`,
+		},
+		"template": {
+			template: `{"summary": {{json .Title}}, "severity": {{json .Level}}, "host": {{json .CustomFields.host}}}`,
+			wantBody: `{"summary": "New \"vulnerability\"", "severity": "error", "host": "10.0.0.1"}`,
+		},
+		"template with invalid JSON": {
+			template: `{"summary": {{.Title}}}`,
+			wantErr:  true,
+		},
+		"invalid template": {
+			template: `{"summary": {{json .Title}`,
+			wantErr:  true,
+		},
+	}
+
+	for name, tt := range tests {
+		t.Run(name, func(t *testing.T) {
+			body, err := RenderWebhookBody(tt.template, notification)
+			if tt.wantErr {
+				require.Error(t, err)
+				return
+			}
+			require.NoError(t, err)
+			assert.Equal(t, tt.wantBody, string(body))
+		})
+	}
+}
+
+func Test_ValidateWebhookBodyTemplate(t *testing.T) {
+	tests := map[string]struct {
+		template string
+		want     string
+	}{
+		"valid": {
+			template: `{"summary": {{json .Title}}, "icon": {{json .LevelIcon}}}`,
+		},
+		"syntax error": {
+			template: `{"summary": {{json .Title}`,
+			want:     translation.InvalidTemplateSyntax,
+		},
+		"unknown field": {
+			template: `{"summary": {{json .Unknown}}}`,
+			want:     translation.TemplateRenderFailed,
+		},
+		"invalid JSON": {
+			template: `{"summary": {{.Title}}}`,
+			want:     translation.InvalidWebhookBodyTemplate,
+		},
+		"too long": {
+			template: `{"summary": "` + strings.Repeat("a", MaxTemplateBodyLength) + `"}`,
+			want:     translation.TemplateBodyTooLong,
+		},
+	}
+
+	for name, tt := range tests {
+		t.Run(name, func(t *testing.T) {
+			assert.Equal(t, tt.want, ValidateWebhookBodyTemplate(tt.template))
+		})
+	}
+}
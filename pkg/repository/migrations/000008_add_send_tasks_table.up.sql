@@ -0,0 +1,14 @@
+-- outbox of pending deliveries, persisted together with the notification so that retries survive restarts
+CREATE TABLE notification_service.send_tasks (
+    "id"              UUID DEFAULT gen_random_uuid() PRIMARY KEY,
+    "notification_id" UUID NOT NULL REFERENCES notification_service.notifications(id) ON DELETE CASCADE,
+    "channel_id"      UUID NOT NULL,
+    "channel_name"    TEXT NOT NULL,
+    "channel_type"    VARCHAR(255) NOT NULL,
+    "recipient"       TEXT NOT NULL,
+    "attempt"         INTEGER NOT NULL DEFAULT 0,
+    "next_execution"  TIMESTAMPTZ NOT NULL,
+    "claimed_until"   TIMESTAMPTZ
+);
+
+CREATE INDEX idx_send_tasks_next_execution ON notification_service.send_tasks(next_execution);
@@ -0,0 +1,18 @@
+ALTER TABLE notification_service.send_tasks ADD COLUMN "rule_id" UUID;
+
+CREATE TABLE notification_service.delivery_attempts (
+    "id"              UUID DEFAULT gen_random_uuid() PRIMARY KEY,
+    "notification_id" UUID NOT NULL REFERENCES notification_service.notifications(id) ON DELETE CASCADE,
+    "rule_id"         UUID,
+    "channel_id"      UUID NOT NULL,
+    "channel_name"    TEXT NOT NULL,
+    "channel_type"    VARCHAR(255) NOT NULL,
+    "recipient"       TEXT NOT NULL,
+    "attempt"         INTEGER NOT NULL,
+    "timestamp"       TIMESTAMPTZ NOT NULL,
+    "outcome"         VARCHAR(255) NOT NULL,
+    "error"           TEXT NOT NULL
+);
+
+CREATE INDEX idx_delivery_attempts_notification_id ON notification_service.delivery_attempts(notification_id);
+CREATE INDEX idx_delivery_attempts_timestamp ON notification_service.delivery_attempts(timestamp);
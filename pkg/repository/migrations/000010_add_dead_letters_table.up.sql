@@ -0,0 +1,15 @@
+-- deliveries which failed permanently, they are kept until an admin replays them
+CREATE TABLE notification_service.dead_letters (
+    "id"               UUID DEFAULT gen_random_uuid() PRIMARY KEY,
+    "notification_id"  UUID NOT NULL REFERENCES notification_service.notifications(id) ON DELETE CASCADE,
+    "rule_id"          UUID,
+    "channel_id"       UUID NOT NULL,
+    "channel_name"     TEXT NOT NULL,
+    "channel_type"     VARCHAR(255) NOT NULL,
+    "recipient"        TEXT NOT NULL,
+    "attempts"         INTEGER NOT NULL,
+    "last_error"       TEXT NOT NULL,
+    "dead_lettered_at" TIMESTAMPTZ NOT NULL
+);
+
+CREATE INDEX idx_dead_letters_dead_lettered_at ON notification_service.dead_letters(dead_lettered_at);
@@ -0,0 +1,8 @@
+-- idempotency keys of notification requests, a repeated request with the same key of the same caller returns the original notification
+CREATE TABLE notification_service.idempotency_keys (
+    "caller"          TEXT NOT NULL,
+    "key"             TEXT NOT NULL,
+    "notification_id" UUID NOT NULL REFERENCES notification_service.notifications(id) ON DELETE CASCADE,
+    "created_at"      TIMESTAMPTZ NOT NULL,
+    PRIMARY KEY ("caller", "key")
+);
@@ -0,0 +1,12 @@
+-- delivery mode of rules, in digest mode the notifications are collected and forwarded together
+ALTER TABLE notification_service.rules
+    ADD COLUMN "delivery_mode"         VARCHAR(255) NOT NULL DEFAULT 'immediate',
+    ADD COLUMN "digest_window_minutes" INTEGER NOT NULL DEFAULT 0,
+    ADD COLUMN "digest_max_count"      INTEGER NOT NULL DEFAULT 0;
+
+-- a send task which is still collecting notifications for a digest, it is closed once it is claimed for delivery
+ALTER TABLE notification_service.send_tasks
+    ADD COLUMN "digest_notification_ids" UUID[],
+    ADD COLUMN "collecting"              BOOLEAN NOT NULL DEFAULT false;
+
+CREATE UNIQUE INDEX idx_send_tasks_collecting ON notification_service.send_tasks(rule_id, channel_id, recipient) WHERE collecting;
@@ -0,0 +1,24 @@
+-- suppression window of rules, repeats of a forwarded notification within the window are not forwarded
+ALTER TABLE notification_service.rules
+    ADD COLUMN "suppression_window_minutes" INTEGER NOT NULL DEFAULT 0;
+
+-- number of suppressed repeats of a forwarded notification
+ALTER TABLE notification_service.notifications
+    ADD COLUMN "repeats" INTEGER NOT NULL DEFAULT 0;
+
+-- number of suppressed repeats since the previously forwarded notification, mentioned in the message
+ALTER TABLE notification_service.send_tasks
+    ADD COLUMN "repeats" INTEGER NOT NULL DEFAULT 0;
+
+-- the suppression window per rule, channel, recipient and deduplication key, it is opened by a forwarded notification
+CREATE TABLE notification_service.suppressions (
+    "rule_id"          UUID NOT NULL,
+    "channel_id"       UUID NOT NULL,
+    "recipient"        TEXT NOT NULL,
+    "dedup_key"        TEXT NOT NULL,
+    "notification_id"  UUID NOT NULL REFERENCES notification_service.notifications(id) ON DELETE CASCADE,
+    "window_start"     TIMESTAMPTZ NOT NULL,
+    "repeats"          INTEGER NOT NULL DEFAULT 0,
+    "previous_repeats" INTEGER NOT NULL DEFAULT 0,
+    PRIMARY KEY ("rule_id", "channel_id", "recipient", "dedup_key")
+);
@@ -0,0 +1,3 @@
+-- quiet hours and maintenance windows of rules, during which the forwarding is muted
+ALTER TABLE notification_service.rules
+    ADD COLUMN "mute" JSONB NOT NULL DEFAULT '{}';
@@ -0,0 +1,20 @@
+-- escalation steps of rules, executed for urgent notifications which are not acknowledged in time
+ALTER TABLE notification_service.rules
+    ADD COLUMN "escalation" JSONB NOT NULL DEFAULT '[]';
+
+ALTER TABLE notification_service.notifications
+    ADD COLUMN "acknowledged_at" TIMESTAMPTZ,
+    ADD COLUMN "acknowledged_by" TEXT;
+
+-- pending escalations, removed once the notification is acknowledged or the last step was executed
+CREATE TABLE notification_service.escalations (
+    "id"              UUID DEFAULT gen_random_uuid() PRIMARY KEY,
+    "notification_id" UUID NOT NULL REFERENCES notification_service.notifications(id) ON DELETE CASCADE,
+    "rule_id"         UUID NOT NULL,
+    "step"            INTEGER NOT NULL,
+    "due_at"          TIMESTAMPTZ NOT NULL,
+    "claimed_until"   TIMESTAMPTZ
+);
+
+CREATE INDEX idx_escalations_due_at ON notification_service.escalations(due_at);
+CREATE INDEX idx_escalations_notification_id ON notification_service.escalations(notification_id);
@@ -0,0 +1,11 @@
+-- read, acknowledged and dismissed state of notifications per user, a missing row means unread
+CREATE TABLE notification_service.notification_user_states (
+    "notification_id" UUID NOT NULL REFERENCES notification_service.notifications(id) ON DELETE CASCADE,
+    "user_id"         TEXT NOT NULL,
+    "read_at"         TIMESTAMPTZ,
+    "acknowledged_at" TIMESTAMPTZ,
+    "dismissed_at"    TIMESTAMPTZ,
+    PRIMARY KEY ("notification_id", "user_id")
+);
+
+CREATE INDEX idx_notification_user_states_user_id ON notification_service.notification_user_states(user_id);
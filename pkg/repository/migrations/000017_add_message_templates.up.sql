@@ -0,0 +1,10 @@
+-- message templates per channel type, a missing row means the default template applies
+CREATE TABLE notification_service.message_templates (
+    "channel_type" TEXT PRIMARY KEY,
+    "subject"      TEXT NOT NULL,
+    "body"         TEXT NOT NULL
+);
+
+-- optional override of the message template for the messages of a rule
+ALTER TABLE notification_service.rules
+    ADD COLUMN "template" JSONB;
@@ -0,0 +1,5 @@
+-- optional overrides of the defaults configured for the webhook, e.g. in Mattermost
+ALTER TABLE notification_service.notification_channel
+    ADD COLUMN "webhook_username" TEXT,
+    ADD COLUMN "webhook_icon_url" TEXT,
+    ADD COLUMN "webhook_channel"  TEXT;
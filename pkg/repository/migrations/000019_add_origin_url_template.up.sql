@@ -0,0 +1,3 @@
+-- template of the link from messages to the resource of a notification, empty if the origin provides no links
+ALTER TABLE notification_service.origins
+    ADD COLUMN "url_template" TEXT NOT NULL DEFAULT '';
@@ -0,0 +1,6 @@
+-- settings of generic webhooks, the headers are stored as encrypted JSON object as they may contain credentials
+ALTER TABLE notification_service.notification_channel
+    ADD COLUMN "webhook_method"        TEXT,
+    ADD COLUMN "webhook_headers"       TEXT,
+    ADD COLUMN "webhook_body_template" TEXT,
+    ADD COLUMN "webhook_secret"        TEXT;
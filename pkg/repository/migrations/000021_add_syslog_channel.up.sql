@@ -0,0 +1,5 @@
+-- settings of syslog channels, the host and port of the collector are stored in domain and port
+ALTER TABLE notification_service.notification_channel
+    ADD COLUMN "syslog_transport" TEXT,
+    ADD COLUMN "syslog_facility"  INTEGER,
+    ADD COLUMN "syslog_app_name"  TEXT;
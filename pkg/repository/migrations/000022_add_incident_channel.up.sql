@@ -0,0 +1,7 @@
+-- routing key of incident channels, the URL of the events API is stored in webhook_url
+ALTER TABLE notification_service.notification_channel
+    ADD COLUMN "routing_key" TEXT;
+
+-- origins report the recovery of a resource with a notification, incident channels resolve the incident of the resource
+ALTER TABLE notification_service.notifications
+    ADD COLUMN "recovered" BOOLEAN NOT NULL DEFAULT FALSE;
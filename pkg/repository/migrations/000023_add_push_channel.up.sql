@@ -0,0 +1,6 @@
+-- settings of push channels, the URL of the ntfy or Gotify server is stored in webhook_url
+ALTER TABLE notification_service.notification_channel
+    ADD COLUMN "push_server"  TEXT,
+    ADD COLUMN "push_topic"   TEXT,
+    ADD COLUMN "push_tags"    TEXT,
+    ADD COLUMN "access_token" TEXT;
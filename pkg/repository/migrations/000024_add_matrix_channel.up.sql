@@ -0,0 +1,3 @@
+-- settings of Matrix channels, the URL of the homeserver is stored in webhook_url and the token in access_token
+ALTER TABLE notification_service.notification_channel
+    ADD COLUMN "matrix_room_id" TEXT;
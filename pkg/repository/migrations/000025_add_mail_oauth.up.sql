@@ -0,0 +1,7 @@
+-- XOAUTH2 settings of mail channels, the mailbox to authenticate as is stored in username
+ALTER TABLE notification_service.notification_channel
+    ADD COLUMN "mail_auth_method"    TEXT,
+    ADD COLUMN "oauth_token_url"     TEXT,
+    ADD COLUMN "oauth_client_id"     TEXT,
+    ADD COLUMN "oauth_client_secret" TEXT,
+    ADD COLUMN "oauth_scopes"        TEXT;
@@ -0,0 +1,15 @@
+-- TLS settings of mail channels, replacing is_tls_enforced
+ALTER TABLE notification_service.notification_channel
+    ADD COLUMN "mail_tls_mode"         TEXT,
+    ADD COLUMN "mail_tls_min_version"  TEXT,
+    ADD COLUMN "mail_ca_certificate"   TEXT,
+    ADD COLUMN "mail_cert_fingerprint" TEXT;
+
+-- without is_tls_enforced the mail client required STARTTLS, so existing channels keep their behavior
+UPDATE notification_service.notification_channel
+SET mail_tls_mode        = CASE WHEN is_tls_enforced THEN 'implicit' ELSE 'starttls' END,
+    mail_tls_min_version = '1.2'
+WHERE channel_type = 'mail';
+
+ALTER TABLE notification_service.notification_channel
+    DROP COLUMN "is_tls_enforced";
@@ -0,0 +1,9 @@
+-- time a notification was stored, compared with the read marks of the users
+ALTER TABLE notification_service.notifications
+    ADD COLUMN "created_at" TIMESTAMPTZ NOT NULL DEFAULT NOW();
+
+-- all notifications stored until read_before count as read by the user, in addition to notification_user_states
+CREATE TABLE notification_service.notification_read_marks (
+    "user_id"     TEXT PRIMARY KEY,
+    "read_before" TIMESTAMPTZ NOT NULL
+);
@@ -0,0 +1,140 @@
+// SPDX-FileCopyrightText: 2026 Greenbone AG <https://greenbone.net>
+//
+// SPDX-License-Identifier: AGPL-3.0-or-later
+
+package notificationrepository
+
+import (
+	"context"
+	"database/sql"
+	"errors"
+	"fmt"
+	"time"
+
+	pgquery "github.com/greenbone/opensight-golang-libraries/pkg/postgres/query"
+	"github.com/greenbone/opensight-golang-libraries/pkg/query"
+	"github.com/greenbone/opensight-notification-service/pkg/errs"
+	"github.com/greenbone/opensight-notification-service/pkg/models"
+	"github.com/greenbone/opensight-notification-service/pkg/repository"
+	"github.com/greenbone/opensight-notification-service/pkg/validation"
+	"github.com/jmoiron/sqlx"
+	"github.com/lib/pq"
+)
+
+// DeadLetterRepository keeps the deliveries which failed permanently.
+type DeadLetterRepository interface {
+	// MoveSendTaskToDeadLetters removes the send task from the outbox and stores it as dead letter.
+	MoveSendTaskToDeadLetters(ctx context.Context, sendTask models.SendTask, lastError string, deadLetteredAt time.Time) error
+	ListDeadLetters(
+		ctx context.Context,
+		resultSelector query.ResultSelector,
+	) (deadLetters []models.DeadLetter, totalResults uint64, err error)
+	// GetDeadLetter returns the dead letter including the notification which could not be delivered.
+	GetDeadLetter(ctx context.Context, id string) (models.DeadLetter, error)
+	// ReplayDeadLetters puts the dead letters back into the outbox, they are delivered as soon as `nextExecution` is due.
+	// Unknown IDs are ignored, the number of replayed dead letters is returned.
+	ReplayDeadLetters(ctx context.Context, ids []string, nextExecution time.Time) (replayed int, err error)
+}
+
+type deadLetterRepository struct {
+	client *sqlx.DB
+}
+
+func NewDeadLetterRepository(db *sqlx.DB) (DeadLetterRepository, error) {
+	if db == nil {
+		return nil, errors.New("nil db reference")
+	}
+	return &deadLetterRepository{client: db}, nil
+}
+
+func (r *deadLetterRepository) MoveSendTaskToDeadLetters(
+	ctx context.Context,
+	sendTask models.SendTask,
+	lastError string,
+	deadLetteredAt time.Time,
+) error {
+	_, err := r.client.ExecContext(ctx, moveSendTaskToDeadLettersQuery, sendTask.ID, sendTask.Attempt+1, lastError, deadLetteredAt)
+	if err != nil {
+		return fmt.Errorf("could not move send task to dead letters: %w", err)
+	}
+	return nil
+}
+
+func (r *deadLetterRepository) ListDeadLetters(
+	ctx context.Context,
+	resultSelector query.ResultSelector,
+) (deadLetters []models.DeadLetter, totalResults uint64, err error) {
+	querySettings := pgquery.Settings{
+		FilterFieldMapping:      deadLetterFieldMapping(),
+		SortingTieBreakerColumn: "id",
+	}
+
+	listQuery, queryParams, err := repository.BuildListQuery(resultSelector, unfilteredListDeadLettersQuery, querySettings)
+	if err != nil {
+		return nil, 0, fmt.Errorf("error building list query: %w", err)
+	}
+
+	var rows []deadLetterRow
+	err = r.client.SelectContext(ctx, &rows, listQuery, queryParams...)
+	if err != nil {
+		return nil, 0, fmt.Errorf("error getting dead letters from database: %w", err)
+	}
+
+	countQuery, queryParams, err := repository.BuildCountQuery(resultSelector.Filter, unfilteredListDeadLettersQuery, querySettings)
+	if err != nil {
+		return nil, 0, fmt.Errorf("error building count query: %w", err)
+	}
+	err = r.client.QueryRowxContext(ctx, countQuery, queryParams...).Scan(&totalResults)
+	if err != nil {
+		return nil, 0, fmt.Errorf("error getting total results: %w", err)
+	}
+
+	deadLetters = make([]models.DeadLetter, 0, len(rows))
+	for _, row := range rows {
+		deadLetters = append(deadLetters, row.ToModel())
+	}
+	return deadLetters, totalResults, nil
+}
+
+func (r *deadLetterRepository) GetDeadLetter(ctx context.Context, id string) (models.DeadLetter, error) {
+	if err := validation.Validate.Var(id, "uuid4"); err != nil {
+		return models.DeadLetter{}, ErrInvalidID
+	}
+
+	var row deadLetterWithNotificationRow
+	err := r.client.GetContext(ctx, &row, getDeadLetterQuery, id)
+	if err != nil {
+		if errors.Is(err, sql.ErrNoRows) {
+			return models.DeadLetter{}, errs.ErrItemNotFound
+		}
+		return models.DeadLetter{}, fmt.Errorf("error getting dead letter from database: %w", err)
+	}
+
+	notification, err := row.Notification.ToNotificationModel()
+	if err != nil {
+		return models.DeadLetter{}, fmt.Errorf("failed to transform notification db entry: %w", err)
+	}
+	deadLetter := row.ToModel()
+	deadLetter.Notification = &notification
+	return deadLetter, nil
+}
+
+func (r *deadLetterRepository) ReplayDeadLetters(
+	ctx context.Context,
+	ids []string,
+	nextExecution time.Time,
+) (replayed int, err error) {
+	if err := validation.Validate.Var(ids, "dive,uuid4"); err != nil {
+		return 0, ErrInvalidID
+	}
+
+	result, err := r.client.ExecContext(ctx, replayDeadLettersQuery, pq.Array(ids), nextExecution)
+	if err != nil {
+		return 0, fmt.Errorf("could not replay dead letters: %w", err)
+	}
+	rowsAffected, err := result.RowsAffected()
+	if err != nil {
+		return 0, fmt.Errorf("could not get number of replayed dead letters: %w", err)
+	}
+	return int(rowsAffected), nil
+}
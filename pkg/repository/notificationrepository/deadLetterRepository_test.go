@@ -0,0 +1,100 @@
+// SPDX-FileCopyrightText: 2026 Greenbone AG <https://greenbone.net>
+//
+// SPDX-License-Identifier: AGPL-3.0-or-later
+
+package notificationrepository
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"github.com/greenbone/opensight-golang-libraries/pkg/query"
+	"github.com/greenbone/opensight-notification-service/pkg/errs"
+	"github.com/greenbone/opensight-notification-service/pkg/models"
+	"github.com/greenbone/opensight-notification-service/pkg/pgtesting"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func Test_DeadLetterRepository(t *testing.T) {
+	db := pgtesting.NewDB(t)
+
+	notificationRepo, err := NewNotificationRepository(db)
+	require.NoError(t, err)
+	sendTaskRepo, err := NewSendTaskRepository(db)
+	require.NoError(t, err)
+	repo, err := NewDeadLetterRepository(db)
+	require.NoError(t, err)
+
+	ctx := context.Background()
+	now := time.Now().UTC().Truncate(time.Microsecond) // precision of postgres timestamps
+
+	action := models.Action{
+		Channel: models.ChannelReference{
+			ID:   "0b9e6c4a-2f0e-4a8e-9c61-6c0d1c9a7e11",
+			Name: "Mail Channel",
+			Type: models.ChannelTypeMail,
+		},
+		Recipient: "a@example.com",
+	}
+
+	notification, sendTasks, err := notificationRepo.CreateNotification(ctx, models.Notification{
+		Origin:      "test",
+		OriginClass: "vi/test",
+		Timestamp:   "2024-10-10T10:00:00Z",
+		Title:       "Test Notification",
+		Detail:      "This is a test notification",
+		Level:       "info",
+	}, []models.SendTask{
+		{Action: action, Attempt: 9, NextExecution: now},
+	})
+	require.NoError(t, err)
+	require.Len(t, sendTasks, 1)
+
+	err = repo.MoveSendTaskToDeadLetters(ctx, sendTasks[0], "smtp server unreachable", now)
+	require.NoError(t, err)
+
+	// the send task is no longer in the outbox
+	gotTasks, err := sendTaskRepo.ClaimDueSendTasks(ctx, now.Add(time.Hour), now.Add(2*time.Hour), 10)
+	require.NoError(t, err)
+	assert.Empty(t, gotTasks)
+
+	deadLetters, totalResults, err := repo.ListDeadLetters(ctx, query.ResultSelector{})
+	require.NoError(t, err)
+	require.Len(t, deadLetters, 1)
+	assert.Equal(t, uint64(1), totalResults)
+	deadLetterID := deadLetters[0].ID
+	assert.Equal(t, models.DeadLetter{
+		ID:             deadLetterID,
+		NotificationID: notification.Id,
+		Channel:        action.Channel,
+		Recipient:      action.Recipient,
+		Attempts:       10,
+		LastError:      "smtp server unreachable",
+		DeadLetteredAt: now,
+	}, deadLetters[0])
+
+	deadLetter, err := repo.GetDeadLetter(ctx, deadLetterID)
+	require.NoError(t, err)
+	require.NotNil(t, deadLetter.Notification)
+	assert.Equal(t, notification, *deadLetter.Notification)
+
+	// replaying puts the task back into the outbox with a fresh attempt counter
+	replayed, err := repo.ReplayDeadLetters(ctx, []string{deadLetterID, "9a1c2b3d-4e5f-4a6b-8c7d-0e1f2a3b4c5d"}, now)
+	require.NoError(t, err)
+	assert.Equal(t, 1, replayed)
+
+	gotTasks, err = sendTaskRepo.ClaimDueSendTasks(ctx, now, now.Add(time.Minute), 10)
+	require.NoError(t, err)
+	require.Len(t, gotTasks, 1)
+	assert.Equal(t, action, gotTasks[0].Action)
+	assert.Equal(t, 0, gotTasks[0].Attempt)
+	assert.Equal(t, notification, *gotTasks[0].Notification)
+
+	_, err = repo.GetDeadLetter(ctx, deadLetterID)
+	require.ErrorIs(t, err, errs.ErrItemNotFound)
+
+	_, err = repo.ReplayDeadLetters(ctx, []string{"invalid"}, now)
+	require.ErrorIs(t, err, ErrInvalidID)
+}
@@ -0,0 +1,89 @@
+// SPDX-FileCopyrightText: 2026 Greenbone AG <https://greenbone.net>
+//
+// SPDX-License-Identifier: AGPL-3.0-or-later
+
+package notificationrepository
+
+import (
+	"time"
+
+	"github.com/greenbone/opensight-notification-service/pkg/helper"
+	"github.com/greenbone/opensight-notification-service/pkg/models"
+	"github.com/greenbone/opensight-notification-service/pkg/services/notificationservice/dtos"
+)
+
+const (
+	deadLettersTable = "notification_service.dead_letters"
+	// moveSendTaskToDeadLettersQuery removes the send task from the outbox and stores it as dead letter in one statement,
+	// a digest results in one dead letter per contained notification
+	moveSendTaskToDeadLettersQuery = `WITH removed AS (
+			DELETE FROM ` + sendTasksTable + ` WHERE id = $1
+			RETURNING notification_id, rule_id, channel_id, channel_name, channel_type, recipient, digest_notification_ids
+		)
+		INSERT INTO ` + deadLettersTable + ` (notification_id, rule_id, channel_id, channel_name, channel_type, recipient, attempts, last_error, dead_lettered_at)
+		SELECT unnest(COALESCE(digest_notification_ids, ARRAY[notification_id])), rule_id, channel_id, channel_name, channel_type, recipient, $2, $3, $4 FROM removed`
+	// replayDeadLettersQuery removes the dead letters and puts them back into the outbox as new send tasks in one statement
+	replayDeadLettersQuery = `WITH replayed AS (
+			DELETE FROM ` + deadLettersTable + ` WHERE id = ANY($1::uuid[])
+			RETURNING notification_id, rule_id, channel_id, channel_name, channel_type, recipient
+		)
+		INSERT INTO ` + sendTasksTable + ` (notification_id, rule_id, channel_id, channel_name, channel_type, recipient, attempt, next_execution)
+		SELECT notification_id, rule_id, channel_id, channel_name, channel_type, recipient, 0, $2 FROM replayed`
+	unfilteredListDeadLettersQuery = `SELECT * FROM ` + deadLettersTable
+	getDeadLetterQuery             = `SELECT d.id, d.notification_id, d.rule_id, d.channel_id, d.channel_name, d.channel_type, d.recipient, d.attempts, d.last_error, d.dead_lettered_at,
+			n.id AS "notification.id", n.origin AS "notification.origin", n.origin_class AS "notification.origin_class",
+			n.origin_resource_id AS "notification.origin_resource_id", n.timestamp AS "notification.timestamp",
+			n.title AS "notification.title", n.detail AS "notification.detail", n.level AS "notification.level",
+			n.custom_fields AS "notification.custom_fields", n.recovered AS "notification.recovered"
+		FROM ` + deadLettersTable + ` d
+		JOIN ` + notificationsTable + ` n ON n.id = d.notification_id
+		WHERE d.id = $1`
+)
+
+type deadLetterRow struct {
+	ID             string    `db:"id"`
+	NotificationID string    `db:"notification_id"`
+	RuleID         *string   `db:"rule_id"`
+	ChannelID      string    `db:"channel_id"`
+	ChannelName    string    `db:"channel_name"`
+	ChannelType    string    `db:"channel_type"`
+	Recipient      string    `db:"recipient"`
+	Attempts       int       `db:"attempts"`
+	LastError      string    `db:"last_error"`
+	DeadLetteredAt time.Time `db:"dead_lettered_at"`
+}
+
+// deadLetterWithNotificationRow is a dead letter together with the notification which could not be delivered
+type deadLetterWithNotificationRow struct {
+	deadLetterRow
+	Notification notificationRow `db:"notification"`
+}
+
+func deadLetterFieldMapping() map[string]string {
+	return map[string]string{
+		dtos.DeadLetterNotificationIDField: "notification_id",
+		dtos.DeadLetterRuleIDField:         "rule_id",
+		dtos.DeadLetterChannelIDField:      "channel_id",
+		dtos.DeadLetterChannelNameField:    "channel_name",
+		dtos.DeadLetterChannelTypeField:    "channel_type",
+		dtos.DeadLetterRecipientField:      "recipient",
+		dtos.DeadLetterTimestampField:      "dead_lettered_at",
+	}
+}
+
+func (r *deadLetterRow) ToModel() models.DeadLetter {
+	return models.DeadLetter{
+		ID:             r.ID,
+		NotificationID: r.NotificationID,
+		RuleID:         helper.SafeDereference(r.RuleID),
+		Channel: models.ChannelReference{
+			ID:   r.ChannelID,
+			Name: r.ChannelName,
+			Type: models.ChannelType(r.ChannelType),
+		},
+		Recipient:      r.Recipient,
+		Attempts:       r.Attempts,
+		LastError:      r.LastError,
+		DeadLetteredAt: r.DeadLetteredAt.UTC(),
+	}
+}
@@ -0,0 +1,107 @@
+// SPDX-FileCopyrightText: 2026 Greenbone AG <https://greenbone.net>
+//
+// SPDX-License-Identifier: AGPL-3.0-or-later
+
+package notificationrepository
+
+import (
+	"context"
+	"errors"
+	"fmt"
+
+	pgquery "github.com/greenbone/opensight-golang-libraries/pkg/postgres/query"
+	"github.com/greenbone/opensight-golang-libraries/pkg/query"
+	"github.com/greenbone/opensight-notification-service/pkg/models"
+	"github.com/greenbone/opensight-notification-service/pkg/repository"
+	"github.com/greenbone/opensight-notification-service/pkg/validation"
+	"github.com/jmoiron/sqlx"
+)
+
+var ErrInvalidID = errors.New("id is not a valid uuid-v4")
+
+// DeliveryAttemptRepository stores the log of delivery attempts of notifications.
+type DeliveryAttemptRepository interface {
+	CreateDeliveryAttempt(ctx context.Context, attempt models.DeliveryAttempt) error
+	ListDeliveryAttempts(
+		ctx context.Context,
+		resultSelector query.ResultSelector,
+	) (attempts []models.DeliveryAttempt, totalResults uint64, err error)
+	// ListDeliveryAttemptsByNotificationID returns all delivery attempts of the notification, oldest first.
+	ListDeliveryAttemptsByNotificationID(ctx context.Context, notificationID string) ([]models.DeliveryAttempt, error)
+}
+
+type deliveryAttemptRepository struct {
+	client *sqlx.DB
+}
+
+func NewDeliveryAttemptRepository(db *sqlx.DB) (DeliveryAttemptRepository, error) {
+	if db == nil {
+		return nil, errors.New("nil db reference")
+	}
+	return &deliveryAttemptRepository{client: db}, nil
+}
+
+func (r *deliveryAttemptRepository) CreateDeliveryAttempt(ctx context.Context, attempt models.DeliveryAttempt) error {
+	_, err := r.client.NamedExecContext(ctx, createDeliveryAttemptQuery, toDeliveryAttemptRow(attempt))
+	if err != nil {
+		return fmt.Errorf("could not insert delivery attempt into database: %w", err)
+	}
+	return nil
+}
+
+func (r *deliveryAttemptRepository) ListDeliveryAttempts(
+	ctx context.Context,
+	resultSelector query.ResultSelector,
+) (attempts []models.DeliveryAttempt, totalResults uint64, err error) {
+	querySettings := pgquery.Settings{
+		FilterFieldMapping:      deliveryAttemptFieldMapping(),
+		SortingTieBreakerColumn: "id",
+	}
+
+	listQuery, queryParams, err := repository.BuildListQuery(resultSelector, unfilteredListDeliveryQuery, querySettings)
+	if err != nil {
+		return nil, 0, fmt.Errorf("error building list query: %w", err)
+	}
+
+	var rows []deliveryAttemptRow
+	err = r.client.SelectContext(ctx, &rows, listQuery, queryParams...)
+	if err != nil {
+		return nil, 0, fmt.Errorf("error getting delivery attempts from database: %w", err)
+	}
+
+	countQuery, queryParams, err := repository.BuildCountQuery(resultSelector.Filter, unfilteredListDeliveryQuery, querySettings)
+	if err != nil {
+		return nil, 0, fmt.Errorf("error building count query: %w", err)
+	}
+	err = r.client.QueryRowxContext(ctx, countQuery, queryParams...).Scan(&totalResults)
+	if err != nil {
+		return nil, 0, fmt.Errorf("error getting total results: %w", err)
+	}
+
+	return toDeliveryAttemptModels(rows), totalResults, nil
+}
+
+func (r *deliveryAttemptRepository) ListDeliveryAttemptsByNotificationID(
+	ctx context.Context,
+	notificationID string,
+) ([]models.DeliveryAttempt, error) {
+	if err := validation.Validate.Var(notificationID, "uuid4"); err != nil {
+		return nil, ErrInvalidID
+	}
+
+	var rows []deliveryAttemptRow
+	err := r.client.SelectContext(ctx, &rows, listDeliveriesByNotification, notificationID)
+	if err != nil {
+		return nil, fmt.Errorf("error getting delivery attempts from database: %w", err)
+	}
+
+	return toDeliveryAttemptModels(rows), nil
+}
+
+func toDeliveryAttemptModels(rows []deliveryAttemptRow) []models.DeliveryAttempt {
+	attempts := make([]models.DeliveryAttempt, 0, len(rows))
+	for _, row := range rows {
+		attempts = append(attempts, row.ToModel())
+	}
+	return attempts
+}
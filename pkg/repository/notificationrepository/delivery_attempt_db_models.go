@@ -0,0 +1,80 @@
+// SPDX-FileCopyrightText: 2026 Greenbone AG <https://greenbone.net>
+//
+// SPDX-License-Identifier: AGPL-3.0-or-later
+
+package notificationrepository
+
+import (
+	"time"
+
+	"github.com/greenbone/opensight-notification-service/pkg/helper"
+	"github.com/greenbone/opensight-notification-service/pkg/models"
+	"github.com/greenbone/opensight-notification-service/pkg/services/notificationservice/dtos"
+)
+
+const (
+	deliveryAttemptsTable        = "notification_service.delivery_attempts"
+	createDeliveryAttemptQuery   = `INSERT INTO ` + deliveryAttemptsTable + ` (notification_id, rule_id, channel_id, channel_name, channel_type, recipient, attempt, timestamp, outcome, error) VALUES (:notification_id, :rule_id, :channel_id, :channel_name, :channel_type, :recipient, :attempt, :timestamp, :outcome, :error)`
+	unfilteredListDeliveryQuery  = `SELECT * FROM ` + deliveryAttemptsTable
+	listDeliveriesByNotification = `SELECT * FROM ` + deliveryAttemptsTable + ` WHERE notification_id = $1 ORDER BY timestamp, id`
+)
+
+type deliveryAttemptRow struct {
+	ID             string    `db:"id"`
+	NotificationID string    `db:"notification_id"`
+	RuleID         *string   `db:"rule_id"`
+	ChannelID      string    `db:"channel_id"`
+	ChannelName    string    `db:"channel_name"`
+	ChannelType    string    `db:"channel_type"`
+	Recipient      string    `db:"recipient"`
+	Attempt        int       `db:"attempt"`
+	Timestamp      time.Time `db:"timestamp"`
+	Outcome        string    `db:"outcome"`
+	Error          string    `db:"error"`
+}
+
+func deliveryAttemptFieldMapping() map[string]string {
+	return map[string]string{
+		dtos.DeliveryNotificationIDField: "notification_id",
+		dtos.DeliveryRuleIDField:         "rule_id",
+		dtos.DeliveryChannelIDField:      "channel_id",
+		dtos.DeliveryChannelNameField:    "channel_name",
+		dtos.DeliveryChannelTypeField:    "channel_type",
+		dtos.DeliveryRecipientField:      "recipient",
+		dtos.DeliveryTimestampField:      "timestamp",
+		dtos.DeliveryOutcomeField:        "outcome",
+	}
+}
+
+func toDeliveryAttemptRow(a models.DeliveryAttempt) deliveryAttemptRow {
+	return deliveryAttemptRow{
+		NotificationID: a.NotificationID,
+		RuleID:         helper.ToNullablePtr(a.RuleID),
+		ChannelID:      a.Channel.ID,
+		ChannelName:    a.Channel.Name,
+		ChannelType:    string(a.Channel.Type),
+		Recipient:      a.Recipient,
+		Attempt:        a.Attempt,
+		Timestamp:      a.Timestamp,
+		Outcome:        string(a.Outcome),
+		Error:          a.Error,
+	}
+}
+
+func (r *deliveryAttemptRow) ToModel() models.DeliveryAttempt {
+	return models.DeliveryAttempt{
+		ID:             r.ID,
+		NotificationID: r.NotificationID,
+		RuleID:         helper.SafeDereference(r.RuleID),
+		Channel: models.ChannelReference{
+			ID:   r.ChannelID,
+			Name: r.ChannelName,
+			Type: models.ChannelType(r.ChannelType),
+		},
+		Recipient: r.Recipient,
+		Attempt:   r.Attempt,
+		Timestamp: r.Timestamp.UTC(),
+		Outcome:   models.DeliveryOutcome(r.Outcome),
+		Error:     r.Error,
+	}
+}
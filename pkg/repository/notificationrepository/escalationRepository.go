@@ -0,0 +1,87 @@
+// SPDX-FileCopyrightText: 2026 Greenbone AG <https://greenbone.net>
+//
+// SPDX-License-Identifier: AGPL-3.0-or-later
+
+package notificationrepository
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"time"
+
+	"github.com/greenbone/opensight-notification-service/pkg/models"
+	"github.com/jmoiron/sqlx"
+)
+
+// EscalationRepository stores the pending escalations of urgent notifications.
+// Escalations are started together with the send tasks of the notification, see [models.SendTask.EscalateAt].
+// Escalations of a notification are removed once it is acknowledged, see [NotificationRepository.AcknowledgeNotification],
+// or once the origin reports the recovery of the resource, see [deleteEscalationsOfResource].
+type EscalationRepository interface {
+	// ClaimDueEscalations reserves up to `limit` escalations of unacknowledged notifications which are due at `now`.
+	// The escalations stay claimed until `claimUntil`, afterwards they can be claimed again.
+	ClaimDueEscalations(ctx context.Context, now time.Time, claimUntil time.Time, limit int) ([]models.Escalation, error)
+	// AdvanceEscalation moves the escalation to the given step and releases the claim.
+	AdvanceEscalation(ctx context.Context, id string, step int, dueAt time.Time) error
+	DeleteEscalation(ctx context.Context, id string) error
+}
+
+type escalationRepository struct {
+	client *sqlx.DB
+}
+
+func NewEscalationRepository(db *sqlx.DB) (EscalationRepository, error) {
+	if db == nil {
+		return nil, errors.New("nil db reference")
+	}
+	return &escalationRepository{client: db}, nil
+}
+
+func (r *escalationRepository) ClaimDueEscalations(
+	ctx context.Context,
+	now time.Time,
+	claimUntil time.Time,
+	limit int,
+) ([]models.Escalation, error) {
+	var rows []escalationRow
+	err := r.client.SelectContext(ctx, &rows, claimDueEscalationsQuery, now, claimUntil, limit)
+	if err != nil {
+		return nil, fmt.Errorf("could not claim escalations: %w", err)
+	}
+
+	escalations := make([]models.Escalation, 0, len(rows))
+	for _, row := range rows {
+		escalations = append(escalations, row.ToModel())
+	}
+	return escalations, nil
+}
+
+func (r *escalationRepository) AdvanceEscalation(ctx context.Context, id string, step int, dueAt time.Time) error {
+	_, err := r.client.ExecContext(ctx, advanceEscalationQuery, id, step, dueAt)
+	if err != nil {
+		return fmt.Errorf("could not advance escalation: %w", err)
+	}
+	return nil
+}
+
+func (r *escalationRepository) DeleteEscalation(ctx context.Context, id string) error {
+	_, err := r.client.ExecContext(ctx, deleteEscalationQuery, id)
+	if err != nil {
+		return fmt.Errorf("could not delete escalation: %w", err)
+	}
+	return nil
+}
+
+// deleteEscalationsOfResource ends the escalations of the problems reported for the resource of the recovered
+// notification within the given transaction, as there is nothing left to acknowledge.
+func deleteEscalationsOfResource(ctx context.Context, tx *sqlx.Tx, notification models.Notification) error {
+	if !notification.Recovered {
+		return nil
+	}
+	_, err := tx.ExecContext(ctx, deleteEscalationsOfResourceQuery, notification.OriginClass, notification.OriginResourceID)
+	if err != nil {
+		return fmt.Errorf("could not delete escalations of recovered resource: %w", err)
+	}
+	return nil
+}
@@ -0,0 +1,146 @@
+// SPDX-FileCopyrightText: 2026 Greenbone AG <https://greenbone.net>
+//
+// SPDX-License-Identifier: AGPL-3.0-or-later
+
+package notificationrepository
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"github.com/greenbone/opensight-notification-service/pkg/errs"
+	"github.com/greenbone/opensight-notification-service/pkg/models"
+	"github.com/greenbone/opensight-notification-service/pkg/pgtesting"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func Test_EscalationRepository(t *testing.T) {
+	db := pgtesting.NewDB(t)
+
+	notificationRepo, err := NewNotificationRepository(db)
+	require.NoError(t, err)
+	repo, err := NewEscalationRepository(db)
+	require.NoError(t, err)
+
+	ctx := context.Background()
+	now := time.Now().UTC().Truncate(time.Microsecond) // precision of postgres timestamps
+	ruleID := "5a7f0c2e-9d3b-4e61-8a4f-2b1c0d9e8f7a"
+
+	// the escalation is started with the send tasks of the rule, once even if the rule forwards to several recipients
+	createNotification := func() models.Notification {
+		sendTask := models.SendTask{
+			RuleID: ruleID,
+			Action: models.Action{
+				Channel:   models.ChannelReference{ID: "0b9e6c4a-2f0e-4a8e-9c61-6c0d1c9a7e11", Type: models.ChannelTypeMail},
+				Recipient: "a@example.com",
+			},
+			NextExecution: now,
+			EscalateAt:    now.Add(time.Minute),
+		}
+		otherRecipient := sendTask
+		otherRecipient.Action.Recipient = "b@example.com"
+
+		notification, sendTasks, err := notificationRepo.CreateNotification(ctx, models.Notification{
+			Origin:      "test",
+			OriginClass: "vi/test",
+			Timestamp:   "2024-10-10T10:00:00Z",
+			Title:       "Test Notification",
+			Detail:      "This is a test notification",
+			Level:       "urgent",
+		}, []models.SendTask{sendTask, otherRecipient})
+		require.NoError(t, err)
+		require.Len(t, sendTasks, 2)
+		return notification
+	}
+	notification := createNotification()
+	acknowledgedNotification := createNotification()
+
+	// nothing is due yet
+	escalations, err := repo.ClaimDueEscalations(ctx, now, now.Add(time.Minute), 10)
+	require.NoError(t, err)
+	assert.Empty(t, escalations)
+
+	// acknowledgement keeps the first user and ends the escalation
+	acknowledged, err := notificationRepo.AcknowledgeNotification(ctx, acknowledgedNotification.Id, "user-1", now)
+	require.NoError(t, err)
+	assert.Equal(t, "user-1", acknowledged.AcknowledgedBy)
+	require.NotNil(t, acknowledged.AcknowledgedAt)
+	assert.True(t, now.Equal(*acknowledged.AcknowledgedAt))
+
+	acknowledged, err = notificationRepo.AcknowledgeNotification(ctx, acknowledgedNotification.Id, "user-2", now.Add(time.Minute))
+	require.NoError(t, err)
+	assert.Equal(t, "user-1", acknowledged.AcknowledgedBy)
+
+	// only the escalation of the unacknowledged notification is claimed, and only once
+	escalations, err = repo.ClaimDueEscalations(ctx, now.Add(time.Minute), now.Add(2*time.Minute), 10)
+	require.NoError(t, err)
+	require.Len(t, escalations, 1)
+	escalation := escalations[0]
+	assert.Equal(t, notification.Id, escalation.NotificationID)
+	assert.Equal(t, ruleID, escalation.RuleID)
+	assert.Equal(t, 0, escalation.Step)
+
+	escalations, err = repo.ClaimDueEscalations(ctx, now.Add(time.Minute), now.Add(2*time.Minute), 10)
+	require.NoError(t, err)
+	assert.Empty(t, escalations)
+
+	// advancing releases the claim
+	err = repo.AdvanceEscalation(ctx, escalation.ID, 1, now.Add(3*time.Minute))
+	require.NoError(t, err)
+
+	escalations, err = repo.ClaimDueEscalations(ctx, now.Add(3*time.Minute), now.Add(4*time.Minute), 10)
+	require.NoError(t, err)
+	require.Len(t, escalations, 1)
+	assert.Equal(t, 1, escalations[0].Step)
+
+	err = repo.DeleteEscalation(ctx, escalation.ID)
+	require.NoError(t, err)
+
+	escalations, err = repo.ClaimDueEscalations(ctx, now.Add(time.Hour), now.Add(2*time.Hour), 10)
+	require.NoError(t, err)
+	assert.Empty(t, escalations)
+
+	t.Run("recovery ends the escalations of the resource", func(t *testing.T) {
+		problem := createNotification()
+
+		// a recovery of another resource doesn't end the escalation
+		_, _, err := notificationRepo.CreateNotification(ctx, models.Notification{
+			Origin:           "test",
+			OriginClass:      "vi/test",
+			OriginResourceID: "other-resource",
+			Timestamp:        "2024-10-10T10:05:00Z",
+			Title:            "Test Notification",
+			Detail:           "The problem is over",
+			Level:            "info",
+			Recovered:        true,
+		}, nil)
+		require.NoError(t, err)
+
+		escalations, err := repo.ClaimDueEscalations(ctx, now.Add(time.Minute), now.Add(time.Minute), 10)
+		require.NoError(t, err)
+		require.Len(t, escalations, 1)
+		assert.Equal(t, problem.Id, escalations[0].NotificationID)
+
+		_, _, err = notificationRepo.CreateNotification(ctx, models.Notification{
+			Origin:      "test",
+			OriginClass: "vi/test",
+			Timestamp:   "2024-10-10T10:05:00Z",
+			Title:       "Test Notification",
+			Detail:      "The problem is over",
+			Level:       "info",
+			Recovered:   true,
+		}, nil)
+		require.NoError(t, err)
+
+		escalations, err = repo.ClaimDueEscalations(ctx, now.Add(time.Hour), now.Add(2*time.Hour), 10)
+		require.NoError(t, err)
+		assert.Empty(t, escalations)
+	})
+
+	t.Run("acknowledging unknown notification", func(t *testing.T) {
+		_, err := notificationRepo.AcknowledgeNotification(ctx, "0f0d1c2b-3a49-4f8e-9d7c-6b5a4e3d2c1b", "user-1", now)
+		assert.ErrorIs(t, err, errs.ErrItemNotFound)
+	})
+}
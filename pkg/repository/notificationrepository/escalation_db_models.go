@@ -0,0 +1,65 @@
+// SPDX-FileCopyrightText: 2026 Greenbone AG <https://greenbone.net>
+//
+// SPDX-License-Identifier: AGPL-3.0-or-later
+
+package notificationrepository
+
+import (
+	"time"
+
+	"github.com/greenbone/opensight-notification-service/pkg/models"
+)
+
+const (
+	escalationsTable      = "notification_service.escalations"
+	escalationColumns     = `id, notification_id, rule_id, step, due_at`
+	createEscalationQuery = `INSERT INTO ` + escalationsTable + ` (notification_id, rule_id, step, due_at)
+		VALUES (:notification_id, :rule_id, :step, :due_at)`
+	// claimDueEscalationsQuery reserves due escalations of notifications which are not acknowledged,
+	// see [claimDueSendTasksQuery] for the locking
+	claimDueEscalationsQuery = `UPDATE ` + escalationsTable + ` SET claimed_until = $2
+		WHERE id IN (
+			SELECT e.id FROM ` + escalationsTable + ` e
+			JOIN ` + notificationsTable + ` n ON n.id = e.notification_id
+			WHERE e.due_at <= $1 AND (e.claimed_until IS NULL OR e.claimed_until <= $1) AND n.acknowledged_at IS NULL
+			ORDER BY e.due_at
+			LIMIT $3
+			FOR UPDATE OF e SKIP LOCKED
+		)
+		RETURNING ` + escalationColumns
+	advanceEscalationQuery                 = `UPDATE ` + escalationsTable + ` SET step = $2, due_at = $3, claimed_until = NULL WHERE id = $1`
+	deleteEscalationQuery                  = `DELETE FROM ` + escalationsTable + ` WHERE id = $1`
+	deleteEscalationsByNotificationIDQuery = `DELETE FROM ` + escalationsTable + ` WHERE notification_id = $1`
+	// deleteEscalationsOfResourceQuery ends the escalations of the notifications about the same origin resource,
+	// see [models.Notification.IncidentKey]
+	deleteEscalationsOfResourceQuery = `DELETE FROM ` + escalationsTable + ` e
+		USING ` + notificationsTable + ` n
+		WHERE n.id = e.notification_id AND n.origin_class = $1 AND COALESCE(n.origin_resource_id, '') = $2`
+)
+
+type escalationRow struct {
+	ID             string    `db:"id"`
+	NotificationID string    `db:"notification_id"`
+	RuleID         string    `db:"rule_id"`
+	Step           int       `db:"step"`
+	DueAt          time.Time `db:"due_at"`
+}
+
+func toEscalationRow(e models.Escalation) escalationRow {
+	return escalationRow{
+		NotificationID: e.NotificationID,
+		RuleID:         e.RuleID,
+		Step:           e.Step,
+		DueAt:          e.DueAt,
+	}
+}
+
+func (r *escalationRow) ToModel() models.Escalation {
+	return models.Escalation{
+		ID:             r.ID,
+		NotificationID: r.NotificationID,
+		RuleID:         r.RuleID,
+		Step:           r.Step,
+		DueAt:          r.DueAt,
+	}
+}
@@ -62,12 +62,24 @@ func NewNotificationChannelRepository(
 const createNotificationChannelQuery = `
     INSERT INTO notification_service.notification_channel (
         channel_type, channel_name, webhook_url, description, domain, port,
-        is_authentication_required, is_tls_enforced, username, password,
-        max_email_attachment_size_mb, max_email_include_size_mb, sender_email_address 
+        is_authentication_required, username, password,
+        max_email_attachment_size_mb, max_email_include_size_mb, sender_email_address,
+        webhook_username, webhook_icon_url, webhook_channel,
+        webhook_method, webhook_headers, webhook_body_template, webhook_secret,
+        syslog_transport, syslog_facility, syslog_app_name, routing_key,
+        push_server, push_topic, push_tags, access_token, matrix_room_id,
+        mail_auth_method, oauth_token_url, oauth_client_id, oauth_client_secret, oauth_scopes,
+        mail_tls_mode, mail_tls_min_version, mail_ca_certificate, mail_cert_fingerprint
     ) VALUES (
         :channel_type, :channel_name, :webhook_url, :description, :domain, :port,
-        :is_authentication_required, :is_tls_enforced, :username, :password,
-        :max_email_attachment_size_mb, :max_email_include_size_mb, :sender_email_address
+        :is_authentication_required, :username, :password,
+        :max_email_attachment_size_mb, :max_email_include_size_mb, :sender_email_address,
+        :webhook_username, :webhook_icon_url, :webhook_channel,
+        :webhook_method, :webhook_headers, :webhook_body_template, :webhook_secret,
+        :syslog_transport, :syslog_facility, :syslog_app_name, :routing_key,
+        :push_server, :push_topic, :push_tags, :access_token, :matrix_room_id,
+        :mail_auth_method, :oauth_token_url, :oauth_client_id, :oauth_client_secret, :oauth_scopes,
+        :mail_tls_mode, :mail_tls_min_version, :mail_ca_certificate, :mail_cert_fingerprint
     )
     RETURNING *
 `
@@ -82,7 +94,6 @@ func buildUpdateNotificationChannelQuery(in models.NotificationChannel) string {
             domain = :domain,
             port = :port,
             is_authentication_required = :is_authentication_required,
-            is_tls_enforced = :is_tls_enforced,
             username = :username,`
 
 	if in.Password != nil {
@@ -93,6 +104,55 @@ func buildUpdateNotificationChannelQuery(in models.NotificationChannel) string {
             max_email_attachment_size_mb = :max_email_attachment_size_mb,
             max_email_include_size_mb = :max_email_include_size_mb,
             sender_email_address = :sender_email_address,
+            webhook_username = :webhook_username,
+            webhook_icon_url = :webhook_icon_url,
+            webhook_channel = :webhook_channel,
+            webhook_method = :webhook_method,
+            webhook_headers = :webhook_headers,
+            webhook_body_template = :webhook_body_template,`
+
+	// the secret is only changed if a new one is given, an empty secret removes it
+	if in.WebhookSecret != nil {
+		query += `webhook_secret = :webhook_secret,`
+	}
+
+	query += `
+            syslog_transport = :syslog_transport,
+            syslog_facility = :syslog_facility,
+            syslog_app_name = :syslog_app_name,`
+
+	// the routing key is only changed if a new one is given
+	if in.RoutingKey != nil {
+		query += `routing_key = :routing_key,`
+	}
+
+	query += `
+            push_server = :push_server,
+            push_topic = :push_topic,
+            push_tags = :push_tags,
+            matrix_room_id = :matrix_room_id,`
+
+	// the access token is only changed if a new one is given, an empty token removes it
+	if in.AccessToken != nil {
+		query += `access_token = :access_token,`
+	}
+
+	query += `
+            mail_auth_method = :mail_auth_method,
+            oauth_token_url = :oauth_token_url,
+            oauth_client_id = :oauth_client_id,
+            oauth_scopes = :oauth_scopes,
+            mail_tls_mode = :mail_tls_mode,
+            mail_tls_min_version = :mail_tls_min_version,
+            mail_ca_certificate = :mail_ca_certificate,
+            mail_cert_fingerprint = :mail_cert_fingerprint,`
+
+	// the client secret is only changed if a new one is given, like the password
+	if in.OAuthClientSecret != nil {
+		query += `oauth_client_secret = :oauth_client_secret,`
+	}
+
+	query += `
             updated_at = NOW()
         WHERE id = :id
         RETURNING *`
@@ -254,6 +314,56 @@ func (r *notificationChannelRepository) encrypt(row notificationChannelRow) (not
 		row.Username = &username
 	}
 
+	if row.WebhookHeaders != nil && *row.WebhookHeaders != "" {
+		encryptedHeaders, err := r.encryptManager.Encrypt(*row.WebhookHeaders)
+		if err != nil {
+			return empty, fmt.Errorf("could not encrypt webhook headers: %w", err)
+		}
+
+		headers := string(encryptedHeaders)
+		row.WebhookHeaders = &headers
+	}
+
+	if row.WebhookSecret != nil && *row.WebhookSecret != "" {
+		encryptedSecret, err := r.encryptManager.Encrypt(*row.WebhookSecret)
+		if err != nil {
+			return empty, fmt.Errorf("could not encrypt webhook secret: %w", err)
+		}
+
+		secret := string(encryptedSecret)
+		row.WebhookSecret = &secret
+	}
+
+	if row.RoutingKey != nil && *row.RoutingKey != "" {
+		encryptedRoutingKey, err := r.encryptManager.Encrypt(*row.RoutingKey)
+		if err != nil {
+			return empty, fmt.Errorf("could not encrypt routing key: %w", err)
+		}
+
+		routingKey := string(encryptedRoutingKey)
+		row.RoutingKey = &routingKey
+	}
+
+	if row.AccessToken != nil && *row.AccessToken != "" {
+		encryptedToken, err := r.encryptManager.Encrypt(*row.AccessToken)
+		if err != nil {
+			return empty, fmt.Errorf("could not encrypt access token: %w", err)
+		}
+
+		token := string(encryptedToken)
+		row.AccessToken = &token
+	}
+
+	if row.OAuthClientSecret != nil && *row.OAuthClientSecret != "" {
+		encryptedSecret, err := r.encryptManager.Encrypt(*row.OAuthClientSecret)
+		if err != nil {
+			return empty, fmt.Errorf("could not encrypt client secret: %w", err)
+		}
+
+		secret := string(encryptedSecret)
+		row.OAuthClientSecret = &secret
+	}
+
 	return row, nil
 }
 
@@ -278,6 +388,51 @@ func (r *notificationChannelRepository) decrypt(row notificationChannelRow) noti
 		row.Username = &dcUsername
 	}
 
+	if row.WebhookHeaders != nil && *row.WebhookHeaders != "" {
+		dcHeaders, err := r.encryptManager.Decrypt([]byte(*row.WebhookHeaders))
+		if err != nil {
+			log.Err(err).Msg("could not decrypt webhook headers")
+		}
+
+		row.WebhookHeaders = &dcHeaders
+	}
+
+	if row.WebhookSecret != nil && *row.WebhookSecret != "" {
+		dcSecret, err := r.encryptManager.Decrypt([]byte(*row.WebhookSecret))
+		if err != nil {
+			log.Err(err).Msg("could not decrypt webhook secret")
+		}
+
+		row.WebhookSecret = &dcSecret
+	}
+
+	if row.RoutingKey != nil && *row.RoutingKey != "" {
+		dcRoutingKey, err := r.encryptManager.Decrypt([]byte(*row.RoutingKey))
+		if err != nil {
+			log.Err(err).Msg("could not decrypt routing key")
+		}
+
+		row.RoutingKey = &dcRoutingKey
+	}
+
+	if row.AccessToken != nil && *row.AccessToken != "" {
+		dcToken, err := r.encryptManager.Decrypt([]byte(*row.AccessToken))
+		if err != nil {
+			log.Err(err).Msg("could not decrypt access token")
+		}
+
+		row.AccessToken = &dcToken
+	}
+
+	if row.OAuthClientSecret != nil && *row.OAuthClientSecret != "" {
+		dcSecret, err := r.encryptManager.Decrypt([]byte(*row.OAuthClientSecret))
+		if err != nil {
+			log.Err(err).Msg("could not decrypt client secret")
+		}
+
+		row.OAuthClientSecret = &dcSecret
+	}
+
 	return row
 }
 
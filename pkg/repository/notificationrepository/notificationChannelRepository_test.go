@@ -43,7 +43,7 @@ func Test_NotificationChannelRepository_CRUD(t *testing.T) {
 		Domain:                   helper.ToPtr("example.com"),
 		Port:                     helper.ToPtr(587),
 		IsAuthenticationRequired: helper.ToPtr(true),
-		IsTlsEnforced:            helper.ToPtr(true),
+		MailTlsMode:              helper.ToPtr(models.MailTlsImplicit),
 		Username:                 helper.ToPtr("user"),
 		Password:                 helper.ToPtr("pass"),
 		MaxEmailAttachmentSizeMb: helper.ToPtr(10),
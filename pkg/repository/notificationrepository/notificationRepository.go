@@ -6,25 +6,55 @@ package notificationrepository
 
 import (
 	"context"
+	"database/sql"
 	"errors"
 	"fmt"
+	"time"
 
 	pgquery "github.com/greenbone/opensight-golang-libraries/pkg/postgres/query"
 	"github.com/greenbone/opensight-golang-libraries/pkg/query"
+	"github.com/greenbone/opensight-notification-service/pkg/errs"
 	"github.com/greenbone/opensight-notification-service/pkg/models"
 	"github.com/greenbone/opensight-notification-service/pkg/repository"
+	"github.com/greenbone/opensight-notification-service/pkg/validation"
 	"github.com/jmoiron/sqlx"
+	"github.com/lib/pq"
+	"github.com/lib/pq/pqerror"
 )
 
 type NotificationRepository interface {
+	// ListNotifications returns the notifications together with their state for the given user.
 	ListNotifications(
 		ctx context.Context,
 		resultSelector query.ResultSelector,
+		userID string,
 	) (notifications []models.Notification, totalResults uint64, err error)
+	// CreateNotification stores the notification together with the send tasks for its delivery in one transaction.
 	CreateNotification(
 		ctx context.Context,
 		notificationIn models.Notification,
-	) (notification models.Notification, err error)
+		sendTasks []models.SendTask,
+	) (notification models.Notification, createdSendTasks []models.SendTask, err error)
+	// CreateNotificationIdempotent is like CreateNotification, but if the idempotency key was already used
+	// since `expiredBefore`, nothing is stored and the original notification is returned with `repeated` set.
+	CreateNotificationIdempotent(
+		ctx context.Context,
+		notificationIn models.Notification,
+		sendTasks []models.SendTask,
+		key models.IdempotencyKey,
+		now time.Time,
+		expiredBefore time.Time,
+	) (notification models.Notification, createdSendTasks []models.SendTask, repeated bool, err error)
+	GetNotification(ctx context.Context, id string) (models.Notification, error)
+	// AcknowledgeNotification marks the notification as acknowledged by the user and stops its escalation.
+	// An already acknowledged notification keeps its first acknowledgement.
+	AcknowledgeNotification(ctx context.Context, id string, userID string, at time.Time) (models.Notification, error)
+	MarkNotificationRead(ctx context.Context, id string, userID string, at time.Time) error
+	// MarkAllNotificationsRead marks all notifications as read by the user and returns the number of newly read notifications.
+	MarkAllNotificationsRead(ctx context.Context, userID string, at time.Time) (marked int, err error)
+	// DismissNotification marks the notification as dismissed and read by the user.
+	DismissNotification(ctx context.Context, id string, userID string, at time.Time) error
+	CountUnreadNotifications(ctx context.Context, userID string) (int, error)
 }
 
 type notificationRepository struct {
@@ -44,25 +74,26 @@ func NewNotificationRepository(db *sqlx.DB) (NotificationRepository, error) {
 func (r *notificationRepository) ListNotifications(
 	ctx context.Context,
 	resultSelector query.ResultSelector,
+	userID string,
 ) (notifications []models.Notification, totalResults uint64, err error) {
 	querySettings := pgquery.Settings{
 		FilterFieldMapping:      notificationFieldMapping(),
 		SortingTieBreakerColumn: "id",
 	}
 
-	listQuery, queryParams, err := repository.BuildListQuery(resultSelector, unfilteredListNotificationsQuery, querySettings)
+	listQuery, queryParams, err := repository.BuildListQuery(resultSelector, unfilteredListNotificationsQuery, querySettings, userID)
 	if err != nil {
 		return nil, 0, fmt.Errorf("error building list query: %w", err)
 	}
 
-	var notificationRows []notificationRow
+	var notificationRows []notificationWithUserStateRow
 	err = r.client.SelectContext(ctx, &notificationRows, listQuery, queryParams...)
 	if err != nil {
 		err = fmt.Errorf("error getting notifications from database: %w", err)
 		return
 	}
 
-	countQuery, queryParams, err := repository.BuildCountQuery(resultSelector.Filter, unfilteredListNotificationsQuery, querySettings)
+	countQuery, queryParams, err := repository.BuildCountQuery(resultSelector.Filter, unfilteredListNotificationsQuery, querySettings, userID)
 	if err != nil {
 		return nil, 0, fmt.Errorf("error building count query: %w", err)
 	}
@@ -86,27 +117,222 @@ func (r *notificationRepository) ListNotifications(
 func (r *notificationRepository) CreateNotification(
 	ctx context.Context,
 	notificationIn models.Notification,
-) (notification models.Notification, err error) {
+	sendTasks []models.SendTask,
+) (notification models.Notification, createdSendTasks []models.SendTask, err error) {
+	tx, err := r.client.BeginTxx(ctx, nil)
+	if err != nil {
+		return notification, nil, fmt.Errorf("could not start transaction: %w", err)
+	}
+	defer func() { _ = tx.Rollback() }()
+
+	notification, err = insertNotification(ctx, tx, notificationIn)
+	if err != nil {
+		return notification, nil, err
+	}
+
+	createdSendTasks, err = insertSendTasks(ctx, tx, &notification, sendTasks)
+	if err != nil {
+		return notification, nil, err
+	}
+
+	err = deleteEscalationsOfResource(ctx, tx, notification)
+	if err != nil {
+		return notification, nil, err
+	}
+
+	err = tx.Commit()
+	if err != nil {
+		return notification, nil, fmt.Errorf("could not commit transaction: %w", err)
+	}
+
+	return notification, createdSendTasks, nil
+}
+
+func (r *notificationRepository) CreateNotificationIdempotent(
+	ctx context.Context,
+	notificationIn models.Notification,
+	sendTasks []models.SendTask,
+	key models.IdempotencyKey,
+	now time.Time,
+	expiredBefore time.Time,
+) (notification models.Notification, createdSendTasks []models.SendTask, repeated bool, err error) {
+	tx, err := r.client.BeginTxx(ctx, nil)
+	if err != nil {
+		return notification, nil, false, fmt.Errorf("could not start transaction: %w", err)
+	}
+	defer func() { _ = tx.Rollback() }()
+
+	notification, err = insertNotification(ctx, tx, notificationIn)
+	if err != nil {
+		return notification, nil, false, err
+	}
+
+	// a concurrent request with the same key blocks here until the other transaction is finished
+	var notificationID string
+	err = tx.QueryRowxContext(ctx, claimIdempotencyKeyQuery, key.Caller, key.Key, notification.Id, now, expiredBefore).
+		Scan(&notificationID)
+	if errors.Is(err, sql.ErrNoRows) {
+		_ = tx.Rollback()
+		original, err := r.getNotificationByIdempotencyKey(ctx, key)
+		return original, nil, true, err
+	}
+	if err != nil {
+		return notification, nil, false, fmt.Errorf("could not store idempotency key: %w", err)
+	}
+
+	createdSendTasks, err = insertSendTasks(ctx, tx, &notification, sendTasks)
+	if err != nil {
+		return notification, nil, false, err
+	}
+
+	err = deleteEscalationsOfResource(ctx, tx, notification)
+	if err != nil {
+		return notification, nil, false, err
+	}
+
+	err = tx.Commit()
+	if err != nil {
+		return notification, nil, false, fmt.Errorf("could not commit transaction: %w", err)
+	}
+
+	return notification, createdSendTasks, false, nil
+}
+
+func (r *notificationRepository) getNotificationByIdempotencyKey(
+	ctx context.Context,
+	key models.IdempotencyKey,
+) (models.Notification, error) {
+	var row notificationRow
+	err := r.client.GetContext(ctx, &row, getNotificationByIdempotencyKeyQuery, key.Caller, key.Key)
+	if err != nil {
+		return models.Notification{}, fmt.Errorf("could not get notification by idempotency key: %w", err)
+	}
+
+	return row.ToNotificationModel()
+}
+
+func insertNotification(ctx context.Context, tx *sqlx.Tx, notificationIn models.Notification) (models.Notification, error) {
 	insertRow, err := toNotificationRow(notificationIn)
 	if err != nil {
-		return notification, fmt.Errorf("invalid argument for inserting notification into database: %w", err)
+		return models.Notification{}, fmt.Errorf("invalid argument for inserting notification into database: %w", err)
 	}
 
-	createNotificationStatement, err := r.client.PrepareNamedContext(ctx, createNotificationQuery)
+	createNotificationStatement, err := tx.PrepareNamedContext(ctx, createNotificationQuery)
 	if err != nil {
-		return notification, fmt.Errorf("could not prepare sql statement: %w", err)
+		return models.Notification{}, fmt.Errorf("could not prepare sql statement: %w", err)
 	}
+	defer createNotificationStatement.Close()
 
 	var row notificationRow
 	err = createNotificationStatement.QueryRowxContext(ctx, insertRow).StructScan(&row)
 	if err != nil {
-		return notification, fmt.Errorf("could not insert into database: %w", err)
+		return models.Notification{}, fmt.Errorf("could not insert into database: %w", err)
 	}
 
-	notification, err = row.ToNotificationModel()
+	notification, err := row.ToNotificationModel()
 	if err != nil {
-		return notification, fmt.Errorf("failed to transform notification db entry to model: %w", err)
+		return models.Notification{}, fmt.Errorf("failed to transform notification db entry to model: %w", err)
 	}
-
 	return notification, nil
 }
+
+func (r *notificationRepository) GetNotification(ctx context.Context, id string) (models.Notification, error) {
+	if err := validation.Validate.Var(id, "uuid4"); err != nil {
+		return models.Notification{}, ErrInvalidID
+	}
+
+	var row notificationRow
+	err := r.client.GetContext(ctx, &row, getNotificationByIdQuery, id)
+	if err != nil {
+		if errors.Is(err, sql.ErrNoRows) {
+			return models.Notification{}, errs.ErrItemNotFound
+		}
+		return models.Notification{}, fmt.Errorf("select by id failed: %w", err)
+	}
+
+	return row.ToNotificationModel()
+}
+
+func (r *notificationRepository) AcknowledgeNotification(
+	ctx context.Context,
+	id string,
+	userID string,
+	at time.Time,
+) (models.Notification, error) {
+	if err := validation.Validate.Var(id, "uuid4"); err != nil {
+		return models.Notification{}, ErrInvalidID
+	}
+
+	tx, err := r.client.BeginTxx(ctx, nil)
+	if err != nil {
+		return models.Notification{}, fmt.Errorf("could not start transaction: %w", err)
+	}
+	defer func() { _ = tx.Rollback() }()
+
+	var row notificationRow
+	err = tx.GetContext(ctx, &row, acknowledgeNotificationQuery, id, at, userID)
+	if err != nil {
+		if errors.Is(err, sql.ErrNoRows) {
+			return models.Notification{}, errs.ErrItemNotFound
+		}
+		return models.Notification{}, fmt.Errorf("could not acknowledge notification: %w", err)
+	}
+
+	_, err = tx.ExecContext(ctx, acknowledgeByUserQuery, id, userID, at)
+	if err != nil {
+		return models.Notification{}, fmt.Errorf("could not update state of user: %w", err)
+	}
+
+	_, err = tx.ExecContext(ctx, deleteEscalationsByNotificationIDQuery, id)
+	if err != nil {
+		return models.Notification{}, fmt.Errorf("could not delete escalations: %w", err)
+	}
+
+	err = tx.Commit()
+	if err != nil {
+		return models.Notification{}, fmt.Errorf("could not commit transaction: %w", err)
+	}
+
+	return row.ToNotificationModel()
+}
+
+func (r *notificationRepository) MarkNotificationRead(ctx context.Context, id string, userID string, at time.Time) error {
+	return r.updateUserState(ctx, markNotificationReadQuery, id, userID, at)
+}
+
+func (r *notificationRepository) DismissNotification(ctx context.Context, id string, userID string, at time.Time) error {
+	return r.updateUserState(ctx, dismissNotificationQuery, id, userID, at)
+}
+
+func (r *notificationRepository) updateUserState(ctx context.Context, updateQuery string, id string, userID string, at time.Time) error {
+	if err := validation.Validate.Var(id, "uuid4"); err != nil {
+		return ErrInvalidID
+	}
+
+	_, err := r.client.ExecContext(ctx, updateQuery, id, userID, at)
+	if err != nil {
+		if pgErr, ok := errors.AsType[*pq.Error](err); ok && pgErr.Code == pqerror.ForeignKeyViolation {
+			return errs.ErrItemNotFound
+		}
+		return fmt.Errorf("could not update state of user: %w", err)
+	}
+	return nil
+}
+
+func (r *notificationRepository) MarkAllNotificationsRead(ctx context.Context, userID string, at time.Time) (int, error) {
+	var marked int
+	err := r.client.GetContext(ctx, &marked, markAllNotificationsReadQuery, userID, at)
+	if err != nil {
+		return 0, fmt.Errorf("could not mark notifications as read: %w", err)
+	}
+	return marked, nil
+}
+
+func (r *notificationRepository) CountUnreadNotifications(ctx context.Context, userID string) (int, error) {
+	var unread int
+	err := r.client.GetContext(ctx, &unread, countUnreadNotificationsQuery, userID)
+	if err != nil {
+		return 0, fmt.Errorf("could not count unread notifications: %w", err)
+	}
+	return unread, nil
+}
@@ -7,11 +7,13 @@ package notificationrepository
 import (
 	"context"
 	"testing"
+	"time"
 
 	"github.com/greenbone/opensight-golang-libraries/pkg/query"
 	"github.com/greenbone/opensight-golang-libraries/pkg/query/filter"
 	"github.com/greenbone/opensight-golang-libraries/pkg/query/paging"
 	"github.com/greenbone/opensight-golang-libraries/pkg/query/sorting"
+	"github.com/greenbone/opensight-notification-service/pkg/errs"
 	"github.com/greenbone/opensight-notification-service/pkg/models"
 	"github.com/greenbone/opensight-notification-service/pkg/pgtesting"
 	"github.com/greenbone/opensight-notification-service/pkg/services/notificationservice/dtos"
@@ -19,6 +21,9 @@ import (
 	"github.com/stretchr/testify/require"
 )
 
+// testUserID is the user for whom the state of the notifications is listed
+const testUserID = "6f1d2c3b-4a5e-4f60-8a7b-9c0d1e2f3a4b"
+
 func Test_CreateNotification_ListNotification(t *testing.T) {
 	resultSelectorListAll := query.ResultSelector{
 		Paging: &paging.Request{
@@ -98,7 +103,7 @@ func Test_CreateNotification_ListNotification(t *testing.T) {
 
 			ctx := context.Background()
 
-			gotNotification, err := repo.CreateNotification(ctx, tt.notificationIn)
+			gotNotification, _, err := repo.CreateNotification(ctx, tt.notificationIn, nil)
 
 			if tt.wantErr {
 				assert.Error(t, err)
@@ -108,11 +113,17 @@ func Test_CreateNotification_ListNotification(t *testing.T) {
 				tt.wantNotification.Id = gotNotification.Id // set the ID for comparison
 				assert.Equal(t, tt.wantNotification, gotNotification)
 
-				fetchedNotifications, gotTotalResults, err := repo.ListNotifications(ctx, resultSelectorListAll)
+				fetchedNotifications, gotTotalResults, err := repo.ListNotifications(ctx, resultSelectorListAll, testUserID)
 				require.NoError(t, err)
 				assert.Equal(t, uint64(1), gotTotalResults, "did not get expected number of results")
 				require.Len(t, fetchedNotifications, 1)
-				assert.Equal(t, tt.wantNotification, fetchedNotifications[0])
+				wantListed := tt.wantNotification
+				wantListed.UserState = &models.NotificationUserState{ReadState: models.ReadStateUnread}
+				assert.Equal(t, wantListed, fetchedNotifications[0])
+
+				gotByID, err := repo.GetNotification(ctx, gotNotification.Id)
+				require.NoError(t, err)
+				assert.Equal(t, tt.wantNotification, gotByID)
 			}
 		})
 	}
@@ -162,8 +173,11 @@ func Test_ListNotifications(t *testing.T) {
 		"key2": float64(2), // not all types are preserved by json marshal/unmarshal
 		"key3": []any{"a", "b", "c"},
 	}
+	wantNotification1.UserState = &models.NotificationUserState{ReadState: models.ReadStateUnread}
 	wantNotification2 := notification2
+	wantNotification2.UserState = &models.NotificationUserState{ReadState: models.ReadStateUnread}
 	wantNotification3 := notification3
+	wantNotification3.UserState = &models.NotificationUserState{ReadState: models.ReadStateUnread}
 
 	// sufficiently large page size to get all results in one page
 	var bigPage = &paging.Request{
@@ -175,7 +189,7 @@ func Test_ListNotifications(t *testing.T) {
 
 	ctx := context.Background()
 	for ii, notification := range notifications {
-		createdNotification, err := repo.CreateNotification(ctx, notification)
+		createdNotification, _, err := repo.CreateNotification(ctx, notification, nil)
 		require.NoError(t, err)
 		require.NotEmpty(t, createdNotification.Id)
 		wantNotifications[ii].Id = createdNotification.Id // set the ID for comparison
@@ -327,7 +341,7 @@ func Test_ListNotifications(t *testing.T) {
 
 	for name, tt := range tests {
 		t.Run(name, func(t *testing.T) {
-			gotScans, totalResults, err := repo.ListNotifications(ctx, tt.resultSelector)
+			gotScans, totalResults, err := repo.ListNotifications(ctx, tt.resultSelector, testUserID)
 
 			assert.NoError(t, err)
 			assert.Equal(t, tt.wantTotalResults, totalResults)
@@ -335,3 +349,206 @@ func Test_ListNotifications(t *testing.T) {
 		})
 	}
 }
+
+func Test_GetNotification_Errors(t *testing.T) {
+	db := pgtesting.NewDB(t)
+
+	repo, err := NewNotificationRepository(db)
+	require.NoError(t, err)
+
+	_, err = repo.GetNotification(context.Background(), "not a uuid")
+	assert.ErrorIs(t, err, ErrInvalidID)
+
+	_, err = repo.GetNotification(context.Background(), "57fe22b8-89a4-445f-b6c7-ef9ea724ea48")
+	assert.ErrorIs(t, err, errs.ErrItemNotFound)
+}
+
+func Test_CreateNotificationIdempotent(t *testing.T) {
+	db := pgtesting.NewDB(t)
+
+	repo, err := NewNotificationRepository(db)
+	require.NoError(t, err)
+
+	ctx := context.Background()
+	now := time.Now()
+	window := time.Hour
+
+	notification := models.Notification{
+		Origin:      "test",
+		OriginClass: "vi/test",
+		Timestamp:   "2024-10-10T10:00:00Z",
+		Title:       "Test Notification",
+		Detail:      "This is a test notification",
+		Level:       "info",
+	}
+	sendTasks := []models.SendTask{{
+		Action: models.Action{Channel: models.ChannelReference{
+			ID:   "2d6b1e8f-3c4a-4f5b-8e9d-0a1b2c3d4e5f",
+			Name: "ops mail",
+			Type: models.ChannelTypeMail,
+		}, Recipient: "ops@example.com"},
+		NextExecution: now,
+	}}
+	key := models.IdempotencyKey{Caller: "service-a", Key: "request-1"}
+
+	original, created, repeated, err := repo.CreateNotificationIdempotent(ctx, notification, sendTasks, key, now, now.Add(-window))
+	require.NoError(t, err)
+	assert.False(t, repeated)
+	assert.Len(t, created, 1)
+
+	// repeated request within the window
+	got, created, repeated, err := repo.CreateNotificationIdempotent(ctx, notification, sendTasks, key, now.Add(time.Minute), now.Add(time.Minute-window))
+	require.NoError(t, err)
+	assert.True(t, repeated)
+	assert.Empty(t, created)
+	assert.Equal(t, original, got)
+
+	// same key of another caller
+	otherCaller := models.IdempotencyKey{Caller: "service-b", Key: key.Key}
+	got, _, repeated, err = repo.CreateNotificationIdempotent(ctx, notification, sendTasks, otherCaller, now, now.Add(-window))
+	require.NoError(t, err)
+	assert.False(t, repeated)
+	assert.NotEqual(t, original.Id, got.Id)
+
+	// after the window the key can be used again
+	later := now.Add(2 * window)
+	got, _, repeated, err = repo.CreateNotificationIdempotent(ctx, notification, sendTasks, key, later, later.Add(-window))
+	require.NoError(t, err)
+	assert.False(t, repeated)
+	assert.NotEqual(t, original.Id, got.Id)
+
+	_, totalResults, err := repo.ListNotifications(ctx, query.ResultSelector{Paging: &paging.Request{PageSize: 100}}, testUserID)
+	require.NoError(t, err)
+	assert.Equal(t, uint64(3), totalResults, "repeated request must not be stored")
+}
+
+func Test_NotificationUserState(t *testing.T) {
+	db := pgtesting.NewDB(t)
+
+	repo, err := NewNotificationRepository(db)
+	require.NoError(t, err)
+
+	ctx := context.Background()
+	now := time.Now().UTC().Truncate(time.Microsecond) // precision of postgres timestamps
+	otherUserID := "0c9b8a7d-6e5f-4a3b-9c2d-1e0f9a8b7c6d"
+
+	var ids []string
+	for range 3 {
+		notification, _, err := repo.CreateNotification(ctx, models.Notification{
+			Origin:      "test",
+			OriginClass: "vi/test",
+			Timestamp:   "2024-10-10T10:00:00Z",
+			Title:       "Test Notification",
+			Detail:      "This is a test notification",
+			Level:       "info",
+		}, nil)
+		require.NoError(t, err)
+		ids = append(ids, notification.Id)
+	}
+
+	listByState := func(userID string, state models.ReadState) []string {
+		notifications, _, err := repo.ListNotifications(ctx, query.ResultSelector{
+			Filter: &filter.Request{
+				Operator: filter.LogicOperatorAnd,
+				Fields: []filter.RequestField{
+					{Name: dtos.ReadStateFieldName, Value: string(state), Operator: filter.CompareOperatorIsEqualTo},
+				},
+			},
+			Paging: &paging.Request{PageSize: 100},
+		}, userID)
+		require.NoError(t, err)
+		var got []string
+		for _, notification := range notifications {
+			got = append(got, notification.Id)
+		}
+		return got
+	}
+
+	unread, err := repo.CountUnreadNotifications(ctx, testUserID)
+	require.NoError(t, err)
+	assert.Equal(t, 3, unread)
+
+	err = repo.MarkNotificationRead(ctx, ids[0], testUserID, now)
+	require.NoError(t, err)
+	err = repo.DismissNotification(ctx, ids[1], testUserID, now.Add(time.Minute))
+	require.NoError(t, err)
+
+	assert.ElementsMatch(t, []string{ids[2]}, listByState(testUserID, models.ReadStateUnread))
+	assert.ElementsMatch(t, []string{ids[0]}, listByState(testUserID, models.ReadStateRead))
+	assert.ElementsMatch(t, []string{ids[1]}, listByState(testUserID, models.ReadStateDismissed))
+	// the state is tracked per user
+	assert.ElementsMatch(t, ids, listByState(otherUserID, models.ReadStateUnread))
+
+	unread, err = repo.CountUnreadNotifications(ctx, testUserID)
+	require.NoError(t, err)
+	assert.Equal(t, 1, unread)
+
+	// the time of the first read is kept
+	err = repo.MarkNotificationRead(ctx, ids[0], testUserID, now.Add(time.Hour))
+	require.NoError(t, err)
+	notifications, _, err := repo.ListNotifications(ctx, query.ResultSelector{Paging: &paging.Request{PageSize: 100}}, testUserID)
+	require.NoError(t, err)
+	for _, notification := range notifications {
+		if notification.Id == ids[0] {
+			require.NotNil(t, notification.UserState.ReadAt)
+			assert.True(t, now.Equal(*notification.UserState.ReadAt))
+		}
+	}
+
+	// the notifications are stored with the time of the database, so the mark has to be taken afterwards
+	markedAt := time.Now().UTC().Truncate(time.Microsecond)
+	marked, err := repo.MarkAllNotificationsRead(ctx, testUserID, markedAt)
+	require.NoError(t, err)
+	assert.Equal(t, 1, marked)
+
+	unread, err = repo.CountUnreadNotifications(ctx, testUserID)
+	require.NoError(t, err)
+	assert.Equal(t, 0, unread)
+	assert.ElementsMatch(t, []string{ids[0], ids[2]}, listByState(testUserID, models.ReadStateRead))
+	assert.ElementsMatch(t, []string{ids[1]}, listByState(testUserID, models.ReadStateDismissed))
+
+	unread, err = repo.CountUnreadNotifications(ctx, otherUserID)
+	require.NoError(t, err)
+	assert.Equal(t, 3, unread)
+
+	notifications, _, err = repo.ListNotifications(ctx, query.ResultSelector{Paging: &paging.Request{PageSize: 100}}, testUserID)
+	require.NoError(t, err)
+	for _, notification := range notifications {
+		require.NotNil(t, notification.UserState.ReadAt)
+		switch notification.Id {
+		case ids[0]:
+			assert.True(t, now.Equal(*notification.UserState.ReadAt))
+		case ids[2]:
+			assert.True(t, markedAt.Equal(*notification.UserState.ReadAt))
+		}
+	}
+
+	// marking again has nothing left to mark
+	marked, err = repo.MarkAllNotificationsRead(ctx, testUserID, markedAt)
+	require.NoError(t, err)
+	assert.Equal(t, 0, marked)
+
+	// notifications stored after the mark are unread
+	later, _, err := repo.CreateNotification(ctx, models.Notification{
+		Origin:      "test",
+		OriginClass: "vi/test",
+		Timestamp:   "2024-10-10T10:00:00Z",
+		Title:       "Later Notification",
+		Detail:      "This is a later notification",
+		Level:       "info",
+	}, nil)
+	require.NoError(t, err)
+	assert.ElementsMatch(t, []string{later.Id}, listByState(testUserID, models.ReadStateUnread))
+
+	unread, err = repo.CountUnreadNotifications(ctx, testUserID)
+	require.NoError(t, err)
+	assert.Equal(t, 1, unread)
+
+	t.Run("unknown notification", func(t *testing.T) {
+		err := repo.MarkNotificationRead(ctx, "0f0d1c2b-3a49-4f8e-9d7c-6b5a4e3d2c1b", testUserID, now)
+		assert.ErrorIs(t, err, errs.ErrItemNotFound)
+
+		err = repo.DismissNotification(ctx, "invalid", testUserID, now)
+		assert.ErrorIs(t, err, ErrInvalidID)
+	})
+}
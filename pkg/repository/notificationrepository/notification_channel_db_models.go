@@ -1,6 +1,12 @@
 package notificationrepository
 
-import "github.com/greenbone/opensight-notification-service/pkg/models"
+import (
+	"encoding/json"
+
+	"github.com/greenbone/opensight-notification-service/pkg/helper"
+	"github.com/greenbone/opensight-notification-service/pkg/models"
+	"github.com/rs/zerolog/log"
+)
 
 var empty = notificationChannelRow{}
 
@@ -15,15 +21,60 @@ type notificationChannelRow struct {
 	Domain                   *string `db:"domain"`
 	Port                     *int    `db:"port"`
 	IsAuthenticationRequired *bool   `db:"is_authentication_required"`
-	IsTlsEnforced            *bool   `db:"is_tls_enforced"`
 	Username                 *string `db:"username"`
 	Password                 *string `db:"password"`
 	MaxEmailAttachmentSizeMb *int    `db:"max_email_attachment_size_mb"`
 	MaxEmailIncludeSizeMb    *int    `db:"max_email_include_size_mb"`
 	SenderEmailAddress       *string `db:"sender_email_address"`
+	WebhookUsername          *string `db:"webhook_username"`
+	WebhookIconUrl           *string `db:"webhook_icon_url"`
+	WebhookChannel           *string `db:"webhook_channel"`
+	WebhookMethod            *string `db:"webhook_method"`
+	WebhookHeaders           *string `db:"webhook_headers"`
+	WebhookBodyTemplate      *string `db:"webhook_body_template"`
+	WebhookSecret            *string `db:"webhook_secret"`
+	SyslogTransport          *string `db:"syslog_transport"`
+	SyslogFacility           *int    `db:"syslog_facility"`
+	SyslogAppName            *string `db:"syslog_app_name"`
+	RoutingKey               *string `db:"routing_key"`
+	PushServer               *string `db:"push_server"`
+	PushTopic                *string `db:"push_topic"`
+	PushTags                 *string `db:"push_tags"`
+	AccessToken              *string `db:"access_token"`
+	MatrixRoomId             *string `db:"matrix_room_id"`
+	MailAuthMethod           *string `db:"mail_auth_method"`
+	OAuthTokenUrl            *string `db:"oauth_token_url"`
+	OAuthClientId            *string `db:"oauth_client_id"`
+	OAuthClientSecret        *string `db:"oauth_client_secret"`
+	OAuthScopes              *string `db:"oauth_scopes"`
+	MailTlsMode              *string `db:"mail_tls_mode"`
+	MailTlsMinVersion        *string `db:"mail_tls_min_version"`
+	MailCaCertificate        *string `db:"mail_ca_certificate"`
+	MailCertFingerprint      *string `db:"mail_cert_fingerprint"`
 }
 
 func (r notificationChannelRow) ToModel() models.NotificationChannel {
+	var headers map[string]string
+	if r.WebhookHeaders != nil && *r.WebhookHeaders != "" {
+		if err := json.Unmarshal([]byte(*r.WebhookHeaders), &headers); err != nil {
+			log.Err(err).Str("id", r.Id).Msg("could not unmarshal webhook headers")
+		}
+	}
+
+	var tags []string
+	if r.PushTags != nil && *r.PushTags != "" {
+		if err := json.Unmarshal([]byte(*r.PushTags), &tags); err != nil {
+			log.Err(err).Str("id", r.Id).Msg("could not unmarshal push tags")
+		}
+	}
+
+	var scopes []string
+	if r.OAuthScopes != nil && *r.OAuthScopes != "" {
+		if err := json.Unmarshal([]byte(*r.OAuthScopes), &scopes); err != nil {
+			log.Err(err).Str("id", r.Id).Msg("could not unmarshal oauth scopes")
+		}
+	}
+
 	return models.NotificationChannel{
 		Id:                       r.Id,
 		CreatedAt:                r.CreatedAt,
@@ -35,17 +86,60 @@ func (r notificationChannelRow) ToModel() models.NotificationChannel {
 		Domain:                   r.Domain,
 		Port:                     r.Port,
 		IsAuthenticationRequired: r.IsAuthenticationRequired,
-		IsTlsEnforced:            r.IsTlsEnforced,
 		Username:                 r.Username,
 		Password:                 r.Password,
 		MaxEmailAttachmentSizeMb: r.MaxEmailAttachmentSizeMb,
 		MaxEmailIncludeSizeMb:    r.MaxEmailIncludeSizeMb,
 		SenderEmailAddress:       r.SenderEmailAddress,
+		WebhookUsername:          r.WebhookUsername,
+		WebhookIconUrl:           r.WebhookIconUrl,
+		WebhookChannel:           r.WebhookChannel,
+		WebhookMethod:            r.WebhookMethod,
+		WebhookHeaders:           headers,
+		WebhookBodyTemplate:      r.WebhookBodyTemplate,
+		WebhookSecret:            r.WebhookSecret,
+		SyslogTransport:          r.SyslogTransport,
+		SyslogFacility:           r.SyslogFacility,
+		SyslogAppName:            r.SyslogAppName,
+		RoutingKey:               r.RoutingKey,
+		PushServer:               r.PushServer,
+		PushTopic:                r.PushTopic,
+		PushTags:                 tags,
+		AccessToken:              r.AccessToken,
+		MatrixRoomId:             r.MatrixRoomId,
+		MailAuthMethod:           r.MailAuthMethod,
+		OAuthTokenUrl:            r.OAuthTokenUrl,
+		OAuthClientId:            r.OAuthClientId,
+		OAuthClientSecret:        r.OAuthClientSecret,
+		OAuthScopes:              scopes,
+		MailTlsMode:              r.MailTlsMode,
+		MailTlsMinVersion:        r.MailTlsMinVersion,
+		MailCaCertificate:        r.MailCaCertificate,
+		MailCertFingerprint:      r.MailCertFingerprint,
 	}
 }
 
 // Helper function to map model to DB row struct
 func toNotificationChannelRow(in models.NotificationChannel) notificationChannelRow {
+	var headers *string
+	if len(in.WebhookHeaders) > 0 {
+		// marshalling a map of strings does not fail
+		marshalled, _ := json.Marshal(in.WebhookHeaders)
+		headers = helper.ToPtr(string(marshalled))
+	}
+
+	var tags *string
+	if len(in.PushTags) > 0 {
+		marshalled, _ := json.Marshal(in.PushTags)
+		tags = helper.ToPtr(string(marshalled))
+	}
+
+	var scopes *string
+	if len(in.OAuthScopes) > 0 {
+		marshalled, _ := json.Marshal(in.OAuthScopes)
+		scopes = helper.ToPtr(string(marshalled))
+	}
+
 	return notificationChannelRow{
 		Id:                       in.Id,
 		CreatedAt:                in.CreatedAt,
@@ -57,11 +151,35 @@ func toNotificationChannelRow(in models.NotificationChannel) notificationChannel
 		Domain:                   in.Domain,
 		Port:                     in.Port,
 		IsAuthenticationRequired: in.IsAuthenticationRequired,
-		IsTlsEnforced:            in.IsTlsEnforced,
 		Username:                 in.Username,
 		Password:                 in.Password,
 		MaxEmailAttachmentSizeMb: in.MaxEmailAttachmentSizeMb,
 		MaxEmailIncludeSizeMb:    in.MaxEmailIncludeSizeMb,
 		SenderEmailAddress:       in.SenderEmailAddress,
+		WebhookUsername:          in.WebhookUsername,
+		WebhookIconUrl:           in.WebhookIconUrl,
+		WebhookChannel:           in.WebhookChannel,
+		WebhookMethod:            in.WebhookMethod,
+		WebhookHeaders:           headers,
+		WebhookBodyTemplate:      in.WebhookBodyTemplate,
+		WebhookSecret:            in.WebhookSecret,
+		SyslogTransport:          in.SyslogTransport,
+		SyslogFacility:           in.SyslogFacility,
+		SyslogAppName:            in.SyslogAppName,
+		RoutingKey:               in.RoutingKey,
+		PushServer:               in.PushServer,
+		PushTopic:                in.PushTopic,
+		PushTags:                 tags,
+		AccessToken:              in.AccessToken,
+		MatrixRoomId:             in.MatrixRoomId,
+		MailAuthMethod:           in.MailAuthMethod,
+		OAuthTokenUrl:            in.OAuthTokenUrl,
+		OAuthClientId:            in.OAuthClientId,
+		OAuthClientSecret:        in.OAuthClientSecret,
+		OAuthScopes:              scopes,
+		MailTlsMode:              in.MailTlsMode,
+		MailTlsMinVersion:        in.MailTlsMinVersion,
+		MailCaCertificate:        in.MailCaCertificate,
+		MailCertFingerprint:      in.MailCertFingerprint,
 	}
 }
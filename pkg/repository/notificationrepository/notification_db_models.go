@@ -5,7 +5,9 @@
 package notificationrepository
 
 import (
+	"database/sql"
 	"encoding/json"
+	"time"
 
 	"github.com/greenbone/opensight-golang-libraries/pkg/notifications"
 	"github.com/greenbone/opensight-notification-service/pkg/helper"
@@ -14,9 +16,69 @@ import (
 )
 
 const (
-	notificationsTable               = "notification_service.notifications"
-	createNotificationQuery          = `INSERT INTO ` + notificationsTable + ` (origin, origin_class, origin_resource_id, timestamp, title, detail, level, custom_fields) VALUES (:origin, :origin_class, :origin_resource_id, :timestamp, :title, :detail, :level, :custom_fields) RETURNING *`
-	unfilteredListNotificationsQuery = `SELECT * FROM ` + notificationsTable
+	notificationsTable       = "notification_service.notifications"
+	createNotificationQuery  = `INSERT INTO ` + notificationsTable + ` (origin, origin_class, origin_resource_id, timestamp, title, detail, level, custom_fields, recovered) VALUES (:origin, :origin_class, :origin_resource_id, :timestamp, :title, :detail, :level, :custom_fields, :recovered) RETURNING *`
+	getNotificationByIdQuery = `SELECT * FROM ` + notificationsTable + ` WHERE id = $1`
+	// acknowledgeNotificationQuery keeps the first acknowledgement
+	acknowledgeNotificationQuery = `UPDATE ` + notificationsTable + ` SET acknowledged_at = COALESCE(acknowledged_at, $2), acknowledged_by = COALESCE(acknowledged_by, $3)
+		WHERE id = $1 RETURNING *`
+)
+
+const (
+	userStatesTable = "notification_service.notification_user_states"
+	readMarksTable  = "notification_service.notification_read_marks"
+	// userStateJoins adds the state of the user ($1) to the notifications n
+	userStateJoins = `LEFT JOIN ` + userStatesTable + ` u ON u.notification_id = n.id AND u.user_id = $1
+		LEFT JOIN ` + readMarksTable + ` m ON m.user_id = $1`
+	// readByUserCondition holds for notifications read explicitly or stored before the user marked all notifications as read
+	readByUserCondition = `(u.read_at IS NOT NULL OR n.created_at <= COALESCE(m.read_before, '-infinity'))`
+	// markNotificationReadQuery keeps the time the notification was read first
+	markNotificationReadQuery = `INSERT INTO ` + userStatesTable + ` AS s (notification_id, user_id, read_at) VALUES ($1, $2, $3)
+		ON CONFLICT (notification_id, user_id) DO UPDATE SET read_at = COALESCE(s.read_at, EXCLUDED.read_at)`
+	// markAllNotificationsReadQuery moves the read mark of the user to $2 and returns the number of notifications which were unread before
+	markAllNotificationsReadQuery = `WITH marked AS (
+			SELECT count(*) AS marked FROM ` + notificationsTable + ` n
+			` + userStateJoins + `
+			WHERE n.created_at <= $2 AND NOT ` + readByUserCondition + `
+		), mark AS (
+			INSERT INTO ` + readMarksTable + ` AS r (user_id, read_before) VALUES ($1, $2)
+			ON CONFLICT (user_id) DO UPDATE SET read_before = GREATEST(r.read_before, EXCLUDED.read_before)
+		)
+		SELECT marked FROM marked`
+	// dismissNotificationQuery also marks the notification as read
+	dismissNotificationQuery = `INSERT INTO ` + userStatesTable + ` AS s (notification_id, user_id, read_at, dismissed_at) VALUES ($1, $2, $3, $3)
+		ON CONFLICT (notification_id, user_id) DO UPDATE SET read_at = COALESCE(s.read_at, EXCLUDED.read_at), dismissed_at = COALESCE(s.dismissed_at, EXCLUDED.dismissed_at)`
+	// acknowledgeByUserQuery records the acknowledgement in the state of the user, it also marks the notification as read
+	acknowledgeByUserQuery = `INSERT INTO ` + userStatesTable + ` AS s (notification_id, user_id, read_at, acknowledged_at) VALUES ($1, $2, $3, $3)
+		ON CONFLICT (notification_id, user_id) DO UPDATE SET read_at = COALESCE(s.read_at, EXCLUDED.read_at), acknowledged_at = COALESCE(s.acknowledged_at, EXCLUDED.acknowledged_at)`
+	countUnreadNotificationsQuery = `SELECT count(*) FROM ` + notificationsTable + ` n
+		` + userStateJoins + `
+		WHERE NOT ` + readByUserCondition
+)
+
+// unfilteredListNotificationsQuery returns the notifications together with their state for the user ($1).
+// Notifications covered by the read mark of the user are read at the time of the mark.
+const unfilteredListNotificationsQuery = `SELECT * FROM (
+		SELECT n.*, COALESCE(u.read_at, CASE WHEN n.created_at <= m.read_before THEN m.read_before END) AS user_read_at,
+			u.acknowledged_at AS user_acknowledged_at, u.dismissed_at AS user_dismissed_at,
+			CASE WHEN u.dismissed_at IS NOT NULL THEN '` + string(models.ReadStateDismissed) + `'
+				WHEN ` + readByUserCondition + ` THEN '` + string(models.ReadStateRead) + `'
+				ELSE '` + string(models.ReadStateUnread) + `' END AS read_state
+		FROM ` + notificationsTable + ` n
+		` + userStateJoins + `
+	) AS notifications`
+
+const (
+	idempotencyKeysTable = "notification_service.idempotency_keys"
+	// claimIdempotencyKeyQuery returns no row if the key is already used by a notification within the idempotency window.
+	// An expired key is taken over by the new notification.
+	claimIdempotencyKeyQuery = `INSERT INTO ` + idempotencyKeysTable + ` (caller, key, notification_id, created_at) VALUES ($1, $2, $3, $4)
+		ON CONFLICT (caller, key) DO UPDATE SET notification_id = EXCLUDED.notification_id, created_at = EXCLUDED.created_at
+		WHERE ` + idempotencyKeysTable + `.created_at < $5
+		RETURNING notification_id`
+	getNotificationByIdempotencyKeyQuery = `SELECT n.* FROM ` + notificationsTable + ` n
+		JOIN ` + idempotencyKeysTable + ` k ON k.notification_id = n.id
+		WHERE k.caller = $1 AND k.key = $2`
 )
 
 type notificationRow struct {
@@ -29,6 +91,20 @@ type notificationRow struct {
 	Detail           string              `db:"detail"`
 	Level            notifications.Level `db:"level"`
 	CustomFields     []byte              `db:"custom_fields"`
+	Recovered        bool                `db:"recovered"`
+	Repeats          int                 `db:"repeats"`
+	AcknowledgedAt   sql.NullTime        `db:"acknowledged_at"`
+	AcknowledgedBy   *string             `db:"acknowledged_by"`
+	CreatedAt        time.Time           `db:"created_at"`
+}
+
+// notificationWithUserStateRow is a notification together with its state for one user
+type notificationWithUserStateRow struct {
+	notificationRow
+	ReadState          models.ReadState `db:"read_state"`
+	UserReadAt         sql.NullTime     `db:"user_read_at"`
+	UserAcknowledgedAt sql.NullTime     `db:"user_acknowledged_at"`
+	UserDismissedAt    sql.NullTime     `db:"user_dismissed_at"`
 }
 
 func notificationFieldMapping() map[string]string {
@@ -38,6 +114,8 @@ func notificationFieldMapping() map[string]string {
 		dtos.LevelFieldName:       "level",
 		dtos.OccurrenceFieldName:  "timestamp",
 		dtos.OriginFieldName:      "origin",
+		dtos.RepeatsFieldName:     "repeats",
+		dtos.ReadStateFieldName:   "read_state",
 	}
 }
 
@@ -59,6 +137,7 @@ func toNotificationRow(n models.Notification) (notificationRow, error) {
 		Detail:           n.Detail,
 		Level:            n.Level,
 		CustomFields:     customFieldsSerialized,
+		Recovered:        n.Recovered,
 	}
 
 	return notificationRow, nil
@@ -76,9 +155,14 @@ func (n *notificationRow) ToNotificationModel() (models.Notification, error) {
 		Title:            n.Title,
 		Detail:           n.Detail,
 		Level:            n.Level,
+		Recovered:        n.Recovered,
+		Repeats:          n.Repeats,
+		AcknowledgedBy:   helper.SafeDereference(n.AcknowledgedBy),
 		// CustomFields is set below
 	}
 
+	notification.AcknowledgedAt = nullTimeToPtr(n.AcknowledgedAt)
+
 	if len(n.CustomFields) > 0 {
 		err := json.Unmarshal(n.CustomFields, &notification.CustomFields)
 		if err != nil {
@@ -88,3 +172,25 @@ func (n *notificationRow) ToNotificationModel() (models.Notification, error) {
 
 	return notification, nil
 }
+
+func (n *notificationWithUserStateRow) ToNotificationModel() (models.Notification, error) {
+	notification, err := n.notificationRow.ToNotificationModel()
+	if err != nil {
+		return models.Notification{}, err
+	}
+
+	notification.UserState = &models.NotificationUserState{
+		ReadState:      n.ReadState,
+		ReadAt:         nullTimeToPtr(n.UserReadAt),
+		AcknowledgedAt: nullTimeToPtr(n.UserAcknowledgedAt),
+		DismissedAt:    nullTimeToPtr(n.UserDismissedAt),
+	}
+	return notification, nil
+}
+
+func nullTimeToPtr(t sql.NullTime) *time.Time {
+	if !t.Valid {
+		return nil
+	}
+	return &t.Time
+}
@@ -0,0 +1,263 @@
+// SPDX-FileCopyrightText: 2026 Greenbone AG <https://greenbone.net>
+//
+// SPDX-License-Identifier: AGPL-3.0-or-later
+
+package notificationrepository
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"slices"
+	"time"
+
+	"github.com/greenbone/opensight-notification-service/pkg/models"
+	"github.com/jmoiron/sqlx"
+	"github.com/lib/pq"
+)
+
+// SendTaskRepository gives access to the outbox of pending deliveries.
+// Send tasks are usually created together with their notification, see [NotificationRepository.CreateNotification].
+type SendTaskRepository interface {
+	// CreateSendTasks adds send tasks for an already stored notification, e.g. to send it again.
+	CreateSendTasks(ctx context.Context, notification models.Notification, sendTasks []models.SendTask) ([]models.SendTask, error)
+	// ClaimDueSendTasks reserves up to `limit` send tasks which are due at `now` and not claimed by someone else.
+	// The tasks stay claimed until `claimUntil`, afterwards they can be claimed again.
+	ClaimDueSendTasks(ctx context.Context, now time.Time, claimUntil time.Time, limit int) ([]models.SendTask, error)
+	// RenewSendTaskClaim extends the claim of the send task to `claimUntil` if it is still claimed until `claimedUntil`.
+	// It returns false if the claim was lost, i.e. the task was claimed again by someone else after the claim expired,
+	// or if the task doesn't exist anymore.
+	RenewSendTaskClaim(ctx context.Context, id string, claimedUntil time.Time, claimUntil time.Time) (bool, error)
+	// RescheduleSendTask updates the attempt counter and next execution of the send task and releases the claim.
+	RescheduleSendTask(ctx context.Context, id string, attempt int, nextExecution time.Time) error
+	DeleteSendTask(ctx context.Context, id string) error
+}
+
+type sendTaskRepository struct {
+	client *sqlx.DB
+}
+
+func NewSendTaskRepository(db *sqlx.DB) (SendTaskRepository, error) {
+	if db == nil {
+		return nil, errors.New("nil db reference")
+	}
+	return &sendTaskRepository{client: db}, nil
+}
+
+func (r *sendTaskRepository) CreateSendTasks(
+	ctx context.Context,
+	notification models.Notification,
+	sendTasks []models.SendTask,
+) ([]models.SendTask, error) {
+	tx, err := r.client.BeginTxx(ctx, nil)
+	if err != nil {
+		return nil, fmt.Errorf("could not start transaction: %w", err)
+	}
+	defer func() { _ = tx.Rollback() }()
+
+	createdSendTasks, err := insertSendTasks(ctx, tx, &notification, sendTasks)
+	if err != nil {
+		return nil, err
+	}
+
+	err = tx.Commit()
+	if err != nil {
+		return nil, fmt.Errorf("could not commit transaction: %w", err)
+	}
+	return createdSendTasks, nil
+}
+
+// insertSendTasks stores the send tasks of the notification within the given transaction.
+// Send tasks to be collected into a digest are merged into the digest and not returned.
+// Send tasks which repeat a notification within the suppression window are dropped, the repeat is counted instead.
+// For stored send tasks to be escalated, the escalation of their rule is started, once per rule.
+func insertSendTasks(
+	ctx context.Context,
+	tx *sqlx.Tx,
+	notification *models.Notification,
+	sendTasks []models.SendTask,
+) ([]models.SendTask, error) {
+	createdSendTasks := make([]models.SendTask, 0, len(sendTasks))
+	if len(sendTasks) == 0 {
+		return createdSendTasks, nil
+	}
+
+	createSendTaskStatement, err := tx.PrepareNamedContext(ctx, createSendTaskQuery)
+	if err != nil {
+		return nil, fmt.Errorf("could not prepare sql statement: %w", err)
+	}
+	defer createSendTaskStatement.Close()
+
+	var repeatedNotificationIDs []string
+	var escalatedRuleIDs []string
+	for _, sendTask := range sendTasks {
+		if sendTask.Suppression != nil {
+			forwardedID, previousRepeats, err := suppressRepeat(ctx, tx, notification.Id, sendTask)
+			if err != nil {
+				return nil, err
+			}
+			if forwardedID != notification.Id {
+				if !slices.Contains(repeatedNotificationIDs, forwardedID) { // count a repeat once, even if it is suppressed for several rules
+					repeatedNotificationIDs = append(repeatedNotificationIDs, forwardedID)
+				}
+				continue
+			}
+			sendTask.Repeats = previousRepeats
+		}
+
+		if sendTask.Collect != nil {
+			err = collectDigest(ctx, tx, notification.Id, sendTask)
+			if err != nil {
+				return nil, err
+			}
+			continue // the digest is delivered once it is due
+		}
+
+		var taskRow sendTaskRow
+		err = createSendTaskStatement.QueryRowxContext(ctx, toSendTaskRow(notification.Id, sendTask)).StructScan(&taskRow)
+		if err != nil {
+			return nil, fmt.Errorf("could not insert send task into database: %w", err)
+		}
+		createdSendTasks = append(createdSendTasks, taskRow.ToModel(notification))
+
+		if !sendTask.EscalateAt.IsZero() && !slices.Contains(escalatedRuleIDs, sendTask.RuleID) {
+			escalatedRuleIDs = append(escalatedRuleIDs, sendTask.RuleID)
+			_, err = tx.NamedExecContext(ctx, createEscalationQuery, toEscalationRow(models.Escalation{
+				NotificationID: notification.Id,
+				RuleID:         sendTask.RuleID,
+				Step:           0,
+				DueAt:          sendTask.EscalateAt,
+			}))
+			if err != nil {
+				return nil, fmt.Errorf("could not insert escalation into database: %w", err)
+			}
+		}
+	}
+
+	if len(repeatedNotificationIDs) > 0 {
+		_, err = tx.ExecContext(ctx, incrementRepeatsQuery, pq.Array(repeatedNotificationIDs))
+		if err != nil {
+			return nil, fmt.Errorf("could not count repeats of notification: %w", err)
+		}
+	}
+	return createdSendTasks, nil
+}
+
+// suppressRepeat tracks the notification in the suppression window of the send task. It returns the ID of the notification
+// which was forwarded in the current window, if this is not the given notification, the send task has to be dropped.
+// Otherwise previousRepeats is the number of repeats suppressed in the previous window.
+func suppressRepeat(
+	ctx context.Context,
+	tx *sqlx.Tx,
+	notificationID string,
+	sendTask models.SendTask,
+) (forwardedNotificationID string, previousRepeats int, err error) {
+	now := time.Now()
+	err = tx.QueryRowxContext(ctx, suppressRepeatQuery,
+		sendTask.RuleID, sendTask.Action.Channel.ID, sendTask.Action.Recipient, sendTask.Suppression.DedupKey,
+		notificationID, now, now.Add(-sendTask.Suppression.Window)).
+		Scan(&forwardedNotificationID, &previousRepeats)
+	if err != nil {
+		return "", 0, fmt.Errorf("could not check suppression of notification: %w", err)
+	}
+	return forwardedNotificationID, previousRepeats, nil
+}
+
+// collectDigest adds the notification to the digest of the rule, channel and recipient of the send task.
+func collectDigest(ctx context.Context, tx *sqlx.Tx, notificationID string, sendTask models.SendTask) error {
+	row := toSendTaskRow(notificationID, sendTask)
+	_, err := tx.ExecContext(ctx, collectDigestQuery,
+		row.NotificationID, row.RuleID, row.ChannelID, row.ChannelName, row.ChannelType, row.Recipient,
+		sendTask.NextExecution, sendTask.Collect.DigestMaxCount, time.Now(), sendTask.Muted)
+	if err != nil {
+		return fmt.Errorf("could not add notification to digest: %w", err)
+	}
+	return nil
+}
+
+func (r *sendTaskRepository) ClaimDueSendTasks(
+	ctx context.Context,
+	now time.Time,
+	claimUntil time.Time,
+	limit int,
+) ([]models.SendTask, error) {
+	var rows []claimedSendTaskRow
+	err := r.client.SelectContext(ctx, &rows, claimDueSendTasksQuery, now, claimUntil, limit)
+	if err != nil {
+		return nil, fmt.Errorf("could not claim send tasks: %w", err)
+	}
+
+	sendTasks := make([]models.SendTask, 0, len(rows))
+	for _, row := range rows {
+		notification, err := row.Notification.ToNotificationModel()
+		if err != nil {
+			return nil, fmt.Errorf("failed to transform notification db entry: %w", err)
+		}
+		sendTask := row.ToModel(&notification)
+		if len(row.DigestNotificationIDs) > 0 {
+			sendTask.Digest, err = r.listDigestNotifications(ctx, row.DigestNotificationIDs)
+			if err != nil {
+				return nil, err
+			}
+		}
+		sendTasks = append(sendTasks, sendTask)
+	}
+	return sendTasks, nil
+}
+
+// listDigestNotifications returns the notifications collected in a digest, oldest first.
+func (r *sendTaskRepository) listDigestNotifications(ctx context.Context, ids []string) ([]models.Notification, error) {
+	var rows []notificationRow
+	err := r.client.SelectContext(ctx, &rows, listDigestNotificationsQuery, pq.Array(ids))
+	if err != nil {
+		return nil, fmt.Errorf("could not get notifications of digest: %w", err)
+	}
+
+	notifications := make([]models.Notification, 0, len(rows))
+	for _, row := range rows {
+		notification, err := row.ToNotificationModel()
+		if err != nil {
+			return nil, fmt.Errorf("failed to transform notification db entry: %w", err)
+		}
+		notifications = append(notifications, notification)
+	}
+	return notifications, nil
+}
+
+func (r *sendTaskRepository) RenewSendTaskClaim(
+	ctx context.Context,
+	id string,
+	claimedUntil time.Time,
+	claimUntil time.Time,
+) (bool, error) {
+	result, err := r.client.ExecContext(ctx, renewSendTaskClaimQuery, id, claimedUntil, claimUntil)
+	if err != nil {
+		return false, fmt.Errorf("could not renew claim of send task: %w", err)
+	}
+	renewed, err := result.RowsAffected()
+	if err != nil {
+		return false, fmt.Errorf("could not renew claim of send task: %w", err)
+	}
+	return renewed == 1, nil
+}
+
+func (r *sendTaskRepository) RescheduleSendTask(
+	ctx context.Context,
+	id string,
+	attempt int,
+	nextExecution time.Time,
+) error {
+	_, err := r.client.ExecContext(ctx, rescheduleSendTaskQuery, id, attempt, nextExecution)
+	if err != nil {
+		return fmt.Errorf("could not reschedule send task: %w", err)
+	}
+	return nil
+}
+
+func (r *sendTaskRepository) DeleteSendTask(ctx context.Context, id string) error {
+	_, err := r.client.ExecContext(ctx, deleteSendTaskQuery, id)
+	if err != nil {
+		return fmt.Errorf("could not delete send task: %w", err)
+	}
+	return nil
+}
@@ -0,0 +1,227 @@
+// SPDX-FileCopyrightText: 2026 Greenbone AG <https://greenbone.net>
+//
+// SPDX-License-Identifier: AGPL-3.0-or-later
+
+package notificationrepository
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"github.com/greenbone/opensight-notification-service/pkg/models"
+	"github.com/greenbone/opensight-notification-service/pkg/pgtesting"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func Test_SendTaskRepository(t *testing.T) {
+	db := pgtesting.NewDB(t)
+
+	notificationRepo, err := NewNotificationRepository(db)
+	require.NoError(t, err)
+	repo, err := NewSendTaskRepository(db)
+	require.NoError(t, err)
+
+	ctx := context.Background()
+	now := time.Now().UTC().Truncate(time.Microsecond) // precision of postgres timestamps
+
+	action := models.Action{
+		Channel: models.ChannelReference{
+			ID:   "0b9e6c4a-2f0e-4a8e-9c61-6c0d1c9a7e11",
+			Name: "Mail Channel",
+			Type: models.ChannelTypeMail,
+		},
+		Recipient: "a@example.com",
+	}
+
+	notification, sendTasks, err := notificationRepo.CreateNotification(ctx, models.Notification{
+		Origin:      "test",
+		OriginClass: "vi/test",
+		Timestamp:   "2024-10-10T10:00:00Z",
+		Title:       "Test Notification",
+		Detail:      "This is a test notification",
+		Level:       "info",
+	}, []models.SendTask{
+		{Action: action, NextExecution: now, ClaimedUntil: now.Add(time.Minute)}, // claimed on creation
+		{Action: action, NextExecution: now.Add(time.Hour)},                      // not due yet
+	})
+	require.NoError(t, err)
+	require.Len(t, sendTasks, 2)
+	claimedTask, futureTask := sendTasks[0], sendTasks[1]
+	assert.NotEmpty(t, claimedTask.ID)
+	assert.Equal(t, action, claimedTask.Action)
+	assert.Equal(t, &notification, claimedTask.Notification)
+
+	// claimed task is not handed out again until the claim expires
+	gotTasks, err := repo.ClaimDueSendTasks(ctx, now, now.Add(time.Minute), 10)
+	require.NoError(t, err)
+	assert.Empty(t, gotTasks)
+
+	gotTasks, err = repo.ClaimDueSendTasks(ctx, now.Add(2*time.Minute), now.Add(5*time.Minute), 10)
+	require.NoError(t, err)
+	require.Len(t, gotTasks, 1)
+	assert.Equal(t, claimedTask.ID, gotTasks[0].ID)
+	assert.Equal(t, notification, *gotTasks[0].Notification)
+	assert.Equal(t, action, gotTasks[0].Action)
+
+	// the expired first claim was lost to the second one and can not be renewed anymore
+	renewed, err := repo.RenewSendTaskClaim(ctx, claimedTask.ID, claimedTask.ClaimedUntil, now.Add(10*time.Minute))
+	require.NoError(t, err)
+	assert.False(t, renewed)
+
+	renewed, err = repo.RenewSendTaskClaim(ctx, claimedTask.ID, gotTasks[0].ClaimedUntil, now.Add(10*time.Minute))
+	require.NoError(t, err)
+	assert.True(t, renewed)
+
+	gotTasks, err = repo.ClaimDueSendTasks(ctx, now.Add(6*time.Minute), now.Add(7*time.Minute), 10)
+	require.NoError(t, err)
+	assert.Empty(t, gotTasks, "renewed claim must not be handed out again")
+
+	// rescheduling releases the claim
+	err = repo.RescheduleSendTask(ctx, claimedTask.ID, 1, now.Add(3*time.Minute))
+	require.NoError(t, err)
+
+	gotTasks, err = repo.ClaimDueSendTasks(ctx, now.Add(3*time.Minute), now.Add(5*time.Minute), 10)
+	require.NoError(t, err)
+	require.Len(t, gotTasks, 1)
+	assert.Equal(t, claimedTask.ID, gotTasks[0].ID)
+	assert.Equal(t, 1, gotTasks[0].Attempt)
+
+	// deleted tasks are gone, the not yet due task is claimed once its time has come
+	err = repo.DeleteSendTask(ctx, claimedTask.ID)
+	require.NoError(t, err)
+
+	gotTasks, err = repo.ClaimDueSendTasks(ctx, now.Add(2*time.Hour), now.Add(3*time.Hour), 10)
+	require.NoError(t, err)
+	require.Len(t, gotTasks, 1)
+	assert.Equal(t, futureTask.ID, gotTasks[0].ID)
+
+	// send tasks can be added to an existing notification
+	createdTasks, err := repo.CreateSendTasks(ctx, notification, []models.SendTask{{Action: action, NextExecution: now}})
+	require.NoError(t, err)
+	require.Len(t, createdTasks, 1)
+	assert.Equal(t, &notification, createdTasks[0].Notification)
+
+	gotTasks, err = repo.ClaimDueSendTasks(ctx, now.Add(2*time.Hour), now.Add(3*time.Hour), 10)
+	require.NoError(t, err)
+	require.Len(t, gotTasks, 1)
+	assert.Equal(t, createdTasks[0].ID, gotTasks[0].ID)
+}
+
+func Test_SendTaskRepository_SuppressRepeats(t *testing.T) {
+	db := pgtesting.NewDB(t)
+
+	notificationRepo, err := NewNotificationRepository(db)
+	require.NoError(t, err)
+
+	ctx := context.Background()
+	now := time.Now().UTC()
+
+	notificationIn := models.Notification{
+		Origin:      "test",
+		OriginClass: "vi/test",
+		Timestamp:   "2024-10-10T10:00:00Z",
+		Title:       "Disk full",
+		Detail:      "The disk is full",
+		Level:       "error",
+	}
+	newSendTask := func(window time.Duration) models.SendTask {
+		return models.SendTask{
+			RuleID: "3f0c1e3a-5b7d-4c2e-9a61-2d4b8f6e1a10",
+			Action: models.Action{
+				Channel: models.ChannelReference{
+					ID:   "0b9e6c4a-2f0e-4a8e-9c61-6c0d1c9a7e11",
+					Name: "Mattermost Channel",
+					Type: models.ChannelTypeMattermost,
+				},
+			},
+			NextExecution: now,
+			Suppression:   &models.Suppression{DedupKey: notificationIn.DedupKey(), Window: window},
+		}
+	}
+
+	// the first notification opens the suppression window
+	forwarded, sendTasks, err := notificationRepo.CreateNotification(ctx, notificationIn, []models.SendTask{newSendTask(time.Hour)})
+	require.NoError(t, err)
+	require.Len(t, sendTasks, 1)
+	assert.Zero(t, sendTasks[0].Repeats)
+
+	// repeats within the window are stored, but not forwarded
+	for range 2 {
+		repeat, sendTasks, err := notificationRepo.CreateNotification(ctx, notificationIn, []models.SendTask{newSendTask(time.Hour)})
+		require.NoError(t, err)
+		assert.NotEmpty(t, repeat.Id)
+		assert.Empty(t, sendTasks)
+	}
+
+	got, err := notificationRepo.GetNotification(ctx, forwarded.Id)
+	require.NoError(t, err)
+	assert.Equal(t, 2, got.Repeats)
+
+	// after the window the notification is forwarded again, together with the number of suppressed repeats
+	_, sendTasks, err = notificationRepo.CreateNotification(ctx, notificationIn, []models.SendTask{newSendTask(time.Nanosecond)})
+	require.NoError(t, err)
+	require.Len(t, sendTasks, 1)
+	assert.Equal(t, 2, sendTasks[0].Repeats)
+}
+
+func Test_SendTaskRepository_MutedNotificationPostponesDigest(t *testing.T) {
+	db := pgtesting.NewDB(t)
+
+	notificationRepo, err := NewNotificationRepository(db)
+	require.NoError(t, err)
+	repo, err := NewSendTaskRepository(db)
+	require.NoError(t, err)
+
+	ctx := context.Background()
+	now := time.Now().UTC().Truncate(time.Microsecond) // precision of postgres timestamps
+	mutedUntil := now.Add(2 * time.Hour)
+
+	notificationIn := models.Notification{
+		Origin:      "test",
+		OriginClass: "vi/test",
+		Timestamp:   "2024-10-10T10:00:00Z",
+		Title:       "Disk full",
+		Detail:      "The disk is full",
+		Level:       "error",
+	}
+	sendTask := models.SendTask{
+		RuleID: "3f0c1e3a-5b7d-4c2e-9a61-2d4b8f6e1a10",
+		Action: models.Action{
+			Channel: models.ChannelReference{
+				ID:   "0b9e6c4a-2f0e-4a8e-9c61-6c0d1c9a7e11",
+				Name: "Mattermost Channel",
+				Type: models.ChannelTypeMattermost,
+			},
+		},
+	}
+
+	// the digest of the rule is opened before the rule is muted
+	digestTask := sendTask
+	digestTask.NextExecution = now.Add(30 * time.Minute)
+	digestTask.Collect = &models.Delivery{Mode: models.DeliveryModeDigest, DigestWindowMinutes: 30}
+	first, _, err := notificationRepo.CreateNotification(ctx, notificationIn, []models.SendTask{digestTask})
+	require.NoError(t, err)
+
+	// the muted notification is added to the open digest, which is then not sent before the mute ends
+	mutedTask := sendTask
+	mutedTask.NextExecution = mutedUntil
+	mutedTask.Collect = &models.Delivery{Mode: models.DeliveryModeDigest}
+	mutedTask.Muted = true
+	muted, _, err := notificationRepo.CreateNotification(ctx, notificationIn, []models.SendTask{mutedTask})
+	require.NoError(t, err)
+
+	gotTasks, err := repo.ClaimDueSendTasks(ctx, now.Add(time.Hour), now.Add(time.Hour+time.Minute), 10)
+	require.NoError(t, err)
+	assert.Empty(t, gotTasks, "digest must not be sent while the rule is muted")
+
+	gotTasks, err = repo.ClaimDueSendTasks(ctx, mutedUntil, mutedUntil.Add(time.Minute), 10)
+	require.NoError(t, err)
+	require.Len(t, gotTasks, 1)
+	var digestIDs []string
+	for _, notification := range gotTasks[0].Digest {
+		digestIDs = append(digestIDs, notification.Id)
+	}
+	assert.ElementsMatch(t, []string{first.Id, muted.Id}, digestIDs)
+}
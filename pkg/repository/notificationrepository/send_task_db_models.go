@@ -0,0 +1,131 @@
+// SPDX-FileCopyrightText: 2026 Greenbone AG <https://greenbone.net>
+//
+// SPDX-License-Identifier: AGPL-3.0-or-later
+
+package notificationrepository
+
+import (
+	"database/sql"
+	"time"
+
+	"github.com/greenbone/opensight-notification-service/pkg/helper"
+	"github.com/greenbone/opensight-notification-service/pkg/models"
+	"github.com/lib/pq"
+)
+
+const (
+	sendTasksTable      = "notification_service.send_tasks"
+	sendTaskColumns     = `id, notification_id, rule_id, channel_id, channel_name, channel_type, recipient, attempt, next_execution, claimed_until, digest_notification_ids, repeats`
+	createSendTaskQuery = `INSERT INTO ` + sendTasksTable + ` (notification_id, rule_id, channel_id, channel_name, channel_type, recipient, attempt, next_execution, claimed_until, repeats)
+		VALUES (:notification_id, :rule_id, :channel_id, :channel_name, :channel_type, :recipient, :attempt, :next_execution, :claimed_until, :repeats) RETURNING ` + sendTaskColumns
+	// collectDigestQuery adds the notification to the digest which is collecting for the rule, channel and recipient,
+	// or opens a new one if there is none. Once the count threshold ($8) is reached, the digest is due immediately ($9).
+	// A muted notification ($10) postpones the digest until the end of the mute, so it is not sent in the quiet hours.
+	collectDigestQuery = `INSERT INTO ` + sendTasksTable + ` AS t (notification_id, rule_id, channel_id, channel_name, channel_type, recipient, attempt, next_execution, digest_notification_ids, collecting)
+		VALUES ($1, $2, $3, $4, $5, $6, 0, $7, ARRAY[$1]::uuid[], true)
+		ON CONFLICT (rule_id, channel_id, recipient) WHERE collecting DO UPDATE
+		SET digest_notification_ids = t.digest_notification_ids || EXCLUDED.digest_notification_ids,
+			next_execution = CASE
+				WHEN $10 THEN GREATEST(t.next_execution, EXCLUDED.next_execution)
+				WHEN $8 > 0 AND cardinality(t.digest_notification_ids) + 1 >= $8 THEN $9
+				ELSE t.next_execution
+			END`
+	// claimDueSendTasksQuery reserves due tasks which are not claimed by another worker, `SKIP LOCKED` allows
+	// several instances of the service to claim tasks concurrently without handing out a task twice
+	claimDueSendTasksQuery = `WITH claimed AS (
+			UPDATE ` + sendTasksTable + ` SET claimed_until = $2, collecting = false
+			WHERE id IN (
+				SELECT id FROM ` + sendTasksTable + `
+				WHERE next_execution <= $1 AND (claimed_until IS NULL OR claimed_until <= $1)
+				ORDER BY next_execution
+				LIMIT $3
+				FOR UPDATE SKIP LOCKED
+			)
+			RETURNING ` + sendTaskColumns + `
+		)
+		SELECT c.id, c.notification_id, c.rule_id, c.channel_id, c.channel_name, c.channel_type, c.recipient, c.attempt, c.next_execution, c.claimed_until, c.digest_notification_ids, c.repeats,
+			n.id AS "notification.id", n.origin AS "notification.origin", n.origin_class AS "notification.origin_class",
+			n.origin_resource_id AS "notification.origin_resource_id", n.timestamp AS "notification.timestamp",
+			n.title AS "notification.title", n.detail AS "notification.detail", n.level AS "notification.level",
+			n.custom_fields AS "notification.custom_fields", n.recovered AS "notification.recovered"
+		FROM claimed c
+		JOIN ` + notificationsTable + ` n ON n.id = c.notification_id
+		ORDER BY c.next_execution`
+	renewSendTaskClaimQuery      = `UPDATE ` + sendTasksTable + ` SET claimed_until = $3 WHERE id = $1 AND claimed_until = $2`
+	rescheduleSendTaskQuery      = `UPDATE ` + sendTasksTable + ` SET attempt = $2, next_execution = $3, claimed_until = NULL WHERE id = $1`
+	deleteSendTaskQuery          = `DELETE FROM ` + sendTasksTable + ` WHERE id = $1`
+	listDigestNotificationsQuery = `SELECT * FROM ` + notificationsTable + ` WHERE id = ANY($1::uuid[]) ORDER BY timestamp, id`
+)
+
+const (
+	suppressionsTable = "notification_service.suppressions"
+	// suppressRepeatQuery tracks the notification in the suppression window of the rule, channel and recipient.
+	// If the window which started before $7 is still open, the notification is a repeat and counted. Otherwise the
+	// notification opens a new window, and the repeats counted in the previous window are returned to be mentioned in the message.
+	suppressRepeatQuery = `INSERT INTO ` + suppressionsTable + ` AS s (rule_id, channel_id, recipient, dedup_key, notification_id, window_start)
+		VALUES ($1, $2, $3, $4, $5, $6)
+		ON CONFLICT (rule_id, channel_id, recipient, dedup_key) DO UPDATE
+		SET repeats = CASE WHEN s.window_start > $7 THEN s.repeats + 1 ELSE 0 END,
+			previous_repeats = CASE WHEN s.window_start > $7 THEN s.previous_repeats ELSE s.repeats END,
+			notification_id = CASE WHEN s.window_start > $7 THEN s.notification_id ELSE EXCLUDED.notification_id END,
+			window_start = CASE WHEN s.window_start > $7 THEN s.window_start ELSE EXCLUDED.window_start END
+		RETURNING notification_id, previous_repeats`
+	incrementRepeatsQuery = `UPDATE ` + notificationsTable + ` SET repeats = repeats + 1 WHERE id = ANY($1::uuid[])`
+)
+
+type sendTaskRow struct {
+	ID             string       `db:"id"`
+	NotificationID string       `db:"notification_id"`
+	RuleID         *string      `db:"rule_id"`
+	ChannelID      string       `db:"channel_id"`
+	ChannelName    string       `db:"channel_name"`
+	ChannelType    string       `db:"channel_type"`
+	Recipient      string       `db:"recipient"`
+	Attempt        int          `db:"attempt"`
+	NextExecution  time.Time    `db:"next_execution"`
+	ClaimedUntil   sql.NullTime `db:"claimed_until"`
+	// only set for digests
+	DigestNotificationIDs pq.StringArray `db:"digest_notification_ids"`
+	Repeats               int            `db:"repeats"`
+}
+
+// claimedSendTaskRow is a send task together with the notification to deliver
+type claimedSendTaskRow struct {
+	sendTaskRow
+	Notification notificationRow `db:"notification"`
+}
+
+func toSendTaskRow(notificationID string, task models.SendTask) sendTaskRow {
+	return sendTaskRow{
+		NotificationID: notificationID,
+		RuleID:         helper.ToNullablePtr(task.RuleID),
+		ChannelID:      task.Action.Channel.ID,
+		ChannelName:    task.Action.Channel.Name,
+		ChannelType:    string(task.Action.Channel.Type),
+		Recipient:      task.Action.Recipient,
+		Attempt:        task.Attempt,
+		NextExecution:  task.NextExecution,
+		ClaimedUntil:   sql.NullTime{Time: task.ClaimedUntil, Valid: !task.ClaimedUntil.IsZero()},
+		Repeats:        task.Repeats,
+	}
+}
+
+func (r *sendTaskRow) ToModel(notification *models.Notification) models.SendTask {
+	return models.SendTask{
+		ID:           r.ID,
+		Notification: notification,
+		RuleID:       helper.SafeDereference(r.RuleID),
+		Action: models.Action{
+			Channel: models.ChannelReference{
+				ID:   r.ChannelID,
+				Name: r.ChannelName,
+				Type: models.ChannelType(r.ChannelType),
+			},
+			Recipient: r.Recipient,
+		},
+		Attempt:       r.Attempt,
+		NextExecution: r.NextExecution,
+		ClaimedUntil:  r.ClaimedUntil.Time, // zero value if not claimed
+		Repeats:       r.Repeats,
+	}
+}
@@ -6,6 +6,7 @@ package originrepository
 
 import (
 	"context"
+	"database/sql"
 	"errors"
 	"fmt"
 
@@ -97,3 +98,17 @@ func (r *OriginRepository) ListOrigins(ctx context.Context) ([]entities.Origin,
 
 	return origins, nil
 }
+
+// GetOriginByClass returns the origin with the given class, the class is unique among all services.
+func (r *OriginRepository) GetOriginByClass(ctx context.Context, class string) (entities.Origin, error) {
+	var row originRow
+	err := r.client.GetContext(ctx, &row, getOriginQuery, class)
+	if err != nil {
+		if errors.Is(err, sql.ErrNoRows) {
+			return entities.Origin{}, errs.ErrItemNotFound
+		}
+		return entities.Origin{}, fmt.Errorf("could not get origin: %w", err)
+	}
+
+	return row.toOriginEntity(), nil
+}
@@ -11,6 +11,7 @@ import (
 	"testing"
 
 	"github.com/greenbone/opensight-notification-service/pkg/entities"
+	"github.com/greenbone/opensight-notification-service/pkg/errs"
 	"github.com/greenbone/opensight-notification-service/pkg/pgtesting"
 	"github.com/stretchr/testify/assert"
 	"github.com/stretchr/testify/require"
@@ -33,13 +34,13 @@ func Test_UpsertOrigins_ListOrigins(t *testing.T) {
 					serviceID: "service1",
 					origins: []entities.Origin{
 						{Name: "origin1", Class: "classA", ServiceID: "read only, to be ignored"},
-						{Name: "origin2", Class: "classB"},
+						{Name: "origin2", Class: "classB", UrlTemplate: "/b/{resourceID}"},
 					},
 				},
 			},
 			wantOrigins: []entities.Origin{
 				{Name: "origin1", Class: "classA", ServiceID: "service1"},
-				{Name: "origin2", Class: "classB", ServiceID: "service1"},
+				{Name: "origin2", Class: "classB", ServiceID: "service1", UrlTemplate: "/b/{resourceID}"},
 			},
 		},
 		"create origins from multiple services": {
@@ -163,6 +164,26 @@ func Test_UpsertOrigins_ListOrigins(t *testing.T) {
 	}
 }
 
+func Test_GetOriginByClass(t *testing.T) {
+	db := pgtesting.NewDB(t)
+
+	repo, err := NewOriginRepository(db)
+	require.NoError(t, err)
+
+	ctx := context.Background()
+	err = repo.UpsertOrigins(ctx, "service1", []entities.Origin{
+		{Name: "origin1", Class: "classA", UrlTemplate: "/a/{resourceID}"},
+	})
+	require.NoError(t, err)
+
+	got, err := repo.GetOriginByClass(ctx, "classA")
+	require.NoError(t, err)
+	assert.Equal(t, entities.Origin{Name: "origin1", Class: "classA", ServiceID: "service1", UrlTemplate: "/a/{resourceID}"}, got)
+
+	_, err = repo.GetOriginByClass(ctx, "unknown")
+	require.ErrorIs(t, err, errs.ErrItemNotFound)
+}
+
 func Test_UpsertOrigins_Concurrency(t *testing.T) {
 	db := pgtesting.NewDB(t)
 
@@ -9,21 +9,24 @@ import "github.com/greenbone/opensight-notification-service/pkg/entities"
 const (
 	originsTable       = "notification_service.origins"
 	deleteOriginsQuery = `DELETE FROM ` + originsTable + ` WHERE service_id = $1`
-	createOriginsQuery = `INSERT INTO ` + originsTable + ` (name, class, service_id) VALUES (:name, :class, :service_id)`
+	createOriginsQuery = `INSERT INTO ` + originsTable + ` (name, class, service_id, url_template) VALUES (:name, :class, :service_id, :url_template)`
 	listOriginsQuery   = `SELECT * FROM ` + originsTable + ` ORDER BY name, service_id COLLATE "C"` // ensure deterministic sort order accross locales
+	getOriginQuery     = `SELECT * FROM ` + originsTable + ` WHERE class = $1`
 )
 
 type originRow struct {
-	Name      string `db:"name"`
-	Class     string `db:"class"`
-	ServiceID string `db:"service_id"`
+	Name        string `db:"name"`
+	Class       string `db:"class"`
+	ServiceID   string `db:"service_id"`
+	UrlTemplate string `db:"url_template"`
 }
 
 func toOriginRow(o entities.Origin, serviceID string) originRow {
 	return originRow{
-		Name:      o.Name,
-		Class:     o.Class,
-		ServiceID: serviceID,
+		Name:        o.Name,
+		Class:       o.Class,
+		ServiceID:   serviceID,
+		UrlTemplate: o.UrlTemplate,
 	}
 }
 
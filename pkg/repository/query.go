@@ -6,14 +6,20 @@ package repository
 
 import (
 	"fmt"
+	"regexp"
+	"slices"
+	"strconv"
 
 	pgquery "github.com/greenbone/opensight-golang-libraries/pkg/postgres/query"
 	"github.com/greenbone/opensight-golang-libraries/pkg/query"
 	"github.com/greenbone/opensight-golang-libraries/pkg/query/filter"
 )
 
-// BuildListQuery builds a query for retrieving results based on the provided result selector
-func BuildListQuery(resultSelector query.ResultSelector, baseQuery string, querySettings pgquery.Settings) (string, []any, error) {
+var placeholderPattern = regexp.MustCompile(`\$(\d+)`)
+
+// BuildListQuery builds a query for retrieving results based on the provided result selector.
+// The base query can use the placeholders $1 to $n for the n base args, the args of the query condition follow them.
+func BuildListQuery(resultSelector query.ResultSelector, baseQuery string, querySettings pgquery.Settings, baseArgs ...any) (string, []any, error) {
 	qb, err := pgquery.NewPostgresQueryBuilder(querySettings)
 	if err != nil {
 		return "", nil, fmt.Errorf("error creating query builder: %w", err)
@@ -24,19 +30,24 @@ func BuildListQuery(resultSelector query.ResultSelector, baseQuery string, query
 		return "", nil, fmt.Errorf("error building query condition: %w", err)
 	}
 
+	if len(baseArgs) > 0 {
+		queryCondition = shiftPlaceholders(queryCondition, len(baseArgs))
+		args = slices.Concat(baseArgs, args)
+	}
+
 	fullQuery := baseQuery + ` ` + queryCondition
 	return fullQuery, args, nil
 }
 
-// BuildCountQuery builds a count query based on the provided filter request
-func BuildCountQuery(filterRequest *filter.Request, baseQuery string, querySettings pgquery.Settings) (string, []any, error) {
+// BuildCountQuery builds a count query based on the provided filter request, the base args are handled like in BuildListQuery
+func BuildCountQuery(filterRequest *filter.Request, baseQuery string, querySettings pgquery.Settings, baseArgs ...any) (string, []any, error) {
 	// create a resultSelector for the filter, sorting and paging are intentionally omitted here.
 	// sorting does not affect the count, and paging (limiting or offsetting rows) is not necessary for counting.
 	resultSelector := query.ResultSelector{
 		Filter: filterRequest, // only the filter is applied to narrow down the count based on conditions.
 	}
 
-	countQuery, args, err := BuildListQuery(resultSelector, baseQuery, querySettings)
+	countQuery, args, err := BuildListQuery(resultSelector, baseQuery, querySettings, baseArgs...)
 	if err != nil {
 		return "", nil, fmt.Errorf("error building count query: %w", err)
 	}
@@ -44,3 +55,12 @@ func BuildCountQuery(filterRequest *filter.Request, baseQuery string, querySetti
 
 	return countQuery, args, nil
 }
+
+// shiftPlaceholders renumbers the placeholders of the query condition, as the query builder always starts with $1.
+// The condition contains no literals, all values are passed as args.
+func shiftPlaceholders(queryCondition string, offset int) string {
+	return placeholderPattern.ReplaceAllStringFunc(queryCondition, func(placeholder string) string {
+		number, _ := strconv.Atoi(placeholder[1:])
+		return "$" + strconv.Itoa(number+offset)
+	})
+}
@@ -0,0 +1,38 @@
+// SPDX-FileCopyrightText: 2024 Greenbone AG <https://greenbone.net>
+//
+// SPDX-License-Identifier: AGPL-3.0-or-later
+
+package repository
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestShiftPlaceholders(t *testing.T) {
+	tests := []struct {
+		name           string
+		queryCondition string
+		offset         int
+		want           string
+	}{
+		{
+			name:           "keeps condition without placeholders",
+			queryCondition: "ORDER BY id ASC",
+			offset:         1,
+			want:           "ORDER BY id ASC",
+		},
+		{
+			name:           "shifts all placeholders",
+			queryCondition: "WHERE level = $1 AND title ILIKE $2 LIMIT $10 OFFSET $11",
+			offset:         2,
+			want:           "WHERE level = $3 AND title ILIKE $4 LIMIT $12 OFFSET $13",
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			assert.Equal(t, tt.want, shiftPlaceholders(tt.queryCondition, tt.offset))
+		})
+	}
+}
@@ -71,7 +71,10 @@ func (r *RuleRepository) List(ctx context.Context) ([]models.Rule, error) {
 }
 
 func (r *RuleRepository) Create(ctx context.Context, rule models.Rule) (models.Rule, error) {
-	rowIn := toRuleRow(rule)
+	rowIn, err := toRuleRow(rule)
+	if err != nil {
+		return models.Rule{}, fmt.Errorf("could not convert rule: %w", err)
+	}
 
 	createStatement, err := r.client.PrepareNamedContext(ctx, createRuleQuery)
 	if err != nil {
@@ -94,7 +97,10 @@ func (r *RuleRepository) Update(ctx context.Context, id string, rule models.Rule
 		return models.Rule{}, err
 	}
 
-	rowIn := toRuleRow(rule)
+	rowIn, err := toRuleRow(rule)
+	if err != nil {
+		return models.Rule{}, fmt.Errorf("could not convert rule: %w", err)
+	}
 
 	updateStatement, err := r.client.PrepareNamedContext(ctx, updateRuleQuery)
 	if err != nil {
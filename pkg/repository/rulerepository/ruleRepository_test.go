@@ -7,6 +7,7 @@ package rulerepository
 import (
 	"context"
 	"testing"
+	"time"
 
 	"github.com/google/uuid"
 	"github.com/greenbone/opensight-golang-libraries/pkg/notifications"
@@ -62,6 +63,33 @@ func createTestOrigin(t *testing.T, db *sqlx.DB, name, class, serviceID string)
 	require.NoError(t, err)
 }
 
+var testMute = models.Mute{
+	TimeZone:   "Europe/Berlin",
+	QuietHours: []models.QuietHours{{Weekdays: []models.Weekday{models.Saturday, models.Sunday}, Start: "00:00", End: "00:00"}},
+	MaintenanceWindows: []models.MaintenanceWindow{{
+		Start:   time.Date(2024, 1, 1, 10, 0, 0, 0, time.UTC),
+		End:     time.Date(2024, 1, 1, 12, 0, 0, 0, time.UTC),
+		Comment: "database upgrade",
+	}},
+	Summary: true,
+}
+
+var testEscalation = []models.EscalationStep{{
+	DelayMinutes: 15,
+	Action: models.Action{
+		Channel:   models.ChannelReference{ID: "3c1e5b7a-9f2d-4e8c-a6b4-0d2f1e3c5a7b"},
+		Recipient: "oncall@example.com",
+	},
+}}
+
+// withDefaultDelivery sets the delivery mode which is stored if a rule has none
+func withDefaultDelivery(rule models.Rule) models.Rule {
+	if rule.Delivery.Mode == "" {
+		rule.Delivery.Mode = models.DeliveryModeImmediate
+	}
+	return rule
+}
+
 func Test_GetRule_NotFound(t *testing.T) {
 	t.Parallel()
 	db := pgtesting.NewDB(t)
@@ -242,6 +270,130 @@ func Test_CreateRule_GetRule(t *testing.T) {
 				Active: true,
 			},
 		},
+		"create rule with digest delivery": {
+			setupData: func(t *testing.T, db *sqlx.DB) string {
+				channelID := createTestChannel(t, db, "test-channel", "mattermost")
+				createTestOrigin(t, db, "Origin1", "class1", "service1")
+				return channelID
+			},
+			rule: models.Rule{
+				Name: "Digest Rule",
+				Trigger: models.Trigger{
+					Levels:  []notifications.Level{notifications.LevelWarning},
+					Origins: []models.OriginReference{{Class: "class1"}},
+				},
+				Action: models.Action{
+					Channel: models.ChannelReference{ID: "set below in test"},
+				},
+				Delivery: models.Delivery{Mode: models.DeliveryModeDigest, DigestWindowMinutes: 60, DigestMaxCount: 100},
+				Active:   true,
+			},
+			wantRule: models.Rule{
+				Name: "Digest Rule",
+				Trigger: models.Trigger{
+					Levels:  []notifications.Level{notifications.LevelWarning},
+					Origins: []models.OriginReference{{Name: "Origin1", Class: "class1", ServiceID: "service1"}},
+				},
+				Action: models.Action{
+					Channel: models.ChannelReference{ID: "set below in test", Name: "test-channel", Type: "mattermost"},
+				},
+				Delivery: models.Delivery{Mode: models.DeliveryModeDigest, DigestWindowMinutes: 60, DigestMaxCount: 100},
+				Active:   true,
+			},
+		},
+		"create rule with mute settings": {
+			setupData: func(t *testing.T, db *sqlx.DB) string {
+				channelID := createTestChannel(t, db, "test-channel", "mattermost")
+				createTestOrigin(t, db, "Origin1", "class1", "service1")
+				return channelID
+			},
+			rule: models.Rule{
+				Name: "Muted Rule",
+				Trigger: models.Trigger{
+					Levels:  []notifications.Level{notifications.LevelWarning},
+					Origins: []models.OriginReference{{Class: "class1"}},
+				},
+				Action: models.Action{
+					Channel: models.ChannelReference{ID: "set below in test"},
+				},
+				Mute:   testMute,
+				Active: true,
+			},
+			wantRule: models.Rule{
+				Name: "Muted Rule",
+				Trigger: models.Trigger{
+					Levels:  []notifications.Level{notifications.LevelWarning},
+					Origins: []models.OriginReference{{Name: "Origin1", Class: "class1", ServiceID: "service1"}},
+				},
+				Action: models.Action{
+					Channel: models.ChannelReference{ID: "set below in test", Name: "test-channel", Type: "mattermost"},
+				},
+				Mute:   testMute,
+				Active: true,
+			},
+		},
+		"create rule with escalation steps": {
+			setupData: func(t *testing.T, db *sqlx.DB) string {
+				channelID := createTestChannel(t, db, "test-channel", "mattermost")
+				createTestOrigin(t, db, "Origin1", "class1", "service1")
+				return channelID
+			},
+			rule: models.Rule{
+				Name: "Escalating Rule",
+				Trigger: models.Trigger{
+					Levels:  []notifications.Level{notifications.LevelUrgent},
+					Origins: []models.OriginReference{{Class: "class1"}},
+				},
+				Action: models.Action{
+					Channel: models.ChannelReference{ID: "set below in test"},
+				},
+				Escalation: testEscalation,
+				Active:     true,
+			},
+			wantRule: models.Rule{
+				Name: "Escalating Rule",
+				Trigger: models.Trigger{
+					Levels:  []notifications.Level{notifications.LevelUrgent},
+					Origins: []models.OriginReference{{Name: "Origin1", Class: "class1", ServiceID: "service1"}},
+				},
+				Action: models.Action{
+					Channel: models.ChannelReference{ID: "set below in test", Name: "test-channel", Type: "mattermost"},
+				},
+				Escalation: testEscalation,
+				Active:     true,
+			},
+		},
+		"create rule with template override": {
+			setupData: func(t *testing.T, db *sqlx.DB) string {
+				channelID := createTestChannel(t, db, "test-channel", "teams")
+				createTestOrigin(t, db, "Origin1", "class1", "service1")
+				return channelID
+			},
+			rule: models.Rule{
+				Name: "Templated Rule",
+				Trigger: models.Trigger{
+					Levels:  []notifications.Level{notifications.LevelError},
+					Origins: []models.OriginReference{{Class: "class1"}},
+				},
+				Action: models.Action{
+					Channel: models.ChannelReference{ID: "set below in test"},
+				},
+				Template: &models.TemplateOverride{Subject: "{{.OriginResourceID}}: {{.Title}}"},
+				Active:   true,
+			},
+			wantRule: models.Rule{
+				Name: "Templated Rule",
+				Trigger: models.Trigger{
+					Levels:  []notifications.Level{notifications.LevelError},
+					Origins: []models.OriginReference{{Name: "Origin1", Class: "class1", ServiceID: "service1"}},
+				},
+				Action: models.Action{
+					Channel: models.ChannelReference{ID: "set below in test", Name: "test-channel", Type: "teams"},
+				},
+				Template: &models.TemplateOverride{Subject: "{{.OriginResourceID}}: {{.Title}}"},
+				Active:   true,
+			},
+		},
 		"create rule with non-existent channel works, but returns an empty channel ID": {
 			setupData: func(t *testing.T, db *sqlx.DB) string {
 				channelID := uuid.NewString() // non-existent channel ID
@@ -340,6 +492,7 @@ func Test_CreateRule_GetRule(t *testing.T) {
 			if tt.wantRule.Action.Channel.ID != "" {
 				tt.wantRule.Action.Channel.ID = channelID // set id for comparison (not known beforehand)
 			}
+			tt.wantRule = withDefaultDelivery(tt.wantRule)
 
 			assert.Equal(t, tt.wantRule, createdRule)
 
@@ -561,6 +714,7 @@ func Test_UpdateRule(t *testing.T) {
 			if tt.wantRule.Action.Channel.ID != "" {
 				tt.wantRule.Action.Channel.ID = channelIDNew
 			}
+			tt.wantRule = withDefaultDelivery(tt.wantRule)
 			tt.rule.ID = ruleID                      // set ID for update
 			tt.rule.Action.Channel.ID = channelIDNew // set channel ID for update
 
@@ -705,6 +859,9 @@ func Test_ListRules(t *testing.T) {
 			},
 		}
 		require.Equal(t, len(rulesIn), len(wantRules), "test setup error: rulesIn and wantRules must have same length")
+		for i := range wantRules {
+			wantRules[i] = withDefaultDelivery(wantRules[i])
+		}
 
 		for i := range rulesIn {
 			_, err := repo.Create(ctx, rulesIn[i])
@@ -27,6 +27,13 @@ func ruleSelectWithJoin(from string) string {
 		r.action_channel_id,
 		r.action_recipient,
 		r.active,
+		r.delivery_mode,
+		r.digest_window_minutes,
+		r.digest_max_count,
+		r.suppression_window_minutes,
+		r.mute,
+		r.escalation,
+		r.template,
 		c.channel_name,
 		c.channel_type,
 		COALESCE(
@@ -48,15 +55,19 @@ func ruleSelectWithJoin(from string) string {
 var ruleQuerySelect = ruleSelectWithJoin(ruleTable)
 
 const ruleQueryGroupBy = `
-GROUP BY r.id, r.name, r.trigger_origins, r.trigger_levels, r.action_channel_id, r.action_recipient, r.active, c.channel_name, c.channel_type`
+GROUP BY r.id, r.name, r.trigger_origins, r.trigger_levels, r.action_channel_id, r.action_recipient, r.active,
+	r.delivery_mode, r.digest_window_minutes, r.digest_max_count, r.suppression_window_minutes, r.mute, r.escalation, r.template, c.channel_name, c.channel_type`
 
 var createRuleQuery = `WITH inserted AS (
 		INSERT INTO ` + ruleTable + ` (
-			name, trigger_origins, trigger_levels, action_channel_id, action_recipient, active
+			name, trigger_origins, trigger_levels, action_channel_id, action_recipient, active,
+			delivery_mode, digest_window_minutes, digest_max_count, suppression_window_minutes, mute, escalation, template
 		) VALUES (
-			:name, :trigger_origins, :trigger_levels, :action_channel_id, :action_recipient, :active
+			:name, :trigger_origins, :trigger_levels, :action_channel_id, :action_recipient, :active,
+			:delivery_mode, :digest_window_minutes, :digest_max_count, :suppression_window_minutes, :mute, :escalation, :template
 		)
-		RETURNING id, name, trigger_origins, trigger_levels, action_channel_id, action_recipient, active
+		RETURNING id, name, trigger_origins, trigger_levels, action_channel_id, action_recipient, active,
+			delivery_mode, digest_window_minutes, digest_max_count, suppression_window_minutes, mute, escalation, template
 	)
 ` + ruleSelectWithJoin("inserted") + ruleQueryGroupBy
 
@@ -71,9 +82,17 @@ UPDATE ` + ruleTable + `
 		trigger_levels = :trigger_levels,
 		action_channel_id = :action_channel_id,
 		action_recipient = :action_recipient,
-		active = :active
+		active = :active,
+		delivery_mode = :delivery_mode,
+		digest_window_minutes = :digest_window_minutes,
+		digest_max_count = :digest_max_count,
+		suppression_window_minutes = :suppression_window_minutes,
+		mute = :mute,
+		escalation = :escalation,
+		template = :template
 	WHERE id = :id
-	RETURNING id, name, trigger_origins, trigger_levels, action_channel_id, action_recipient, active
+	RETURNING id, name, trigger_origins, trigger_levels, action_channel_id, action_recipient, active,
+		delivery_mode, digest_window_minutes, digest_max_count, suppression_window_minutes, mute, escalation, template
 )
 ` + ruleSelectWithJoin("updated") + ruleQueryGroupBy
 
@@ -87,10 +106,23 @@ type ruleRow struct {
 	ActionChannelID *string        `db:"action_channel_id"`
 	ActionRecipient *string        `db:"action_recipient"`
 	Active          bool           `db:"active"`
+	Mute            []byte         `db:"mute"`
+	Escalation      []byte         `db:"escalation"`
+	Template        []byte         `db:"template"` // NULL if the rule uses the template of the channel type
+	deliveryRow
 	channelRow
 	originRow
 }
 
+// delivery settings of the rule
+type deliveryRow struct {
+	DeliveryMode        string `db:"delivery_mode"`
+	DigestWindowMinutes int    `db:"digest_window_minutes"`
+	DigestMaxCount      int    `db:"digest_max_count"`
+	// zero if the global suppression window applies
+	SuppressionWindowMinutes int `db:"suppression_window_minutes"`
+}
+
 // columns joined from notification_channel table
 type channelRow struct {
 	ChannelName *string `db:"channel_name"`
@@ -120,6 +152,30 @@ func (r ruleRow) ToModel() (models.Rule, error) {
 		channelID = "" // don't set the channel ID if the channel doesn't exist anymore
 	}
 
+	var mute models.Mute
+	if len(r.Mute) > 0 {
+		if err := json.Unmarshal(r.Mute, &mute); err != nil {
+			return models.Rule{}, err
+		}
+	}
+
+	var escalation []models.EscalationStep
+	if len(r.Escalation) > 0 {
+		if err := json.Unmarshal(r.Escalation, &escalation); err != nil {
+			return models.Rule{}, err
+		}
+	}
+	if len(escalation) == 0 {
+		escalation = nil // rule without escalation steps
+	}
+
+	var template *models.TemplateOverride
+	if len(r.Template) > 0 {
+		if err := json.Unmarshal(r.Template, &template); err != nil {
+			return models.Rule{}, err
+		}
+	}
+
 	var levels []notifications.Level
 	if r.TriggerLevels != nil {
 		for _, l := range r.TriggerLevels {
@@ -142,14 +198,24 @@ func (r ruleRow) ToModel() (models.Rule, error) {
 			},
 			Recipient: helper.SafeDereference(r.ActionRecipient),
 		},
-		Active: r.Active,
+		Delivery: models.Delivery{
+			Mode:                models.DeliveryMode(r.DeliveryMode),
+			DigestWindowMinutes: r.DigestWindowMinutes,
+			DigestMaxCount:      r.DigestMaxCount,
+
+			SuppressionWindowMinutes: r.SuppressionWindowMinutes,
+		},
+		Mute:       mute,
+		Escalation: escalation,
+		Template:   template,
+		Active:     r.Active,
 	}
 
 	return rule, nil
 }
 
 // toRuleRow converts a models.Rule to a ruleRow for insert
-func toRuleRow(rule models.Rule) ruleRow {
+func toRuleRow(rule models.Rule) (ruleRow, error) {
 	// Extract origin classes (the only writable field)
 	originClasses := make([]string, 0, len(rule.Trigger.Origins))
 	for _, origin := range rule.Trigger.Origins {
@@ -161,6 +227,27 @@ func toRuleRow(rule models.Rule) ruleRow {
 		triggerLevels[i] = string(l)
 	}
 
+	mute, err := json.Marshal(rule.Mute)
+	if err != nil {
+		return ruleRow{}, err
+	}
+	steps := rule.Escalation
+	if steps == nil {
+		steps = []models.EscalationStep{} // column is not nullable
+	}
+	escalation, err := json.Marshal(steps)
+	if err != nil {
+		return ruleRow{}, err
+	}
+
+	var template []byte
+	if rule.Template != nil {
+		template, err = json.Marshal(rule.Template)
+		if err != nil {
+			return ruleRow{}, err
+		}
+	}
+
 	row := ruleRow{
 		Name:            rule.Name,
 		TriggerOrigins:  originClasses,
@@ -168,7 +255,20 @@ func toRuleRow(rule models.Rule) ruleRow {
 		ActionChannelID: helper.ToNullablePtr(rule.Action.Channel.ID), // take only the writable field
 		ActionRecipient: helper.ToPtr(rule.Action.Recipient),
 		Active:          rule.Active,
+		Mute:            mute,
+		Escalation:      escalation,
+		Template:        template,
+		deliveryRow: deliveryRow{
+			DeliveryMode:        string(rule.Delivery.Mode),
+			DigestWindowMinutes: rule.Delivery.DigestWindowMinutes,
+			DigestMaxCount:      rule.Delivery.DigestMaxCount,
+
+			SuppressionWindowMinutes: rule.Delivery.SuppressionWindowMinutes,
+		},
+	}
+	if row.DeliveryMode == "" {
+		row.DeliveryMode = string(models.DeliveryModeImmediate)
 	}
 
-	return row
+	return row, nil
 }
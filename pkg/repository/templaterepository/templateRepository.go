@@ -0,0 +1,108 @@
+// SPDX-FileCopyrightText: 2026 Greenbone AG <https://greenbone.net>
+//
+// SPDX-License-Identifier: AGPL-3.0-or-later
+
+package templaterepository
+
+import (
+	"context"
+	"database/sql"
+	"encoding/json"
+	"errors"
+	"fmt"
+
+	"github.com/greenbone/opensight-notification-service/pkg/errs"
+	"github.com/greenbone/opensight-notification-service/pkg/models"
+	"github.com/greenbone/opensight-notification-service/pkg/validation"
+	"github.com/jmoiron/sqlx"
+)
+
+type TemplateRepository struct {
+	client *sqlx.DB
+}
+
+func NewTemplateRepository(db *sqlx.DB) (*TemplateRepository, error) {
+	if db == nil {
+		return nil, errors.New("nil db reference")
+	}
+	return &TemplateRepository{client: db}, nil
+}
+
+// ListTemplates returns the templates changed by an admin, channel types with the default template are missing.
+func (r *TemplateRepository) ListTemplates(ctx context.Context) ([]models.MessageTemplate, error) {
+	var rows []templateRow
+	err := r.client.SelectContext(ctx, &rows, listTemplatesQuery)
+	if err != nil {
+		return nil, fmt.Errorf("could not list templates: %w", err)
+	}
+
+	templates := make([]models.MessageTemplate, 0, len(rows))
+	for _, row := range rows {
+		templates = append(templates, row.ToModel())
+	}
+	return templates, nil
+}
+
+// GetTemplate returns the template of the channel type, or [errs.ErrItemNotFound] if the default template applies.
+func (r *TemplateRepository) GetTemplate(ctx context.Context, channelType models.ChannelType) (models.MessageTemplate, error) {
+	var row templateRow
+	err := r.client.GetContext(ctx, &row, getTemplateQuery, channelType)
+	if err != nil {
+		if errors.Is(err, sql.ErrNoRows) {
+			return models.MessageTemplate{}, errs.ErrItemNotFound
+		}
+		return models.MessageTemplate{}, fmt.Errorf("could not get template: %w", err)
+	}
+	return row.ToModel(), nil
+}
+
+// UpsertTemplate stores the template of its channel type, replacing the previous one.
+func (r *TemplateRepository) UpsertTemplate(ctx context.Context, template models.MessageTemplate) (models.MessageTemplate, error) {
+	statement, err := r.client.PrepareNamedContext(ctx, upsertTemplateQuery)
+	if err != nil {
+		return models.MessageTemplate{}, fmt.Errorf("could not prepare sql statement: %w", err)
+	}
+	defer statement.Close()
+
+	var row templateRow
+	err = statement.QueryRowxContext(ctx, toTemplateRow(template)).StructScan(&row)
+	if err != nil {
+		return models.MessageTemplate{}, fmt.Errorf("could not store template: %w", err)
+	}
+	return row.ToModel(), nil
+}
+
+// DeleteTemplate removes the template of the channel type, so the default template applies again.
+func (r *TemplateRepository) DeleteTemplate(ctx context.Context, channelType models.ChannelType) error {
+	_, err := r.client.ExecContext(ctx, deleteTemplateQuery, channelType)
+	if err != nil {
+		return fmt.Errorf("could not delete template: %w", err)
+	}
+	return nil
+}
+
+// GetRuleTemplate returns the template override of the rule, nil if the rule has none.
+func (r *TemplateRepository) GetRuleTemplate(ctx context.Context, ruleID string) (*models.TemplateOverride, error) {
+	if validation.Validate.Var(ruleID, "uuid4") != nil {
+		return nil, errs.ErrItemNotFound
+	}
+
+	var raw []byte
+	err := r.client.GetContext(ctx, &raw, getRuleTemplateQuery, ruleID)
+	if err != nil {
+		if errors.Is(err, sql.ErrNoRows) {
+			return nil, errs.ErrItemNotFound
+		}
+		return nil, fmt.Errorf("could not get template of rule: %w", err)
+	}
+	if len(raw) == 0 {
+		return nil, nil
+	}
+
+	var template models.TemplateOverride
+	err = json.Unmarshal(raw, &template)
+	if err != nil {
+		return nil, fmt.Errorf("could not parse template of rule: %w", err)
+	}
+	return &template, nil
+}
@@ -0,0 +1,41 @@
+// SPDX-FileCopyrightText: 2026 Greenbone AG <https://greenbone.net>
+//
+// SPDX-License-Identifier: AGPL-3.0-or-later
+
+package templaterepository
+
+import "github.com/greenbone/opensight-notification-service/pkg/models"
+
+const (
+	templatesTable      = "notification_service.message_templates"
+	rulesTable          = "notification_service.rules"
+	listTemplatesQuery  = `SELECT * FROM ` + templatesTable + ` ORDER BY channel_type`
+	getTemplateQuery    = `SELECT * FROM ` + templatesTable + ` WHERE channel_type = $1`
+	upsertTemplateQuery = `INSERT INTO ` + templatesTable + ` (channel_type, subject, body) VALUES (:channel_type, :subject, :body)
+		ON CONFLICT (channel_type) DO UPDATE SET subject = EXCLUDED.subject, body = EXCLUDED.body
+		RETURNING *`
+	deleteTemplateQuery  = `DELETE FROM ` + templatesTable + ` WHERE channel_type = $1`
+	getRuleTemplateQuery = `SELECT template FROM ` + rulesTable + ` WHERE id = $1`
+)
+
+type templateRow struct {
+	ChannelType string `db:"channel_type"`
+	Subject     string `db:"subject"`
+	Body        string `db:"body"`
+}
+
+func toTemplateRow(template models.MessageTemplate) templateRow {
+	return templateRow{
+		ChannelType: string(template.ChannelType),
+		Subject:     template.Subject,
+		Body:        template.Body,
+	}
+}
+
+func (r *templateRow) ToModel() models.MessageTemplate {
+	return models.MessageTemplate{
+		ChannelType: models.ChannelType(r.ChannelType),
+		Subject:     r.Subject,
+		Body:        r.Body,
+	}
+}
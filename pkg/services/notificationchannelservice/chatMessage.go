@@ -0,0 +1,54 @@
+// SPDX-FileCopyrightText: 2026 Greenbone AG <https://greenbone.net>
+//
+// SPDX-License-Identifier: AGPL-3.0-or-later
+
+package notificationchannelservice
+
+import (
+	"fmt"
+
+	"github.com/greenbone/opensight-notification-service/pkg/markdown"
+	"github.com/greenbone/opensight-notification-service/pkg/models"
+)
+
+// chatMessageFooter is shown below the structured messages
+const chatMessageFooter = "Greenbone OpenSight"
+
+// chatMessageFallback is the plain text shown in notifications of clients which can't display structured messages
+func chatMessageFallback(message models.ChatMessage) string {
+	if message.Title != "" {
+		return message.Title
+	}
+	return markdown.ToText(message.Text)
+}
+
+// chatMessageToMarkdown renders the message as markdown text with the title in bold,
+// for webhooks which don't support structured messages
+func chatMessageToMarkdown(message models.ChatMessage) string {
+	text := message.Text
+	if message.Title != "" {
+		text = fmt.Sprintf("**%s**\n\n%s", markdown.EscapeText(message.Title), text)
+	}
+	if message.Link != "" {
+		text += "\n\n" + markdown.Link(models.OpenLinkTitle, message.Link)
+	}
+	return text
+}
+
+// messageDetails lists the level, origin, time and custom fields of the message, empty details are left out
+func messageDetails(message models.ChatMessage) []models.MessageField {
+	details := []models.MessageField{
+		{Name: "Level", Value: string(message.Level)},
+		{Name: "Origin", Value: message.Origin},
+		{Name: "Origin class", Value: message.OriginClass},
+		{Name: "Time", Value: message.Timestamp},
+	}
+
+	var fields []models.MessageField
+	for _, field := range append(details, message.Fields...) {
+		if field.Value != "" {
+			fields = append(fields, field)
+		}
+	}
+	return fields
+}
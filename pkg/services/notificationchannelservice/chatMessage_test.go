@@ -0,0 +1,37 @@
+// SPDX-FileCopyrightText: 2026 Greenbone AG <https://greenbone.net>
+//
+// SPDX-License-Identifier: AGPL-3.0-or-later
+
+package notificationchannelservice
+
+import (
+	"testing"
+
+	"github.com/greenbone/opensight-notification-service/pkg/models"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestChatMessageToMarkdown(t *testing.T) {
+	tests := map[string]struct {
+		message models.ChatMessage
+		want    string
+	}{
+		"text only": {
+			message: models.ChatMessage{Text: "Hello"},
+			want:    "Hello",
+		},
+		"title is escaped": {
+			message: models.ChatMessage{Title: "Package *foo_bar*", Text: "Details"},
+			want:    "**Package \\*foo\\_bar\\***\n\nDetails",
+		},
+		"link": {
+			message: models.ChatMessage{Title: "Title", Text: "Details", Link: "https://example.com/1"},
+			want:    "**Title**\n\nDetails\n\n[Open in OpenSight](https://example.com/1)",
+		},
+	}
+	for name, tt := range tests {
+		t.Run(name, func(t *testing.T) {
+			assert.Equal(t, tt.want, chatMessageToMarkdown(tt.message))
+		})
+	}
+}
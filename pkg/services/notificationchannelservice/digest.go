@@ -0,0 +1,45 @@
+// SPDX-FileCopyrightText: 2026 Greenbone AG <https://greenbone.net>
+//
+// SPDX-License-Identifier: AGPL-3.0-or-later
+
+package notificationchannelservice
+
+import (
+	"strings"
+
+	"github.com/greenbone/opensight-notification-service/pkg/markdown"
+	"github.com/greenbone/opensight-notification-service/pkg/models"
+)
+
+var markdownCellReplacer = strings.NewReplacer("|", `\|`, "\r\n", " ", "\n", " ")
+
+// digestToMarkdown renders the digest as markdown text with the notifications in a table
+func digestToMarkdown(digest models.DigestMessage) string {
+	var b strings.Builder
+	b.WriteString("#### " + digest.Title + "\n\n")
+	if digest.Summary != "" {
+		b.WriteString(digest.Summary + "\n\n")
+	}
+
+	writeRow := func(cells []string, lastCell string) {
+		b.WriteString("|")
+		for _, cell := range cells {
+			b.WriteString(" " + markdownCellReplacer.Replace(cell) + " |")
+		}
+		if len(digest.Links) > 0 {
+			b.WriteString(" " + lastCell + " |")
+		}
+		b.WriteString("\n")
+	}
+
+	columns := len(digest.Columns)
+	if len(digest.Links) > 0 {
+		columns++
+	}
+	writeRow(digest.Columns, models.DigestLinkColumn)
+	b.WriteString("|" + strings.Repeat(" --- |", columns) + "\n")
+	for i, row := range digest.Rows {
+		writeRow(row, markdown.Link(models.DigestLinkTitle, digest.Link(i)))
+	}
+	return b.String()
+}
@@ -0,0 +1,110 @@
+package notificationchannelservice
+
+import (
+	"context"
+	"errors"
+
+	"github.com/greenbone/opensight-notification-service/pkg/models"
+	"github.com/greenbone/opensight-notification-service/pkg/web/incidentcontroller/incidentdto"
+)
+
+var (
+	ErrIncidentChannelLimitReached  = errors.New("Incident channel limit reached.")
+	ErrListIncidentChannels         = errors.New("failed to list incident channels")
+	ErrIncidentChannelNameExists    = errors.New("Incident channel name already exists.")
+	ErrIncidentRoutingKeyIsRequired = errors.New("A routing key is required.")
+	ErrIncidentEventDelivery        = errors.New("incident event could not be send")
+)
+
+type IncidentChannelService interface {
+	CreateIncidentChannel(
+		ctx context.Context,
+		channel incidentdto.IncidentNotificationChannelRequest,
+	) (incidentdto.IncidentNotificationChannelResponse, error)
+	UpdateIncidentChannel(
+		ctx context.Context,
+		id string,
+		channel incidentdto.IncidentNotificationChannelRequest,
+	) (incidentdto.IncidentNotificationChannelResponse, error)
+}
+
+type incidentChannelService struct {
+	notificationChannelService NotificationChannelService
+	incidentChannelLimit       int
+}
+
+func NewIncidentChannelService(
+	notificationChannelService NotificationChannelService,
+	incidentChannelLimit int,
+) IncidentChannelService {
+	return &incidentChannelService{
+		notificationChannelService: notificationChannelService,
+		incidentChannelLimit:       incidentChannelLimit,
+	}
+}
+
+// CreateIncidentChannel creates the channel, unlike on update the routing key is required.
+func (i *incidentChannelService) CreateIncidentChannel(
+	ctx context.Context,
+	channel incidentdto.IncidentNotificationChannelRequest,
+) (incidentdto.IncidentNotificationChannelResponse, error) {
+	if channel.RoutingKey == nil || *channel.RoutingKey == "" {
+		return incidentdto.IncidentNotificationChannelResponse{}, ErrIncidentRoutingKeyIsRequired
+	}
+	if err := i.incidentChannelValidations(ctx, channel.ChannelName, ""); err != nil {
+		return incidentdto.IncidentNotificationChannelResponse{}, err
+	}
+
+	notificationChannel := incidentdto.MapIncidentToNotificationChannel(channel)
+	created, err := i.notificationChannelService.CreateNotificationChannel(ctx, notificationChannel)
+	if err != nil {
+		return incidentdto.IncidentNotificationChannelResponse{}, err
+	}
+
+	return incidentdto.MapNotificationChannelToIncident(created), nil
+}
+
+func (i *incidentChannelService) UpdateIncidentChannel(
+	ctx context.Context,
+	id string,
+	channel incidentdto.IncidentNotificationChannelRequest,
+) (incidentdto.IncidentNotificationChannelResponse, error) {
+	if err := i.incidentChannelValidations(ctx, channel.ChannelName, id); err != nil {
+		return incidentdto.IncidentNotificationChannelResponse{}, err
+	}
+
+	notificationChannel := incidentdto.MapIncidentToNotificationChannel(channel)
+	updated, err := i.notificationChannelService.UpdateNotificationChannel(ctx, id, notificationChannel)
+	if err != nil {
+		return incidentdto.IncidentNotificationChannelResponse{}, err
+	}
+
+	return incidentdto.MapNotificationChannelToIncident(updated), nil
+}
+
+func (i *incidentChannelService) incidentChannelValidations(
+	ctx context.Context,
+	channelName string,
+	excludeId string,
+) error {
+	channels, err := i.notificationChannelService.ListNotificationChannelsByType(ctx, models.ChannelTypeIncident)
+	if err != nil {
+		return errors.Join(ErrListIncidentChannels, err)
+	}
+
+	if len(channels) >= i.incidentChannelLimit {
+		return ErrIncidentChannelLimitReached
+	}
+
+	for _, ch := range channels {
+		if ch.Id == excludeId {
+			continue
+		}
+
+		if ch.ChannelName == channelName {
+			return ErrIncidentChannelNameExists
+		}
+	}
+
+	return nil
+}
@@ -0,0 +1,46 @@
+package notificationchannelservice
+
+import (
+	"context"
+	"testing"
+
+	"github.com/greenbone/opensight-notification-service/pkg/models"
+	"github.com/greenbone/opensight-notification-service/pkg/services/notificationchannelservice/mocks"
+	"github.com/greenbone/opensight-notification-service/pkg/web/incidentcontroller/incidentdto"
+	"github.com/stretchr/testify/require"
+)
+
+func TestIncidentChannelLimit(t *testing.T) {
+	notificationChannelService := mocks.NewNotificationChannelService(t)
+	notificationChannelService.EXPECT().ListNotificationChannelsByType(context.Background(), models.ChannelTypeIncident).
+		Return([]models.NotificationChannel{
+			{},
+		}, nil)
+
+	service := NewIncidentChannelService(notificationChannelService, 1)
+
+	_, err := service.CreateIncidentChannel(context.Background(), incidentdto.IncidentNotificationChannelRequest{
+		RoutingKey: new("R0UT1NGK3Y"),
+	})
+	require.ErrorIs(t, err, ErrIncidentChannelLimitReached)
+}
+
+func TestIncidentChannelRoutingKeyIsRequired(t *testing.T) {
+	service := NewIncidentChannelService(mocks.NewNotificationChannelService(t), 1)
+
+	_, err := service.CreateIncidentChannel(context.Background(), incidentdto.IncidentNotificationChannelRequest{})
+	require.ErrorIs(t, err, ErrIncidentRoutingKeyIsRequired)
+}
+
+func TestIncidentChannelNameExists(t *testing.T) {
+	notificationChannelService := mocks.NewNotificationChannelService(t)
+	notificationChannelService.EXPECT().ListNotificationChannelsByType(context.Background(), models.ChannelTypeIncident).
+		Return([]models.NotificationChannel{
+			{Id: "1", ChannelName: "on-call"},
+		}, nil)
+
+	service := NewIncidentChannelService(notificationChannelService, 5)
+
+	_, err := service.UpdateIncidentChannel(context.Background(), "2", incidentdto.IncidentNotificationChannelRequest{ChannelName: "on-call"})
+	require.ErrorIs(t, err, ErrIncidentChannelNameExists)
+}
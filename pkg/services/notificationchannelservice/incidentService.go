@@ -0,0 +1,121 @@
+// SPDX-FileCopyrightText: 2026 Greenbone AG <https://greenbone.net>
+//
+// SPDX-License-Identifier: AGPL-3.0-or-later
+
+package notificationchannelservice
+
+import (
+	"bytes"
+	"context"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"net/http"
+
+	"github.com/greenbone/opensight-golang-libraries/pkg/notifications"
+	"github.com/greenbone/opensight-notification-service/pkg/helper"
+	"github.com/greenbone/opensight-notification-service/pkg/markdown"
+	"github.com/greenbone/opensight-notification-service/pkg/models"
+)
+
+// limits of the events API, longer summaries are rejected
+const incidentMaxSummaryLength = 1024
+
+// IncidentService opens and resolves incidents in on-call tools via an events API compatible with
+// the PagerDuty Events API v2. For details see:
+// https://developer.pagerduty.com/docs/events-api-v2/overview
+type IncidentService struct {
+	transport *http.Client
+}
+
+func NewIncidentService(transport *http.Client) *IncidentService {
+	return &IncidentService{transport: transport}
+}
+
+// Trigger opens the incident with the dedup key, or adds the message to it if it is still open.
+// The level is mapped to the severity of the incident, the text and fields are sent as details.
+func (i *IncidentService) Trigger(channel models.NotificationChannel, dedupKey string, message models.ChatMessage) error {
+	details := map[string]string{}
+	if text := markdown.ToText(message.Text); text != "" {
+		details["detail"] = text
+	}
+	for _, field := range message.Fields {
+		details[field.Name] = field.Value
+	}
+
+	payload := map[string]any{
+		"summary":        truncate(chatMessageFallback(message), incidentMaxSummaryLength),
+		"source":         message.Origin,
+		"severity":       incidentSeverity(message.Level),
+		"class":          message.OriginClass,
+		"custom_details": details,
+	}
+	if message.Timestamp != "" {
+		payload["timestamp"] = message.Timestamp
+	}
+
+	event := map[string]any{
+		"routing_key":  helper.SafeDereference(channel.RoutingKey),
+		"event_action": models.EventActionTrigger,
+		"dedup_key":    dedupKey,
+		"payload":      payload,
+		"client":       chatMessageFooter,
+	}
+	if message.Link != "" {
+		event["links"] = []map[string]string{{"href": message.Link, "text": models.OpenLinkTitle}}
+	}
+	return i.post(channel, event)
+}
+
+// Resolve resolves the incident with the dedup key, the events API ignores keys without open incident.
+func (i *IncidentService) Resolve(channel models.NotificationChannel, dedupKey string) error {
+	return i.post(channel, map[string]any{
+		"routing_key":  helper.SafeDereference(channel.RoutingKey),
+		"event_action": models.EventActionResolve,
+		"dedup_key":    dedupKey,
+	})
+}
+
+// incidentSeverity maps the level to the severity of the events API
+func incidentSeverity(level notifications.Level) string {
+	switch level {
+	case notifications.LevelUrgent:
+		return "critical"
+	case notifications.LevelError:
+		return "error"
+	case notifications.LevelWarning:
+		return "warning"
+	default:
+		return "info"
+	}
+}
+
+func (i *IncidentService) post(channel models.NotificationChannel, event map[string]any) error {
+	body, err := json.Marshal(event)
+	if err != nil {
+		return fmt.Errorf("can not marshal incident event: %w", err)
+	}
+
+	url := helper.SafeDereference(channel.WebhookUrl)
+	if url == "" {
+		url = models.DefaultEventsUrl
+	}
+
+	resp, err := i.transport.Post(url, "application/json", bytes.NewBuffer(body))
+	if err != nil {
+		if errors.Is(err, context.DeadlineExceeded) {
+			return fmt.Errorf("%w: timeout", ErrIncidentEventDelivery)
+		}
+		return fmt.Errorf("%w: %w", ErrIncidentEventDelivery, err)
+	}
+
+	defer func() {
+		_ = resp.Body.Close()
+	}()
+
+	if resp.StatusCode < 200 || resp.StatusCode >= 300 {
+		return fmt.Errorf("%w: http status: %s", ErrIncidentEventDelivery, resp.Status)
+	}
+
+	return nil
+}
@@ -0,0 +1,99 @@
+// SPDX-FileCopyrightText: 2026 Greenbone AG <https://greenbone.net>
+//
+// SPDX-License-Identifier: AGPL-3.0-or-later
+
+package notificationchannelservice
+
+import (
+	"encoding/json"
+	"net/http"
+	"testing"
+
+	"github.com/greenbone/opensight-golang-libraries/pkg/notifications"
+	"github.com/greenbone/opensight-notification-service/pkg/models"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestTriggerIncident(t *testing.T) {
+	var gotRequest *http.Request
+	var gotBody []byte
+	svc := NewIncidentService(recordingClient(t, http.StatusAccepted, &gotRequest, &gotBody))
+
+	err := svc.Trigger(models.NotificationChannel{RoutingKey: new("R0UT1NGK3Y")}, "/vi/SBOM/react", models.ChatMessage{
+		Title:       "New vulnerability",
+		Text:        "A **critical** vulnerability was found.",
+		Level:       notifications.LevelUrgent,
+		Origin:      "SBOM - React",
+		OriginClass: "/vi/SBOM",
+		Timestamp:   "2026-01-01T12:00:00Z",
+		Link:        "https://opensight.example.com/vi/sbom/react",
+		Fields:      []models.MessageField{{Name: "host", Value: "10.0.0.1"}},
+	})
+	require.NoError(t, err)
+
+	assert.Equal(t, http.MethodPost, gotRequest.Method)
+	assert.Equal(t, models.DefaultEventsUrl, gotRequest.URL.String(), "without URL the PagerDuty events API is used")
+	assert.Equal(t, "application/json", gotRequest.Header.Get("Content-Type"))
+	assert.JSONEq(t, `{
+		"routing_key": "R0UT1NGK3Y",
+		"event_action": "trigger",
+		"dedup_key": "/vi/SBOM/react",
+		"client": "Greenbone OpenSight",
+		"links": [{"href": "https://opensight.example.com/vi/sbom/react", "text": "Open in OpenSight"}],
+		"payload": {
+			"summary": "New vulnerability",
+			"source": "SBOM - React",
+			"severity": "critical",
+			"class": "/vi/SBOM",
+			"timestamp": "2026-01-01T12:00:00Z",
+			"custom_details": {
+				"detail": "A critical vulnerability was found.",
+				"host": "10.0.0.1"
+			}
+		}
+	}`, string(gotBody))
+}
+
+func TestResolveIncident(t *testing.T) {
+	var gotRequest *http.Request
+	var gotBody []byte
+	svc := NewIncidentService(recordingClient(t, http.StatusAccepted, &gotRequest, &gotBody))
+
+	err := svc.Resolve(models.NotificationChannel{
+		WebhookUrl: new("https://alerts.example.com/v2/enqueue"),
+		RoutingKey: new("R0UT1NGK3Y"),
+	}, "/vi/SBOM/react")
+	require.NoError(t, err)
+
+	assert.Equal(t, "https://alerts.example.com/v2/enqueue", gotRequest.URL.String())
+
+	var got map[string]any
+	require.NoError(t, json.Unmarshal(gotBody, &got))
+	assert.Equal(t, map[string]any{
+		"routing_key":  "R0UT1NGK3Y",
+		"event_action": "resolve",
+		"dedup_key":    "/vi/SBOM/react",
+	}, got)
+}
+
+func TestTriggerIncident_Rejected(t *testing.T) {
+	var gotRequest *http.Request
+	var gotBody []byte
+	svc := NewIncidentService(recordingClient(t, http.StatusBadRequest, &gotRequest, &gotBody))
+
+	err := svc.Trigger(models.NotificationChannel{RoutingKey: new("invalid")}, "/vi/SBOM/react", models.ChatMessage{Title: "test"})
+	require.ErrorIs(t, err, ErrIncidentEventDelivery)
+}
+
+func TestIncidentSeverity(t *testing.T) {
+	tests := map[notifications.Level]string{
+		notifications.LevelUrgent:  "critical",
+		notifications.LevelError:   "error",
+		notifications.LevelWarning: "warning",
+		notifications.LevelInfo:    "info",
+	}
+	for level, want := range tests {
+		assert.Equal(t, want, incidentSeverity(level), level)
+	}
+}
@@ -4,6 +4,7 @@ import (
 	"context"
 	"errors"
 
+	"github.com/greenbone/opensight-notification-service/pkg/helper"
 	"github.com/greenbone/opensight-notification-service/pkg/models"
 	"github.com/greenbone/opensight-notification-service/pkg/web/mailcontroller/maildto"
 )
@@ -88,6 +89,11 @@ func (m *mailChannelService) CheckNotificationChannelEntityConnectivity(
 		}
 	}
 
+	// like the password, the stored client secret is used if none is given
+	if helper.SafeDereference(mailServer.OAuthClientSecret) == "" && channel.OAuthClientSecret != nil {
+		mailServer.OAuthClientSecret = channel.OAuthClientSecret
+	}
+
 	return m.mailService.ConnectionCheck(ctx, mailServer)
 }
 
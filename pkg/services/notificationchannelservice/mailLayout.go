@@ -0,0 +1,125 @@
+// SPDX-FileCopyrightText: 2026 Greenbone AG <https://greenbone.net>
+//
+// SPDX-License-Identifier: AGPL-3.0-or-later
+
+package notificationchannelservice
+
+import (
+	"bytes"
+	_ "embed"
+	"fmt"
+	"html/template"
+	"os"
+	"strings"
+
+	"github.com/greenbone/opensight-golang-libraries/pkg/notifications"
+	"github.com/greenbone/opensight-notification-service/pkg/models"
+)
+
+//go:embed mailLayout.html
+var defaultMailLayout string
+
+// MailLayoutConfig configures the branded layout of mails.
+type MailLayoutConfig struct {
+	TemplateFile string // html/template file replacing the built-in layout, optional
+	Header       string
+	Footer       string
+	LogoUrl      string
+}
+
+// MailLayout wraps mails into a branded layout with header, footer and a badge for origin and level.
+type MailLayout struct {
+	config   MailLayoutConfig
+	template *template.Template
+}
+
+// MailLayoutData is passed to the layout template.
+type MailLayoutData struct {
+	Header     string
+	Footer     string
+	LogoUrl    string
+	Subject    string
+	Body       template.HTML // the sanitized HTML part of the message
+	Origin     string
+	Level      string
+	LevelColor string
+}
+
+// NewMailLayout parses the layout template, either the configured file or the built-in layout.
+// The template is rendered once with a sample message, so errors show up at startup and not when sending mails.
+func NewMailLayout(config MailLayoutConfig) (*MailLayout, error) {
+	layout := defaultMailLayout
+	if config.TemplateFile != "" {
+		content, err := os.ReadFile(config.TemplateFile)
+		if err != nil {
+			return nil, fmt.Errorf("failed to read mail layout: %w", err)
+		}
+		layout = string(content)
+	}
+
+	tmpl, err := template.New("mailLayout").Option("missingkey=error").Parse(layout)
+	if err != nil {
+		return nil, fmt.Errorf("failed to parse mail layout: %w", err)
+	}
+
+	mailLayout := &MailLayout{config: config, template: tmpl}
+	_, _, err = mailLayout.Render(models.MailMessage{
+		Subject:  "Sample",
+		HTMLBody: "<p>Sample</p>",
+		TextBody: "Sample",
+		Origin:   "Sample",
+		Level:    notifications.LevelInfo,
+	})
+	if err != nil {
+		return nil, fmt.Errorf("failed to render mail layout: %w", err)
+	}
+	return mailLayout, nil
+}
+
+// Render returns the HTML and the plain text part of the message within the layout.
+func (l *MailLayout) Render(message models.MailMessage) (htmlBody string, textBody string, err error) {
+	var buf bytes.Buffer
+	err = l.template.Execute(&buf, MailLayoutData{
+		Header:     l.config.Header,
+		Footer:     l.config.Footer,
+		LogoUrl:    l.config.LogoUrl,
+		Subject:    message.Subject,
+		Body:       template.HTML(message.HTMLBody), // the body is sanitized already
+		Origin:     message.Origin,
+		Level:      string(message.Level),
+		LevelColor: models.LevelColor(message.Level),
+	})
+	if err != nil {
+		return "", "", err
+	}
+
+	var text strings.Builder
+	if l.config.Header != "" {
+		text.WriteString(l.config.Header + "\n\n")
+	}
+	if badge := textBadge(message); badge != "" {
+		text.WriteString(badge + "\n\n")
+	}
+	text.WriteString(strings.TrimRight(message.TextBody, "\n"))
+	if l.config.Footer != "" {
+		text.WriteString("\n\n-- \n" + l.config.Footer)
+	}
+	text.WriteString("\n")
+
+	return buf.String(), text.String(), nil
+}
+
+// textBadge is the plain text equivalent of the badge in the HTML layout
+func textBadge(message models.MailMessage) string {
+	var parts []string
+	if message.Level != "" {
+		parts = append(parts, strings.ToUpper(string(message.Level)))
+	}
+	if message.Origin != "" {
+		parts = append(parts, message.Origin)
+	}
+	if len(parts) == 0 {
+		return ""
+	}
+	return "[" + strings.Join(parts, "] [") + "]"
+}
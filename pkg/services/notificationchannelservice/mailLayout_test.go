@@ -0,0 +1,80 @@
+// SPDX-FileCopyrightText: 2026 Greenbone AG <https://greenbone.net>
+//
+// SPDX-License-Identifier: AGPL-3.0-or-later
+
+package notificationchannelservice
+
+import (
+	"os"
+	"path/filepath"
+	"testing"
+
+	"github.com/greenbone/opensight-golang-libraries/pkg/notifications"
+	"github.com/greenbone/opensight-notification-service/pkg/models"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestMailLayout_Render(t *testing.T) {
+	layout, err := NewMailLayout(MailLayoutConfig{
+		Header:  "Greenbone OpenSight",
+		Footer:  "Sent by the notification service",
+		LogoUrl: "https://example.com/logo.png",
+	})
+	require.NoError(t, err)
+
+	htmlBody, textBody, err := layout.Render(models.MailMessage{
+		Subject:  "New <vulnerability>",
+		HTMLBody: "<p>Details</p>",
+		TextBody: "Details",
+		Origin:   "SBOM - React",
+		Level:    notifications.LevelError,
+	})
+	require.NoError(t, err)
+
+	assert.Contains(t, htmlBody, "Greenbone OpenSight")
+	assert.Contains(t, htmlBody, `<img src="https://example.com/logo.png"`)
+	assert.Contains(t, htmlBody, "New &lt;vulnerability&gt;", "the subject must be escaped")
+	assert.Contains(t, htmlBody, "<p>Details</p>", "the sanitized body must be kept")
+	assert.Contains(t, htmlBody, "background-color:"+models.LevelColor(notifications.LevelError))
+	assert.Contains(t, htmlBody, "SBOM - React")
+	assert.Contains(t, htmlBody, "Sent by the notification service")
+
+	assert.Equal(t, "Greenbone OpenSight\n\n[ERROR] [SBOM - React]\n\nDetails\n\n-- \nSent by the notification service\n", textBody)
+}
+
+func TestNewMailLayout_TemplateFile(t *testing.T) {
+	tests := map[string]struct {
+		layout  string
+		wantErr bool
+	}{
+		"valid": {
+			layout: "<h1>{{.Header}}</h1>{{.Body}}",
+		},
+		"syntax error": {
+			layout:  "{{.Header",
+			wantErr: true,
+		},
+		"unknown field": {
+			layout:  "{{.Unknown}}",
+			wantErr: true,
+		},
+	}
+	for name, tt := range tests {
+		t.Run(name, func(t *testing.T) {
+			file := filepath.Join(t.TempDir(), "layout.html")
+			require.NoError(t, os.WriteFile(file, []byte(tt.layout), 0o600))
+
+			layout, err := NewMailLayout(MailLayoutConfig{TemplateFile: file, Header: "Header"})
+			if tt.wantErr {
+				require.Error(t, err)
+				return
+			}
+			require.NoError(t, err)
+
+			htmlBody, _, err := layout.Render(models.MailMessage{HTMLBody: "<p>Details</p>"})
+			require.NoError(t, err)
+			assert.Equal(t, "<h1>Header</h1><p>Details</p>", htmlBody)
+		})
+	}
+}
@@ -3,9 +3,10 @@ package notificationchannelservice
 import (
 	"context"
 	"errors"
-	"strings"
+	"net/http"
 	"time"
 
+	"github.com/greenbone/opensight-notification-service/pkg/helper"
 	"github.com/greenbone/opensight-notification-service/pkg/models"
 	"github.com/wneessen/go-mail"
 )
@@ -18,38 +19,41 @@ var (
 )
 
 type MailService interface {
-	// SendMail sends an HTML email
+	// SendMail sends a multipart/alternative email with an HTML and a plain text part
 	SendMail(
 		ctx context.Context,
 		mailServer models.NotificationChannel,
 		receiver string,
-		subject string,
-		body string,
+		content models.MailMessage,
 	) error
 
-	// ConnectionCheck checks connection for host, port and TLS settings
+	// ConnectionCheck checks connection for host, port, TLS and authentication settings
 	ConnectionCheck(ctx context.Context, mailServer models.NotificationChannel) error
 }
 
 type mailService struct {
+	layout *MailLayout
+	tokens *OAuthTokenCache
 }
 
-func NewMailService() MailService {
-	return &mailService{}
+// NewMailService creates the mail service. If a layout is given, mails are wrapped into it,
+// otherwise they contain only the message. The access tokens of channels authenticating with
+// XOAUTH2 are requested with the given transport.
+func NewMailService(layout *MailLayout, transport *http.Client) MailService {
+	return &mailService{layout: layout, tokens: NewOAuthTokenCache(transport)}
 }
 
 // SendMail sends an email to the given receiver.
-// The message has to be in HTML format.
+// The HTML body of the content is sent as it is, so it has to be escaped already.
 func (m *mailService) SendMail(
 	ctx context.Context,
 	mailServer models.NotificationChannel,
 	receiver string,
-	subject string,
-	body string,
+	content models.MailMessage,
 ) error {
-	client, err := m.createClient(mailServer)
+	client, err := m.createClient(ctx, mailServer)
 	if err != nil {
-		return errors.Join(err, ErrCreateMailClient)
+		return err
 	}
 
 	defer func() {
@@ -63,9 +67,13 @@ func (m *mailService) SendMail(
 	if err := message.To(receiver); err != nil {
 		return errors.Join(err, ErrCreatingMailMessage)
 	}
-	body = strings.ReplaceAll(body, "\n", "<br>") // Convert newlines to HTML line breaks
-	message.Subject(subject)
-	message.SetBodyString(mail.TypeTextHTML, body)
+	htmlBody, textBody, err := m.render(content)
+	if err != nil {
+		return errors.Join(err, ErrCreatingMailMessage)
+	}
+	message.Subject(content.Subject)
+	message.SetBodyString(mail.TypeTextPlain, textBody)
+	message.AddAlternativeString(mail.TypeTextHTML, htmlBody)
 
 	err = client.DialAndSendWithContext(ctx, message)
 	if err != nil {
@@ -75,10 +83,18 @@ func (m *mailService) SendMail(
 	return nil
 }
 
+// render wraps the content into the layout, if there is one
+func (m *mailService) render(content models.MailMessage) (htmlBody string, textBody string, err error) {
+	if m.layout == nil {
+		return content.HTMLBody, content.TextBody, nil
+	}
+	return m.layout.Render(content)
+}
+
 func (m *mailService) ConnectionCheck(ctx context.Context, mailServer models.NotificationChannel) error {
-	client, err := m.createClient(mailServer)
+	client, err := m.createClient(ctx, mailServer)
 	if err != nil {
-		return errors.Join(err, ErrCreateMailClient)
+		return err
 	}
 
 	defer func() {
@@ -92,23 +108,43 @@ func (m *mailService) ConnectionCheck(ctx context.Context, mailServer models.Not
 	return nil
 }
 
-func (m *mailService) createClient(mailServer models.NotificationChannel) (*mail.Client, error) {
+// createClient creates the client for the mail server. With XOAUTH2 the access token is requested
+// before, errors of the token endpoint are returned as ErrMailOAuthToken.
+func (m *mailService) createClient(ctx context.Context, mailServer models.NotificationChannel) (*mail.Client, error) {
 	options := []mail.Option{
 		mail.WithPort(*mailServer.Port),
 		mail.WithTimeout(5 * time.Second),
 	}
 
-	if mailServer.IsTlsEnforced != nil && *mailServer.IsTlsEnforced {
-		options = append(options, mail.WithSSL())
+	tlsOptions, err := mailTlsOptions(mailServer)
+	if err != nil {
+		return nil, errors.Join(err, ErrCreateMailClient)
 	}
+	options = append(options, tlsOptions...)
 
 	if mailServer.IsAuthenticationRequired != nil && *mailServer.IsAuthenticationRequired {
-		options = append(options, mail.WithUsername(*mailServer.Username), mail.WithPassword(*mailServer.Password))
+		switch helper.SafeDereference(mailServer.MailAuthMethod) {
+		case models.MailAuthXOAuth2:
+			token, err := m.tokens.Token(ctx, mailServer)
+			if err != nil {
+				return nil, err
+			}
+			options = append(options,
+				mail.WithSMTPAuth(mail.SMTPAuthXOAUTH2),
+				mail.WithUsername(helper.SafeDereference(mailServer.Username)),
+				mail.WithPassword(token),
+			)
+		default:
+			options = append(options, mail.WithUsername(*mailServer.Username), mail.WithPassword(*mailServer.Password))
+		}
 	}
 
 	client, err := mail.NewClient(
 		*mailServer.Domain,
 		options...,
 	)
-	return client, err
+	if err != nil {
+		return nil, errors.Join(err, ErrCreateMailClient)
+	}
+	return client, nil
 }
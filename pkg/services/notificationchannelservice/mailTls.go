@@ -0,0 +1,86 @@
+// SPDX-FileCopyrightText: 2026 Greenbone AG <https://greenbone.net>
+//
+// SPDX-License-Identifier: AGPL-3.0-or-later
+
+package notificationchannelservice
+
+import (
+	"crypto/sha256"
+	"crypto/tls"
+	"encoding/hex"
+	"errors"
+	"fmt"
+
+	"github.com/greenbone/opensight-notification-service/pkg/helper"
+	"github.com/greenbone/opensight-notification-service/pkg/models"
+	"github.com/greenbone/opensight-notification-service/pkg/policy"
+	"github.com/wneessen/go-mail"
+)
+
+var ErrMailCertFingerprintMismatch = errors.New("certificate of the mail server does not match the pinned fingerprint")
+
+var mailTlsVersions = map[string]uint16{
+	models.MailTlsVersion10: tls.VersionTLS10,
+	models.MailTlsVersion11: tls.VersionTLS11,
+	models.MailTlsVersion12: tls.VersionTLS12,
+	models.MailTlsVersion13: tls.VersionTLS13,
+}
+
+// mailTlsOptions returns the client options of the TLS mode of the mail channel. Channels without a mode
+// require STARTTLS, which is the default of the mail client.
+func mailTlsOptions(mailServer models.NotificationChannel) ([]mail.Option, error) {
+	tlsConfig, err := mailTlsConfig(mailServer)
+	if err != nil {
+		return nil, err
+	}
+
+	options := []mail.Option{mail.WithTLSConfig(tlsConfig)}
+	switch helper.SafeDereference(mailServer.MailTlsMode) {
+	case models.MailTlsImplicit:
+		options = append(options, mail.WithSSL())
+	case models.MailTlsOpportunistic:
+		options = append(options, mail.WithTLSPolicy(mail.TLSOpportunistic))
+	case models.MailTlsNone:
+		options = append(options, mail.WithTLSPolicy(mail.NoTLS))
+	default:
+		options = append(options, mail.WithTLSPolicy(mail.TLSMandatory))
+	}
+	return options, nil
+}
+
+// mailTlsConfig returns the TLS config with the minimum version, the CA certificates trusted instead of the
+// system ones and the pinned certificate. The certificate chain is verified in any case, the pinned
+// fingerprint is checked in addition.
+func mailTlsConfig(mailServer models.NotificationChannel) (*tls.Config, error) {
+	minVersion, ok := mailTlsVersions[helper.SafeDereference(mailServer.MailTlsMinVersion)]
+	if !ok {
+		minVersion = tls.VersionTLS12
+	}
+
+	tlsConfig := &tls.Config{
+		ServerName: helper.SafeDereference(mailServer.Domain),
+		MinVersion: minVersion,
+	}
+
+	if caCertificate := helper.SafeDereference(mailServer.MailCaCertificate); caCertificate != "" {
+		pool, err := policy.CaCertificatePolicy(caCertificate)
+		if err != nil {
+			return nil, fmt.Errorf("invalid CA certificates: %w", err)
+		}
+		tlsConfig.RootCAs = pool
+	}
+
+	if fingerprint := helper.SafeDereference(mailServer.MailCertFingerprint); fingerprint != "" {
+		tlsConfig.VerifyConnection = func(state tls.ConnectionState) error {
+			if len(state.PeerCertificates) == 0 {
+				return ErrMailCertFingerprintMismatch
+			}
+			hash := sha256.Sum256(state.PeerCertificates[0].Raw)
+			if hex.EncodeToString(hash[:]) != fingerprint {
+				return ErrMailCertFingerprintMismatch
+			}
+			return nil
+		}
+	}
+	return tlsConfig, nil
+}
@@ -0,0 +1,94 @@
+// SPDX-FileCopyrightText: 2026 Greenbone AG <https://greenbone.net>
+//
+// SPDX-License-Identifier: AGPL-3.0-or-later
+
+package notificationchannelservice
+
+import (
+	"crypto/sha256"
+	"crypto/tls"
+	"crypto/x509"
+	"encoding/hex"
+	"encoding/pem"
+	"net/http/httptest"
+	"strings"
+	"testing"
+
+	"github.com/greenbone/opensight-notification-service/pkg/models"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+// dialMailTls connects to the TLS server with the TLS config of the mail channel
+func dialMailTls(t *testing.T, server *httptest.Server, channel models.NotificationChannel) error {
+	tlsConfig, err := mailTlsConfig(channel)
+	require.NoError(t, err)
+
+	conn, err := tls.Dial("tcp", server.Listener.Addr().String(), tlsConfig)
+	if err != nil {
+		return err
+	}
+	return conn.Close()
+}
+
+func testTlsMailChannel(server *httptest.Server) models.NotificationChannel {
+	caCertificate := pem.EncodeToMemory(&pem.Block{Type: "CERTIFICATE", Bytes: server.Certificate().Raw})
+	return models.NotificationChannel{
+		Domain:            new("127.0.0.1"),
+		MailTlsMode:       new(models.MailTlsImplicit),
+		MailCaCertificate: new(string(caCertificate)),
+	}
+}
+
+func TestMailTlsConfig_Defaults(t *testing.T) {
+	tlsConfig, err := mailTlsConfig(models.NotificationChannel{Domain: new("mail.example.com")})
+	require.NoError(t, err)
+
+	assert.Equal(t, "mail.example.com", tlsConfig.ServerName)
+	assert.Equal(t, uint16(tls.VersionTLS12), tlsConfig.MinVersion)
+	assert.Nil(t, tlsConfig.RootCAs)
+	assert.Nil(t, tlsConfig.VerifyConnection)
+}
+
+func TestMailTlsConfig_MinVersion(t *testing.T) {
+	tlsConfig, err := mailTlsConfig(models.NotificationChannel{MailTlsMinVersion: new(models.MailTlsVersion13)})
+	require.NoError(t, err)
+
+	assert.Equal(t, uint16(tls.VersionTLS13), tlsConfig.MinVersion)
+}
+
+func TestMailTlsConfig_InvalidCaCertificate(t *testing.T) {
+	_, err := mailTlsConfig(models.NotificationChannel{MailCaCertificate: new("not a certificate")})
+
+	require.Error(t, err)
+}
+
+func TestMailTlsConfig_CaCertificate(t *testing.T) {
+	server := httptest.NewTLSServer(nil)
+	defer server.Close()
+
+	err := dialMailTls(t, server, testTlsMailChannel(server))
+	require.NoError(t, err)
+
+	// the certificate of the test server isn't trusted by the system
+	withoutCa := testTlsMailChannel(server)
+	withoutCa.MailCaCertificate = nil
+	err = dialMailTls(t, server, withoutCa)
+	var unknownAuthority x509.UnknownAuthorityError
+	assert.ErrorAs(t, err, &unknownAuthority)
+}
+
+func TestMailTlsConfig_PinnedCertificate(t *testing.T) {
+	server := httptest.NewTLSServer(nil)
+	defer server.Close()
+
+	hash := sha256.Sum256(server.Certificate().Raw)
+	channel := testTlsMailChannel(server)
+	channel.MailCertFingerprint = new(hex.EncodeToString(hash[:]))
+	err := dialMailTls(t, server, channel)
+	require.NoError(t, err)
+
+	channel.MailCertFingerprint = new(strings.Repeat("0", 64))
+	err = dialMailTls(t, server, channel)
+	assert.ErrorIs(t, err, ErrMailCertFingerprintMismatch)
+}
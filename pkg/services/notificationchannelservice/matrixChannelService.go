@@ -0,0 +1,129 @@
+package notificationchannelservice
+
+import (
+	"context"
+	"errors"
+
+	"github.com/google/uuid"
+	"github.com/greenbone/opensight-golang-libraries/pkg/notifications"
+	"github.com/greenbone/opensight-notification-service/pkg/helper"
+	"github.com/greenbone/opensight-notification-service/pkg/models"
+	"github.com/greenbone/opensight-notification-service/pkg/web/matrixcontroller/matrixdto"
+)
+
+var (
+	ErrMatrixChannelLimitReached   = errors.New("Matrix channel limit reached.")
+	ErrListMatrixChannels          = errors.New("failed to list matrix channels")
+	ErrMatrixChannelNameExists     = errors.New("Matrix channel name already exists.")
+	ErrMatrixAccessTokenIsRequired = errors.New("An access token is required.")
+	ErrMatrixMessageDelivery       = errors.New("matrix message could not be send")
+)
+
+type MatrixChannelService interface {
+	SendMatrixTestMessage(channel models.NotificationChannel) error
+	CreateMatrixChannel(
+		ctx context.Context,
+		channel matrixdto.MatrixNotificationChannelRequest,
+	) (matrixdto.MatrixNotificationChannelResponse, error)
+	UpdateMatrixChannel(
+		ctx context.Context,
+		id string,
+		channel matrixdto.MatrixNotificationChannelRequest,
+	) (matrixdto.MatrixNotificationChannelResponse, error)
+}
+
+type matrixChannelService struct {
+	notificationChannelService NotificationChannelService
+	matrixChannelLimit         int
+	matrixService              *MatrixService
+}
+
+func NewMatrixChannelService(
+	notificationChannelService NotificationChannelService,
+	matrixChannelLimit int,
+	matrixService *MatrixService,
+) MatrixChannelService {
+	return &matrixChannelService{
+		notificationChannelService: notificationChannelService,
+		matrixChannelLimit:         matrixChannelLimit,
+		matrixService:              matrixService,
+	}
+}
+
+func (m *matrixChannelService) SendMatrixTestMessage(channel models.NotificationChannel) error {
+	return m.matrixService.SendMessage(channel, uuid.NewString(), models.ChatMessage{
+		Title: "Test message",
+		Text:  "Hello, This is a test message",
+		Level: notifications.LevelInfo,
+	})
+}
+
+func (m *matrixChannelService) CreateMatrixChannel(
+	ctx context.Context,
+	channel matrixdto.MatrixNotificationChannelRequest,
+) (matrixdto.MatrixNotificationChannelResponse, error) {
+	if err := m.matrixChannelValidations(ctx, channel, ""); err != nil {
+		return matrixdto.MatrixNotificationChannelResponse{}, err
+	}
+
+	notificationChannel := matrixdto.MapMatrixToNotificationChannel(channel)
+	created, err := m.notificationChannelService.CreateNotificationChannel(ctx, notificationChannel)
+	if err != nil {
+		return matrixdto.MatrixNotificationChannelResponse{}, err
+	}
+
+	return matrixdto.MapNotificationChannelToMatrix(created), nil
+}
+
+func (m *matrixChannelService) UpdateMatrixChannel(
+	ctx context.Context,
+	id string,
+	channel matrixdto.MatrixNotificationChannelRequest,
+) (matrixdto.MatrixNotificationChannelResponse, error) {
+	if err := m.matrixChannelValidations(ctx, channel, id); err != nil {
+		return matrixdto.MatrixNotificationChannelResponse{}, err
+	}
+
+	notificationChannel := matrixdto.MapMatrixToNotificationChannel(channel)
+	updated, err := m.notificationChannelService.UpdateNotificationChannel(ctx, id, notificationChannel)
+	if err != nil {
+		return matrixdto.MatrixNotificationChannelResponse{}, err
+	}
+
+	return matrixdto.MapNotificationChannelToMatrix(updated), nil
+}
+
+// matrixChannelValidations checks the limit and the name of the channel. An access token is required,
+// on update the stored token is kept if none is given.
+func (m *matrixChannelService) matrixChannelValidations(
+	ctx context.Context,
+	channel matrixdto.MatrixNotificationChannelRequest,
+	excludeId string,
+) error {
+	channels, err := m.notificationChannelService.ListNotificationChannelsByType(ctx, models.ChannelTypeMatrix)
+	if err != nil {
+		return errors.Join(ErrListMatrixChannels, err)
+	}
+
+	if len(channels) >= m.matrixChannelLimit {
+		return ErrMatrixChannelLimitReached
+	}
+
+	hasToken := helper.SafeDereference(channel.AccessToken) != ""
+	for _, ch := range channels {
+		if ch.Id == excludeId {
+			hasToken = hasToken || (channel.AccessToken == nil && helper.SafeDereference(ch.AccessToken) != "")
+			continue
+		}
+
+		if ch.ChannelName == channel.ChannelName {
+			return ErrMatrixChannelNameExists
+		}
+	}
+
+	if !hasToken {
+		return ErrMatrixAccessTokenIsRequired
+	}
+
+	return nil
+}
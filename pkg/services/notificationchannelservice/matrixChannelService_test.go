@@ -0,0 +1,69 @@
+package notificationchannelservice
+
+import (
+	"context"
+	"testing"
+
+	"github.com/greenbone/opensight-notification-service/pkg/models"
+	"github.com/greenbone/opensight-notification-service/pkg/services/notificationchannelservice/mocks"
+	"github.com/greenbone/opensight-notification-service/pkg/web/matrixcontroller/matrixdto"
+	"github.com/stretchr/testify/require"
+)
+
+func TestMatrixChannelLimit(t *testing.T) {
+	notificationChannelService := mocks.NewNotificationChannelService(t)
+	notificationChannelService.EXPECT().ListNotificationChannelsByType(context.Background(), models.ChannelTypeMatrix).
+		Return([]models.NotificationChannel{
+			{},
+		}, nil)
+
+	service := NewMatrixChannelService(notificationChannelService, 1, NewMatrixService(nil))
+
+	_, err := service.CreateMatrixChannel(context.Background(), matrixdto.MatrixNotificationChannelRequest{})
+	require.ErrorIs(t, err, ErrMatrixChannelLimitReached)
+}
+
+func TestMatrixChannelNameExists(t *testing.T) {
+	notificationChannelService := mocks.NewNotificationChannelService(t)
+	notificationChannelService.EXPECT().ListNotificationChannelsByType(context.Background(), models.ChannelTypeMatrix).
+		Return([]models.NotificationChannel{
+			{Id: "1", ChannelName: "alerts"},
+		}, nil)
+
+	service := NewMatrixChannelService(notificationChannelService, 5, NewMatrixService(nil))
+
+	_, err := service.UpdateMatrixChannel(context.Background(), "2", matrixdto.MatrixNotificationChannelRequest{ChannelName: "alerts"})
+	require.ErrorIs(t, err, ErrMatrixChannelNameExists)
+}
+
+func TestMatrixChannelAccessToken(t *testing.T) {
+	stored := []models.NotificationChannel{
+		{Id: "1", ChannelName: "alerts", AccessToken: new("syt_abc")},
+	}
+
+	tests := map[string]struct {
+		id          string
+		accessToken *string
+		wantErr     error
+	}{
+		"create without token":            {accessToken: nil, wantErr: ErrMatrixAccessTokenIsRequired},
+		"create with token":               {accessToken: new("syt_abc")},
+		"update keeps the stored token":   {id: "1", accessToken: nil},
+		"update removing the token fails": {id: "1", accessToken: new(""), wantErr: ErrMatrixAccessTokenIsRequired},
+	}
+
+	for name, tt := range tests {
+		t.Run(name, func(t *testing.T) {
+			notificationChannelService := mocks.NewNotificationChannelService(t)
+			notificationChannelService.EXPECT().ListNotificationChannelsByType(context.Background(), models.ChannelTypeMatrix).
+				Return(stored, nil)
+			service := &matrixChannelService{notificationChannelService: notificationChannelService, matrixChannelLimit: 5}
+
+			err := service.matrixChannelValidations(context.Background(), matrixdto.MatrixNotificationChannelRequest{
+				ChannelName: "security alerts",
+				AccessToken: tt.accessToken,
+			}, tt.id)
+			require.ErrorIs(t, err, tt.wantErr)
+		})
+	}
+}
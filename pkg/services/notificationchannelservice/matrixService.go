@@ -0,0 +1,105 @@
+// SPDX-FileCopyrightText: 2026 Greenbone AG <https://greenbone.net>
+//
+// SPDX-License-Identifier: AGPL-3.0-or-later
+
+package notificationchannelservice
+
+import (
+	"bytes"
+	"context"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"net/http"
+	"net/url"
+	"strings"
+
+	"github.com/greenbone/opensight-notification-service/pkg/helper"
+	"github.com/greenbone/opensight-notification-service/pkg/markdown"
+	"github.com/greenbone/opensight-notification-service/pkg/models"
+)
+
+// matrixHTMLFormat is the format of the formatted body, clients without HTML support show the plain body
+const matrixHTMLFormat = "org.matrix.custom.html"
+
+// MatrixService posts notifications to a Matrix room with the access token of a user, usually a bot account
+// which joined the room. For details see:
+// https://spec.matrix.org/latest/client-server-api/#put_matrixclientv3roomsroomidsendeventtypetxnid
+type MatrixService struct {
+	transport *http.Client
+}
+
+func NewMatrixService(transport *http.Client) *MatrixService {
+	return &MatrixService{transport: transport}
+}
+
+// SendMessage posts the message to the room of the given Matrix channel. The title is shown in bold,
+// followed by the text, the details as list and the link to the origin resource.
+// The transaction ID must be kept when the message is sent again, see [MatrixService.send].
+func (m *MatrixService) SendMessage(channel models.NotificationChannel, txnID string, message models.ChatMessage) error {
+	var b strings.Builder
+	if message.Title != "" {
+		b.WriteString("**" + markdown.EscapeText(message.Title) + "**\n\n")
+	}
+	if message.Text != "" {
+		b.WriteString(message.Text + "\n\n")
+	}
+	for _, field := range messageDetails(message) {
+		b.WriteString(fmt.Sprintf("- **%s:** %s\n", markdown.EscapeText(field.Name), markdown.EscapeText(field.Value)))
+	}
+	if message.Link != "" {
+		b.WriteString("\n" + markdown.Link(models.OpenLinkTitle, message.Link) + "\n")
+	}
+	return m.send(channel, txnID, b.String())
+}
+
+// SendDigest posts the digest with the notifications as table to the room of the given Matrix channel.
+func (m *MatrixService) SendDigest(channel models.NotificationChannel, txnID string, digest models.DigestMessage) error {
+	return m.send(channel, txnID, digestToMarkdown(digest))
+}
+
+// send posts the markdown text as message event with the plain text as body and the rendered HTML as formatted body.
+// The homeserver drops a repeated transaction ID of the access token as duplicate, so a retry with the same
+// transaction ID, e.g. after a timeout, doesn't post the message twice.
+func (m *MatrixService) send(channel models.NotificationChannel, txnID string, text string) error {
+	body, err := json.Marshal(map[string]any{
+		"msgtype":        "m.text",
+		"body":           markdown.ToText(text),
+		"format":         matrixHTMLFormat,
+		"formatted_body": markdown.ToHTML(text),
+	})
+	if err != nil {
+		return fmt.Errorf("can not marshal matrix message: %w", err)
+	}
+
+	sendUrl := fmt.Sprintf("%s/_matrix/client/v3/rooms/%s/send/m.room.message/%s",
+		strings.TrimRight(helper.SafeDereference(channel.WebhookUrl), "/"),
+		url.PathEscape(helper.SafeDereference(channel.MatrixRoomId)),
+		url.PathEscape(txnID),
+	)
+
+	req, err := http.NewRequest(http.MethodPut, sendUrl, bytes.NewBuffer(body))
+	if err != nil {
+		return fmt.Errorf("%w: %w", ErrMatrixMessageDelivery, err)
+	}
+	req.Header.Set("Content-Type", "application/json")
+	req.Header.Set("Authorization", "Bearer "+helper.SafeDereference(channel.AccessToken))
+
+	resp, err := m.transport.Do(req)
+	if err != nil {
+		if errors.Is(err, context.DeadlineExceeded) {
+			return fmt.Errorf("%w: timeout", ErrMatrixMessageDelivery)
+		}
+		return fmt.Errorf("%w: %w", ErrMatrixMessageDelivery, err)
+	}
+
+	defer func() {
+		_ = resp.Body.Close()
+	}()
+
+	if resp.StatusCode < 200 || resp.StatusCode >= 300 {
+		return fmt.Errorf("%w: http status: %s", ErrMatrixMessageDelivery, resp.Status)
+	}
+
+	return nil
+}
@@ -0,0 +1,101 @@
+// SPDX-FileCopyrightText: 2026 Greenbone AG <https://greenbone.net>
+//
+// SPDX-License-Identifier: AGPL-3.0-or-later
+
+package notificationchannelservice
+
+import (
+	"net/http"
+	"testing"
+
+	"github.com/greenbone/opensight-golang-libraries/pkg/notifications"
+	"github.com/greenbone/opensight-notification-service/pkg/models"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+var testMatrixChannel = models.NotificationChannel{
+	WebhookUrl:   new("https://matrix.example.org/"),
+	MatrixRoomId: new("!alerts:example.org"),
+	AccessToken:  new("syt_abc"),
+}
+
+func TestSendMatrixMessage(t *testing.T) {
+	var gotRequest *http.Request
+	var gotBody []byte
+	svc := NewMatrixService(recordingClient(t, http.StatusOK, &gotRequest, &gotBody))
+
+	err := svc.SendMessage(testMatrixChannel, "send-task-id", models.ChatMessage{
+		Title:  "New vulnerability",
+		Text:   "A **critical** vulnerability was found.",
+		Level:  notifications.LevelUrgent,
+		Origin: "SBOM - React",
+		Link:   "https://opensight.example.com/vi/sbom/react",
+	})
+	require.NoError(t, err)
+
+	assert.Equal(t, http.MethodPut, gotRequest.Method)
+	assert.Equal(t, "matrix.example.org", gotRequest.URL.Host)
+	assert.Equal(t, "/_matrix/client/v3/rooms/%21alerts:example.org/send/m.room.message/send-task-id",
+		gotRequest.URL.EscapedPath())
+	assert.Equal(t, "Bearer syt_abc", gotRequest.Header.Get("Authorization"))
+	assert.JSONEq(t, `{
+		"msgtype": "m.text",
+		"body": "New vulnerability\n\nA critical vulnerability was found.\n\n- Level: urgent\n- Origin: SBOM - React\n\nOpen in OpenSight (https://opensight.example.com/vi/sbom/react)",
+		"format": "org.matrix.custom.html",
+		"formatted_body": "<p><strong>New vulnerability</strong></p>\n<p>A <strong>critical</strong> vulnerability was found.</p>\n<ul>\n<li><strong>Level:</strong> urgent</li>\n<li><strong>Origin:</strong> SBOM - React</li>\n</ul>\n<p><a href=\"https://opensight.example.com/vi/sbom/react\" rel=\"nofollow\">Open in OpenSight</a></p>\n"
+	}`, string(gotBody))
+}
+
+func TestSendMatrixMessage_RetryKeepsTransaction(t *testing.T) {
+	var gotRequest *http.Request
+	var gotBody []byte
+	svc := NewMatrixService(recordingClient(t, http.StatusOK, &gotRequest, &gotBody))
+	message := models.ChatMessage{Title: "Test message"}
+
+	require.NoError(t, svc.SendMessage(testMatrixChannel, "send-task-id", message))
+	firstPath := gotRequest.URL.Path
+	require.NoError(t, svc.SendMessage(testMatrixChannel, "send-task-id", message))
+
+	// the homeserver ignores the repeated event, as it has already seen the transaction ID
+	assert.Equal(t, firstPath, gotRequest.URL.Path)
+}
+
+func TestSendMatrixDigest(t *testing.T) {
+	var gotRequest *http.Request
+	var gotBody []byte
+	svc := NewMatrixService(recordingClient(t, http.StatusOK, &gotRequest, &gotBody))
+
+	err := svc.SendDigest(testMatrixChannel, "send-task-id", models.DigestMessage{
+		Title:   "2 new notifications",
+		Summary: "1 error, 1 warning",
+		Level:   notifications.LevelError,
+		Columns: []string{"Level", "Title"},
+		Rows:    [][]string{{"error", "Disk full"}, {"warning", "Disk almost full"}},
+		Links:   []string{"https://opensight.example.com/1", ""},
+	})
+	require.NoError(t, err)
+
+	assert.JSONEq(t, `{
+		"msgtype": "m.text",
+		"body": "2 new notifications\n\n1 error, 1 warning\n\nLevel | Title | Link\nerror | Disk full | Open (https://opensight.example.com/1)\nwarning | Disk almost full | ",
+		"format": "org.matrix.custom.html",
+		"formatted_body": "<h4>2 new notifications</h4>\n<p>1 error, 1 warning</p>\n<table>\n<thead>\n<tr>\n<th>Level</th>\n<th>Title</th>\n<th>Link</th>\n</tr>\n</thead>\n<tbody>\n<tr>\n<td>error</td>\n<td>Disk full</td>\n<td><a href=\"https://opensight.example.com/1\" rel=\"nofollow\">Open</a></td>\n</tr>\n<tr>\n<td>warning</td>\n<td>Disk almost full</td>\n<td></td>\n</tr>\n</tbody>\n</table>\n"
+	}`, string(gotBody))
+}
+
+func TestSendMatrixMessage_Rejected(t *testing.T) {
+	svc := NewMatrixService(&http.Client{
+		Transport: roundTripperFunc(func(r *http.Request) (*http.Response, error) {
+			return &http.Response{
+				StatusCode: http.StatusForbidden,
+				Status:     "403 Forbidden",
+				Body:       http.NoBody,
+				Header:     make(http.Header),
+			}, nil
+		})},
+	)
+
+	err := svc.SendMessage(testMatrixChannel, "send-task-id", models.ChatMessage{Title: "Test message"})
+	require.ErrorIs(t, err, ErrMatrixMessageDelivery)
+}
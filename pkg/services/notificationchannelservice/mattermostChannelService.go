@@ -47,7 +47,10 @@ func NewMattermostChannelService(
 }
 
 func (m *mattermostChannelService) SendMattermostTestMessage(webhookUrl string) error {
-	return m.mattermostService.SendMessage(webhookUrl, "Hello, This is a test message")
+	return m.mattermostService.SendMessage(
+		models.NotificationChannel{WebhookUrl: &webhookUrl},
+		models.ChatMessage{Text: "Hello, This is a test message"},
+	)
 }
 
 func (m *mattermostChannelService) CreateMattermostChannel(
@@ -11,6 +11,10 @@ import (
 	"errors"
 	"fmt"
 	"net/http"
+
+	"github.com/greenbone/opensight-notification-service/pkg/helper"
+	"github.com/greenbone/opensight-notification-service/pkg/markdown"
+	"github.com/greenbone/opensight-notification-service/pkg/models"
 )
 
 type MattermostService struct {
@@ -21,18 +25,64 @@ func NewMattermostService(transport *http.Client) *MattermostService {
 	return &MattermostService{transport: transport}
 }
 
-// SendMessage sends a message to the given Mattermost webhook URL.
-// The message has to be in Markdown format. For details see:
-// https://docs.mattermost.com/end-user-guide/collaborate/format-messages.html#use-markdown
-func (m *MattermostService) SendMessage(webhookUrl string, message string) error {
-	body, err := json.Marshal(map[string]string{
-		"text": message,
+// SendMessage sends the message as attachment to the webhook URL of the given Mattermost channel.
+// The attachment has a color bar by level, the details as fields and the title links to the origin resource.
+// The text has to be in Markdown format. For details see:
+// https://developers.mattermost.com/integrate/reference/message-attachments/
+func (m *MattermostService) SendMessage(channel models.NotificationChannel, message models.ChatMessage) error {
+	attachment := map[string]any{
+		"fallback": chatMessageFallback(message),
+		"color":    models.LevelColor(message.Level),
+		"text":     message.Text,
+		"footer":   chatMessageFooter,
+	}
+	if message.Title != "" {
+		attachment["title"] = message.Title
+		if message.Link != "" {
+			attachment["title_link"] = message.Link
+		}
+	} else if message.Link != "" {
+		attachment["text"] = message.Text + "\n\n" + markdown.Link(models.OpenLinkTitle, message.Link)
+	}
+
+	var fields []map[string]any
+	for _, field := range messageDetails(message) {
+		fields = append(fields, map[string]any{"title": field.Name, "value": field.Value, "short": true})
+	}
+	if len(fields) > 0 {
+		attachment["fields"] = fields
+	}
+
+	return m.post(channel, map[string]any{
+		"attachments": []map[string]any{attachment},
 	})
+}
+
+// SendDigest sends the digest as a message with a markdown table to the webhook URL of the given Mattermost channel.
+func (m *MattermostService) SendDigest(channel models.NotificationChannel, digest models.DigestMessage) error {
+	return m.post(channel, map[string]any{
+		"text": digestToMarkdown(digest),
+	})
+}
+
+// post sends the message with the username, icon and channel overrides of the channel
+func (m *MattermostService) post(channel models.NotificationChannel, msg map[string]any) error {
+	if channel.WebhookUsername != nil && *channel.WebhookUsername != "" {
+		msg["username"] = *channel.WebhookUsername
+	}
+	if channel.WebhookIconUrl != nil && *channel.WebhookIconUrl != "" {
+		msg["icon_url"] = *channel.WebhookIconUrl
+	}
+	if channel.WebhookChannel != nil && *channel.WebhookChannel != "" {
+		msg["channel"] = *channel.WebhookChannel
+	}
+
+	body, err := json.Marshal(msg)
 	if err != nil {
 		return fmt.Errorf("can not marshal mattermost message: %w", err)
 	}
 
-	resp, err := m.transport.Post(webhookUrl, "application/json", bytes.NewBuffer(body))
+	resp, err := m.transport.Post(helper.SafeDereference(channel.WebhookUrl), "application/json", bytes.NewBuffer(body))
 	if err != nil {
 		if errors.Is(err, context.DeadlineExceeded) {
 			return fmt.Errorf("%w: timeout", ErrMattermostMassageDelivery)
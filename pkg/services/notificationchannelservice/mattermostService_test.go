@@ -5,33 +5,131 @@
 package notificationchannelservice
 
 import (
+	"encoding/json"
 	"net/http"
 	"testing"
 
+	"github.com/greenbone/opensight-golang-libraries/pkg/notifications"
+	"github.com/greenbone/opensight-notification-service/pkg/models"
 	"github.com/stretchr/testify/assert"
 	"github.com/stretchr/testify/require"
 )
 
 func TestSendMattermostMessage(t *testing.T) {
-	var gotMethod, gotURL string
-
-	svc := &MattermostService{
-		transport: &http.Client{
-			Transport: roundTripperFunc(func(r *http.Request) (*http.Response, error) {
-				gotMethod = r.Method
-				gotURL = r.URL.String()
-				return &http.Response{
-					StatusCode: http.StatusNoContent,
-					Body:       http.NoBody,
-					Header:     make(http.Header),
-				}, nil
-			})},
+	webhook := "https://example.com:443/hooks/01fa130f2e134641b2cf39d8a710a002"
+	message := models.ChatMessage{
+		Title:       "New vulnerability",
+		Text:        "Details",
+		Level:       notifications.LevelWarning,
+		Origin:      "SBOM - React",
+		OriginClass: "/vi/SBOM",
+		Timestamp:   "2024-01-01T00:00:00Z",
+		Fields:      []models.MessageField{{Name: "host", Value: "10.0.0.1"}},
+		Link:        "https://opensight.example.com/vi/sbom/1",
 	}
+	attachment := map[string]any{
+		"fallback":   "New vulnerability",
+		"color":      "#F9A825",
+		"title":      "New vulnerability",
+		"title_link": "https://opensight.example.com/vi/sbom/1",
+		"text":       "Details",
+		"footer":     "Greenbone OpenSight",
+		"fields": []any{
+			map[string]any{"title": "Level", "value": "warning", "short": true},
+			map[string]any{"title": "Origin", "value": "SBOM - React", "short": true},
+			map[string]any{"title": "Origin class", "value": "/vi/SBOM", "short": true},
+			map[string]any{"title": "Time", "value": "2024-01-01T00:00:00Z", "short": true},
+			map[string]any{"title": "host", "value": "10.0.0.1", "short": true},
+		},
+	}
+
+	tests := map[string]struct {
+		channel  models.NotificationChannel
+		wantBody map[string]any
+	}{
+		"attachment": {
+			channel: models.NotificationChannel{WebhookUrl: &webhook},
+			wantBody: map[string]any{
+				"attachments": []any{attachment},
+			},
+		},
+		"attachment with overrides": {
+			channel: models.NotificationChannel{
+				WebhookUrl:      &webhook,
+				WebhookUsername: new("OpenSight"),
+				WebhookIconUrl:  new("https://opensight.example.com/icon.png"),
+				WebhookChannel:  new("security-alerts"),
+			},
+			wantBody: map[string]any{
+				"attachments": []any{attachment},
+				"username":    "OpenSight",
+				"icon_url":    "https://opensight.example.com/icon.png",
+				"channel":     "security-alerts",
+			},
+		},
+	}
+
+	for name, tt := range tests {
+		t.Run(name, func(t *testing.T) {
+			var gotMethod, gotURL string
+			var gotBody map[string]any
+
+			svc := NewMattermostService(&http.Client{
+				Transport: roundTripperFunc(func(r *http.Request) (*http.Response, error) {
+					gotMethod = r.Method
+					gotURL = r.URL.String()
+					require.NoError(t, json.NewDecoder(r.Body).Decode(&gotBody))
+					return &http.Response{
+						StatusCode: http.StatusNoContent,
+						Body:       http.NoBody,
+						Header:     make(http.Header),
+					}, nil
+				})},
+			)
+
+			err := svc.SendMessage(tt.channel, message)
+			require.NoError(t, err)
+
+			assert.Equal(t, http.MethodPost, gotMethod)
+			assert.Equal(t, webhook, gotURL)
+			assert.Equal(t, tt.wantBody, gotBody)
+		})
+	}
+}
+
+func TestSendMattermostDigest(t *testing.T) {
+	var gotBody map[string]string
+
+	svc := NewMattermostService(&http.Client{
+		Transport: roundTripperFunc(func(r *http.Request) (*http.Response, error) {
+			err := json.NewDecoder(r.Body).Decode(&gotBody)
+			require.NoError(t, err)
+			return &http.Response{
+				StatusCode: http.StatusOK,
+				Body:       http.NoBody,
+				Header:     make(http.Header),
+			}, nil
+		})},
+	)
 
-	webhook := "https://example.com:443/workflows/01fa130f2e134641b2cf39d8a710a002"
-	err := svc.SendMessage(webhook, "test message")
+	err := svc.SendDigest(models.NotificationChannel{WebhookUrl: new("https://example.com/hooks/abc")}, models.DigestMessage{
+		Title:   "Digest: 1 notifications",
+		Summary: "1 info",
+		Columns: []string{"Level", "Title"},
+		Rows:    [][]string{{"info", "a|b\nc"}},
+	})
 	require.NoError(t, err)
 
-	assert.Equal(t, http.MethodPost, gotMethod)
-	assert.Equal(t, webhook, gotURL)
+	assert.Equal(t, "#### Digest: 1 notifications\n\n1 info\n\n| Level | Title |\n| --- | --- |\n| info | a\\|b c |\n", gotBody["text"])
+}
+
+func TestDigestToMarkdown_Links(t *testing.T) {
+	got := digestToMarkdown(models.DigestMessage{
+		Title:   "Digest: 2 notifications",
+		Columns: []string{"Title"},
+		Rows:    [][]string{{"first"}, {"second"}},
+		Links:   []string{"https://opensight.example.com/vi/sbom/1", ""},
+	})
+
+	assert.Equal(t, "#### Digest: 2 notifications\n\n| Title | Link |\n| --- | --- |\n| first | [Open](https://opensight.example.com/vi/sbom/1) |\n| second |  |\n", got)
 }
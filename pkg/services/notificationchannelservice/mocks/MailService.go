@@ -96,16 +96,16 @@ func (_c *MailService_ConnectionCheck_Call) RunAndReturn(run func(ctx context.Co
 }
 
 // SendMail provides a mock function for the type MailService
-func (_mock *MailService) SendMail(ctx context.Context, mailServer models.NotificationChannel, receiver string, subject string, body string) error {
-	ret := _mock.Called(ctx, mailServer, receiver, subject, body)
+func (_mock *MailService) SendMail(ctx context.Context, mailServer models.NotificationChannel, receiver string, content models.MailMessage) error {
+	ret := _mock.Called(ctx, mailServer, receiver, content)
 
 	if len(ret) == 0 {
 		panic("no return value specified for SendMail")
 	}
 
 	var r0 error
-	if returnFunc, ok := ret.Get(0).(func(context.Context, models.NotificationChannel, string, string, string) error); ok {
-		r0 = returnFunc(ctx, mailServer, receiver, subject, body)
+	if returnFunc, ok := ret.Get(0).(func(context.Context, models.NotificationChannel, string, models.MailMessage) error); ok {
+		r0 = returnFunc(ctx, mailServer, receiver, content)
 	} else {
 		r0 = ret.Error(0)
 	}
@@ -121,13 +121,12 @@ type MailService_SendMail_Call struct {
 //   - ctx context.Context
 //   - mailServer models.NotificationChannel
 //   - receiver string
-//   - subject string
-//   - body string
-func (_e *MailService_Expecter) SendMail(ctx interface{}, mailServer interface{}, receiver interface{}, subject interface{}, body interface{}) *MailService_SendMail_Call {
-	return &MailService_SendMail_Call{Call: _e.mock.On("SendMail", ctx, mailServer, receiver, subject, body)}
+//   - content models.MailMessage
+func (_e *MailService_Expecter) SendMail(ctx interface{}, mailServer interface{}, receiver interface{}, content interface{}) *MailService_SendMail_Call {
+	return &MailService_SendMail_Call{Call: _e.mock.On("SendMail", ctx, mailServer, receiver, content)}
 }
 
-func (_c *MailService_SendMail_Call) Run(run func(ctx context.Context, mailServer models.NotificationChannel, receiver string, subject string, body string)) *MailService_SendMail_Call {
+func (_c *MailService_SendMail_Call) Run(run func(ctx context.Context, mailServer models.NotificationChannel, receiver string, content models.MailMessage)) *MailService_SendMail_Call {
 	_c.Call.Run(func(args mock.Arguments) {
 		var arg0 context.Context
 		if args[0] != nil {
@@ -141,20 +140,15 @@ func (_c *MailService_SendMail_Call) Run(run func(ctx context.Context, mailServe
 		if args[2] != nil {
 			arg2 = args[2].(string)
 		}
-		var arg3 string
+		var arg3 models.MailMessage
 		if args[3] != nil {
-			arg3 = args[3].(string)
-		}
-		var arg4 string
-		if args[4] != nil {
-			arg4 = args[4].(string)
+			arg3 = args[3].(models.MailMessage)
 		}
 		run(
 			arg0,
 			arg1,
 			arg2,
 			arg3,
-			arg4,
 		)
 	})
 	return _c
@@ -165,7 +159,7 @@ func (_c *MailService_SendMail_Call) Return(err error) *MailService_SendMail_Cal
 	return _c
 }
 
-func (_c *MailService_SendMail_Call) RunAndReturn(run func(ctx context.Context, mailServer models.NotificationChannel, receiver string, subject string, body string) error) *MailService_SendMail_Call {
+func (_c *MailService_SendMail_Call) RunAndReturn(run func(ctx context.Context, mailServer models.NotificationChannel, receiver string, content models.MailMessage) error) *MailService_SendMail_Call {
 	_c.Call.Return(run)
 	return _c
 }
@@ -12,6 +12,7 @@ type NotificationChannelService interface {
 		ctx context.Context,
 		channelIn models.NotificationChannel,
 	) (models.NotificationChannel, error)
+	GetNotificationChannelById(ctx context.Context, id string) (models.NotificationChannel, error)
 	GetNotificationChannelByIdAndType(
 		ctx context.Context,
 		id string,
@@ -51,6 +52,13 @@ func (s *notificationChannelService) CreateNotificationChannel(
 	return notificationChannel, nil
 }
 
+func (s *notificationChannelService) GetNotificationChannelById(
+	ctx context.Context,
+	id string,
+) (models.NotificationChannel, error) {
+	return s.store.GetNotificationChannelById(ctx, id)
+}
+
 func (s *notificationChannelService) GetNotificationChannelByIdAndType(
 	ctx context.Context,
 	id string,
@@ -0,0 +1,170 @@
+// SPDX-FileCopyrightText: 2026 Greenbone AG <https://greenbone.net>
+//
+// SPDX-License-Identifier: AGPL-3.0-or-later
+
+package notificationchannelservice
+
+import (
+	"context"
+	"crypto/sha256"
+	"encoding/hex"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"io"
+	"net/http"
+	"net/url"
+	"strings"
+	"sync"
+	"time"
+
+	"github.com/greenbone/opensight-notification-service/pkg/helper"
+	"github.com/greenbone/opensight-notification-service/pkg/models"
+)
+
+var ErrMailOAuthToken = errors.New("failed to get OAuth access token")
+
+const (
+	// oauthTokenRenewBefore renews tokens ahead of their expiry, so they don't expire during the SMTP session
+	oauthTokenRenewBefore = time.Minute
+	// oauthDefaultTokenLifetime is assumed if the token endpoint doesn't return the lifetime of the token
+	oauthDefaultTokenLifetime = 5 * time.Minute
+	// oauthMaxResponseSize limits the token response which is read into memory
+	oauthMaxResponseSize = 1 << 20
+)
+
+// OAuthTokenCache requests the access tokens of mail channels authenticating with XOAUTH2 with the
+// client credentials flow and caches them until shortly before they expire. For details see:
+// https://datatracker.ietf.org/doc/html/rfc6749#section-4.4
+type OAuthTokenCache struct {
+	transport *http.Client
+	now       func() time.Time
+
+	mu      sync.Mutex // guards the map only, each entry has its own lock
+	entries map[string]*oauthTokenEntry
+}
+
+// oauthTokenEntry holds the token of one set of client credentials. Its lock is kept while requesting, so
+// concurrent mails of a channel share one new token, while other channels don't wait for the token endpoint.
+type oauthTokenEntry struct {
+	mu    sync.Mutex
+	token oauthToken
+}
+
+type oauthToken struct {
+	accessToken string
+	expiresAt   time.Time
+}
+
+func NewOAuthTokenCache(transport *http.Client) *OAuthTokenCache {
+	return &OAuthTokenCache{
+		transport: transport,
+		now:       time.Now,
+		entries:   make(map[string]*oauthTokenEntry),
+	}
+}
+
+// Token returns the access token for the client credentials of the mail channel. A cached token is
+// returned until it is about to expire, then a new one is requested from the token endpoint.
+func (c *OAuthTokenCache) Token(ctx context.Context, mailServer models.NotificationChannel) (string, error) {
+	entry := c.entry(oauthTokenKey(mailServer))
+	entry.mu.Lock()
+	defer entry.mu.Unlock()
+
+	if c.now().Add(oauthTokenRenewBefore).Before(entry.token.expiresAt) {
+		return entry.token.accessToken, nil
+	}
+
+	token, err := c.requestToken(ctx, mailServer)
+	if err != nil {
+		entry.token = oauthToken{}
+		return "", err
+	}
+	entry.token = token
+	return token.accessToken, nil
+}
+
+// entry returns the cache entry of the client credentials, it is created on first use
+func (c *OAuthTokenCache) entry(key string) *oauthTokenEntry {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+
+	entry, ok := c.entries[key]
+	if !ok {
+		entry = &oauthTokenEntry{}
+		c.entries[key] = entry
+	}
+	return entry
+}
+
+// requestToken requests a new access token, the client credentials are sent in the body
+// as expected by Microsoft Entra ID and accepted by most other identity providers.
+func (c *OAuthTokenCache) requestToken(ctx context.Context, mailServer models.NotificationChannel) (oauthToken, error) {
+	form := url.Values{
+		"grant_type":    {"client_credentials"},
+		"client_id":     {helper.SafeDereference(mailServer.OAuthClientId)},
+		"client_secret": {helper.SafeDereference(mailServer.OAuthClientSecret)},
+	}
+	if len(mailServer.OAuthScopes) > 0 {
+		form.Set("scope", strings.Join(mailServer.OAuthScopes, " "))
+	}
+
+	req, err := http.NewRequestWithContext(ctx, http.MethodPost, helper.SafeDereference(mailServer.OAuthTokenUrl),
+		strings.NewReader(form.Encode()))
+	if err != nil {
+		return oauthToken{}, fmt.Errorf("%w: %w", ErrMailOAuthToken, err)
+	}
+	req.Header.Set("Content-Type", "application/x-www-form-urlencoded")
+	req.Header.Set("Accept", "application/json")
+
+	requestedAt := c.now()
+	resp, err := c.transport.Do(req)
+	if err != nil {
+		if errors.Is(err, context.DeadlineExceeded) {
+			return oauthToken{}, fmt.Errorf("%w: timeout", ErrMailOAuthToken)
+		}
+		return oauthToken{}, fmt.Errorf("%w: %w", ErrMailOAuthToken, err)
+	}
+
+	defer func() {
+		_ = resp.Body.Close()
+	}()
+
+	var body struct {
+		AccessToken string `json:"access_token"`
+		ExpiresIn   int    `json:"expires_in"`
+		Error       string `json:"error"` // error code like invalid_client, the description may contain request details
+	}
+	decodeErr := json.NewDecoder(io.LimitReader(resp.Body, oauthMaxResponseSize)).Decode(&body)
+
+	if resp.StatusCode < 200 || resp.StatusCode >= 300 {
+		if body.Error != "" {
+			return oauthToken{}, fmt.Errorf("%w: http status: %s: %s", ErrMailOAuthToken, resp.Status, body.Error)
+		}
+		return oauthToken{}, fmt.Errorf("%w: http status: %s", ErrMailOAuthToken, resp.Status)
+	}
+	if decodeErr != nil {
+		return oauthToken{}, fmt.Errorf("%w: invalid token response: %w", ErrMailOAuthToken, decodeErr)
+	}
+	if body.AccessToken == "" {
+		return oauthToken{}, fmt.Errorf("%w: token response without access token", ErrMailOAuthToken)
+	}
+
+	lifetime := oauthDefaultTokenLifetime
+	if body.ExpiresIn > 0 {
+		lifetime = time.Duration(body.ExpiresIn) * time.Second
+	}
+	return oauthToken{accessToken: body.AccessToken, expiresAt: requestedAt.Add(lifetime)}, nil
+}
+
+// oauthTokenKey identifies the token of the client credentials. A changed secret or scope gets a new token,
+// the secret is hashed to not keep a copy of it in the cache.
+func oauthTokenKey(mailServer models.NotificationChannel) string {
+	secret := sha256.Sum256([]byte(helper.SafeDereference(mailServer.OAuthClientSecret)))
+	return strings.Join([]string{
+		helper.SafeDereference(mailServer.OAuthTokenUrl),
+		helper.SafeDereference(mailServer.OAuthClientId),
+		strings.Join(mailServer.OAuthScopes, " "),
+		hex.EncodeToString(secret[:]),
+	}, "\n")
+}
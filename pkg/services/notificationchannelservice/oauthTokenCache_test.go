@@ -0,0 +1,192 @@
+// SPDX-FileCopyrightText: 2026 Greenbone AG <https://greenbone.net>
+//
+// SPDX-License-Identifier: AGPL-3.0-or-later
+
+package notificationchannelservice
+
+import (
+	"context"
+	"fmt"
+	"io"
+	"net/http"
+	"net/url"
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/greenbone/opensight-notification-service/pkg/models"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+var testOAuthMailChannel = models.NotificationChannel{
+	MailAuthMethod:    new(models.MailAuthXOAuth2),
+	Username:          new("alerts@example.com"),
+	OAuthTokenUrl:     new("https://login.example.com/tenant/oauth2/v2.0/token"),
+	OAuthClientId:     new("client-id"),
+	OAuthClientSecret: new("client-secret"),
+	OAuthScopes:       []string{"https://outlook.office365.com/.default"},
+}
+
+// newTestOAuthTokenCache returns a cache with a fixed clock, the token endpoint answers with a numbered token
+// which expires after an hour
+func newTestOAuthTokenCache(t *testing.T, requests *[]url.Values) (*OAuthTokenCache, *time.Time) {
+	now := time.Date(2026, 1, 1, 12, 0, 0, 0, time.UTC)
+	cache := NewOAuthTokenCache(&http.Client{
+		Transport: roundTripperFunc(func(r *http.Request) (*http.Response, error) {
+			assert.Equal(t, http.MethodPost, r.Method)
+			assert.Equal(t, "application/x-www-form-urlencoded", r.Header.Get("Content-Type"))
+
+			body, err := io.ReadAll(r.Body)
+			require.NoError(t, err)
+			form, err := url.ParseQuery(string(body))
+			require.NoError(t, err)
+			*requests = append(*requests, form)
+
+			return &http.Response{
+				StatusCode: http.StatusOK,
+				Body: io.NopCloser(strings.NewReader(
+					fmt.Sprintf(`{"access_token":"token-%d","token_type":"Bearer","expires_in":3600}`, len(*requests)))),
+				Header: make(http.Header),
+			}, nil
+		})},
+	)
+	cache.now = func() time.Time { return now }
+	return cache, &now
+}
+
+func TestOAuthTokenCache_RequestsTokenWithClientCredentials(t *testing.T) {
+	var requests []url.Values
+	cache, _ := newTestOAuthTokenCache(t, &requests)
+
+	token, err := cache.Token(context.Background(), testOAuthMailChannel)
+	require.NoError(t, err)
+
+	assert.Equal(t, "token-1", token)
+	require.Len(t, requests, 1)
+	assert.Equal(t, url.Values{
+		"grant_type":    {"client_credentials"},
+		"client_id":     {"client-id"},
+		"client_secret": {"client-secret"},
+		"scope":         {"https://outlook.office365.com/.default"},
+	}, requests[0])
+}
+
+func TestOAuthTokenCache_CachesTokenUntilShortlyBeforeExpiry(t *testing.T) {
+	var requests []url.Values
+	cache, now := newTestOAuthTokenCache(t, &requests)
+
+	token, err := cache.Token(context.Background(), testOAuthMailChannel)
+	require.NoError(t, err)
+	assert.Equal(t, "token-1", token)
+
+	*now = now.Add(58 * time.Minute)
+	token, err = cache.Token(context.Background(), testOAuthMailChannel)
+	require.NoError(t, err)
+	assert.Equal(t, "token-1", token)
+
+	*now = now.Add(time.Minute)
+	token, err = cache.Token(context.Background(), testOAuthMailChannel)
+	require.NoError(t, err)
+	assert.Equal(t, "token-2", token)
+	assert.Len(t, requests, 2)
+}
+
+func TestOAuthTokenCache_ChangedSecretGetsNewToken(t *testing.T) {
+	var requests []url.Values
+	cache, _ := newTestOAuthTokenCache(t, &requests)
+
+	_, err := cache.Token(context.Background(), testOAuthMailChannel)
+	require.NoError(t, err)
+
+	changed := testOAuthMailChannel
+	changed.OAuthClientSecret = new("new-secret")
+	token, err := cache.Token(context.Background(), changed)
+	require.NoError(t, err)
+
+	assert.Equal(t, "token-2", token)
+	require.Len(t, requests, 2)
+	assert.Equal(t, "new-secret", requests[1].Get("client_secret"))
+}
+
+func TestOAuthTokenCache_Rejected(t *testing.T) {
+	cache := NewOAuthTokenCache(&http.Client{
+		Transport: roundTripperFunc(func(r *http.Request) (*http.Response, error) {
+			return &http.Response{
+				StatusCode: http.StatusUnauthorized,
+				Status:     "401 Unauthorized",
+				Body: io.NopCloser(strings.NewReader(
+					`{"error":"invalid_client","error_description":"AADSTS7000215: Invalid client secret provided."}`)),
+				Header: make(http.Header),
+			}, nil
+		})},
+	)
+
+	_, err := cache.Token(context.Background(), testOAuthMailChannel)
+
+	require.ErrorIs(t, err, ErrMailOAuthToken)
+	assert.ErrorContains(t, err, "invalid_client")
+	assert.NotContains(t, err.Error(), "AADSTS7000215")
+}
+
+func TestOAuthTokenCache_ResponseWithoutToken(t *testing.T) {
+	cache := NewOAuthTokenCache(&http.Client{
+		Transport: roundTripperFunc(func(r *http.Request) (*http.Response, error) {
+			return &http.Response{
+				StatusCode: http.StatusOK,
+				Body:       io.NopCloser(strings.NewReader(`{"token_type":"Bearer"}`)),
+				Header:     make(http.Header),
+			}, nil
+		})},
+	)
+
+	_, err := cache.Token(context.Background(), testOAuthMailChannel)
+
+	require.ErrorIs(t, err, ErrMailOAuthToken)
+}
+
+func TestOAuthTokenCache_SlowEndpointBlocksOnlyItsChannel(t *testing.T) {
+	slowChannel := testOAuthMailChannel
+	slowChannel.OAuthTokenUrl = new("https://slow.example.com/token")
+
+	slowStarted := make(chan struct{})
+	releaseSlow := make(chan struct{})
+	cache := NewOAuthTokenCache(&http.Client{
+		Transport: roundTripperFunc(func(r *http.Request) (*http.Response, error) {
+			if r.URL.Host == "slow.example.com" {
+				close(slowStarted)
+				<-releaseSlow
+			}
+			return &http.Response{
+				StatusCode: http.StatusOK,
+				Body:       io.NopCloser(strings.NewReader(`{"access_token":"token-` + r.URL.Host + `","expires_in":3600}`)),
+				Header:     make(http.Header),
+			}, nil
+		})},
+	)
+
+	slowDone := make(chan string)
+	go func() {
+		token, err := cache.Token(context.Background(), slowChannel)
+		assert.NoError(t, err)
+		slowDone <- token
+	}()
+	<-slowStarted
+
+	// the other channel gets its token while the slow endpoint is still requested
+	done := make(chan string)
+	go func() {
+		token, err := cache.Token(context.Background(), testOAuthMailChannel)
+		assert.NoError(t, err)
+		done <- token
+	}()
+	select {
+	case token := <-done:
+		assert.Equal(t, "token-login.example.com", token)
+	case <-time.After(5 * time.Second):
+		t.Fatal("token request of the other channel waited for the slow endpoint")
+	}
+
+	close(releaseSlow)
+	assert.Equal(t, "token-slow.example.com", <-slowDone)
+}
@@ -0,0 +1,134 @@
+// SPDX-FileCopyrightText: 2026 Greenbone AG <https://greenbone.net>
+//
+// SPDX-License-Identifier: AGPL-3.0-or-later
+
+package notificationchannelservice
+
+import (
+	"bytes"
+	"context"
+	"crypto/hmac"
+	"crypto/sha256"
+	"encoding/base64"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"net/http"
+	"strconv"
+	"strings"
+	"time"
+
+	"github.com/greenbone/opensight-notification-service/pkg/helper"
+	"github.com/greenbone/opensight-notification-service/pkg/models"
+)
+
+// OutboundWebhookService calls generic webhooks, e.g. of ticketing systems, with the notification as JSON.
+// The requests follow https://www.standardwebhooks.com, so receivers can verify the signature and
+// reject replayed requests by the signed timestamp.
+type OutboundWebhookService struct {
+	transport *http.Client
+}
+
+func NewOutboundWebhookService(transport *http.Client) *OutboundWebhookService {
+	return &OutboundWebhookService{transport: transport}
+}
+
+// SendNotification sends the notification to the given webhook channel. The delivery ID identifies
+// the delivery, it is the same for retries, so receivers can drop duplicates.
+func (o *OutboundWebhookService) SendNotification(
+	channel models.NotificationChannel,
+	deliveryID string,
+	notification models.Notification,
+) error {
+	body, err := models.RenderWebhookBody(helper.SafeDereference(channel.WebhookBodyTemplate), notification)
+	if err != nil {
+		return fmt.Errorf("can not render webhook body: %w", err)
+	}
+	return o.post(channel, deliveryID, body)
+}
+
+// SendDigest sends the collected notifications of a digest as JSON array to the given webhook channel.
+// Each element is the body the notification would have been sent with on its own.
+func (o *OutboundWebhookService) SendDigest(
+	channel models.NotificationChannel,
+	deliveryID string,
+	notifications []models.Notification,
+) error {
+	bodies := make([]json.RawMessage, 0, len(notifications))
+	for _, notification := range notifications {
+		body, err := models.RenderWebhookBody(helper.SafeDereference(channel.WebhookBodyTemplate), notification)
+		if err != nil {
+			return fmt.Errorf("can not render webhook body: %w", err)
+		}
+		bodies = append(bodies, body)
+	}
+
+	body, err := json.Marshal(bodies)
+	if err != nil {
+		return fmt.Errorf("can not marshal webhook digest: %w", err)
+	}
+	return o.post(channel, deliveryID, body)
+}
+
+func (o *OutboundWebhookService) post(channel models.NotificationChannel, deliveryID string, body []byte) error {
+	method := helper.SafeDereference(channel.WebhookMethod)
+	if method == "" {
+		method = http.MethodPost
+	}
+
+	req, err := http.NewRequest(method, helper.SafeDereference(channel.WebhookUrl), bytes.NewReader(body))
+	if err != nil {
+		return fmt.Errorf("%w: %w", ErrWebhookMessageDelivery, err)
+	}
+
+	for name, value := range channel.WebhookHeaders {
+		req.Header.Set(name, value)
+	}
+	timestamp := strconv.FormatInt(time.Now().Unix(), 10)
+	req.Header.Set("Content-Type", "application/json")
+	req.Header.Set(models.WebhookIdHeader, deliveryID)
+	req.Header.Set(models.WebhookTimestampHeader, timestamp)
+	if secret := helper.SafeDereference(channel.WebhookSecret); secret != "" {
+		signature, err := signWebhook(secret, deliveryID, timestamp, body)
+		if err != nil {
+			return fmt.Errorf("%w: %w", ErrWebhookMessageDelivery, err)
+		}
+		req.Header.Set(models.WebhookSignatureHeader, signature)
+	}
+
+	resp, err := o.transport.Do(req)
+	if err != nil {
+		if errors.Is(err, context.DeadlineExceeded) {
+			return fmt.Errorf("%w: timeout", ErrWebhookMessageDelivery)
+		}
+		return fmt.Errorf("%w: %w", ErrWebhookMessageDelivery, err)
+	}
+
+	defer func() {
+		_ = resp.Body.Close()
+	}()
+
+	if resp.StatusCode < 200 || resp.StatusCode >= 300 {
+		return fmt.Errorf("%w: http status: %s", ErrWebhookMessageDelivery, resp.Status)
+	}
+
+	return nil
+}
+
+// signWebhook returns the HMAC-SHA256 signature of the request as `v1,<base64 signature>`.
+// The ID and the timestamp are signed together with the body, so they can not be replaced.
+func signWebhook(secret, deliveryID, timestamp string, body []byte) (string, error) {
+	key := []byte(secret)
+	if encoded, ok := strings.CutPrefix(secret, models.WebhookSecretPrefix); ok {
+		decoded, err := base64.StdEncoding.DecodeString(encoded)
+		if err != nil {
+			return "", fmt.Errorf("invalid webhook secret: %w", err)
+		}
+		key = decoded
+	}
+
+	mac := hmac.New(sha256.New, key)
+	mac.Write([]byte(deliveryID + "." + timestamp + "."))
+	mac.Write(body)
+	return "v1," + base64.StdEncoding.EncodeToString(mac.Sum(nil)), nil
+}
@@ -0,0 +1,136 @@
+// SPDX-FileCopyrightText: 2026 Greenbone AG <https://greenbone.net>
+//
+// SPDX-License-Identifier: AGPL-3.0-or-later
+
+package notificationchannelservice
+
+import (
+	"crypto/hmac"
+	"crypto/sha256"
+	"encoding/base64"
+	"encoding/json"
+	"net/http"
+	"strconv"
+	"testing"
+	"time"
+
+	"github.com/greenbone/opensight-golang-libraries/pkg/notifications"
+	"github.com/greenbone/opensight-notification-service/pkg/models"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+var testWebhookNotification = models.Notification{
+	Id:          "6d3cfb39-2f4d-4f10-9d77-2cbd0e1c1a2b",
+	Origin:      "SBOM - React",
+	OriginClass: "/vi/SBOM",
+	Timestamp:   "2026-01-01T12:00:00Z",
+	Title:       "New vulnerability \"found\"",
+	Detail:      "A new vulnerability was found.",
+	Level:       notifications.LevelError,
+}
+
+func TestSendWebhookNotification(t *testing.T) {
+	var gotRequest *http.Request
+	var gotBody []byte
+	svc := NewOutboundWebhookService(recordingClient(t, http.StatusAccepted, &gotRequest, &gotBody))
+
+	err := svc.SendNotification(models.NotificationChannel{
+		WebhookUrl:     new("https://tickets.example.com/api/events"),
+		WebhookHeaders: map[string]string{"Authorization": "Bearer token"},
+	}, "delivery-1", testWebhookNotification)
+	require.NoError(t, err)
+
+	assert.Equal(t, http.MethodPost, gotRequest.Method)
+	assert.Equal(t, "https://tickets.example.com/api/events", gotRequest.URL.String())
+	assert.Equal(t, "application/json", gotRequest.Header.Get("Content-Type"))
+	assert.Equal(t, "Bearer token", gotRequest.Header.Get("Authorization"))
+	assert.Equal(t, "delivery-1", gotRequest.Header.Get(models.WebhookIdHeader))
+	assert.Empty(t, gotRequest.Header.Get(models.WebhookSignatureHeader), "requests without secret are not signed")
+
+	timestamp, err := strconv.ParseInt(gotRequest.Header.Get(models.WebhookTimestampHeader), 10, 64)
+	require.NoError(t, err)
+	assert.WithinDuration(t, time.Now(), time.Unix(timestamp, 0), time.Minute)
+
+	var got models.Notification
+	require.NoError(t, json.Unmarshal(gotBody, &got))
+	assert.Equal(t, testWebhookNotification, got)
+}
+
+func TestSendWebhookNotification_Template(t *testing.T) {
+	var gotRequest *http.Request
+	var gotBody []byte
+	svc := NewOutboundWebhookService(recordingClient(t, http.StatusAccepted, &gotRequest, &gotBody))
+
+	err := svc.SendNotification(models.NotificationChannel{
+		WebhookUrl:          new("https://tickets.example.com/api/events"),
+		WebhookMethod:       new(http.MethodPut),
+		WebhookBodyTemplate: new(`{"summary": {{json .Title}}, "severity": {{json .Level}}}`),
+	}, "delivery-1", testWebhookNotification)
+	require.NoError(t, err)
+
+	assert.Equal(t, http.MethodPut, gotRequest.Method)
+	assert.JSONEq(t, `{"summary": "New vulnerability \"found\"", "severity": "error"}`, string(gotBody))
+}
+
+func TestSendWebhookNotification_Signature(t *testing.T) {
+	key := []byte("0123456789abcdef0123456789abcdef")
+	tests := map[string]string{
+		"raw secret":              string(key),
+		"standard webhook secret": "whsec_" + base64.StdEncoding.EncodeToString(key),
+	}
+	for name, secret := range tests {
+		t.Run(name, func(t *testing.T) {
+			var gotRequest *http.Request
+			var gotBody []byte
+			svc := NewOutboundWebhookService(recordingClient(t, http.StatusAccepted, &gotRequest, &gotBody))
+
+			err := svc.SendNotification(models.NotificationChannel{
+				WebhookUrl:    new("https://tickets.example.com/api/events"),
+				WebhookSecret: new(secret),
+			}, "delivery-1", testWebhookNotification)
+			require.NoError(t, err)
+
+			mac := hmac.New(sha256.New, key)
+			mac.Write([]byte("delivery-1." + gotRequest.Header.Get(models.WebhookTimestampHeader) + "."))
+			mac.Write(gotBody)
+			want := "v1," + base64.StdEncoding.EncodeToString(mac.Sum(nil))
+			assert.Equal(t, want, gotRequest.Header.Get(models.WebhookSignatureHeader))
+		})
+	}
+}
+
+func TestSendWebhookNotification_ErrorStatus(t *testing.T) {
+	svc := NewOutboundWebhookService(&http.Client{
+		Transport: roundTripperFunc(func(r *http.Request) (*http.Response, error) {
+			return &http.Response{
+				StatusCode: http.StatusUnauthorized,
+				Status:     "401 Unauthorized",
+				Body:       http.NoBody,
+				Header:     make(http.Header),
+			}, nil
+		})},
+	)
+
+	err := svc.SendNotification(models.NotificationChannel{
+		WebhookUrl: new("https://tickets.example.com/api/events"),
+	}, "delivery-1", testWebhookNotification)
+	require.ErrorIs(t, err, ErrWebhookMessageDelivery)
+	assert.ErrorContains(t, err, "401 Unauthorized")
+}
+
+func TestSendWebhookDigest(t *testing.T) {
+	var gotRequest *http.Request
+	var gotBody []byte
+	svc := NewOutboundWebhookService(recordingClient(t, http.StatusAccepted, &gotRequest, &gotBody))
+
+	second := testWebhookNotification
+	second.Title = "Second"
+	err := svc.SendDigest(models.NotificationChannel{
+		WebhookUrl:          new("https://tickets.example.com/api/events"),
+		WebhookBodyTemplate: new(`{"summary": {{json .Title}}}`),
+	}, "delivery-1", []models.Notification{testWebhookNotification, second})
+	require.NoError(t, err)
+
+	assert.JSONEq(t, `[{"summary": "New vulnerability \"found\""}, {"summary": "Second"}]`, string(gotBody))
+}
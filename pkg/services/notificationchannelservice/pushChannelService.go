@@ -0,0 +1,128 @@
+package notificationchannelservice
+
+import (
+	"context"
+	"errors"
+
+	"github.com/greenbone/opensight-golang-libraries/pkg/notifications"
+	"github.com/greenbone/opensight-notification-service/pkg/helper"
+	"github.com/greenbone/opensight-notification-service/pkg/models"
+	"github.com/greenbone/opensight-notification-service/pkg/web/pushcontroller/pushdto"
+)
+
+var (
+	ErrPushChannelLimitReached = errors.New("Push channel limit reached.")
+	ErrListPushChannels        = errors.New("failed to list push channels")
+	ErrPushChannelNameExists   = errors.New("Push channel name already exists.")
+	ErrPushAppTokenIsRequired  = errors.New("An app token is required.")
+	ErrPushMessageDelivery     = errors.New("push message could not be send")
+)
+
+type PushChannelService interface {
+	SendPushTestMessage(channel models.NotificationChannel) error
+	CreatePushChannel(
+		ctx context.Context,
+		channel pushdto.PushNotificationChannelRequest,
+	) (pushdto.PushNotificationChannelResponse, error)
+	UpdatePushChannel(
+		ctx context.Context,
+		id string,
+		channel pushdto.PushNotificationChannelRequest,
+	) (pushdto.PushNotificationChannelResponse, error)
+}
+
+type pushChannelService struct {
+	notificationChannelService NotificationChannelService
+	pushChannelLimit           int
+	pushService                *PushService
+}
+
+func NewPushChannelService(
+	notificationChannelService NotificationChannelService,
+	pushChannelLimit int,
+	pushService *PushService,
+) PushChannelService {
+	return &pushChannelService{
+		notificationChannelService: notificationChannelService,
+		pushChannelLimit:           pushChannelLimit,
+		pushService:                pushService,
+	}
+}
+
+func (p *pushChannelService) SendPushTestMessage(channel models.NotificationChannel) error {
+	return p.pushService.SendMessage(channel, models.ChatMessage{
+		Title: "Test message",
+		Text:  "Hello, This is a test message",
+		Level: notifications.LevelInfo,
+	})
+}
+
+func (p *pushChannelService) CreatePushChannel(
+	ctx context.Context,
+	channel pushdto.PushNotificationChannelRequest,
+) (pushdto.PushNotificationChannelResponse, error) {
+	if err := p.pushChannelValidations(ctx, channel, ""); err != nil {
+		return pushdto.PushNotificationChannelResponse{}, err
+	}
+
+	notificationChannel := pushdto.MapPushToNotificationChannel(channel)
+	created, err := p.notificationChannelService.CreateNotificationChannel(ctx, notificationChannel)
+	if err != nil {
+		return pushdto.PushNotificationChannelResponse{}, err
+	}
+
+	return pushdto.MapNotificationChannelToPush(created), nil
+}
+
+func (p *pushChannelService) UpdatePushChannel(
+	ctx context.Context,
+	id string,
+	channel pushdto.PushNotificationChannelRequest,
+) (pushdto.PushNotificationChannelResponse, error) {
+	if err := p.pushChannelValidations(ctx, channel, id); err != nil {
+		return pushdto.PushNotificationChannelResponse{}, err
+	}
+
+	notificationChannel := pushdto.MapPushToNotificationChannel(channel)
+	updated, err := p.notificationChannelService.UpdateNotificationChannel(ctx, id, notificationChannel)
+	if err != nil {
+		return pushdto.PushNotificationChannelResponse{}, err
+	}
+
+	return pushdto.MapNotificationChannelToPush(updated), nil
+}
+
+// pushChannelValidations checks the limit and the name of the channel. Gotify requires an app token,
+// on update the stored token is kept if none is given.
+func (p *pushChannelService) pushChannelValidations(
+	ctx context.Context,
+	channel pushdto.PushNotificationChannelRequest,
+	excludeId string,
+) error {
+	channels, err := p.notificationChannelService.ListNotificationChannelsByType(ctx, models.ChannelTypePush)
+	if err != nil {
+		return errors.Join(ErrListPushChannels, err)
+	}
+
+	if len(channels) >= p.pushChannelLimit {
+		return ErrPushChannelLimitReached
+	}
+
+	hasToken := helper.SafeDereference(channel.AccessToken) != ""
+	for _, ch := range channels {
+		if ch.Id == excludeId {
+			hasToken = hasToken || (channel.AccessToken == nil && helper.SafeDereference(ch.AccessToken) != "")
+			continue
+		}
+
+		if ch.ChannelName == channel.ChannelName {
+			return ErrPushChannelNameExists
+		}
+	}
+
+	if channel.Server == models.PushServerGotify && !hasToken {
+		return ErrPushAppTokenIsRequired
+	}
+
+	return nil
+}
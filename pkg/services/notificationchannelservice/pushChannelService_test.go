@@ -0,0 +1,70 @@
+package notificationchannelservice
+
+import (
+	"context"
+	"testing"
+
+	"github.com/greenbone/opensight-notification-service/pkg/models"
+	"github.com/greenbone/opensight-notification-service/pkg/services/notificationchannelservice/mocks"
+	"github.com/greenbone/opensight-notification-service/pkg/web/pushcontroller/pushdto"
+	"github.com/stretchr/testify/require"
+)
+
+func TestPushChannelLimit(t *testing.T) {
+	notificationChannelService := mocks.NewNotificationChannelService(t)
+	notificationChannelService.EXPECT().ListNotificationChannelsByType(context.Background(), models.ChannelTypePush).
+		Return([]models.NotificationChannel{
+			{},
+		}, nil)
+
+	service := NewPushChannelService(notificationChannelService, 1, NewPushService(nil))
+
+	_, err := service.CreatePushChannel(context.Background(), pushdto.PushNotificationChannelRequest{})
+	require.ErrorIs(t, err, ErrPushChannelLimitReached)
+}
+
+func TestPushChannelNameExists(t *testing.T) {
+	notificationChannelService := mocks.NewNotificationChannelService(t)
+	notificationChannelService.EXPECT().ListNotificationChannelsByType(context.Background(), models.ChannelTypePush).
+		Return([]models.NotificationChannel{
+			{Id: "1", ChannelName: "phones"},
+		}, nil)
+
+	service := NewPushChannelService(notificationChannelService, 5, NewPushService(nil))
+
+	_, err := service.UpdatePushChannel(context.Background(), "2", pushdto.PushNotificationChannelRequest{ChannelName: "phones"})
+	require.ErrorIs(t, err, ErrPushChannelNameExists)
+}
+
+func TestPushChannelAppToken(t *testing.T) {
+	stored := []models.NotificationChannel{
+		{Id: "1", ChannelName: "phones", AccessToken: new("AbCdEf123")},
+	}
+
+	tests := map[string]struct {
+		id          string
+		accessToken *string
+		wantErr     error
+	}{
+		"create without token":            {accessToken: nil, wantErr: ErrPushAppTokenIsRequired},
+		"create with token":               {accessToken: new("AbCdEf123")},
+		"update keeps the stored token":   {id: "1", accessToken: nil},
+		"update removing the token fails": {id: "1", accessToken: new(""), wantErr: ErrPushAppTokenIsRequired},
+	}
+
+	for name, tt := range tests {
+		t.Run(name, func(t *testing.T) {
+			notificationChannelService := mocks.NewNotificationChannelService(t)
+			notificationChannelService.EXPECT().ListNotificationChannelsByType(context.Background(), models.ChannelTypePush).
+				Return(stored, nil)
+			service := &pushChannelService{notificationChannelService: notificationChannelService, pushChannelLimit: 5}
+
+			err := service.pushChannelValidations(context.Background(), pushdto.PushNotificationChannelRequest{
+				ChannelName: "on-call phones",
+				Server:      models.PushServerGotify,
+				AccessToken: tt.accessToken,
+			}, tt.id)
+			require.ErrorIs(t, err, tt.wantErr)
+		})
+	}
+}
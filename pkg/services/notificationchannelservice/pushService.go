@@ -0,0 +1,171 @@
+// SPDX-FileCopyrightText: 2026 Greenbone AG <https://greenbone.net>
+//
+// SPDX-License-Identifier: AGPL-3.0-or-later
+
+package notificationchannelservice
+
+import (
+	"bytes"
+	"context"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"net/http"
+	"strings"
+
+	"github.com/greenbone/opensight-golang-libraries/pkg/notifications"
+	"github.com/greenbone/opensight-notification-service/pkg/helper"
+	"github.com/greenbone/opensight-notification-service/pkg/markdown"
+	"github.com/greenbone/opensight-notification-service/pkg/models"
+)
+
+// pushMaxMessageLength keeps the messages below 4096 bytes, ntfy turns longer messages into attachments
+const pushMaxMessageLength = 1024
+
+// PushService publishes notifications to self-hosted ntfy or Gotify servers, which forward them
+// to the apps on mobile devices without cloud services. For details see:
+// https://docs.ntfy.sh/publish/#publish-as-json and https://gotify.net/api-docs
+type PushService struct {
+	transport *http.Client
+}
+
+func NewPushService(transport *http.Client) *PushService {
+	return &PushService{transport: transport}
+}
+
+// SendMessage publishes the message to the server of the given push channel. The level is mapped to
+// the priority of the push message, the link to the origin resource is opened when the message is tapped.
+func (p *PushService) SendMessage(channel models.NotificationChannel, message models.ChatMessage) error {
+	text := markdown.ToText(message.Text)
+	if text == "" {
+		text = message.Title
+	}
+	return p.publish(channel, message.Title, text, message.Level, message.Link)
+}
+
+// SendDigest publishes the digest to the server of the given push channel, each notification is
+// listed as a line with the values separated by |.
+func (p *PushService) SendDigest(channel models.NotificationChannel, digest models.DigestMessage) error {
+	lines := make([]string, 0, len(digest.Rows)+1)
+	if digest.Summary != "" {
+		lines = append(lines, digest.Summary)
+	}
+	for _, row := range digest.Rows {
+		cells := make([]string, 0, len(row))
+		for _, cell := range row {
+			cells = append(cells, strings.Join(strings.Fields(cell), " "))
+		}
+		lines = append(lines, "• "+strings.Join(cells, " | "))
+	}
+	return p.publish(channel, digest.Title, strings.Join(lines, "\n"), digest.Level, "")
+}
+
+func (p *PushService) publish(
+	channel models.NotificationChannel,
+	title string,
+	text string,
+	level notifications.Level,
+	link string,
+) error {
+	text = truncate(text, pushMaxMessageLength)
+	serverUrl := strings.TrimRight(helper.SafeDereference(channel.WebhookUrl), "/")
+	token := helper.SafeDereference(channel.AccessToken)
+
+	var msg map[string]any
+	header := make(http.Header)
+	switch server := helper.SafeDereference(channel.PushServer); server {
+	case models.PushServerNtfy:
+		msg = map[string]any{
+			"topic":    helper.SafeDereference(channel.PushTopic),
+			"title":    title,
+			"message":  text,
+			"priority": ntfyPriority(level),
+		}
+		if len(channel.PushTags) > 0 {
+			msg["tags"] = channel.PushTags
+		}
+		if link != "" {
+			msg["click"] = link
+		}
+		if token != "" {
+			header.Set("Authorization", "Bearer "+token)
+		}
+	case models.PushServerGotify:
+		msg = map[string]any{
+			"title":    title,
+			"message":  text,
+			"priority": gotifyPriority(level),
+		}
+		if link != "" {
+			msg["extras"] = map[string]any{
+				"client::notification": map[string]any{"click": map[string]any{"url": link}},
+			}
+		}
+		serverUrl += "/message"
+		header.Set("X-Gotify-Key", token)
+	default:
+		return fmt.Errorf("%w: unsupported push server %s", ErrPushMessageDelivery, server)
+	}
+
+	return p.post(serverUrl, header, msg)
+}
+
+// ntfyPriority maps the level to the priorities of ntfy, from 1 (min) to 5 (max)
+func ntfyPriority(level notifications.Level) int {
+	switch level {
+	case notifications.LevelUrgent:
+		return 5
+	case notifications.LevelError:
+		return 4
+	case notifications.LevelWarning:
+		return 3
+	default:
+		return 2
+	}
+}
+
+// gotifyPriority maps the level to the priorities of Gotify, the app notifies from 4 and pops up from 8
+func gotifyPriority(level notifications.Level) int {
+	switch level {
+	case notifications.LevelUrgent:
+		return 10
+	case notifications.LevelError:
+		return 8
+	case notifications.LevelWarning:
+		return 5
+	default:
+		return 2
+	}
+}
+
+func (p *PushService) post(url string, header http.Header, msg map[string]any) error {
+	body, err := json.Marshal(msg)
+	if err != nil {
+		return fmt.Errorf("can not marshal push message: %w", err)
+	}
+
+	req, err := http.NewRequest(http.MethodPost, url, bytes.NewBuffer(body))
+	if err != nil {
+		return fmt.Errorf("%w: %w", ErrPushMessageDelivery, err)
+	}
+	req.Header = header
+	req.Header.Set("Content-Type", "application/json")
+
+	resp, err := p.transport.Do(req)
+	if err != nil {
+		if errors.Is(err, context.DeadlineExceeded) {
+			return fmt.Errorf("%w: timeout", ErrPushMessageDelivery)
+		}
+		return fmt.Errorf("%w: %w", ErrPushMessageDelivery, err)
+	}
+
+	defer func() {
+		_ = resp.Body.Close()
+	}()
+
+	if resp.StatusCode < 200 || resp.StatusCode >= 300 {
+		return fmt.Errorf("%w: http status: %s", ErrPushMessageDelivery, resp.Status)
+	}
+
+	return nil
+}
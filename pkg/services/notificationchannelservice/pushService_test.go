@@ -0,0 +1,139 @@
+// SPDX-FileCopyrightText: 2026 Greenbone AG <https://greenbone.net>
+//
+// SPDX-License-Identifier: AGPL-3.0-or-later
+
+package notificationchannelservice
+
+import (
+	"net/http"
+	"testing"
+
+	"github.com/greenbone/opensight-golang-libraries/pkg/notifications"
+	"github.com/greenbone/opensight-notification-service/pkg/models"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+var testPushMessage = models.ChatMessage{
+	Title:  "New vulnerability",
+	Text:   "A **critical** vulnerability was found.",
+	Level:  notifications.LevelUrgent,
+	Origin: "SBOM - React",
+	Link:   "https://opensight.example.com/vi/sbom/react",
+}
+
+func TestSendPushMessage_Ntfy(t *testing.T) {
+	var gotRequest *http.Request
+	var gotBody []byte
+	svc := NewPushService(recordingClient(t, http.StatusOK, &gotRequest, &gotBody))
+
+	err := svc.SendMessage(models.NotificationChannel{
+		WebhookUrl:  new("https://ntfy.example.com/"),
+		PushServer:  new(models.PushServerNtfy),
+		PushTopic:   new("opensight"),
+		PushTags:    []string{"rotating_light", "opensight"},
+		AccessToken: new("tk_abc"),
+	}, testPushMessage)
+	require.NoError(t, err)
+
+	assert.Equal(t, http.MethodPost, gotRequest.Method)
+	assert.Equal(t, "https://ntfy.example.com", gotRequest.URL.String())
+	assert.Equal(t, "Bearer tk_abc", gotRequest.Header.Get("Authorization"))
+	assert.JSONEq(t, `{
+		"topic": "opensight",
+		"title": "New vulnerability",
+		"message": "A critical vulnerability was found.",
+		"priority": 5,
+		"tags": ["rotating_light", "opensight"],
+		"click": "https://opensight.example.com/vi/sbom/react"
+	}`, string(gotBody))
+}
+
+func TestSendPushMessage_Gotify(t *testing.T) {
+	var gotRequest *http.Request
+	var gotBody []byte
+	svc := NewPushService(recordingClient(t, http.StatusOK, &gotRequest, &gotBody))
+
+	err := svc.SendMessage(models.NotificationChannel{
+		WebhookUrl:  new("https://gotify.example.com"),
+		PushServer:  new(models.PushServerGotify),
+		AccessToken: new("AbCdEf123"),
+	}, testPushMessage)
+	require.NoError(t, err)
+
+	assert.Equal(t, "https://gotify.example.com/message", gotRequest.URL.String())
+	assert.Equal(t, "AbCdEf123", gotRequest.Header.Get("X-Gotify-Key"))
+	assert.Empty(t, gotRequest.Header.Get("Authorization"))
+	assert.JSONEq(t, `{
+		"title": "New vulnerability",
+		"message": "A critical vulnerability was found.",
+		"priority": 10,
+		"extras": {
+			"client::notification": {"click": {"url": "https://opensight.example.com/vi/sbom/react"}}
+		}
+	}`, string(gotBody))
+}
+
+func TestSendPushDigest(t *testing.T) {
+	var gotRequest *http.Request
+	var gotBody []byte
+	svc := NewPushService(recordingClient(t, http.StatusOK, &gotRequest, &gotBody))
+
+	err := svc.SendDigest(models.NotificationChannel{
+		WebhookUrl: new("https://ntfy.example.com"),
+		PushServer: new(models.PushServerNtfy),
+		PushTopic:  new("opensight"),
+	}, models.DigestMessage{
+		Title:   "2 new notifications",
+		Summary: "1 error, 1 warning",
+		Level:   notifications.LevelError,
+		Columns: []string{"Level", "Title"},
+		Rows:    [][]string{{"error", "Disk\nfull"}, {"warning", "Disk almost full"}},
+		Links:   []string{"https://opensight.example.com/1", ""},
+	})
+	require.NoError(t, err)
+
+	assert.JSONEq(t, `{
+		"topic": "opensight",
+		"title": "2 new notifications",
+		"message": "1 error, 1 warning\n• error | Disk full\n• warning | Disk almost full",
+		"priority": 4
+	}`, string(gotBody))
+}
+
+func TestSendPushMessage_Rejected(t *testing.T) {
+	svc := NewPushService(&http.Client{
+		Transport: roundTripperFunc(func(r *http.Request) (*http.Response, error) {
+			return &http.Response{
+				StatusCode: http.StatusUnauthorized,
+				Status:     "401 Unauthorized",
+				Body:       http.NoBody,
+				Header:     make(http.Header),
+			}, nil
+		})},
+	)
+
+	err := svc.SendMessage(models.NotificationChannel{
+		WebhookUrl: new("https://ntfy.example.com"),
+		PushServer: new(models.PushServerNtfy),
+		PushTopic:  new("opensight"),
+	}, testPushMessage)
+	require.ErrorIs(t, err, ErrPushMessageDelivery)
+}
+
+func TestPushPriority(t *testing.T) {
+	tests := []struct {
+		level      notifications.Level
+		wantNtfy   int
+		wantGotify int
+	}{
+		{notifications.LevelUrgent, 5, 10},
+		{notifications.LevelError, 4, 8},
+		{notifications.LevelWarning, 3, 5},
+		{notifications.LevelInfo, 2, 2},
+	}
+	for _, tt := range tests {
+		assert.Equal(t, tt.wantNtfy, ntfyPriority(tt.level), tt.level)
+		assert.Equal(t, tt.wantGotify, gotifyPriority(tt.level), tt.level)
+	}
+}
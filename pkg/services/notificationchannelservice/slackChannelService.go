@@ -0,0 +1,117 @@
+package notificationchannelservice
+
+import (
+	"context"
+	"errors"
+
+	"github.com/greenbone/opensight-notification-service/pkg/models"
+	"github.com/greenbone/opensight-notification-service/pkg/web/slackcontroller/slackdto"
+)
+
+var (
+	ErrSlackChannelLimitReached = errors.New("Slack channel limit reached.")
+	ErrListSlackChannels        = errors.New("failed to list slack channels")
+	ErrSlackChannelNameExists   = errors.New("Slack channel name already exists.")
+	ErrSlackMessageDelivery     = errors.New("slack message could not be send")
+)
+
+type SlackChannelService interface {
+	SendSlackTestMessage(webhookUrl string) error
+	CreateSlackChannel(
+		ctx context.Context,
+		channel slackdto.SlackNotificationChannelRequest,
+	) (slackdto.SlackNotificationChannelResponse, error)
+	UpdateSlackChannel(
+		ctx context.Context,
+		id string,
+		channel slackdto.SlackNotificationChannelRequest,
+	) (slackdto.SlackNotificationChannelResponse, error)
+}
+
+type slackChannelService struct {
+	notificationChannelService NotificationChannelService
+	slackChannelLimit          int
+	slackService               *SlackService
+}
+
+func NewSlackChannelService(
+	notificationChannelService NotificationChannelService,
+	slackChannelLimit int,
+	slackService *SlackService,
+) SlackChannelService {
+	return &slackChannelService{
+		notificationChannelService: notificationChannelService,
+		slackChannelLimit:          slackChannelLimit,
+		slackService:               slackService,
+	}
+}
+
+func (s *slackChannelService) SendSlackTestMessage(webhookUrl string) error {
+	return s.slackService.SendMessage(
+		models.NotificationChannel{WebhookUrl: &webhookUrl},
+		models.ChatMessage{Text: "Hello, This is a test message"},
+	)
+}
+
+func (s *slackChannelService) CreateSlackChannel(
+	ctx context.Context,
+	channel slackdto.SlackNotificationChannelRequest,
+) (slackdto.SlackNotificationChannelResponse, error) {
+	if err := s.slackChannelValidations(ctx, channel.ChannelName, ""); err != nil {
+		return slackdto.SlackNotificationChannelResponse{}, err
+	}
+
+	notificationChannel := slackdto.MapSlackToNotificationChannel(channel)
+	created, err := s.notificationChannelService.CreateNotificationChannel(ctx, notificationChannel)
+	if err != nil {
+		return slackdto.SlackNotificationChannelResponse{}, err
+	}
+
+	return slackdto.MapNotificationChannelToSlack(created), nil
+}
+
+func (s *slackChannelService) UpdateSlackChannel(
+	ctx context.Context,
+	id string,
+	channel slackdto.SlackNotificationChannelRequest,
+) (slackdto.SlackNotificationChannelResponse, error) {
+
+	if err := s.slackChannelValidations(ctx, channel.ChannelName, id); err != nil {
+		return slackdto.SlackNotificationChannelResponse{}, err
+	}
+
+	notificationChannel := slackdto.MapSlackToNotificationChannel(channel)
+	updated, err := s.notificationChannelService.UpdateNotificationChannel(ctx, id, notificationChannel)
+	if err != nil {
+		return slackdto.SlackNotificationChannelResponse{}, err
+	}
+
+	return slackdto.MapNotificationChannelToSlack(updated), nil
+}
+
+func (s *slackChannelService) slackChannelValidations(
+	ctx context.Context,
+	channelName string,
+	excludeId string,
+) error {
+	channels, err := s.notificationChannelService.ListNotificationChannelsByType(ctx, models.ChannelTypeSlack)
+	if err != nil {
+		return errors.Join(ErrListSlackChannels, err)
+	}
+
+	if len(channels) >= s.slackChannelLimit {
+		return ErrSlackChannelLimitReached
+	}
+
+	for _, ch := range channels {
+		if ch.Id == excludeId {
+			continue
+		}
+
+		if ch.ChannelName == channelName {
+			return ErrSlackChannelNameExists
+		}
+	}
+
+	return nil
+}
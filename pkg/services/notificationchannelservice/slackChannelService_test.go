@@ -0,0 +1,64 @@
+package notificationchannelservice
+
+import (
+	"context"
+	"net/http"
+	"testing"
+
+	"github.com/greenbone/opensight-notification-service/pkg/models"
+	"github.com/greenbone/opensight-notification-service/pkg/services/notificationchannelservice/mocks"
+	"github.com/greenbone/opensight-notification-service/pkg/web/slackcontroller/slackdto"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestSendSlackTestMessage(t *testing.T) {
+	var gotMethod, gotURL string
+
+	notificationChannelService := mocks.NewNotificationChannelService(t)
+	slackService := NewSlackService(&http.Client{
+		Transport: roundTripperFunc(func(r *http.Request) (*http.Response, error) {
+			gotMethod = r.Method
+			gotURL = r.URL.String()
+			return &http.Response{
+				StatusCode: http.StatusNoContent,
+				Body:       http.NoBody,
+				Header:     make(http.Header),
+			}, nil
+		})},
+	)
+	svc := NewSlackChannelService(notificationChannelService, 10, slackService)
+
+	webhook := "https://hooks.slack.com/services/T0001/B0001/01fa130f2e134641b2cf39d8a710a002"
+	err := svc.SendSlackTestMessage(webhook)
+	require.NoError(t, err)
+
+	assert.Equal(t, "POST", gotMethod)
+	assert.Equal(t, webhook, gotURL)
+}
+
+func TestSendSlackTestMessage_ErrorOnTransport(t *testing.T) {
+	notificationChannelService := mocks.NewNotificationChannelService(t)
+	slackService := NewSlackService(&http.Client{
+		Transport: roundTripperFunc(func(r *http.Request) (*http.Response, error) {
+			return nil, ErrSlackMessageDelivery
+		})})
+	svc := NewSlackChannelService(notificationChannelService, 10, slackService)
+
+	err := svc.SendSlackTestMessage("https://hooks.slack.com/services/T0001/B0001/01fa130f2e134641b2cf39d8a710a002")
+	require.ErrorContains(t, err, "slack message could not be send")
+}
+
+func TestSlackChannelLimit(t *testing.T) {
+	notificationChannelService := mocks.NewNotificationChannelService(t)
+	notificationChannelService.EXPECT().ListNotificationChannelsByType(context.Background(), models.ChannelTypeSlack).
+		Return([]models.NotificationChannel{
+			{},
+		}, nil)
+	slackService := NewSlackService(http.DefaultClient)
+
+	service := NewSlackChannelService(notificationChannelService, 1, slackService)
+
+	_, err := service.CreateSlackChannel(context.Background(), slackdto.SlackNotificationChannelRequest{})
+	require.ErrorIs(t, err, ErrSlackChannelLimitReached)
+}
@@ -0,0 +1,191 @@
+// SPDX-FileCopyrightText: 2026 Greenbone AG <https://greenbone.net>
+//
+// SPDX-License-Identifier: AGPL-3.0-or-later
+
+package notificationchannelservice
+
+import (
+	"bytes"
+	"context"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"net/http"
+	"strings"
+	"unicode/utf8"
+
+	"github.com/greenbone/opensight-notification-service/pkg/helper"
+	"github.com/greenbone/opensight-notification-service/pkg/markdown"
+	"github.com/greenbone/opensight-notification-service/pkg/models"
+)
+
+// limits of Block Kit, Slack rejects messages with longer texts or more fields
+const (
+	slackMaxHeaderLength  = 150
+	slackMaxSectionLength = 3000
+	slackMaxFields        = 10
+)
+
+type SlackService struct {
+	transport *http.Client
+}
+
+func NewSlackService(transport *http.Client) *SlackService {
+	return &SlackService{transport: transport}
+}
+
+// SendMessage sends the message as Block Kit attachment to the webhook URL of the given Slack channel.
+// The attachment has a color bar by level, the details as fields and a button linking to the origin resource.
+// The markdown text is converted to the mrkdwn format of Slack. For details see:
+// https://api.slack.com/reference/block-kit/blocks
+func (s *SlackService) SendMessage(channel models.NotificationChannel, message models.ChatMessage) error {
+	var blocks []map[string]any
+	if message.Title != "" {
+		blocks = append(blocks, slackHeader(message.Title))
+	}
+	if text := markdown.ToSlack(message.Text); text != "" {
+		blocks = append(blocks, slackSection(text))
+	}
+
+	details := messageDetails(message)
+	for len(details) > 0 {
+		n := min(len(details), slackMaxFields)
+		fields := make([]map[string]any, 0, n)
+		for _, field := range details[:n] {
+			fields = append(fields, slackText(fmt.Sprintf("*%s*\n%s", markdown.EscapeSlack(field.Name), markdown.EscapeSlack(field.Value))))
+		}
+		blocks = append(blocks, map[string]any{"type": "section", "fields": fields})
+		details = details[n:]
+	}
+
+	if message.Link != "" {
+		blocks = append(blocks, map[string]any{
+			"type": "actions",
+			"elements": []map[string]any{
+				{
+					"type": "button",
+					"text": map[string]any{"type": "plain_text", "text": models.OpenLinkTitle},
+					"url":  message.Link,
+				},
+			},
+		})
+	}
+	blocks = append(blocks, slackFooter())
+
+	return s.post(channel, map[string]any{
+		"text": chatMessageFallback(message),
+		"attachments": []map[string]any{
+			{
+				"color":  models.LevelColor(message.Level),
+				"blocks": blocks,
+			},
+		},
+	})
+}
+
+// SendDigest sends the digest to the webhook URL of the given Slack channel. Block Kit has no tables,
+// so each notification is listed as a line with the values separated by |.
+func (s *SlackService) SendDigest(channel models.NotificationChannel, digest models.DigestMessage) error {
+	blocks := []map[string]any{slackHeader(digest.Title)}
+	if digest.Summary != "" {
+		blocks = append(blocks, slackSection(markdown.EscapeSlack(digest.Summary)))
+	}
+
+	line := func(cells []string) string {
+		escaped := make([]string, 0, len(cells))
+		for _, cell := range cells {
+			escaped = append(escaped, markdown.EscapeSlack(strings.Join(strings.Fields(cell), " ")))
+		}
+		return strings.Join(escaped, " | ")
+	}
+
+	lines := []string{"*" + line(digest.Columns) + "*"}
+	for i, row := range digest.Rows {
+		text := line(row)
+		if link := digest.Link(i); link != "" {
+			text += fmt.Sprintf(" | <%s|%s>", link, models.DigestLinkTitle)
+		}
+		lines = append(lines, text)
+	}
+
+	// the lines are split into several sections, as the length of a section is limited
+	var section strings.Builder
+	for _, text := range lines {
+		if section.Len() > 0 && section.Len()+len(text)+1 > slackMaxSectionLength {
+			blocks = append(blocks, slackSection(section.String()))
+			section.Reset()
+		}
+		if section.Len() > 0 {
+			section.WriteString("\n")
+		}
+		section.WriteString(text)
+	}
+	blocks = append(blocks, slackSection(section.String()), slackFooter())
+
+	return s.post(channel, map[string]any{
+		"text": digest.Title,
+		"attachments": []map[string]any{
+			{
+				"color":  models.LevelColor(digest.Level),
+				"blocks": blocks,
+			},
+		},
+	})
+}
+
+func slackHeader(title string) map[string]any {
+	return map[string]any{
+		"type": "header",
+		"text": map[string]any{"type": "plain_text", "text": truncate(title, slackMaxHeaderLength), "emoji": true},
+	}
+}
+
+func slackSection(text string) map[string]any {
+	return map[string]any{"type": "section", "text": slackText(truncate(text, slackMaxSectionLength))}
+}
+
+func slackText(text string) map[string]any {
+	return map[string]any{"type": "mrkdwn", "text": text}
+}
+
+// slackFooter is the context block shown below the messages
+func slackFooter() map[string]any {
+	return map[string]any{
+		"type":     "context",
+		"elements": []map[string]any{slackText(chatMessageFooter)},
+	}
+}
+
+// truncate shortens the text to at most limit characters, a cut text ends with …
+func truncate(text string, limit int) string {
+	if utf8.RuneCountInString(text) <= limit {
+		return text
+	}
+	runes := []rune(text)
+	return string(runes[:limit-1]) + "…"
+}
+
+func (s *SlackService) post(channel models.NotificationChannel, msg map[string]any) error {
+	body, err := json.Marshal(msg)
+	if err != nil {
+		return fmt.Errorf("can not marshal slack message: %w", err)
+	}
+
+	resp, err := s.transport.Post(helper.SafeDereference(channel.WebhookUrl), "application/json", bytes.NewBuffer(body))
+	if err != nil {
+		if errors.Is(err, context.DeadlineExceeded) {
+			return fmt.Errorf("%w: timeout", ErrSlackMessageDelivery)
+		}
+		return fmt.Errorf("%w: %w", ErrSlackMessageDelivery, err)
+	}
+
+	defer func() {
+		_ = resp.Body.Close()
+	}()
+
+	if resp.StatusCode < 200 || resp.StatusCode >= 300 {
+		return fmt.Errorf("%w: http status: %s", ErrSlackMessageDelivery, resp.Status)
+	}
+
+	return nil
+}
@@ -0,0 +1,170 @@
+// SPDX-FileCopyrightText: 2026 Greenbone AG <https://greenbone.net>
+//
+// SPDX-License-Identifier: AGPL-3.0-or-later
+
+package notificationchannelservice
+
+import (
+	"encoding/json"
+	"net/http"
+	"strings"
+	"testing"
+
+	"github.com/greenbone/opensight-golang-libraries/pkg/notifications"
+	"github.com/greenbone/opensight-notification-service/pkg/models"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func newTestSlackService(t *testing.T, gotBody *map[string]any) *SlackService {
+	return NewSlackService(&http.Client{
+		Transport: roundTripperFunc(func(r *http.Request) (*http.Response, error) {
+			assert.Equal(t, http.MethodPost, r.Method)
+			assert.Equal(t, "https://hooks.slack.com/services/T0001/B0001/abc", r.URL.String())
+			require.NoError(t, json.NewDecoder(r.Body).Decode(gotBody))
+			return &http.Response{
+				StatusCode: http.StatusOK,
+				Body:       http.NoBody,
+				Header:     make(http.Header),
+			}, nil
+		})},
+	)
+}
+
+func TestSendSlackMessage(t *testing.T) {
+	var gotBody map[string]any
+	svc := newTestSlackService(t, &gotBody)
+
+	err := svc.SendMessage(models.NotificationChannel{WebhookUrl: new("https://hooks.slack.com/services/T0001/B0001/abc")}, models.ChatMessage{
+		Title:       "New vulnerability",
+		Text:        "**Host** is affected, see [details](https://example.com/1)",
+		Level:       notifications.LevelWarning,
+		Origin:      "SBOM - React",
+		OriginClass: "/vi/SBOM",
+		Timestamp:   "2024-01-01T00:00:00Z",
+		Fields:      []models.MessageField{{Name: "host", Value: "<10.0.0.1>"}},
+		Link:        "https://opensight.example.com/vi/sbom/1",
+	})
+	require.NoError(t, err)
+
+	field := func(text string) any {
+		return map[string]any{"type": "mrkdwn", "text": text}
+	}
+	assert.Equal(t, map[string]any{
+		"text": "New vulnerability",
+		"attachments": []any{
+			map[string]any{
+				"color": "#F9A825",
+				"blocks": []any{
+					map[string]any{
+						"type": "header",
+						"text": map[string]any{"type": "plain_text", "text": "New vulnerability", "emoji": true},
+					},
+					map[string]any{
+						"type": "section",
+						"text": field("*Host* is affected, see <https://example.com/1|details>"),
+					},
+					map[string]any{
+						"type": "section",
+						"fields": []any{
+							field("*Level*\nwarning"),
+							field("*Origin*\nSBOM - React"),
+							field("*Origin class*\n/vi/SBOM"),
+							field("*Time*\n2024-01-01T00:00:00Z"),
+							field("*host*\n&lt;10.0.0.1&gt;"),
+						},
+					},
+					map[string]any{
+						"type": "actions",
+						"elements": []any{
+							map[string]any{
+								"type": "button",
+								"text": map[string]any{"type": "plain_text", "text": "Open in OpenSight"},
+								"url":  "https://opensight.example.com/vi/sbom/1",
+							},
+						},
+					},
+					map[string]any{
+						"type":     "context",
+						"elements": []any{field("Greenbone OpenSight")},
+					},
+				},
+			},
+		},
+	}, gotBody)
+}
+
+func TestSendSlackMessage_Limits(t *testing.T) {
+	var gotBody map[string]any
+	svc := newTestSlackService(t, &gotBody)
+
+	var fields []models.MessageField
+	for range 9 {
+		fields = append(fields, models.MessageField{Name: "name", Value: "value"})
+	}
+	err := svc.SendMessage(models.NotificationChannel{WebhookUrl: new("https://hooks.slack.com/services/T0001/B0001/abc")}, models.ChatMessage{
+		Title:  strings.Repeat("a", 200),
+		Level:  notifications.LevelInfo,
+		Origin: "SBOM - React",
+		Fields: fields,
+	})
+	require.NoError(t, err)
+
+	blocks := gotBody["attachments"].([]any)[0].(map[string]any)["blocks"].([]any)
+	require.Len(t, blocks, 4, "header, two field sections and footer")
+
+	title := blocks[0].(map[string]any)["text"].(map[string]any)["text"].(string)
+	assert.Equal(t, 150, len([]rune(title)))
+	assert.True(t, strings.HasSuffix(title, "…"))
+	assert.Len(t, blocks[1].(map[string]any)["fields"], 10)
+	assert.Len(t, blocks[2].(map[string]any)["fields"], 1)
+}
+
+func TestSendSlackDigest(t *testing.T) {
+	var gotBody map[string]any
+	svc := newTestSlackService(t, &gotBody)
+
+	err := svc.SendDigest(models.NotificationChannel{WebhookUrl: new("https://hooks.slack.com/services/T0001/B0001/abc")}, models.DigestMessage{
+		Title:   "Digest: 2 notifications",
+		Summary: "1 error, 1 info",
+		Level:   notifications.LevelError,
+		Columns: []string{"Level", "Title"},
+		Rows:    [][]string{{"error", "a <b>\nc"}, {"info", "d"}},
+		Links:   []string{"https://opensight.example.com/vi/sbom/1", ""},
+	})
+	require.NoError(t, err)
+
+	assert.Equal(t, "Digest: 2 notifications", gotBody["text"])
+	attachment := gotBody["attachments"].([]any)[0].(map[string]any)
+	assert.Equal(t, models.LevelColor(notifications.LevelError), attachment["color"])
+	blocks := attachment["blocks"].([]any)
+	require.Len(t, blocks, 4)
+	assert.Equal(t, "1 error, 1 info", blocks[1].(map[string]any)["text"].(map[string]any)["text"])
+	assert.Equal(t,
+		"*Level | Title*\nerror | a &lt;b&gt; c | <https://opensight.example.com/vi/sbom/1|Open>\ninfo | d",
+		blocks[2].(map[string]any)["text"].(map[string]any)["text"],
+	)
+}
+
+func TestSendSlackDigest_SplitsSections(t *testing.T) {
+	var gotBody map[string]any
+	svc := newTestSlackService(t, &gotBody)
+
+	var rows [][]string
+	for range 50 {
+		rows = append(rows, []string{strings.Repeat("x", 100)})
+	}
+	err := svc.SendDigest(models.NotificationChannel{WebhookUrl: new("https://hooks.slack.com/services/T0001/B0001/abc")}, models.DigestMessage{
+		Title:   "Digest: 50 notifications",
+		Columns: []string{"Title"},
+		Rows:    rows,
+	})
+	require.NoError(t, err)
+
+	blocks := gotBody["attachments"].([]any)[0].(map[string]any)["blocks"].([]any)
+	require.Len(t, blocks, 4, "header, two sections and footer")
+	for _, block := range blocks[1:3] {
+		text := block.(map[string]any)["text"].(map[string]any)["text"].(string)
+		assert.LessOrEqual(t, len(text), slackMaxSectionLength)
+	}
+}
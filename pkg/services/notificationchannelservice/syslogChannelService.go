@@ -0,0 +1,115 @@
+package notificationchannelservice
+
+import (
+	"context"
+	"errors"
+
+	"github.com/greenbone/opensight-notification-service/pkg/models"
+	"github.com/greenbone/opensight-notification-service/pkg/web/syslogcontroller/syslogdto"
+)
+
+var (
+	ErrSyslogChannelLimitReached = errors.New("Syslog channel limit reached.")
+	ErrListSyslogChannels        = errors.New("failed to list syslog channels")
+	ErrSyslogChannelNameExists   = errors.New("Syslog channel name already exists.")
+	ErrSyslogServerUnreachable   = errors.New("syslog server is unreachable")
+	ErrSyslogMessageDelivery     = errors.New("syslog message could not be send")
+)
+
+type SyslogChannelService interface {
+	CheckSyslogConnectivity(ctx context.Context, channel models.NotificationChannel) error
+	CreateSyslogChannel(
+		ctx context.Context,
+		channel syslogdto.SyslogNotificationChannelRequest,
+	) (syslogdto.SyslogNotificationChannelResponse, error)
+	UpdateSyslogChannel(
+		ctx context.Context,
+		id string,
+		channel syslogdto.SyslogNotificationChannelRequest,
+	) (syslogdto.SyslogNotificationChannelResponse, error)
+}
+
+type syslogChannelService struct {
+	notificationChannelService NotificationChannelService
+	syslogChannelLimit         int
+	syslogService              *SyslogService
+}
+
+func NewSyslogChannelService(
+	notificationChannelService NotificationChannelService,
+	syslogChannelLimit int,
+	syslogService *SyslogService,
+) SyslogChannelService {
+	return &syslogChannelService{
+		notificationChannelService: notificationChannelService,
+		syslogChannelLimit:         syslogChannelLimit,
+		syslogService:              syslogService,
+	}
+}
+
+// CheckSyslogConnectivity checks that the collector of the channel can be reached with its transport
+func (s *syslogChannelService) CheckSyslogConnectivity(ctx context.Context, channel models.NotificationChannel) error {
+	return s.syslogService.ConnectionCheck(ctx, channel)
+}
+
+func (s *syslogChannelService) CreateSyslogChannel(
+	ctx context.Context,
+	channel syslogdto.SyslogNotificationChannelRequest,
+) (syslogdto.SyslogNotificationChannelResponse, error) {
+	if err := s.syslogChannelValidations(ctx, channel.ChannelName, ""); err != nil {
+		return syslogdto.SyslogNotificationChannelResponse{}, err
+	}
+
+	notificationChannel := syslogdto.MapSyslogToNotificationChannel(channel)
+	created, err := s.notificationChannelService.CreateNotificationChannel(ctx, notificationChannel)
+	if err != nil {
+		return syslogdto.SyslogNotificationChannelResponse{}, err
+	}
+
+	return syslogdto.MapNotificationChannelToSyslog(created), nil
+}
+
+func (s *syslogChannelService) UpdateSyslogChannel(
+	ctx context.Context,
+	id string,
+	channel syslogdto.SyslogNotificationChannelRequest,
+) (syslogdto.SyslogNotificationChannelResponse, error) {
+	if err := s.syslogChannelValidations(ctx, channel.ChannelName, id); err != nil {
+		return syslogdto.SyslogNotificationChannelResponse{}, err
+	}
+
+	notificationChannel := syslogdto.MapSyslogToNotificationChannel(channel)
+	updated, err := s.notificationChannelService.UpdateNotificationChannel(ctx, id, notificationChannel)
+	if err != nil {
+		return syslogdto.SyslogNotificationChannelResponse{}, err
+	}
+
+	return syslogdto.MapNotificationChannelToSyslog(updated), nil
+}
+
+func (s *syslogChannelService) syslogChannelValidations(
+	ctx context.Context,
+	channelName string,
+	excludeId string,
+) error {
+	channels, err := s.notificationChannelService.ListNotificationChannelsByType(ctx, models.ChannelTypeSyslog)
+	if err != nil {
+		return errors.Join(ErrListSyslogChannels, err)
+	}
+
+	if len(channels) >= s.syslogChannelLimit {
+		return ErrSyslogChannelLimitReached
+	}
+
+	for _, ch := range channels {
+		if ch.Id == excludeId {
+			continue
+		}
+
+		if ch.ChannelName == channelName {
+			return ErrSyslogChannelNameExists
+		}
+	}
+
+	return nil
+}
@@ -0,0 +1,37 @@
+package notificationchannelservice
+
+import (
+	"context"
+	"testing"
+
+	"github.com/greenbone/opensight-notification-service/pkg/models"
+	"github.com/greenbone/opensight-notification-service/pkg/services/notificationchannelservice/mocks"
+	"github.com/greenbone/opensight-notification-service/pkg/web/syslogcontroller/syslogdto"
+	"github.com/stretchr/testify/require"
+)
+
+func TestSyslogChannelLimit(t *testing.T) {
+	notificationChannelService := mocks.NewNotificationChannelService(t)
+	notificationChannelService.EXPECT().ListNotificationChannelsByType(context.Background(), models.ChannelTypeSyslog).
+		Return([]models.NotificationChannel{
+			{},
+		}, nil)
+
+	service := NewSyslogChannelService(notificationChannelService, 1, NewSyslogService(nil))
+
+	_, err := service.CreateSyslogChannel(context.Background(), syslogdto.SyslogNotificationChannelRequest{})
+	require.ErrorIs(t, err, ErrSyslogChannelLimitReached)
+}
+
+func TestSyslogChannelNameExists(t *testing.T) {
+	notificationChannelService := mocks.NewNotificationChannelService(t)
+	notificationChannelService.EXPECT().ListNotificationChannelsByType(context.Background(), models.ChannelTypeSyslog).
+		Return([]models.NotificationChannel{
+			{Id: "1", ChannelName: "siem"},
+		}, nil)
+
+	service := NewSyslogChannelService(notificationChannelService, 5, NewSyslogService(nil))
+
+	_, err := service.UpdateSyslogChannel(context.Background(), "2", syslogdto.SyslogNotificationChannelRequest{ChannelName: "siem"})
+	require.ErrorIs(t, err, ErrSyslogChannelNameExists)
+}
@@ -0,0 +1,256 @@
+// SPDX-FileCopyrightText: 2026 Greenbone AG <https://greenbone.net>
+//
+// SPDX-License-Identifier: AGPL-3.0-or-later
+
+package notificationchannelservice
+
+import (
+	"context"
+	"crypto/tls"
+	"fmt"
+	"io"
+	"net"
+	"os"
+	"strconv"
+	"strings"
+	"time"
+	"unicode/utf8"
+
+	"github.com/greenbone/opensight-golang-libraries/pkg/notifications"
+	"github.com/greenbone/opensight-notification-service/pkg/helper"
+	"github.com/greenbone/opensight-notification-service/pkg/markdown"
+	"github.com/greenbone/opensight-notification-service/pkg/models"
+)
+
+const (
+	syslogTimeout = 5 * time.Second
+	// syslogMaxMessageSize is the size of messages every receiver should accept (RFC 5424, section 6.1),
+	// longer messages may be truncated or dropped, especially over UDP
+	syslogMaxMessageSize = 2048
+	syslogTimeFormat     = "2006-01-02T15:04:05.999999Z07:00"
+	syslogMsgID          = "notification"
+	syslogBOM            = "\ufeff"
+	// IDs of the structured data elements. Custom IDs require an enterprise number, 32473
+	// is the number reserved for documentation by RFC 5612.
+	syslogSDID       = "opensight@32473"
+	syslogFieldsSDID = "fields@32473"
+)
+
+var syslogParamValueReplacer = strings.NewReplacer(`\`, `\\`, `"`, `\"`, `]`, `\]`)
+
+// SyslogService sends notifications as RFC 5424 messages to the syslog collector of a SIEM.
+type SyslogService struct {
+	hostname  string
+	tlsConfig *tls.Config
+}
+
+// NewSyslogService creates the syslog service. Without TLS config the certificates
+// of collectors are verified with the system roots.
+func NewSyslogService(tlsConfig *tls.Config) *SyslogService {
+	hostname, err := os.Hostname()
+	if err != nil || hostname == "" {
+		hostname = "-"
+	}
+	return &SyslogService{hostname: hostname, tlsConfig: tlsConfig}
+}
+
+// SendMessages sends the messages over one connection to the collector of the given syslog channel.
+// The level of a message is mapped to the syslog severity, origin, class and custom fields are sent
+// as structured data.
+func (s *SyslogService) SendMessages(
+	ctx context.Context,
+	channel models.NotificationChannel,
+	messages []models.ChatMessage,
+) error {
+	conn, err := s.dial(ctx, channel)
+	if err != nil {
+		return err
+	}
+	defer func() {
+		_ = conn.Close()
+	}()
+
+	if err := conn.SetWriteDeadline(time.Now().Add(syslogTimeout)); err != nil {
+		return fmt.Errorf("%w: %w", ErrSyslogMessageDelivery, err)
+	}
+	for _, message := range messages {
+		frame := s.format(channel, message)
+		if syslogTransport(channel) != models.SyslogTransportUDP {
+			// octet counting, so messages may contain line breaks
+			frame = strconv.Itoa(len(frame)) + " " + frame
+		}
+		if _, err := io.WriteString(conn, frame); err != nil {
+			return fmt.Errorf("%w: %w", ErrSyslogMessageDelivery, err)
+		}
+	}
+	return nil
+}
+
+// ConnectionCheck checks that a connection to the collector can be established. Over UDP
+// only the host can be checked, as datagrams are not acknowledged.
+func (s *SyslogService) ConnectionCheck(ctx context.Context, channel models.NotificationChannel) error {
+	conn, err := s.dial(ctx, channel)
+	if err != nil {
+		return err
+	}
+	return conn.Close()
+}
+
+func (s *SyslogService) dial(ctx context.Context, channel models.NotificationChannel) (net.Conn, error) {
+	transport := syslogTransport(channel)
+	port := helper.SafeDereference(channel.Port)
+	if port == 0 {
+		port = models.DefaultSyslogPort(transport)
+	}
+	address := net.JoinHostPort(helper.SafeDereference(channel.Domain), strconv.Itoa(port))
+	dialer := &net.Dialer{Timeout: syslogTimeout}
+
+	var conn net.Conn
+	var err error
+	switch transport {
+	case models.SyslogTransportUDP:
+		conn, err = dialer.DialContext(ctx, "udp", address)
+	case models.SyslogTransportTCP:
+		conn, err = dialer.DialContext(ctx, "tcp", address)
+	case models.SyslogTransportTLS:
+		tlsDialer := &tls.Dialer{NetDialer: dialer, Config: s.tlsConfig}
+		conn, err = tlsDialer.DialContext(ctx, "tcp", address)
+	default:
+		return nil, fmt.Errorf("%w: unsupported transport %s", ErrSyslogServerUnreachable, transport)
+	}
+	if err != nil {
+		return nil, fmt.Errorf("%w: %w", ErrSyslogServerUnreachable, err)
+	}
+	return conn, nil
+}
+
+// format renders the message as `<PRI>1 TIMESTAMP HOSTNAME APP-NAME PROCID MSGID STRUCTURED-DATA MSG`.
+// The timestamp is the one of the notification, the text is sent as a single line of plain text.
+// The text is truncated to keep the message within syslogMaxMessageSize, custom fields are left out if they
+// don't fit either.
+func (s *SyslogService) format(channel models.NotificationChannel, message models.ChatMessage) string {
+	facility := models.DefaultSyslogFacility
+	if channel.SyslogFacility != nil {
+		facility = *channel.SyslogFacility
+	}
+	appName := helper.SafeDereference(channel.SyslogAppName)
+	if appName == "" {
+		appName = models.DefaultSyslogAppName
+	}
+
+	timestamp, err := time.Parse(time.RFC3339, message.Timestamp)
+	if err != nil {
+		timestamp = time.Now()
+	}
+
+	text := strings.Join(strings.Fields(markdown.ToText(message.Text)), " ")
+	if message.Title != "" && text != "" {
+		text = message.Title + ": " + text
+	} else if message.Title != "" {
+		text = message.Title
+	}
+
+	header := fmt.Sprintf("<%d>1 %s %s %s - %s ",
+		facility*8+syslogSeverity(message.Level),
+		timestamp.UTC().Format(syslogTimeFormat),
+		s.hostname,
+		appName,
+		syslogMsgID,
+	)
+	structuredData := syslogStructuredData(message, true)
+	if len(header)+len(structuredData) >= syslogMaxMessageSize {
+		structuredData = syslogStructuredData(message, false)
+	}
+	prefix := header + structuredData + " " + syslogBOM
+
+	return prefix + truncateBytes(text, syslogMaxMessageSize-len(prefix))
+}
+
+// truncateBytes shortens the text to at most limit bytes without splitting a character
+func truncateBytes(text string, limit int) string {
+	if len(text) <= limit {
+		return text
+	}
+	const ellipsis = "…"
+	if limit < len(ellipsis) {
+		return ""
+	}
+	cut := limit - len(ellipsis)
+	for cut > 0 && !utf8.RuneStart(text[cut]) {
+		cut--
+	}
+	return text[:cut] + ellipsis
+}
+
+func syslogTransport(channel models.NotificationChannel) string {
+	if transport := helper.SafeDereference(channel.SyslogTransport); transport != "" {
+		return transport
+	}
+	return models.SyslogTransportUDP
+}
+
+// syslogSeverity maps the level to the severity of RFC 5424
+func syslogSeverity(level notifications.Level) int {
+	switch level {
+	case notifications.LevelUrgent:
+		return 2 // critical
+	case notifications.LevelError:
+		return 3 // error
+	case notifications.LevelWarning:
+		return 4 // warning
+	case notifications.LevelInfo:
+		return 6 // informational
+	default:
+		return 5 // notice
+	}
+}
+
+// syslogStructuredData renders the details of the message as structured data. Custom fields
+// with names which are not valid as parameter names are left out, all custom fields without withFields.
+func syslogStructuredData(message models.ChatMessage, withFields bool) string {
+	var b strings.Builder
+	param := func(name, value string) {
+		fmt.Fprintf(&b, ` %s="%s"`, name, syslogParamValueReplacer.Replace(value))
+	}
+
+	b.WriteString("[" + syslogSDID)
+	param("origin", message.Origin)
+	param("originClass", message.OriginClass)
+	param("level", string(message.Level))
+	if message.Link != "" {
+		param("link", message.Link)
+	}
+	b.WriteString("]")
+
+	if !withFields {
+		return b.String()
+	}
+	hasFields := false
+	for _, field := range message.Fields {
+		if !isSyslogParamName(field.Name) {
+			continue
+		}
+		if !hasFields {
+			b.WriteString("[" + syslogFieldsSDID)
+			hasFields = true
+		}
+		param(field.Name, field.Value)
+	}
+	if hasFields {
+		b.WriteString("]")
+	}
+	return b.String()
+}
+
+// isSyslogParamName checks that the name has at most 32 printable ASCII characters except =, ], " and space
+func isSyslogParamName(name string) bool {
+	if name == "" || len(name) > 32 {
+		return false
+	}
+	for _, c := range name {
+		if c < 33 || c > 126 || c == '=' || c == ']' || c == '"' {
+			return false
+		}
+	}
+	return true
+}
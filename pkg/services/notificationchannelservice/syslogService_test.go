@@ -0,0 +1,234 @@
+// SPDX-FileCopyrightText: 2026 Greenbone AG <https://greenbone.net>
+//
+// SPDX-License-Identifier: AGPL-3.0-or-later
+
+package notificationchannelservice
+
+import (
+	"bufio"
+	"context"
+	"crypto/tls"
+	"crypto/x509"
+	"io"
+	"net"
+	"net/http/httptest"
+	"strconv"
+	"strings"
+	"testing"
+	"unicode/utf8"
+
+	"github.com/greenbone/opensight-golang-libraries/pkg/notifications"
+	"github.com/greenbone/opensight-notification-service/pkg/models"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+var testSyslogMessage = models.ChatMessage{
+	Title:       "New vulnerability",
+	Text:        "**Host** is affected,\nsee [details](https://example.com/1)",
+	Level:       notifications.LevelError,
+	Origin:      "SBOM - React",
+	OriginClass: "/vi/SBOM",
+	Timestamp:   "2026-01-01T12:00:00.5+01:00",
+	Fields: []models.MessageField{
+		{Name: "host", Value: `"a\b]`},
+		{Name: "invalid name", Value: "dropped"},
+	},
+	Link: "https://opensight.example.com/vi/sbom/1",
+}
+
+func testSyslogChannel(transport string, port int) models.NotificationChannel {
+	return models.NotificationChannel{
+		ChannelType:     models.ChannelTypeSyslog,
+		Domain:          new("127.0.0.1"),
+		Port:            &port,
+		SyslogTransport: &transport,
+		SyslogFacility:  new(4),
+		SyslogAppName:   new("opensight"),
+	}
+}
+
+// readFrames reads the octet counted frames of one connection
+func readFrames(t *testing.T, conn net.Conn, n int) []string {
+	reader := bufio.NewReader(conn)
+	frames := make([]string, 0, n)
+	for range n {
+		length, err := reader.ReadString(' ')
+		require.NoError(t, err)
+		size, err := strconv.Atoi(strings.TrimSpace(length))
+		require.NoError(t, err)
+		frame := make([]byte, size)
+		_, err = io.ReadFull(reader, frame)
+		require.NoError(t, err)
+		frames = append(frames, string(frame))
+	}
+	return frames
+}
+
+func TestSyslogFormat(t *testing.T) {
+	svc := &SyslogService{hostname: "opensight.example.com"}
+
+	got := svc.format(testSyslogChannel(models.SyslogTransportUDP, 514), testSyslogMessage)
+
+	assert.Equal(t, `<35>1 2026-01-01T11:00:00.5Z opensight.example.com opensight - notification `+
+		`[opensight@32473 origin="SBOM - React" originClass="/vi/SBOM" level="error" link="https://opensight.example.com/vi/sbom/1"]`+
+		`[fields@32473 host="\"a\\b\]"] `+
+		"\ufeffNew vulnerability: Host is affected, see details (https://example.com/1)", got)
+}
+
+func TestSyslogFormat_Defaults(t *testing.T) {
+	svc := &SyslogService{hostname: "-"}
+
+	got := svc.format(models.NotificationChannel{}, models.ChatMessage{
+		Title:     strings.Repeat("a", 3000),
+		Level:     notifications.LevelUrgent,
+		Timestamp: "2026-01-01T12:00:00Z",
+	})
+
+	prefix := `<130>1 2026-01-01T12:00:00Z - opensight-notification - notification ` +
+		`[opensight@32473 origin="" originClass="" level="urgent"] ` + "\ufeff"
+	require.True(t, strings.HasPrefix(got, prefix), got)
+	assert.Len(t, got, syslogMaxMessageSize)
+	assert.True(t, strings.HasSuffix(got, "a…"), got)
+}
+
+func TestSyslogFormat_TruncatesMultiByteText(t *testing.T) {
+	svc := &SyslogService{hostname: "-"}
+
+	got := svc.format(models.NotificationChannel{}, models.ChatMessage{
+		Title:     strings.Repeat("ä", 2000),
+		Level:     notifications.LevelInfo,
+		Timestamp: "2026-01-01T12:00:00Z",
+	})
+
+	assert.LessOrEqual(t, len(got), syslogMaxMessageSize)
+	assert.True(t, utf8.ValidString(got))
+	assert.True(t, strings.HasSuffix(got, "ä…"), got)
+}
+
+func TestSyslogFormat_LeavesOutFieldsWhichDoNotFit(t *testing.T) {
+	svc := &SyslogService{hostname: "-"}
+
+	got := svc.format(models.NotificationChannel{}, models.ChatMessage{
+		Title:     "Title",
+		Level:     notifications.LevelInfo,
+		Timestamp: "2026-01-01T12:00:00Z",
+		Fields:    []models.MessageField{{Name: "long", Value: strings.Repeat("v", 3000)}},
+	})
+
+	assert.NotContains(t, got, syslogFieldsSDID)
+	assert.True(t, strings.HasSuffix(got, "\ufeffTitle"), got)
+}
+
+func TestTruncateBytes(t *testing.T) {
+	tests := map[string]struct {
+		text  string
+		limit int
+		want  string
+	}{
+		"short text":           {text: "abc", limit: 3, want: "abc"},
+		"long text":            {text: "abcdef", limit: 5, want: "ab…"},
+		"multi byte character": {text: "aäöü", limit: 5, want: "a…"},
+		"limit below ellipsis": {text: "abcdef", limit: 2, want: ""},
+	}
+	for name, tt := range tests {
+		t.Run(name, func(t *testing.T) {
+			assert.Equal(t, tt.want, truncateBytes(tt.text, tt.limit))
+		})
+	}
+}
+
+func TestSyslogSeverity(t *testing.T) {
+	assert.Equal(t, 2, syslogSeverity(notifications.LevelUrgent))
+	assert.Equal(t, 3, syslogSeverity(notifications.LevelError))
+	assert.Equal(t, 4, syslogSeverity(notifications.LevelWarning))
+	assert.Equal(t, 6, syslogSeverity(notifications.LevelInfo))
+}
+
+func TestSendSyslogMessages_UDP(t *testing.T) {
+	conn, err := net.ListenPacket("udp", "127.0.0.1:0")
+	require.NoError(t, err)
+	defer conn.Close()
+
+	svc := NewSyslogService(nil)
+	channel := testSyslogChannel(models.SyslogTransportUDP, conn.LocalAddr().(*net.UDPAddr).Port)
+	err = svc.SendMessages(context.Background(), channel, []models.ChatMessage{testSyslogMessage, testSyslogMessage})
+	require.NoError(t, err)
+
+	buf := make([]byte, 2048)
+	for range 2 {
+		n, _, err := conn.ReadFrom(buf)
+		require.NoError(t, err)
+		assert.Equal(t, svc.format(channel, testSyslogMessage), string(buf[:n]))
+	}
+}
+
+func TestSendSyslogMessages_TCP(t *testing.T) {
+	listener, err := net.Listen("tcp", "127.0.0.1:0")
+	require.NoError(t, err)
+	defer listener.Close()
+
+	svc := NewSyslogService(nil)
+	channel := testSyslogChannel(models.SyslogTransportTCP, listener.Addr().(*net.TCPAddr).Port)
+
+	received := make(chan []string)
+	go func() {
+		conn, err := listener.Accept()
+		if !assert.NoError(t, err) {
+			close(received)
+			return
+		}
+		defer conn.Close()
+		received <- readFrames(t, conn, 2)
+	}()
+
+	err = svc.SendMessages(context.Background(), channel, []models.ChatMessage{testSyslogMessage, testSyslogMessage})
+	require.NoError(t, err)
+
+	want := svc.format(channel, testSyslogMessage)
+	assert.Equal(t, []string{want, want}, <-received)
+}
+
+func TestSendSyslogMessages_TLS(t *testing.T) {
+	server := httptest.NewUnstartedServer(nil)
+	defer server.Close()
+	server.StartTLS()
+
+	certificate := server.TLS.Certificates[0]
+	listener, err := tls.Listen("tcp", "127.0.0.1:0", &tls.Config{Certificates: []tls.Certificate{certificate}})
+	require.NoError(t, err)
+	defer listener.Close()
+
+	roots := x509.NewCertPool()
+	roots.AddCert(server.Certificate())
+	svc := NewSyslogService(&tls.Config{RootCAs: roots})
+	channel := testSyslogChannel(models.SyslogTransportTLS, listener.Addr().(*net.TCPAddr).Port)
+
+	received := make(chan []string)
+	go func() {
+		conn, err := listener.Accept()
+		if !assert.NoError(t, err) {
+			close(received)
+			return
+		}
+		defer conn.Close()
+		received <- readFrames(t, conn, 1)
+	}()
+
+	err = svc.SendMessages(context.Background(), channel, []models.ChatMessage{testSyslogMessage})
+	require.NoError(t, err)
+	assert.Equal(t, []string{svc.format(channel, testSyslogMessage)}, <-received)
+}
+
+func TestSyslogConnectionCheck(t *testing.T) {
+	listener, err := net.Listen("tcp", "127.0.0.1:0")
+	require.NoError(t, err)
+	port := listener.Addr().(*net.TCPAddr).Port
+
+	svc := NewSyslogService(nil)
+	require.NoError(t, svc.ConnectionCheck(context.Background(), testSyslogChannel(models.SyslogTransportTCP, port)))
+
+	require.NoError(t, listener.Close())
+	err = svc.ConnectionCheck(context.Background(), testSyslogChannel(models.SyslogTransportTCP, port))
+	require.ErrorIs(t, err, ErrSyslogServerUnreachable)
+}
@@ -47,7 +47,10 @@ func NewTeamsChannelService(
 }
 
 func (t *teamsChannelService) SendTeamsTestMessage(webhookUrl string) error {
-	return t.teamsService.SendMessage(webhookUrl, "Hello, This is a test message")
+	return t.teamsService.SendMessage(
+		models.NotificationChannel{WebhookUrl: &webhookUrl},
+		models.ChatMessage{Text: "Hello, This is a test message"},
+	)
 }
 
 func (t *teamsChannelService) CreateTeamsChannel(
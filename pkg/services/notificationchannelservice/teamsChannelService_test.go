@@ -2,6 +2,7 @@ package notificationchannelservice
 
 import (
 	"context"
+	"io"
 	"net/http"
 	"testing"
 
@@ -18,6 +19,24 @@ func (f roundTripperFunc) RoundTrip(req *http.Request) (*http.Response, error) {
 	return f(req)
 }
 
+// recordingClient returns a client which records the last request and its body, and responds with the given status code
+func recordingClient(t *testing.T, statusCode int, gotRequest **http.Request, gotBody *[]byte) *http.Client {
+	return &http.Client{
+		Transport: roundTripperFunc(func(r *http.Request) (*http.Response, error) {
+			body, err := io.ReadAll(r.Body)
+			require.NoError(t, err)
+			*gotRequest = r
+			*gotBody = body
+			return &http.Response{
+				StatusCode: statusCode,
+				Status:     http.StatusText(statusCode),
+				Body:       http.NoBody,
+				Header:     make(http.Header),
+			}, nil
+		}),
+	}
+}
+
 func TestSendTeamsTestMessage_PostsToWebhook(t *testing.T) {
 	var gotMethod, gotURL string
 
@@ -11,7 +11,12 @@ import (
 	"errors"
 	"fmt"
 	"net/http"
+	"slices"
 
+	"github.com/greenbone/opensight-golang-libraries/pkg/notifications"
+	"github.com/greenbone/opensight-notification-service/pkg/helper"
+	"github.com/greenbone/opensight-notification-service/pkg/markdown"
+	"github.com/greenbone/opensight-notification-service/pkg/models"
 	"github.com/greenbone/opensight-notification-service/pkg/policy"
 )
 
@@ -23,45 +28,174 @@ func NewTeamsService(transport *http.Client) *TeamsService {
 	return &TeamsService{transport: transport}
 }
 
-// SendMessage sends a message to the given MS Teams webhook URL.
-// The message has to be in markdown format. For details see:
+// SendMessage sends a message to the webhook URL of the given MS Teams channel. Workflow webhooks receive an adaptive card
+// with a header colored by level, the details as facts and a button linking to the origin resource.
+// Old webhooks receive the message as markdown text. For the supported markdown see:
 // https://learn.microsoft.com/en-us/adaptive-cards/authoring-cards/text-features#markdown-commonmark-subset
-func (s *TeamsService) SendMessage(webhookUrl string, message string) error {
+func (s *TeamsService) SendMessage(channel models.NotificationChannel, message models.ChatMessage) error {
+	webhookUrl := helper.SafeDereference(channel.WebhookUrl)
 	isTeamsOldWebhookUrl, err := policy.IsTeamsOldWebhookUrl(webhookUrl)
 	if err != nil {
 		return fmt.Errorf("failed to validate teams webhook url: %w", err)
 	}
 
-	var msg map[string]any
 	if isTeamsOldWebhookUrl {
-		msg = map[string]any{
-			"text": message,
-		}
+		return s.post(webhookUrl, map[string]any{
+			"text": chatMessageToMarkdown(message),
+		})
+	}
 
-	} else {
-		msg = map[string]any{
-			"type": "message",
-			"attachments": []map[string]any{
+	var body []map[string]any
+	if message.Title != "" {
+		body = append(body, map[string]any{
+			"type":  "Container",
+			"style": teamsContainerStyle(message.Level),
+			"bleed": true,
+			"items": []map[string]any{
 				{
-					"contentType": "application/vnd.microsoft.card.adaptive",
-					"content": map[string]any{
-						"$schema": "http://adaptivecards.io/schemas/adaptive-card.json",
-						"type":    "AdaptiveCard",
-						"version": "1.2",
-						"body": []map[string]any{
-							{
-								"type": "TextBlock",
-								"text": message,
-								"wrap": true,
-							},
-						},
-					},
+					"type":   "TextBlock",
+					"text":   message.Title,
+					"weight": "bolder",
+					"size":   "medium",
+					"wrap":   true,
 				},
 			},
+		})
+	}
+	body = append(body, map[string]any{
+		"type": "TextBlock",
+		"text": message.Text,
+		"wrap": true,
+	})
+	if facts := teamsFacts(message); len(facts) > 0 {
+		body = append(body, map[string]any{
+			"type":  "FactSet",
+			"facts": facts,
+		})
+	}
+
+	var actions []map[string]any
+	if message.Link != "" {
+		actions = append(actions, map[string]any{
+			"type":  "Action.OpenUrl",
+			"title": models.OpenLinkTitle,
+			"url":   message.Link,
+		})
+	}
+
+	return s.post(webhookUrl, adaptiveCardMessage("1.4", body, actions))
+}
+
+// teamsContainerStyle returns the container style which colors the card header by level
+func teamsContainerStyle(level notifications.Level) string {
+	switch level {
+	case notifications.LevelUrgent, notifications.LevelError:
+		return "attention"
+	case notifications.LevelWarning:
+		return "warning"
+	case notifications.LevelInfo:
+		return "accent"
+	}
+	return "emphasis"
+}
+
+// teamsFacts lists the details of the message as facts
+func teamsFacts(message models.ChatMessage) []map[string]any {
+	var facts []map[string]any
+	for _, field := range messageDetails(message) {
+		facts = append(facts, map[string]any{"title": field.Name, "value": field.Value})
+	}
+	return facts
+}
+
+// SendDigest sends the digest to the webhook URL of the given MS Teams channel. Workflow webhooks receive
+// an adaptive card with a table, old webhooks which don't support tables a markdown table.
+func (s *TeamsService) SendDigest(channel models.NotificationChannel, digest models.DigestMessage) error {
+	webhookUrl := helper.SafeDereference(channel.WebhookUrl)
+	isTeamsOldWebhookUrl, err := policy.IsTeamsOldWebhookUrl(webhookUrl)
+	if err != nil {
+		return fmt.Errorf("failed to validate teams webhook url: %w", err)
+	}
+
+	if isTeamsOldWebhookUrl {
+		return s.post(webhookUrl, map[string]any{
+			"text": digestToMarkdown(digest),
+		})
+	}
+
+	cells := func(values []string) []map[string]any {
+		cells := make([]map[string]any, 0, len(values))
+		for _, value := range values {
+			cells = append(cells, map[string]any{
+				"type":  "TableCell",
+				"items": []map[string]any{{"type": "TextBlock", "text": value, "wrap": true}},
+			})
+		}
+		return cells
+	}
+
+	header := digest.Columns
+	if len(digest.Links) > 0 {
+		header = append(slices.Clone(header), models.DigestLinkColumn)
+	}
+
+	columns := make([]map[string]any, 0, len(header))
+	rows := []map[string]any{{"type": "TableRow", "cells": cells(header)}}
+	for range header {
+		columns = append(columns, map[string]any{"width": 1})
+	}
+	for i, row := range digest.Rows {
+		if len(digest.Links) > 0 {
+			row = append(slices.Clone(row), markdown.Link(models.DigestLinkTitle, digest.Link(i)))
 		}
+		rows = append(rows, map[string]any{"type": "TableRow", "cells": cells(row)})
+	}
 
+	return s.post(webhookUrl, adaptiveCardMessage("1.5", []map[string]any{
+		{
+			"type":   "TextBlock",
+			"text":   digest.Title,
+			"weight": "bolder",
+			"size":   "medium",
+			"wrap":   true,
+		},
+		{
+			"type": "TextBlock",
+			"text": digest.Summary,
+			"wrap": true,
+		},
+		{
+			"type":             "Table",
+			"firstRowAsHeader": true,
+			"columns":          columns,
+			"rows":             rows,
+		},
+	}, nil))
+}
+
+func adaptiveCardMessage(version string, body []map[string]any, actions []map[string]any) map[string]any {
+	card := map[string]any{
+		"$schema": "http://adaptivecards.io/schemas/adaptive-card.json",
+		"type":    "AdaptiveCard",
+		"version": version,
+		"body":    body,
+	}
+	if len(actions) > 0 {
+		card["actions"] = actions
 	}
 
+	return map[string]any{
+		"type": "message",
+		"attachments": []map[string]any{
+			{
+				"contentType": "application/vnd.microsoft.card.adaptive",
+				"content":     card,
+			},
+		},
+	}
+}
+
+func (s *TeamsService) post(webhookUrl string, msg any) error {
 	body, err := json.Marshal(msg)
 	if err != nil {
 		return fmt.Errorf("can not marshal teams message: %w", err)
@@ -5,32 +5,144 @@
 package notificationchannelservice
 
 import (
+	"encoding/json"
+	"io"
 	"net/http"
 	"testing"
 
+	"github.com/greenbone/opensight-golang-libraries/pkg/notifications"
+	"github.com/greenbone/opensight-notification-service/pkg/models"
 	"github.com/stretchr/testify/assert"
 	"github.com/stretchr/testify/require"
 )
 
 func TestSendTeamsMessage(t *testing.T) {
-	var gotMethod, gotURL string
-
-	svc := NewTeamsService(&http.Client{
-		Transport: roundTripperFunc(func(r *http.Request) (*http.Response, error) {
-			gotMethod = r.Method
-			gotURL = r.URL.String()
-			return &http.Response{
-				StatusCode: http.StatusNoContent,
-				Body:       http.NoBody,
-				Header:     make(http.Header),
-			}, nil
-		})},
-	)
-
-	webhook := "https://example.com:443/workflows/01fa130f2e134641b2cf39d8a710a002"
-	err := svc.SendMessage(webhook, "test message")
-	require.NoError(t, err)
-
-	assert.Equal(t, http.MethodPost, gotMethod)
-	assert.Equal(t, webhook, gotURL)
+	message := models.ChatMessage{
+		Title:       "🔴 New vulnerability [SBOM - React]",
+		Text:        "Details",
+		Level:       notifications.LevelError,
+		Origin:      "SBOM - React",
+		OriginClass: "/vi/SBOM",
+		Timestamp:   "2024-01-01T00:00:00Z",
+		Fields:      []models.MessageField{{Name: "host", Value: "10.0.0.1"}},
+		Link:        "https://opensight.example.com/vi/sbom/1",
+	}
+
+	tests := map[string]struct {
+		webhook  string
+		wantCard bool
+	}{
+		"workflow webhook receives adaptive card": {
+			webhook:  "https://example.com:443/workflows/01fa130f2e134641b2cf39d8a710a002",
+			wantCard: true,
+		},
+		"old webhook receives markdown": {
+			webhook: "https://example.com/webhook/a1b2c3",
+		},
+	}
+
+	for name, tt := range tests {
+		t.Run(name, func(t *testing.T) {
+			var gotMethod, gotURL string
+			var gotBody map[string]any
+
+			svc := NewTeamsService(&http.Client{
+				Transport: roundTripperFunc(func(r *http.Request) (*http.Response, error) {
+					gotMethod = r.Method
+					gotURL = r.URL.String()
+					require.NoError(t, json.NewDecoder(r.Body).Decode(&gotBody))
+					return &http.Response{
+						StatusCode: http.StatusNoContent,
+						Body:       http.NoBody,
+						Header:     make(http.Header),
+					}, nil
+				})},
+			)
+
+			err := svc.SendMessage(models.NotificationChannel{WebhookUrl: &tt.webhook}, message)
+			require.NoError(t, err)
+
+			assert.Equal(t, http.MethodPost, gotMethod)
+			assert.Equal(t, tt.webhook, gotURL)
+			if !tt.wantCard {
+				assert.Equal(t, map[string]any{
+					"text": "**🔴 New vulnerability [SBOM - React]**\n\nDetails\n\n[Open in OpenSight](https://opensight.example.com/vi/sbom/1)",
+				}, gotBody)
+				return
+			}
+
+			card := gotBody["attachments"].([]any)[0].(map[string]any)["content"].(map[string]any)
+			body := card["body"].([]any)
+			header := body[0].(map[string]any)
+			assert.Equal(t, "Container", header["type"])
+			assert.Equal(t, "attention", header["style"])
+			assert.Equal(t, message.Title, header["items"].([]any)[0].(map[string]any)["text"])
+			assert.Equal(t, "Details", body[1].(map[string]any)["text"])
+			assert.Equal(t, map[string]any{
+				"type": "FactSet",
+				"facts": []any{
+					map[string]any{"title": "Level", "value": "error"},
+					map[string]any{"title": "Origin", "value": "SBOM - React"},
+					map[string]any{"title": "Origin class", "value": "/vi/SBOM"},
+					map[string]any{"title": "Time", "value": "2024-01-01T00:00:00Z"},
+					map[string]any{"title": "host", "value": "10.0.0.1"},
+				},
+			}, body[2])
+			assert.Equal(t, []any{
+				map[string]any{"type": "Action.OpenUrl", "title": "Open in OpenSight", "url": message.Link},
+			}, card["actions"])
+		})
+	}
+}
+
+func TestSendTeamsDigest(t *testing.T) {
+	digest := models.DigestMessage{
+		Title:   "Digest: 1 notifications",
+		Summary: "1 info",
+		Columns: []string{"Level", "Title"},
+		Rows:    [][]string{{"info", "Title"}},
+	}
+
+	tests := map[string]struct {
+		webhook   string
+		wantTable bool
+	}{
+		"workflow webhook receives adaptive card table": {
+			webhook:   "https://example.com:443/workflows/01fa130f2e134641b2cf39d8a710a002",
+			wantTable: true,
+		},
+		"old webhook receives markdown": {
+			webhook: "https://example.com/webhook/a1b2c3",
+		},
+	}
+
+	for name, tt := range tests {
+		t.Run(name, func(t *testing.T) {
+			var gotBody string
+
+			svc := NewTeamsService(&http.Client{
+				Transport: roundTripperFunc(func(r *http.Request) (*http.Response, error) {
+					body, err := io.ReadAll(r.Body)
+					require.NoError(t, err)
+					gotBody = string(body)
+					return &http.Response{
+						StatusCode: http.StatusOK,
+						Body:       http.NoBody,
+						Header:     make(http.Header),
+					}, nil
+				})},
+			)
+
+			err := svc.SendDigest(models.NotificationChannel{WebhookUrl: &tt.webhook}, digest)
+			require.NoError(t, err)
+
+			if tt.wantTable {
+				assert.Contains(t, gotBody, `"type":"Table"`)
+				assert.Contains(t, gotBody, `"version":"1.5"`)
+			} else {
+				assert.NotContains(t, gotBody, `"attachments"`)
+				assert.Contains(t, gotBody, `| info | Title |`)
+			}
+		})
+	}
 }
@@ -0,0 +1,139 @@
+package notificationchannelservice
+
+import (
+	"context"
+	"errors"
+	"maps"
+	"slices"
+
+	"github.com/google/uuid"
+	"github.com/greenbone/opensight-notification-service/pkg/models"
+	"github.com/greenbone/opensight-notification-service/pkg/web/webhookcontroller/webhookdto"
+)
+
+var (
+	ErrWebhookChannelLimitReached = errors.New("Webhook channel limit reached.")
+	ErrListWebhookChannels        = errors.New("failed to list webhook channels")
+	ErrWebhookChannelNameExists   = errors.New("Webhook channel name already exists.")
+	ErrWebhookMessageDelivery     = errors.New("webhook message could not be send")
+)
+
+type WebhookChannelService interface {
+	SendWebhookTestMessage(channel models.NotificationChannel) error
+	CreateWebhookChannel(
+		ctx context.Context,
+		channel webhookdto.WebhookNotificationChannelRequest,
+	) (webhookdto.WebhookNotificationChannelResponse, error)
+	UpdateWebhookChannel(
+		ctx context.Context,
+		id string,
+		channel webhookdto.WebhookNotificationChannelRequest,
+	) (webhookdto.WebhookNotificationChannelResponse, error)
+}
+
+type webhookChannelService struct {
+	notificationChannelService NotificationChannelService
+	webhookChannelLimit        int
+	outboundWebhookService     *OutboundWebhookService
+}
+
+func NewWebhookChannelService(
+	notificationChannelService NotificationChannelService,
+	webhookChannelLimit int,
+	outboundWebhookService *OutboundWebhookService,
+) WebhookChannelService {
+	return &webhookChannelService{
+		notificationChannelService: notificationChannelService,
+		webhookChannelLimit:        webhookChannelLimit,
+		outboundWebhookService:     outboundWebhookService,
+	}
+}
+
+// SendWebhookTestMessage sends the sample notification of the templates to the webhook
+func (w *webhookChannelService) SendWebhookTestMessage(channel models.NotificationChannel) error {
+	return w.outboundWebhookService.SendNotification(channel, uuid.NewString(), models.SampleTemplateData().Notification)
+}
+
+func (w *webhookChannelService) CreateWebhookChannel(
+	ctx context.Context,
+	channel webhookdto.WebhookNotificationChannelRequest,
+) (webhookdto.WebhookNotificationChannelResponse, error) {
+	if err := w.webhookChannelValidations(ctx, channel.ChannelName, ""); err != nil {
+		return webhookdto.WebhookNotificationChannelResponse{}, err
+	}
+
+	notificationChannel := webhookdto.MapWebhookToNotificationChannel(channel)
+	created, err := w.notificationChannelService.CreateNotificationChannel(ctx, notificationChannel)
+	if err != nil {
+		return webhookdto.WebhookNotificationChannelResponse{}, err
+	}
+
+	return webhookdto.MapNotificationChannelToWebhook(created), nil
+}
+
+func (w *webhookChannelService) UpdateWebhookChannel(
+	ctx context.Context,
+	id string,
+	channel webhookdto.WebhookNotificationChannelRequest,
+) (webhookdto.WebhookNotificationChannelResponse, error) {
+
+	if err := w.webhookChannelValidations(ctx, channel.ChannelName, id); err != nil {
+		return webhookdto.WebhookNotificationChannelResponse{}, err
+	}
+
+	notificationChannel := webhookdto.MapWebhookToNotificationChannel(channel)
+	if slices.Contains(slices.Collect(maps.Values(notificationChannel.WebhookHeaders)), "") {
+		stored, err := w.notificationChannelService.GetNotificationChannelByIdAndType(ctx, id, models.ChannelTypeWebhook)
+		if err != nil {
+			return webhookdto.WebhookNotificationChannelResponse{}, err
+		}
+		notificationChannel.WebhookHeaders = keepStoredHeaderValues(notificationChannel.WebhookHeaders, stored.WebhookHeaders)
+	}
+
+	updated, err := w.notificationChannelService.UpdateNotificationChannel(ctx, id, notificationChannel)
+	if err != nil {
+		return webhookdto.WebhookNotificationChannelResponse{}, err
+	}
+
+	return webhookdto.MapNotificationChannelToWebhook(updated), nil
+}
+
+// keepStoredHeaderValues returns the headers with the stored values for the empty ones,
+// as the header values are not returned to the client
+func keepStoredHeaderValues(headers map[string]string, stored map[string]string) map[string]string {
+	merged := make(map[string]string, len(headers))
+	for name, value := range headers {
+		if value == "" {
+			value = stored[name]
+		}
+		merged[name] = value
+	}
+	return merged
+}
+
+func (w *webhookChannelService) webhookChannelValidations(
+	ctx context.Context,
+	channelName string,
+	excludeId string,
+) error {
+	channels, err := w.notificationChannelService.ListNotificationChannelsByType(ctx, models.ChannelTypeWebhook)
+	if err != nil {
+		return errors.Join(ErrListWebhookChannels, err)
+	}
+
+	if len(channels) >= w.webhookChannelLimit {
+		return ErrWebhookChannelLimitReached
+	}
+
+	for _, ch := range channels {
+		if ch.Id == excludeId {
+			continue
+		}
+
+		if ch.ChannelName == channelName {
+			return ErrWebhookChannelNameExists
+		}
+	}
+
+	return nil
+}
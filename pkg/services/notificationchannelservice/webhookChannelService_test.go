@@ -0,0 +1,108 @@
+package notificationchannelservice
+
+import (
+	"context"
+	"encoding/json"
+	"io"
+	"net/http"
+	"testing"
+
+	"github.com/greenbone/opensight-notification-service/pkg/models"
+	"github.com/greenbone/opensight-notification-service/pkg/services/notificationchannelservice/mocks"
+	"github.com/greenbone/opensight-notification-service/pkg/web/webhookcontroller/webhookdto"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/mock"
+	"github.com/stretchr/testify/require"
+)
+
+func TestSendWebhookTestMessage(t *testing.T) {
+	var gotMethod, gotURL string
+	var gotNotification models.Notification
+
+	notificationChannelService := mocks.NewNotificationChannelService(t)
+	outboundWebhookService := NewOutboundWebhookService(&http.Client{
+		Transport: roundTripperFunc(func(r *http.Request) (*http.Response, error) {
+			gotMethod = r.Method
+			gotURL = r.URL.String()
+			body, err := io.ReadAll(r.Body)
+			require.NoError(t, err)
+			require.NoError(t, json.Unmarshal(body, &gotNotification))
+			return &http.Response{
+				StatusCode: http.StatusNoContent,
+				Body:       http.NoBody,
+				Header:     make(http.Header),
+			}, nil
+		})},
+	)
+	svc := NewWebhookChannelService(notificationChannelService, 10, outboundWebhookService)
+
+	webhook := "https://tickets.example.com/api/events"
+	err := svc.SendWebhookTestMessage(models.NotificationChannel{
+		WebhookUrl:    &webhook,
+		WebhookMethod: new(http.MethodPatch),
+	})
+	require.NoError(t, err)
+
+	assert.Equal(t, http.MethodPatch, gotMethod)
+	assert.Equal(t, webhook, gotURL)
+	assert.Equal(t, models.SampleTemplateData().Title, gotNotification.Title)
+}
+
+func TestSendWebhookTestMessage_ErrorOnTransport(t *testing.T) {
+	notificationChannelService := mocks.NewNotificationChannelService(t)
+	outboundWebhookService := NewOutboundWebhookService(&http.Client{
+		Transport: roundTripperFunc(func(r *http.Request) (*http.Response, error) {
+			return nil, ErrWebhookMessageDelivery
+		})})
+	svc := NewWebhookChannelService(notificationChannelService, 10, outboundWebhookService)
+
+	err := svc.SendWebhookTestMessage(models.NotificationChannel{WebhookUrl: new("https://tickets.example.com/api/events")})
+	require.ErrorContains(t, err, "webhook message could not be send")
+}
+
+func TestWebhookChannelLimit(t *testing.T) {
+	notificationChannelService := mocks.NewNotificationChannelService(t)
+	notificationChannelService.EXPECT().ListNotificationChannelsByType(context.Background(), models.ChannelTypeWebhook).
+		Return([]models.NotificationChannel{
+			{},
+		}, nil)
+	outboundWebhookService := NewOutboundWebhookService(http.DefaultClient)
+
+	service := NewWebhookChannelService(notificationChannelService, 1, outboundWebhookService)
+
+	_, err := service.CreateWebhookChannel(context.Background(), webhookdto.WebhookNotificationChannelRequest{})
+	require.ErrorIs(t, err, ErrWebhookChannelLimitReached)
+}
+
+func TestUpdateWebhookChannel_KeepsStoredHeaderValues(t *testing.T) {
+	ctx := context.Background()
+	notificationChannelService := mocks.NewNotificationChannelService(t)
+	notificationChannelService.EXPECT().ListNotificationChannelsByType(ctx, models.ChannelTypeWebhook).
+		Return([]models.NotificationChannel{{Id: "webhook-id", ChannelName: "webhook"}}, nil)
+	notificationChannelService.EXPECT().GetNotificationChannelByIdAndType(ctx, "webhook-id", models.ChannelTypeWebhook).
+		Return(models.NotificationChannel{
+			Id:             "webhook-id",
+			WebhookHeaders: map[string]string{"Authorization": "Bearer token", "X-Tenant": "tenant"},
+		}, nil)
+
+	var updated models.NotificationChannel
+	notificationChannelService.EXPECT().UpdateNotificationChannel(ctx, "webhook-id", mock.Anything).
+		RunAndReturn(func(_ context.Context, id string, channel models.NotificationChannel) (models.NotificationChannel, error) {
+			updated = channel
+			channel.Id = id
+			return channel, nil
+		})
+
+	service := NewWebhookChannelService(notificationChannelService, 10, NewOutboundWebhookService(http.DefaultClient))
+
+	// the client sends back the header names it got without values, a removed header is dropped
+	response, err := service.UpdateWebhookChannel(ctx, "webhook-id", webhookdto.WebhookNotificationChannelRequest{
+		ChannelName: "webhook",
+		WebhookUrl:  "https://tickets.example.com/api/events",
+		Headers:     map[string]string{"Authorization": "", "X-Source": "opensight"},
+	})
+	require.NoError(t, err)
+
+	assert.Equal(t, map[string]string{"Authorization": "Bearer token", "X-Source": "opensight"}, updated.WebhookHeaders)
+	assert.Equal(t, map[string]string{"Authorization": "", "X-Source": ""}, response.Headers)
+}
@@ -0,0 +1,254 @@
+// SPDX-FileCopyrightText: 2026 Greenbone AG <https://greenbone.net>
+//
+// SPDX-License-Identifier: AGPL-3.0-or-later
+
+package notificationservice
+
+import (
+	"context"
+	"fmt"
+	"html"
+	"slices"
+	"strings"
+
+	"github.com/greenbone/opensight-golang-libraries/pkg/logs"
+	"github.com/greenbone/opensight-golang-libraries/pkg/notifications"
+	"github.com/greenbone/opensight-notification-service/pkg/models"
+)
+
+// maxDigestRows limits the size of a digest message, webhooks reject too large messages
+const maxDigestRows = 50
+
+// levelsBySeverity lists the notification levels, most severe first
+var levelsBySeverity = []notifications.Level{
+	notifications.LevelUrgent,
+	notifications.LevelError,
+	notifications.LevelWarning,
+	notifications.LevelInfo,
+}
+
+// sendDigest delivers the collected notifications of the send task as one message to the channel of its action.
+func (s *notificationService) sendDigest(ctx context.Context, sendTask models.SendTask) error {
+	action := sendTask.Action
+	digest := createDigest(sendTask.Digest)
+	digest.Links = s.resolveDigestLinks(ctx, sendTask.Digest[:len(digest.Rows)])
+
+	channel, err := s.channelService.GetNotificationChannelByIdAndType(ctx, action.Channel.ID, action.Channel.Type)
+	if err != nil {
+		logs.Ctx(ctx).Err(err).Int("attempt", sendTask.Attempt).Msg("failed to get channel for forwarding digest")
+		return fmt.Errorf("failed to get channel: %w", err)
+	}
+
+	switch channelType := action.Channel.Type; channelType {
+	case models.ChannelTypeMail:
+		err = s.mailService.SendMail(ctx, channel, action.Recipient, models.MailMessage{
+			Subject:  digest.Title,
+			HTMLBody: renderDigestHTML(digest),
+			TextBody: renderDigestText(digest),
+			Level:    digest.Level,
+		})
+		if err != nil {
+			logs.Ctx(ctx).Err(err).Int("attempt", sendTask.Attempt).Msg("failed to send digest mail")
+			return fmt.Errorf("failed to send mail: %w", err)
+		}
+	case models.ChannelTypeTeams:
+		err = s.teamsService.SendDigest(channel, digest)
+		if err != nil {
+			logs.Ctx(ctx).Err(err).Int("attempt", sendTask.Attempt).Msg("failed to send teams digest")
+			return fmt.Errorf("failed to send teams message: %w", err)
+		}
+	case models.ChannelTypeMattermost:
+		err = s.mattermostService.SendDigest(channel, digest)
+		if err != nil {
+			logs.Ctx(ctx).Err(err).Int("attempt", sendTask.Attempt).Msg("failed to send mattermost digest")
+			return fmt.Errorf("failed to send mattermost message: %w", err)
+		}
+	case models.ChannelTypeSlack:
+		err = s.slackService.SendDigest(channel, digest)
+		if err != nil {
+			logs.Ctx(ctx).Err(err).Int("attempt", sendTask.Attempt).Msg("failed to send slack digest")
+			return fmt.Errorf("failed to send slack message: %w", err)
+		}
+	case models.ChannelTypeWebhook:
+		err = s.webhookService.SendDigest(channel, sendTask.ID, sendTask.Digest[:len(digest.Rows)])
+		if err != nil {
+			logs.Ctx(ctx).Err(err).Int("attempt", sendTask.Attempt).Msg("failed to call webhook with digest")
+			return fmt.Errorf("failed to call webhook: %w", err)
+		}
+	case models.ChannelTypeSyslog:
+		// a SIEM correlates single events, so the notifications are sent as one message each
+		err = s.syslogService.SendMessages(ctx, channel, s.digestChatMessages(ctx, digest, sendTask.Digest))
+		if err != nil {
+			logs.Ctx(ctx).Err(err).Int("attempt", sendTask.Attempt).Msg("failed to send syslog digest")
+			return fmt.Errorf("failed to send syslog message: %w", err)
+		}
+	case models.ChannelTypeIncident:
+		// each notification triggers or resolves the incident of its origin resource
+		for i, message := range s.digestChatMessages(ctx, digest, sendTask.Digest) {
+			err = s.sendIncidentEvent(channel, sendTask.Digest[i], message)
+			if err != nil {
+				logs.Ctx(ctx).Err(err).Int("attempt", sendTask.Attempt).Msg("failed to send incident events of digest")
+				return fmt.Errorf("failed to send incident event: %w", err)
+			}
+		}
+	case models.ChannelTypePush:
+		err = s.pushService.SendDigest(channel, digest)
+		if err != nil {
+			logs.Ctx(ctx).Err(err).Int("attempt", sendTask.Attempt).Msg("failed to send push digest")
+			return fmt.Errorf("failed to send push message: %w", err)
+		}
+	case models.ChannelTypeMatrix:
+		err = s.matrixService.SendDigest(channel, sendTask.ID, digest)
+		if err != nil {
+			logs.Ctx(ctx).Err(err).Int("attempt", sendTask.Attempt).Msg("failed to send matrix digest")
+			return fmt.Errorf("failed to send matrix message: %w", err)
+		}
+	default:
+		return fmt.Errorf("%w: %s", errInvalidChannelType, channelType)
+	}
+
+	return nil
+}
+
+// digestChatMessages returns a message for each collected notification, also for those not listed in the digest
+func (s *notificationService) digestChatMessages(
+	ctx context.Context,
+	digest models.DigestMessage,
+	collected []models.Notification,
+) []models.ChatMessage {
+	messages := make([]models.ChatMessage, 0, len(collected))
+	for i, notification := range collected {
+		var link string
+		if i < len(digest.Rows) {
+			link = digest.Link(i)
+		} else {
+			link = s.resolveLink(ctx, notification)
+		}
+		messages = append(messages, newChatMessage(notification.Title, notification.Detail, link, notification))
+	}
+	return messages
+}
+
+// resolveDigestLinks returns the links to the resources of the notifications, nil if none of them has a link
+func (s *notificationService) resolveDigestLinks(ctx context.Context, collected []models.Notification) []string {
+	links := make([]string, 0, len(collected))
+	hasLinks := false
+	for _, notification := range collected {
+		link := s.resolveLink(ctx, notification)
+		hasLinks = hasLinks || link != ""
+		links = append(links, link)
+	}
+	if !hasLinks {
+		return nil
+	}
+	return links
+}
+
+// createDigest summarizes the notifications, they are listed in the given order.
+func createDigest(collected []models.Notification) models.DigestMessage {
+	countByLevel := make(map[notifications.Level]int)
+	for _, notification := range collected {
+		countByLevel[notification.Level]++
+	}
+
+	var mostSevere notifications.Level
+	var counts []string
+	for _, level := range levelsBySeverity {
+		if countByLevel[level] == 0 {
+			continue
+		}
+		if mostSevere == "" {
+			mostSevere = level
+		}
+		counts = append(counts, fmt.Sprintf("%d %s", countByLevel[level], level))
+	}
+
+	title := fmt.Sprintf("Digest: %d notifications", len(collected))
+	if icon := models.LevelIcon(mostSevere); icon != "" {
+		title = fmt.Sprintf("%s %s", icon, title)
+	}
+
+	summary := strings.Join(counts, ", ")
+	if len(collected) > maxDigestRows {
+		summary += fmt.Sprintf(" (showing the first %d)", maxDigestRows)
+	}
+
+	rows := make([][]string, 0, min(len(collected), maxDigestRows))
+	for _, notification := range collected[:min(len(collected), maxDigestRows)] {
+		rows = append(rows, []string{
+			notification.Timestamp,
+			string(notification.Level),
+			notification.Origin,
+			notification.Title,
+		})
+	}
+
+	return models.DigestMessage{
+		Title:   title,
+		Summary: summary,
+		Level:   mostSevere,
+		Columns: []string{"Time", "Level", "Origin", "Title"},
+		Rows:    rows,
+	}
+}
+
+// renderDigestHTML renders the digest as HTML for mails
+func renderDigestHTML(digest models.DigestMessage) string {
+	cellHTML := func(tag, content string) string {
+		return fmt.Sprintf(`<%s style="border:1px solid #ccc;padding:4px;text-align:left">%s</%s>`, tag, content, tag)
+	}
+	cell := func(tag, value string) string {
+		return cellHTML(tag, html.EscapeString(strings.ReplaceAll(value, "\n", " ")))
+	}
+	linkCell := func(link string) string {
+		if link == "" {
+			return cellHTML("td", "")
+		}
+		return cellHTML("td", fmt.Sprintf(`<a href="%s">%s</a>`, html.EscapeString(link), models.DigestLinkTitle))
+	}
+
+	var b strings.Builder
+	fmt.Fprintf(&b, "<p>%s</p>", html.EscapeString(digest.Summary))
+	b.WriteString(`<table style="border-collapse:collapse"><thead><tr>`)
+	for _, column := range digest.Columns {
+		b.WriteString(cell("th", column))
+	}
+	if len(digest.Links) > 0 {
+		b.WriteString(cell("th", models.DigestLinkColumn))
+	}
+	b.WriteString("</tr></thead><tbody>")
+	for i, row := range digest.Rows {
+		b.WriteString("<tr>")
+		for _, value := range row {
+			b.WriteString(cell("td", value))
+		}
+		if len(digest.Links) > 0 {
+			b.WriteString(linkCell(digest.Link(i)))
+		}
+		b.WriteString("</tr>")
+	}
+	b.WriteString("</tbody></table>")
+	return b.String()
+}
+
+// renderDigestText renders the digest as plain text for the text part of mails, one line per row
+func renderDigestText(digest models.DigestMessage) string {
+	var b strings.Builder
+	b.WriteString(digest.Summary + "\n\n")
+	columns := digest.Columns
+	if len(digest.Links) > 0 {
+		columns = append(slices.Clone(columns), models.DigestLinkColumn)
+	}
+	b.WriteString(strings.Join(columns, " | ") + "\n")
+	for i, row := range digest.Rows {
+		values := make([]string, 0, len(columns))
+		for _, value := range row {
+			values = append(values, strings.ReplaceAll(value, "\n", " "))
+		}
+		if len(digest.Links) > 0 {
+			values = append(values, digest.Link(i))
+		}
+		b.WriteString(strings.Join(values, " | ") + "\n")
+	}
+	return b.String()
+}
@@ -10,4 +10,29 @@ const (
 	OccurrenceFieldName  = "occurrence"
 	LevelFieldName       = "level"
 	OriginFieldName      = "origin"
+	RepeatsFieldName     = "repeats"
+	ReadStateFieldName   = "readState"
+)
+
+// fields of delivery attempts
+const (
+	DeliveryNotificationIDField = "notificationID"
+	DeliveryRuleIDField         = "ruleID"
+	DeliveryChannelIDField      = "channelID"
+	DeliveryChannelNameField    = "channelName"
+	DeliveryChannelTypeField    = "channelType"
+	DeliveryRecipientField      = "recipient"
+	DeliveryTimestampField      = "timestamp"
+	DeliveryOutcomeField        = "outcome"
+)
+
+// fields of dead letters
+const (
+	DeadLetterNotificationIDField = "notificationID"
+	DeadLetterRuleIDField         = "ruleID"
+	DeadLetterChannelIDField      = "channelID"
+	DeadLetterChannelNameField    = "channelName"
+	DeadLetterChannelTypeField    = "channelType"
+	DeadLetterRecipientField      = "recipient"
+	DeadLetterTimestampField      = "deadLetteredAt"
 )
@@ -0,0 +1,153 @@
+// SPDX-FileCopyrightText: 2026 Greenbone AG <https://greenbone.net>
+//
+// SPDX-License-Identifier: AGPL-3.0-or-later
+
+package notificationservice
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"slices"
+	"time"
+
+	"github.com/greenbone/opensight-golang-libraries/pkg/logs"
+	"github.com/greenbone/opensight-golang-libraries/pkg/notifications"
+	"github.com/greenbone/opensight-notification-service/pkg/errs"
+	"github.com/greenbone/opensight-notification-service/pkg/models"
+)
+
+const (
+	// a claimed escalation is reserved for one instance of the service, see [sendTaskClaimDuration]
+	escalationClaimDuration  = 10 * time.Minute
+	escalationClaimBatchSize = 10
+)
+
+var errEscalationChannelNotFound = errors.New("channel of escalation step not found")
+
+// AcknowledgeNotification marks the notification as acknowledged by the user, pending escalations are dropped.
+func (s *notificationService) AcknowledgeNotification(
+	ctx context.Context,
+	id string,
+	userID string,
+) (models.Notification, error) {
+	notification, err := s.store.AcknowledgeNotification(ctx, id, userID, time.Now())
+	if err != nil {
+		return models.Notification{}, fmt.Errorf("failed to acknowledge notification: %w", err)
+	}
+	return notification, nil
+}
+
+// startEscalations marks the immediate send tasks of an urgent notification for escalation, if their rule has
+// escalation steps. The escalation is started in the same transaction as the send tasks are stored, so it is
+// only started if the rule forwarded the notification and it is not lost if the service stops in between.
+// A recovery is not escalated, it ends the escalations of the resource instead.
+func startEscalations(notification models.Notification, actions []models.RuleAction, sendTasks []models.SendTask) {
+	if notification.Level != notifications.LevelUrgent || notification.Recovered {
+		return
+	}
+
+	now := time.Now()
+	for i, sendTask := range sendTasks {
+		if sendTask.Collect != nil || sendTask.RuleID == "" {
+			continue
+		}
+		j := slices.IndexFunc(actions, func(a models.RuleAction) bool {
+			return a.RuleID == sendTask.RuleID && len(a.Escalation) > 0
+		})
+		if j >= 0 {
+			sendTasks[i].EscalateAt = now.Add(actions[j].Escalation[0].Delay())
+		}
+	}
+}
+
+// EscalateNotifications executes all due escalation steps. Failed escalations are retried
+// once their claim expired.
+func (s *notificationService) EscalateNotifications(ctx context.Context) error {
+	for {
+		now := time.Now()
+		escalations, err := s.escalations.ClaimDueEscalations(ctx, now, now.Add(escalationClaimDuration), escalationClaimBatchSize)
+		if err != nil {
+			return fmt.Errorf("failed to claim escalations: %w", err)
+		}
+
+		var errEscalate error
+		for _, escalation := range escalations {
+			if err := s.escalate(ctx, escalation, now); err != nil {
+				logs.Ctx(ctx).Err(err).Str("notification", escalation.NotificationID).Msg("failed to escalate notification")
+				errEscalate = errors.Join(errEscalate, err)
+			}
+		}
+		if errEscalate != nil {
+			return errEscalate
+		}
+
+		if len(escalations) < escalationClaimBatchSize {
+			return nil
+		}
+	}
+}
+
+// escalate forwards the notification to the channel of the current escalation step
+// and schedules the next step, if there is one.
+func (s *notificationService) escalate(ctx context.Context, escalation models.Escalation, now time.Time) error {
+	rule, err := s.ruleService.Get(ctx, escalation.RuleID)
+	if err != nil && !errors.Is(err, errs.ErrItemNotFound) {
+		return fmt.Errorf("failed to get rule: %w", err)
+	}
+	// the escalation ends if the rule was deleted, deactivated or its steps were removed meanwhile
+	if err != nil || !rule.Active || escalation.Step >= len(rule.Escalation) {
+		return s.escalations.DeleteEscalation(ctx, escalation.ID)
+	}
+
+	notification, err := s.store.GetNotification(ctx, escalation.NotificationID)
+	if err != nil {
+		return fmt.Errorf("failed to get notification: %w", err)
+	}
+
+	actions, err := s.escalationActions(ctx, rule.ID, rule.Escalation[escalation.Step].Action)
+	if errors.Is(err, errEscalationChannelNotFound) {
+		logs.Ctx(ctx).Warn().Str("rule", rule.ID).Int("step", escalation.Step).Msg("skipping escalation step, channel not found")
+	} else if err != nil {
+		return err
+	} else {
+		sendTasks, err := s.outbox.CreateSendTasks(ctx, notification, newSendTasks(actions))
+		if err != nil {
+			return fmt.Errorf("failed to store send tasks: %w", err)
+		}
+		s.dispatch(ctx, sendTasks)
+	}
+
+	next := escalation.Step + 1
+	if next >= len(rule.Escalation) {
+		return s.escalations.DeleteEscalation(ctx, escalation.ID)
+	}
+	return s.escalations.AdvanceEscalation(ctx, escalation.ID, next, now.Add(rule.Escalation[next].Delay()))
+}
+
+// escalationActions resolves the channel of the escalation step action.
+func (s *notificationService) escalationActions(
+	ctx context.Context,
+	ruleID string,
+	action models.Action,
+) ([]models.RuleAction, error) {
+	channel, err := s.channelService.GetNotificationChannelById(ctx, action.Channel.ID)
+	if err != nil {
+		if errors.Is(err, errs.ErrItemNotFound) {
+			return nil, errEscalationChannelNotFound
+		}
+		return nil, fmt.Errorf("failed to get channel: %w", err)
+	}
+	if !slices.Contains(models.AllowedChannels, channel.ChannelType) {
+		return nil, errEscalationChannelNotFound
+	}
+
+	action.Channel.Name = channel.ChannelName
+	action.Channel.Type = channel.ChannelType
+
+	var actions []models.RuleAction
+	for _, action := range action.SplitRecipients() {
+		actions = append(actions, models.RuleAction{RuleID: ruleID, Action: action})
+	}
+	return actions, nil
+}
@@ -39,16 +39,16 @@ func (_m *MailService) EXPECT() *MailService_Expecter {
 }
 
 // SendMail provides a mock function for the type MailService
-func (_mock *MailService) SendMail(ctx context.Context, channel models.NotificationChannel, recipient string, subject string, htmlBody string) error {
-	ret := _mock.Called(ctx, channel, recipient, subject, htmlBody)
+func (_mock *MailService) SendMail(ctx context.Context, channel models.NotificationChannel, recipient string, message models.MailMessage) error {
+	ret := _mock.Called(ctx, channel, recipient, message)
 
 	if len(ret) == 0 {
 		panic("no return value specified for SendMail")
 	}
 
 	var r0 error
-	if returnFunc, ok := ret.Get(0).(func(context.Context, models.NotificationChannel, string, string, string) error); ok {
-		r0 = returnFunc(ctx, channel, recipient, subject, htmlBody)
+	if returnFunc, ok := ret.Get(0).(func(context.Context, models.NotificationChannel, string, models.MailMessage) error); ok {
+		r0 = returnFunc(ctx, channel, recipient, message)
 	} else {
 		r0 = ret.Error(0)
 	}
@@ -64,13 +64,12 @@ type MailService_SendMail_Call struct {
 //   - ctx context.Context
 //   - channel models.NotificationChannel
 //   - recipient string
-//   - subject string
-//   - htmlBody string
-func (_e *MailService_Expecter) SendMail(ctx interface{}, channel interface{}, recipient interface{}, subject interface{}, htmlBody interface{}) *MailService_SendMail_Call {
-	return &MailService_SendMail_Call{Call: _e.mock.On("SendMail", ctx, channel, recipient, subject, htmlBody)}
+//   - message models.MailMessage
+func (_e *MailService_Expecter) SendMail(ctx interface{}, channel interface{}, recipient interface{}, message interface{}) *MailService_SendMail_Call {
+	return &MailService_SendMail_Call{Call: _e.mock.On("SendMail", ctx, channel, recipient, message)}
 }
 
-func (_c *MailService_SendMail_Call) Run(run func(ctx context.Context, channel models.NotificationChannel, recipient string, subject string, htmlBody string)) *MailService_SendMail_Call {
+func (_c *MailService_SendMail_Call) Run(run func(ctx context.Context, channel models.NotificationChannel, recipient string, message models.MailMessage)) *MailService_SendMail_Call {
 	_c.Call.Run(func(args mock.Arguments) {
 		var arg0 context.Context
 		if args[0] != nil {
@@ -84,20 +83,15 @@ func (_c *MailService_SendMail_Call) Run(run func(ctx context.Context, channel m
 		if args[2] != nil {
 			arg2 = args[2].(string)
 		}
-		var arg3 string
+		var arg3 models.MailMessage
 		if args[3] != nil {
-			arg3 = args[3].(string)
-		}
-		var arg4 string
-		if args[4] != nil {
-			arg4 = args[4].(string)
+			arg3 = args[3].(models.MailMessage)
 		}
 		run(
 			arg0,
 			arg1,
 			arg2,
 			arg3,
-			arg4,
 		)
 	})
 	return _c
@@ -108,7 +102,7 @@ func (_c *MailService_SendMail_Call) Return(err error) *MailService_SendMail_Cal
 	return _c
 }
 
-func (_c *MailService_SendMail_Call) RunAndReturn(run func(ctx context.Context, channel models.NotificationChannel, recipient string, subject string, htmlBody string) error) *MailService_SendMail_Call {
+func (_c *MailService_SendMail_Call) RunAndReturn(run func(ctx context.Context, channel models.NotificationChannel, recipient string, message models.MailMessage) error) *MailService_SendMail_Call {
 	_c.Call.Return(run)
 	return _c
 }
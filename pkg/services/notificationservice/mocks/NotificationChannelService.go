@@ -38,6 +38,72 @@ func (_m *NotificationChannelService) EXPECT() *NotificationChannelService_Expec
 	return &NotificationChannelService_Expecter{mock: &_m.Mock}
 }
 
+// GetNotificationChannelById provides a mock function for the type NotificationChannelService
+func (_mock *NotificationChannelService) GetNotificationChannelById(ctx context.Context, id string) (models.NotificationChannel, error) {
+	ret := _mock.Called(ctx, id)
+
+	if len(ret) == 0 {
+		panic("no return value specified for GetNotificationChannelById")
+	}
+
+	var r0 models.NotificationChannel
+	var r1 error
+	if returnFunc, ok := ret.Get(0).(func(context.Context, string) (models.NotificationChannel, error)); ok {
+		return returnFunc(ctx, id)
+	}
+	if returnFunc, ok := ret.Get(0).(func(context.Context, string) models.NotificationChannel); ok {
+		r0 = returnFunc(ctx, id)
+	} else {
+		r0 = ret.Get(0).(models.NotificationChannel)
+	}
+	if returnFunc, ok := ret.Get(1).(func(context.Context, string) error); ok {
+		r1 = returnFunc(ctx, id)
+	} else {
+		r1 = ret.Error(1)
+	}
+	return r0, r1
+}
+
+// NotificationChannelService_GetNotificationChannelById_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'GetNotificationChannelById'
+type NotificationChannelService_GetNotificationChannelById_Call struct {
+	*mock.Call
+}
+
+// GetNotificationChannelById is a helper method to define mock.On call
+//   - ctx context.Context
+//   - id string
+func (_e *NotificationChannelService_Expecter) GetNotificationChannelById(ctx interface{}, id interface{}) *NotificationChannelService_GetNotificationChannelById_Call {
+	return &NotificationChannelService_GetNotificationChannelById_Call{Call: _e.mock.On("GetNotificationChannelById", ctx, id)}
+}
+
+func (_c *NotificationChannelService_GetNotificationChannelById_Call) Run(run func(ctx context.Context, id string)) *NotificationChannelService_GetNotificationChannelById_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		var arg0 context.Context
+		if args[0] != nil {
+			arg0 = args[0].(context.Context)
+		}
+		var arg1 string
+		if args[1] != nil {
+			arg1 = args[1].(string)
+		}
+		run(
+			arg0,
+			arg1,
+		)
+	})
+	return _c
+}
+
+func (_c *NotificationChannelService_GetNotificationChannelById_Call) Return(notificationChannel models.NotificationChannel, err error) *NotificationChannelService_GetNotificationChannelById_Call {
+	_c.Call.Return(notificationChannel, err)
+	return _c
+}
+
+func (_c *NotificationChannelService_GetNotificationChannelById_Call) RunAndReturn(run func(ctx context.Context, id string) (models.NotificationChannel, error)) *NotificationChannelService_GetNotificationChannelById_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
 // GetNotificationChannelByIdAndType provides a mock function for the type NotificationChannelService
 func (_mock *NotificationChannelService) GetNotificationChannelByIdAndType(ctx context.Context, id string, channelType models.ChannelType) (models.NotificationChannel, error) {
 	ret := _mock.Called(ctx, id, channelType)
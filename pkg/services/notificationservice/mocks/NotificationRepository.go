@@ -6,6 +6,7 @@ package mocks
 
 import (
 	"context"
+	"time"
 
 	"github.com/greenbone/opensight-golang-libraries/pkg/query"
 	"github.com/greenbone/opensight-notification-service/pkg/models"
@@ -39,32 +40,184 @@ func (_m *NotificationRepository) EXPECT() *NotificationRepository_Expecter {
 	return &NotificationRepository_Expecter{mock: &_m.Mock}
 }
 
-// CreateNotification provides a mock function for the type NotificationRepository
-func (_mock *NotificationRepository) CreateNotification(ctx context.Context, notification models.Notification) (models.Notification, error) {
-	ret := _mock.Called(ctx, notification)
+// AcknowledgeNotification provides a mock function for the type NotificationRepository
+func (_mock *NotificationRepository) AcknowledgeNotification(ctx context.Context, id string, userID string, at time.Time) (models.Notification, error) {
+	ret := _mock.Called(ctx, id, userID, at)
 
 	if len(ret) == 0 {
-		panic("no return value specified for CreateNotification")
+		panic("no return value specified for AcknowledgeNotification")
 	}
 
 	var r0 models.Notification
 	var r1 error
-	if returnFunc, ok := ret.Get(0).(func(context.Context, models.Notification) (models.Notification, error)); ok {
-		return returnFunc(ctx, notification)
+	if returnFunc, ok := ret.Get(0).(func(context.Context, string, string, time.Time) (models.Notification, error)); ok {
+		return returnFunc(ctx, id, userID, at)
 	}
-	if returnFunc, ok := ret.Get(0).(func(context.Context, models.Notification) models.Notification); ok {
-		r0 = returnFunc(ctx, notification)
+	if returnFunc, ok := ret.Get(0).(func(context.Context, string, string, time.Time) models.Notification); ok {
+		r0 = returnFunc(ctx, id, userID, at)
 	} else {
 		r0 = ret.Get(0).(models.Notification)
 	}
-	if returnFunc, ok := ret.Get(1).(func(context.Context, models.Notification) error); ok {
-		r1 = returnFunc(ctx, notification)
+	if returnFunc, ok := ret.Get(1).(func(context.Context, string, string, time.Time) error); ok {
+		r1 = returnFunc(ctx, id, userID, at)
 	} else {
 		r1 = ret.Error(1)
 	}
 	return r0, r1
 }
 
+// NotificationRepository_AcknowledgeNotification_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'AcknowledgeNotification'
+type NotificationRepository_AcknowledgeNotification_Call struct {
+	*mock.Call
+}
+
+// AcknowledgeNotification is a helper method to define mock.On call
+//   - ctx context.Context
+//   - id string
+//   - userID string
+//   - at time.Time
+func (_e *NotificationRepository_Expecter) AcknowledgeNotification(ctx interface{}, id interface{}, userID interface{}, at interface{}) *NotificationRepository_AcknowledgeNotification_Call {
+	return &NotificationRepository_AcknowledgeNotification_Call{Call: _e.mock.On("AcknowledgeNotification", ctx, id, userID, at)}
+}
+
+func (_c *NotificationRepository_AcknowledgeNotification_Call) Run(run func(ctx context.Context, id string, userID string, at time.Time)) *NotificationRepository_AcknowledgeNotification_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		var arg0 context.Context
+		if args[0] != nil {
+			arg0 = args[0].(context.Context)
+		}
+		var arg1 string
+		if args[1] != nil {
+			arg1 = args[1].(string)
+		}
+		var arg2 string
+		if args[2] != nil {
+			arg2 = args[2].(string)
+		}
+		var arg3 time.Time
+		if args[3] != nil {
+			arg3 = args[3].(time.Time)
+		}
+		run(
+			arg0,
+			arg1,
+			arg2,
+			arg3,
+		)
+	})
+	return _c
+}
+
+func (_c *NotificationRepository_AcknowledgeNotification_Call) Return(notification models.Notification, err error) *NotificationRepository_AcknowledgeNotification_Call {
+	_c.Call.Return(notification, err)
+	return _c
+}
+
+func (_c *NotificationRepository_AcknowledgeNotification_Call) RunAndReturn(run func(ctx context.Context, id string, userID string, at time.Time) (models.Notification, error)) *NotificationRepository_AcknowledgeNotification_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// CountUnreadNotifications provides a mock function for the type NotificationRepository
+func (_mock *NotificationRepository) CountUnreadNotifications(ctx context.Context, userID string) (int, error) {
+	ret := _mock.Called(ctx, userID)
+
+	if len(ret) == 0 {
+		panic("no return value specified for CountUnreadNotifications")
+	}
+
+	var r0 int
+	var r1 error
+	if returnFunc, ok := ret.Get(0).(func(context.Context, string) (int, error)); ok {
+		return returnFunc(ctx, userID)
+	}
+	if returnFunc, ok := ret.Get(0).(func(context.Context, string) int); ok {
+		r0 = returnFunc(ctx, userID)
+	} else {
+		r0 = ret.Get(0).(int)
+	}
+	if returnFunc, ok := ret.Get(1).(func(context.Context, string) error); ok {
+		r1 = returnFunc(ctx, userID)
+	} else {
+		r1 = ret.Error(1)
+	}
+	return r0, r1
+}
+
+// NotificationRepository_CountUnreadNotifications_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'CountUnreadNotifications'
+type NotificationRepository_CountUnreadNotifications_Call struct {
+	*mock.Call
+}
+
+// CountUnreadNotifications is a helper method to define mock.On call
+//   - ctx context.Context
+//   - userID string
+func (_e *NotificationRepository_Expecter) CountUnreadNotifications(ctx interface{}, userID interface{}) *NotificationRepository_CountUnreadNotifications_Call {
+	return &NotificationRepository_CountUnreadNotifications_Call{Call: _e.mock.On("CountUnreadNotifications", ctx, userID)}
+}
+
+func (_c *NotificationRepository_CountUnreadNotifications_Call) Run(run func(ctx context.Context, userID string)) *NotificationRepository_CountUnreadNotifications_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		var arg0 context.Context
+		if args[0] != nil {
+			arg0 = args[0].(context.Context)
+		}
+		var arg1 string
+		if args[1] != nil {
+			arg1 = args[1].(string)
+		}
+		run(
+			arg0,
+			arg1,
+		)
+	})
+	return _c
+}
+
+func (_c *NotificationRepository_CountUnreadNotifications_Call) Return(n int, err error) *NotificationRepository_CountUnreadNotifications_Call {
+	_c.Call.Return(n, err)
+	return _c
+}
+
+func (_c *NotificationRepository_CountUnreadNotifications_Call) RunAndReturn(run func(ctx context.Context, userID string) (int, error)) *NotificationRepository_CountUnreadNotifications_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// CreateNotification provides a mock function for the type NotificationRepository
+func (_mock *NotificationRepository) CreateNotification(ctx context.Context, notification models.Notification, sendTasks []models.SendTask) (models.Notification, []models.SendTask, error) {
+	ret := _mock.Called(ctx, notification, sendTasks)
+
+	if len(ret) == 0 {
+		panic("no return value specified for CreateNotification")
+	}
+
+	var r0 models.Notification
+	var r1 []models.SendTask
+	var r2 error
+	if returnFunc, ok := ret.Get(0).(func(context.Context, models.Notification, []models.SendTask) (models.Notification, []models.SendTask, error)); ok {
+		return returnFunc(ctx, notification, sendTasks)
+	}
+	if returnFunc, ok := ret.Get(0).(func(context.Context, models.Notification, []models.SendTask) models.Notification); ok {
+		r0 = returnFunc(ctx, notification, sendTasks)
+	} else {
+		r0 = ret.Get(0).(models.Notification)
+	}
+	if returnFunc, ok := ret.Get(1).(func(context.Context, models.Notification, []models.SendTask) []models.SendTask); ok {
+		r1 = returnFunc(ctx, notification, sendTasks)
+	} else {
+		if ret.Get(1) != nil {
+			r1 = ret.Get(1).([]models.SendTask)
+		}
+	}
+	if returnFunc, ok := ret.Get(2).(func(context.Context, models.Notification, []models.SendTask) error); ok {
+		r2 = returnFunc(ctx, notification, sendTasks)
+	} else {
+		r2 = ret.Error(2)
+	}
+	return r0, r1, r2
+}
+
 // NotificationRepository_CreateNotification_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'CreateNotification'
 type NotificationRepository_CreateNotification_Call struct {
 	*mock.Call
@@ -73,11 +226,101 @@ type NotificationRepository_CreateNotification_Call struct {
 // CreateNotification is a helper method to define mock.On call
 //   - ctx context.Context
 //   - notification models.Notification
-func (_e *NotificationRepository_Expecter) CreateNotification(ctx interface{}, notification interface{}) *NotificationRepository_CreateNotification_Call {
-	return &NotificationRepository_CreateNotification_Call{Call: _e.mock.On("CreateNotification", ctx, notification)}
+//   - sendTasks []models.SendTask
+func (_e *NotificationRepository_Expecter) CreateNotification(ctx interface{}, notification interface{}, sendTasks interface{}) *NotificationRepository_CreateNotification_Call {
+	return &NotificationRepository_CreateNotification_Call{Call: _e.mock.On("CreateNotification", ctx, notification, sendTasks)}
+}
+
+func (_c *NotificationRepository_CreateNotification_Call) Run(run func(ctx context.Context, notification models.Notification, sendTasks []models.SendTask)) *NotificationRepository_CreateNotification_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		var arg0 context.Context
+		if args[0] != nil {
+			arg0 = args[0].(context.Context)
+		}
+		var arg1 models.Notification
+		if args[1] != nil {
+			arg1 = args[1].(models.Notification)
+		}
+		var arg2 []models.SendTask
+		if args[2] != nil {
+			arg2 = args[2].([]models.SendTask)
+		}
+		run(
+			arg0,
+			arg1,
+			arg2,
+		)
+	})
+	return _c
+}
+
+func (_c *NotificationRepository_CreateNotification_Call) Return(notification1 models.Notification, sendTasks1 []models.SendTask, err error) *NotificationRepository_CreateNotification_Call {
+	_c.Call.Return(notification1, sendTasks1, err)
+	return _c
+}
+
+func (_c *NotificationRepository_CreateNotification_Call) RunAndReturn(run func(ctx context.Context, notification models.Notification, sendTasks []models.SendTask) (models.Notification, []models.SendTask, error)) *NotificationRepository_CreateNotification_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// CreateNotificationIdempotent provides a mock function for the type NotificationRepository
+func (_mock *NotificationRepository) CreateNotificationIdempotent(ctx context.Context, notification models.Notification, sendTasks []models.SendTask, key models.IdempotencyKey, now time.Time, expiredBefore time.Time) (models.Notification, []models.SendTask, bool, error) {
+	ret := _mock.Called(ctx, notification, sendTasks, key, now, expiredBefore)
+
+	if len(ret) == 0 {
+		panic("no return value specified for CreateNotificationIdempotent")
+	}
+
+	var r0 models.Notification
+	var r1 []models.SendTask
+	var r2 bool
+	var r3 error
+	if returnFunc, ok := ret.Get(0).(func(context.Context, models.Notification, []models.SendTask, models.IdempotencyKey, time.Time, time.Time) (models.Notification, []models.SendTask, bool, error)); ok {
+		return returnFunc(ctx, notification, sendTasks, key, now, expiredBefore)
+	}
+	if returnFunc, ok := ret.Get(0).(func(context.Context, models.Notification, []models.SendTask, models.IdempotencyKey, time.Time, time.Time) models.Notification); ok {
+		r0 = returnFunc(ctx, notification, sendTasks, key, now, expiredBefore)
+	} else {
+		r0 = ret.Get(0).(models.Notification)
+	}
+	if returnFunc, ok := ret.Get(1).(func(context.Context, models.Notification, []models.SendTask, models.IdempotencyKey, time.Time, time.Time) []models.SendTask); ok {
+		r1 = returnFunc(ctx, notification, sendTasks, key, now, expiredBefore)
+	} else {
+		if ret.Get(1) != nil {
+			r1 = ret.Get(1).([]models.SendTask)
+		}
+	}
+	if returnFunc, ok := ret.Get(2).(func(context.Context, models.Notification, []models.SendTask, models.IdempotencyKey, time.Time, time.Time) bool); ok {
+		r2 = returnFunc(ctx, notification, sendTasks, key, now, expiredBefore)
+	} else {
+		r2 = ret.Get(2).(bool)
+	}
+	if returnFunc, ok := ret.Get(3).(func(context.Context, models.Notification, []models.SendTask, models.IdempotencyKey, time.Time, time.Time) error); ok {
+		r3 = returnFunc(ctx, notification, sendTasks, key, now, expiredBefore)
+	} else {
+		r3 = ret.Error(3)
+	}
+	return r0, r1, r2, r3
+}
+
+// NotificationRepository_CreateNotificationIdempotent_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'CreateNotificationIdempotent'
+type NotificationRepository_CreateNotificationIdempotent_Call struct {
+	*mock.Call
+}
+
+// CreateNotificationIdempotent is a helper method to define mock.On call
+//   - ctx context.Context
+//   - notification models.Notification
+//   - sendTasks []models.SendTask
+//   - key models.IdempotencyKey
+//   - now time.Time
+//   - expiredBefore time.Time
+func (_e *NotificationRepository_Expecter) CreateNotificationIdempotent(ctx interface{}, notification interface{}, sendTasks interface{}, key interface{}, now interface{}, expiredBefore interface{}) *NotificationRepository_CreateNotificationIdempotent_Call {
+	return &NotificationRepository_CreateNotificationIdempotent_Call{Call: _e.mock.On("CreateNotificationIdempotent", ctx, notification, sendTasks, key, now, expiredBefore)}
 }
 
-func (_c *NotificationRepository_CreateNotification_Call) Run(run func(ctx context.Context, notification models.Notification)) *NotificationRepository_CreateNotification_Call {
+func (_c *NotificationRepository_CreateNotificationIdempotent_Call) Run(run func(ctx context.Context, notification models.Notification, sendTasks []models.SendTask, key models.IdempotencyKey, now time.Time, expiredBefore time.Time)) *NotificationRepository_CreateNotificationIdempotent_Call {
 	_c.Call.Run(func(args mock.Arguments) {
 		var arg0 context.Context
 		if args[0] != nil {
@@ -87,27 +330,182 @@ func (_c *NotificationRepository_CreateNotification_Call) Run(run func(ctx conte
 		if args[1] != nil {
 			arg1 = args[1].(models.Notification)
 		}
+		var arg2 []models.SendTask
+		if args[2] != nil {
+			arg2 = args[2].([]models.SendTask)
+		}
+		var arg3 models.IdempotencyKey
+		if args[3] != nil {
+			arg3 = args[3].(models.IdempotencyKey)
+		}
+		var arg4 time.Time
+		if args[4] != nil {
+			arg4 = args[4].(time.Time)
+		}
+		var arg5 time.Time
+		if args[5] != nil {
+			arg5 = args[5].(time.Time)
+		}
 		run(
 			arg0,
 			arg1,
+			arg2,
+			arg3,
+			arg4,
+			arg5,
 		)
 	})
 	return _c
 }
 
-func (_c *NotificationRepository_CreateNotification_Call) Return(notification1 models.Notification, err error) *NotificationRepository_CreateNotification_Call {
-	_c.Call.Return(notification1, err)
+func (_c *NotificationRepository_CreateNotificationIdempotent_Call) Return(notification1 models.Notification, sendTasks1 []models.SendTask, b bool, err error) *NotificationRepository_CreateNotificationIdempotent_Call {
+	_c.Call.Return(notification1, sendTasks1, b, err)
 	return _c
 }
 
-func (_c *NotificationRepository_CreateNotification_Call) RunAndReturn(run func(ctx context.Context, notification models.Notification) (models.Notification, error)) *NotificationRepository_CreateNotification_Call {
+func (_c *NotificationRepository_CreateNotificationIdempotent_Call) RunAndReturn(run func(ctx context.Context, notification models.Notification, sendTasks []models.SendTask, key models.IdempotencyKey, now time.Time, expiredBefore time.Time) (models.Notification, []models.SendTask, bool, error)) *NotificationRepository_CreateNotificationIdempotent_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// DismissNotification provides a mock function for the type NotificationRepository
+func (_mock *NotificationRepository) DismissNotification(ctx context.Context, id string, userID string, at time.Time) error {
+	ret := _mock.Called(ctx, id, userID, at)
+
+	if len(ret) == 0 {
+		panic("no return value specified for DismissNotification")
+	}
+
+	var r0 error
+	if returnFunc, ok := ret.Get(0).(func(context.Context, string, string, time.Time) error); ok {
+		r0 = returnFunc(ctx, id, userID, at)
+	} else {
+		r0 = ret.Error(0)
+	}
+	return r0
+}
+
+// NotificationRepository_DismissNotification_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'DismissNotification'
+type NotificationRepository_DismissNotification_Call struct {
+	*mock.Call
+}
+
+// DismissNotification is a helper method to define mock.On call
+//   - ctx context.Context
+//   - id string
+//   - userID string
+//   - at time.Time
+func (_e *NotificationRepository_Expecter) DismissNotification(ctx interface{}, id interface{}, userID interface{}, at interface{}) *NotificationRepository_DismissNotification_Call {
+	return &NotificationRepository_DismissNotification_Call{Call: _e.mock.On("DismissNotification", ctx, id, userID, at)}
+}
+
+func (_c *NotificationRepository_DismissNotification_Call) Run(run func(ctx context.Context, id string, userID string, at time.Time)) *NotificationRepository_DismissNotification_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		var arg0 context.Context
+		if args[0] != nil {
+			arg0 = args[0].(context.Context)
+		}
+		var arg1 string
+		if args[1] != nil {
+			arg1 = args[1].(string)
+		}
+		var arg2 string
+		if args[2] != nil {
+			arg2 = args[2].(string)
+		}
+		var arg3 time.Time
+		if args[3] != nil {
+			arg3 = args[3].(time.Time)
+		}
+		run(
+			arg0,
+			arg1,
+			arg2,
+			arg3,
+		)
+	})
+	return _c
+}
+
+func (_c *NotificationRepository_DismissNotification_Call) Return(err error) *NotificationRepository_DismissNotification_Call {
+	_c.Call.Return(err)
+	return _c
+}
+
+func (_c *NotificationRepository_DismissNotification_Call) RunAndReturn(run func(ctx context.Context, id string, userID string, at time.Time) error) *NotificationRepository_DismissNotification_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// GetNotification provides a mock function for the type NotificationRepository
+func (_mock *NotificationRepository) GetNotification(ctx context.Context, id string) (models.Notification, error) {
+	ret := _mock.Called(ctx, id)
+
+	if len(ret) == 0 {
+		panic("no return value specified for GetNotification")
+	}
+
+	var r0 models.Notification
+	var r1 error
+	if returnFunc, ok := ret.Get(0).(func(context.Context, string) (models.Notification, error)); ok {
+		return returnFunc(ctx, id)
+	}
+	if returnFunc, ok := ret.Get(0).(func(context.Context, string) models.Notification); ok {
+		r0 = returnFunc(ctx, id)
+	} else {
+		r0 = ret.Get(0).(models.Notification)
+	}
+	if returnFunc, ok := ret.Get(1).(func(context.Context, string) error); ok {
+		r1 = returnFunc(ctx, id)
+	} else {
+		r1 = ret.Error(1)
+	}
+	return r0, r1
+}
+
+// NotificationRepository_GetNotification_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'GetNotification'
+type NotificationRepository_GetNotification_Call struct {
+	*mock.Call
+}
+
+// GetNotification is a helper method to define mock.On call
+//   - ctx context.Context
+//   - id string
+func (_e *NotificationRepository_Expecter) GetNotification(ctx interface{}, id interface{}) *NotificationRepository_GetNotification_Call {
+	return &NotificationRepository_GetNotification_Call{Call: _e.mock.On("GetNotification", ctx, id)}
+}
+
+func (_c *NotificationRepository_GetNotification_Call) Run(run func(ctx context.Context, id string)) *NotificationRepository_GetNotification_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		var arg0 context.Context
+		if args[0] != nil {
+			arg0 = args[0].(context.Context)
+		}
+		var arg1 string
+		if args[1] != nil {
+			arg1 = args[1].(string)
+		}
+		run(
+			arg0,
+			arg1,
+		)
+	})
+	return _c
+}
+
+func (_c *NotificationRepository_GetNotification_Call) Return(notification models.Notification, err error) *NotificationRepository_GetNotification_Call {
+	_c.Call.Return(notification, err)
+	return _c
+}
+
+func (_c *NotificationRepository_GetNotification_Call) RunAndReturn(run func(ctx context.Context, id string) (models.Notification, error)) *NotificationRepository_GetNotification_Call {
 	_c.Call.Return(run)
 	return _c
 }
 
 // ListNotifications provides a mock function for the type NotificationRepository
-func (_mock *NotificationRepository) ListNotifications(ctx context.Context, resultSelector query.ResultSelector) ([]models.Notification, uint64, error) {
-	ret := _mock.Called(ctx, resultSelector)
+func (_mock *NotificationRepository) ListNotifications(ctx context.Context, resultSelector query.ResultSelector, userID string) ([]models.Notification, uint64, error) {
+	ret := _mock.Called(ctx, resultSelector, userID)
 
 	if len(ret) == 0 {
 		panic("no return value specified for ListNotifications")
@@ -116,23 +514,23 @@ func (_mock *NotificationRepository) ListNotifications(ctx context.Context, resu
 	var r0 []models.Notification
 	var r1 uint64
 	var r2 error
-	if returnFunc, ok := ret.Get(0).(func(context.Context, query.ResultSelector) ([]models.Notification, uint64, error)); ok {
-		return returnFunc(ctx, resultSelector)
+	if returnFunc, ok := ret.Get(0).(func(context.Context, query.ResultSelector, string) ([]models.Notification, uint64, error)); ok {
+		return returnFunc(ctx, resultSelector, userID)
 	}
-	if returnFunc, ok := ret.Get(0).(func(context.Context, query.ResultSelector) []models.Notification); ok {
-		r0 = returnFunc(ctx, resultSelector)
+	if returnFunc, ok := ret.Get(0).(func(context.Context, query.ResultSelector, string) []models.Notification); ok {
+		r0 = returnFunc(ctx, resultSelector, userID)
 	} else {
 		if ret.Get(0) != nil {
 			r0 = ret.Get(0).([]models.Notification)
 		}
 	}
-	if returnFunc, ok := ret.Get(1).(func(context.Context, query.ResultSelector) uint64); ok {
-		r1 = returnFunc(ctx, resultSelector)
+	if returnFunc, ok := ret.Get(1).(func(context.Context, query.ResultSelector, string) uint64); ok {
+		r1 = returnFunc(ctx, resultSelector, userID)
 	} else {
 		r1 = ret.Get(1).(uint64)
 	}
-	if returnFunc, ok := ret.Get(2).(func(context.Context, query.ResultSelector) error); ok {
-		r2 = returnFunc(ctx, resultSelector)
+	if returnFunc, ok := ret.Get(2).(func(context.Context, query.ResultSelector, string) error); ok {
+		r2 = returnFunc(ctx, resultSelector, userID)
 	} else {
 		r2 = ret.Error(2)
 	}
@@ -147,11 +545,12 @@ type NotificationRepository_ListNotifications_Call struct {
 // ListNotifications is a helper method to define mock.On call
 //   - ctx context.Context
 //   - resultSelector query.ResultSelector
-func (_e *NotificationRepository_Expecter) ListNotifications(ctx interface{}, resultSelector interface{}) *NotificationRepository_ListNotifications_Call {
-	return &NotificationRepository_ListNotifications_Call{Call: _e.mock.On("ListNotifications", ctx, resultSelector)}
+//   - userID string
+func (_e *NotificationRepository_Expecter) ListNotifications(ctx interface{}, resultSelector interface{}, userID interface{}) *NotificationRepository_ListNotifications_Call {
+	return &NotificationRepository_ListNotifications_Call{Call: _e.mock.On("ListNotifications", ctx, resultSelector, userID)}
 }
 
-func (_c *NotificationRepository_ListNotifications_Call) Run(run func(ctx context.Context, resultSelector query.ResultSelector)) *NotificationRepository_ListNotifications_Call {
+func (_c *NotificationRepository_ListNotifications_Call) Run(run func(ctx context.Context, resultSelector query.ResultSelector, userID string)) *NotificationRepository_ListNotifications_Call {
 	_c.Call.Run(func(args mock.Arguments) {
 		var arg0 context.Context
 		if args[0] != nil {
@@ -161,9 +560,14 @@ func (_c *NotificationRepository_ListNotifications_Call) Run(run func(ctx contex
 		if args[1] != nil {
 			arg1 = args[1].(query.ResultSelector)
 		}
+		var arg2 string
+		if args[2] != nil {
+			arg2 = args[2].(string)
+		}
 		run(
 			arg0,
 			arg1,
+			arg2,
 		)
 	})
 	return _c
@@ -174,7 +578,148 @@ func (_c *NotificationRepository_ListNotifications_Call) Return(notifications []
 	return _c
 }
 
-func (_c *NotificationRepository_ListNotifications_Call) RunAndReturn(run func(ctx context.Context, resultSelector query.ResultSelector) ([]models.Notification, uint64, error)) *NotificationRepository_ListNotifications_Call {
+func (_c *NotificationRepository_ListNotifications_Call) RunAndReturn(run func(ctx context.Context, resultSelector query.ResultSelector, userID string) ([]models.Notification, uint64, error)) *NotificationRepository_ListNotifications_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// MarkAllNotificationsRead provides a mock function for the type NotificationRepository
+func (_mock *NotificationRepository) MarkAllNotificationsRead(ctx context.Context, userID string, at time.Time) (int, error) {
+	ret := _mock.Called(ctx, userID, at)
+
+	if len(ret) == 0 {
+		panic("no return value specified for MarkAllNotificationsRead")
+	}
+
+	var r0 int
+	var r1 error
+	if returnFunc, ok := ret.Get(0).(func(context.Context, string, time.Time) (int, error)); ok {
+		return returnFunc(ctx, userID, at)
+	}
+	if returnFunc, ok := ret.Get(0).(func(context.Context, string, time.Time) int); ok {
+		r0 = returnFunc(ctx, userID, at)
+	} else {
+		r0 = ret.Get(0).(int)
+	}
+	if returnFunc, ok := ret.Get(1).(func(context.Context, string, time.Time) error); ok {
+		r1 = returnFunc(ctx, userID, at)
+	} else {
+		r1 = ret.Error(1)
+	}
+	return r0, r1
+}
+
+// NotificationRepository_MarkAllNotificationsRead_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'MarkAllNotificationsRead'
+type NotificationRepository_MarkAllNotificationsRead_Call struct {
+	*mock.Call
+}
+
+// MarkAllNotificationsRead is a helper method to define mock.On call
+//   - ctx context.Context
+//   - userID string
+//   - at time.Time
+func (_e *NotificationRepository_Expecter) MarkAllNotificationsRead(ctx interface{}, userID interface{}, at interface{}) *NotificationRepository_MarkAllNotificationsRead_Call {
+	return &NotificationRepository_MarkAllNotificationsRead_Call{Call: _e.mock.On("MarkAllNotificationsRead", ctx, userID, at)}
+}
+
+func (_c *NotificationRepository_MarkAllNotificationsRead_Call) Run(run func(ctx context.Context, userID string, at time.Time)) *NotificationRepository_MarkAllNotificationsRead_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		var arg0 context.Context
+		if args[0] != nil {
+			arg0 = args[0].(context.Context)
+		}
+		var arg1 string
+		if args[1] != nil {
+			arg1 = args[1].(string)
+		}
+		var arg2 time.Time
+		if args[2] != nil {
+			arg2 = args[2].(time.Time)
+		}
+		run(
+			arg0,
+			arg1,
+			arg2,
+		)
+	})
+	return _c
+}
+
+func (_c *NotificationRepository_MarkAllNotificationsRead_Call) Return(n int, err error) *NotificationRepository_MarkAllNotificationsRead_Call {
+	_c.Call.Return(n, err)
+	return _c
+}
+
+func (_c *NotificationRepository_MarkAllNotificationsRead_Call) RunAndReturn(run func(ctx context.Context, userID string, at time.Time) (int, error)) *NotificationRepository_MarkAllNotificationsRead_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// MarkNotificationRead provides a mock function for the type NotificationRepository
+func (_mock *NotificationRepository) MarkNotificationRead(ctx context.Context, id string, userID string, at time.Time) error {
+	ret := _mock.Called(ctx, id, userID, at)
+
+	if len(ret) == 0 {
+		panic("no return value specified for MarkNotificationRead")
+	}
+
+	var r0 error
+	if returnFunc, ok := ret.Get(0).(func(context.Context, string, string, time.Time) error); ok {
+		r0 = returnFunc(ctx, id, userID, at)
+	} else {
+		r0 = ret.Error(0)
+	}
+	return r0
+}
+
+// NotificationRepository_MarkNotificationRead_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'MarkNotificationRead'
+type NotificationRepository_MarkNotificationRead_Call struct {
+	*mock.Call
+}
+
+// MarkNotificationRead is a helper method to define mock.On call
+//   - ctx context.Context
+//   - id string
+//   - userID string
+//   - at time.Time
+func (_e *NotificationRepository_Expecter) MarkNotificationRead(ctx interface{}, id interface{}, userID interface{}, at interface{}) *NotificationRepository_MarkNotificationRead_Call {
+	return &NotificationRepository_MarkNotificationRead_Call{Call: _e.mock.On("MarkNotificationRead", ctx, id, userID, at)}
+}
+
+func (_c *NotificationRepository_MarkNotificationRead_Call) Run(run func(ctx context.Context, id string, userID string, at time.Time)) *NotificationRepository_MarkNotificationRead_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		var arg0 context.Context
+		if args[0] != nil {
+			arg0 = args[0].(context.Context)
+		}
+		var arg1 string
+		if args[1] != nil {
+			arg1 = args[1].(string)
+		}
+		var arg2 string
+		if args[2] != nil {
+			arg2 = args[2].(string)
+		}
+		var arg3 time.Time
+		if args[3] != nil {
+			arg3 = args[3].(time.Time)
+		}
+		run(
+			arg0,
+			arg1,
+			arg2,
+			arg3,
+		)
+	})
+	return _c
+}
+
+func (_c *NotificationRepository_MarkNotificationRead_Call) Return(err error) *NotificationRepository_MarkNotificationRead_Call {
+	_c.Call.Return(err)
+	return _c
+}
+
+func (_c *NotificationRepository_MarkNotificationRead_Call) RunAndReturn(run func(ctx context.Context, id string, userID string, at time.Time) error) *NotificationRepository_MarkNotificationRead_Call {
 	_c.Call.Return(run)
 	return _c
 }
@@ -39,6 +39,144 @@ func (_m *NotificationService) EXPECT() *NotificationService_Expecter {
 	return &NotificationService_Expecter{mock: &_m.Mock}
 }
 
+// AcknowledgeNotification provides a mock function for the type NotificationService
+func (_mock *NotificationService) AcknowledgeNotification(ctx context.Context, id string, userID string) (models.Notification, error) {
+	ret := _mock.Called(ctx, id, userID)
+
+	if len(ret) == 0 {
+		panic("no return value specified for AcknowledgeNotification")
+	}
+
+	var r0 models.Notification
+	var r1 error
+	if returnFunc, ok := ret.Get(0).(func(context.Context, string, string) (models.Notification, error)); ok {
+		return returnFunc(ctx, id, userID)
+	}
+	if returnFunc, ok := ret.Get(0).(func(context.Context, string, string) models.Notification); ok {
+		r0 = returnFunc(ctx, id, userID)
+	} else {
+		r0 = ret.Get(0).(models.Notification)
+	}
+	if returnFunc, ok := ret.Get(1).(func(context.Context, string, string) error); ok {
+		r1 = returnFunc(ctx, id, userID)
+	} else {
+		r1 = ret.Error(1)
+	}
+	return r0, r1
+}
+
+// NotificationService_AcknowledgeNotification_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'AcknowledgeNotification'
+type NotificationService_AcknowledgeNotification_Call struct {
+	*mock.Call
+}
+
+// AcknowledgeNotification is a helper method to define mock.On call
+//   - ctx context.Context
+//   - id string
+//   - userID string
+func (_e *NotificationService_Expecter) AcknowledgeNotification(ctx interface{}, id interface{}, userID interface{}) *NotificationService_AcknowledgeNotification_Call {
+	return &NotificationService_AcknowledgeNotification_Call{Call: _e.mock.On("AcknowledgeNotification", ctx, id, userID)}
+}
+
+func (_c *NotificationService_AcknowledgeNotification_Call) Run(run func(ctx context.Context, id string, userID string)) *NotificationService_AcknowledgeNotification_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		var arg0 context.Context
+		if args[0] != nil {
+			arg0 = args[0].(context.Context)
+		}
+		var arg1 string
+		if args[1] != nil {
+			arg1 = args[1].(string)
+		}
+		var arg2 string
+		if args[2] != nil {
+			arg2 = args[2].(string)
+		}
+		run(
+			arg0,
+			arg1,
+			arg2,
+		)
+	})
+	return _c
+}
+
+func (_c *NotificationService_AcknowledgeNotification_Call) Return(notification models.Notification, err error) *NotificationService_AcknowledgeNotification_Call {
+	_c.Call.Return(notification, err)
+	return _c
+}
+
+func (_c *NotificationService_AcknowledgeNotification_Call) RunAndReturn(run func(ctx context.Context, id string, userID string) (models.Notification, error)) *NotificationService_AcknowledgeNotification_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// CountUnreadNotifications provides a mock function for the type NotificationService
+func (_mock *NotificationService) CountUnreadNotifications(ctx context.Context, userID string) (int, error) {
+	ret := _mock.Called(ctx, userID)
+
+	if len(ret) == 0 {
+		panic("no return value specified for CountUnreadNotifications")
+	}
+
+	var r0 int
+	var r1 error
+	if returnFunc, ok := ret.Get(0).(func(context.Context, string) (int, error)); ok {
+		return returnFunc(ctx, userID)
+	}
+	if returnFunc, ok := ret.Get(0).(func(context.Context, string) int); ok {
+		r0 = returnFunc(ctx, userID)
+	} else {
+		r0 = ret.Get(0).(int)
+	}
+	if returnFunc, ok := ret.Get(1).(func(context.Context, string) error); ok {
+		r1 = returnFunc(ctx, userID)
+	} else {
+		r1 = ret.Error(1)
+	}
+	return r0, r1
+}
+
+// NotificationService_CountUnreadNotifications_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'CountUnreadNotifications'
+type NotificationService_CountUnreadNotifications_Call struct {
+	*mock.Call
+}
+
+// CountUnreadNotifications is a helper method to define mock.On call
+//   - ctx context.Context
+//   - userID string
+func (_e *NotificationService_Expecter) CountUnreadNotifications(ctx interface{}, userID interface{}) *NotificationService_CountUnreadNotifications_Call {
+	return &NotificationService_CountUnreadNotifications_Call{Call: _e.mock.On("CountUnreadNotifications", ctx, userID)}
+}
+
+func (_c *NotificationService_CountUnreadNotifications_Call) Run(run func(ctx context.Context, userID string)) *NotificationService_CountUnreadNotifications_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		var arg0 context.Context
+		if args[0] != nil {
+			arg0 = args[0].(context.Context)
+		}
+		var arg1 string
+		if args[1] != nil {
+			arg1 = args[1].(string)
+		}
+		run(
+			arg0,
+			arg1,
+		)
+	})
+	return _c
+}
+
+func (_c *NotificationService_CountUnreadNotifications_Call) Return(n int, err error) *NotificationService_CountUnreadNotifications_Call {
+	_c.Call.Return(n, err)
+	return _c
+}
+
+func (_c *NotificationService_CountUnreadNotifications_Call) RunAndReturn(run func(ctx context.Context, userID string) (int, error)) *NotificationService_CountUnreadNotifications_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
 // CreateNotification provides a mock function for the type NotificationService
 func (_mock *NotificationService) CreateNotification(ctx context.Context, notificationIn models.Notification) (models.Notification, error) {
 	ret := _mock.Called(ctx, notificationIn)
@@ -105,76 +243,915 @@ func (_c *NotificationService_CreateNotification_Call) RunAndReturn(run func(ctx
 	return _c
 }
 
-// ListNotifications provides a mock function for the type NotificationService
-func (_mock *NotificationService) ListNotifications(ctx context.Context, resultSelector query.ResultSelector) ([]models.Notification, uint64, error) {
-	ret := _mock.Called(ctx, resultSelector)
+// CreateNotificationIdempotent provides a mock function for the type NotificationService
+func (_mock *NotificationService) CreateNotificationIdempotent(ctx context.Context, notificationIn models.Notification, caller string) (models.Notification, bool, error) {
+	ret := _mock.Called(ctx, notificationIn, caller)
 
 	if len(ret) == 0 {
-		panic("no return value specified for ListNotifications")
+		panic("no return value specified for CreateNotificationIdempotent")
 	}
 
-	var r0 []models.Notification
-	var r1 uint64
+	var r0 models.Notification
+	var r1 bool
 	var r2 error
-	if returnFunc, ok := ret.Get(0).(func(context.Context, query.ResultSelector) ([]models.Notification, uint64, error)); ok {
-		return returnFunc(ctx, resultSelector)
+	if returnFunc, ok := ret.Get(0).(func(context.Context, models.Notification, string) (models.Notification, bool, error)); ok {
+		return returnFunc(ctx, notificationIn, caller)
 	}
-	if returnFunc, ok := ret.Get(0).(func(context.Context, query.ResultSelector) []models.Notification); ok {
-		r0 = returnFunc(ctx, resultSelector)
+	if returnFunc, ok := ret.Get(0).(func(context.Context, models.Notification, string) models.Notification); ok {
+		r0 = returnFunc(ctx, notificationIn, caller)
 	} else {
-		if ret.Get(0) != nil {
-			r0 = ret.Get(0).([]models.Notification)
-		}
+		r0 = ret.Get(0).(models.Notification)
 	}
-	if returnFunc, ok := ret.Get(1).(func(context.Context, query.ResultSelector) uint64); ok {
-		r1 = returnFunc(ctx, resultSelector)
+	if returnFunc, ok := ret.Get(1).(func(context.Context, models.Notification, string) bool); ok {
+		r1 = returnFunc(ctx, notificationIn, caller)
 	} else {
-		r1 = ret.Get(1).(uint64)
+		r1 = ret.Get(1).(bool)
 	}
-	if returnFunc, ok := ret.Get(2).(func(context.Context, query.ResultSelector) error); ok {
-		r2 = returnFunc(ctx, resultSelector)
+	if returnFunc, ok := ret.Get(2).(func(context.Context, models.Notification, string) error); ok {
+		r2 = returnFunc(ctx, notificationIn, caller)
 	} else {
 		r2 = ret.Error(2)
 	}
 	return r0, r1, r2
 }
 
-// NotificationService_ListNotifications_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'ListNotifications'
-type NotificationService_ListNotifications_Call struct {
+// NotificationService_CreateNotificationIdempotent_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'CreateNotificationIdempotent'
+type NotificationService_CreateNotificationIdempotent_Call struct {
 	*mock.Call
 }
 
-// ListNotifications is a helper method to define mock.On call
+// CreateNotificationIdempotent is a helper method to define mock.On call
 //   - ctx context.Context
-//   - resultSelector query.ResultSelector
-func (_e *NotificationService_Expecter) ListNotifications(ctx interface{}, resultSelector interface{}) *NotificationService_ListNotifications_Call {
-	return &NotificationService_ListNotifications_Call{Call: _e.mock.On("ListNotifications", ctx, resultSelector)}
+//   - notificationIn models.Notification
+//   - caller string
+func (_e *NotificationService_Expecter) CreateNotificationIdempotent(ctx interface{}, notificationIn interface{}, caller interface{}) *NotificationService_CreateNotificationIdempotent_Call {
+	return &NotificationService_CreateNotificationIdempotent_Call{Call: _e.mock.On("CreateNotificationIdempotent", ctx, notificationIn, caller)}
 }
 
-func (_c *NotificationService_ListNotifications_Call) Run(run func(ctx context.Context, resultSelector query.ResultSelector)) *NotificationService_ListNotifications_Call {
+func (_c *NotificationService_CreateNotificationIdempotent_Call) Run(run func(ctx context.Context, notificationIn models.Notification, caller string)) *NotificationService_CreateNotificationIdempotent_Call {
 	_c.Call.Run(func(args mock.Arguments) {
 		var arg0 context.Context
 		if args[0] != nil {
 			arg0 = args[0].(context.Context)
 		}
-		var arg1 query.ResultSelector
+		var arg1 models.Notification
 		if args[1] != nil {
-			arg1 = args[1].(query.ResultSelector)
+			arg1 = args[1].(models.Notification)
+		}
+		var arg2 string
+		if args[2] != nil {
+			arg2 = args[2].(string)
 		}
 		run(
 			arg0,
 			arg1,
+			arg2,
 		)
 	})
 	return _c
 }
 
-func (_c *NotificationService_ListNotifications_Call) Return(notifications []models.Notification, totalResult uint64, err error) *NotificationService_ListNotifications_Call {
-	_c.Call.Return(notifications, totalResult, err)
+func (_c *NotificationService_CreateNotificationIdempotent_Call) Return(notification models.Notification, repeated bool, err error) *NotificationService_CreateNotificationIdempotent_Call {
+	_c.Call.Return(notification, repeated, err)
+	return _c
+}
+
+func (_c *NotificationService_CreateNotificationIdempotent_Call) RunAndReturn(run func(ctx context.Context, notificationIn models.Notification, caller string) (models.Notification, bool, error)) *NotificationService_CreateNotificationIdempotent_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// DismissNotification provides a mock function for the type NotificationService
+func (_mock *NotificationService) DismissNotification(ctx context.Context, id string, userID string) error {
+	ret := _mock.Called(ctx, id, userID)
+
+	if len(ret) == 0 {
+		panic("no return value specified for DismissNotification")
+	}
+
+	var r0 error
+	if returnFunc, ok := ret.Get(0).(func(context.Context, string, string) error); ok {
+		r0 = returnFunc(ctx, id, userID)
+	} else {
+		r0 = ret.Error(0)
+	}
+	return r0
+}
+
+// NotificationService_DismissNotification_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'DismissNotification'
+type NotificationService_DismissNotification_Call struct {
+	*mock.Call
+}
+
+// DismissNotification is a helper method to define mock.On call
+//   - ctx context.Context
+//   - id string
+//   - userID string
+func (_e *NotificationService_Expecter) DismissNotification(ctx interface{}, id interface{}, userID interface{}) *NotificationService_DismissNotification_Call {
+	return &NotificationService_DismissNotification_Call{Call: _e.mock.On("DismissNotification", ctx, id, userID)}
+}
+
+func (_c *NotificationService_DismissNotification_Call) Run(run func(ctx context.Context, id string, userID string)) *NotificationService_DismissNotification_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		var arg0 context.Context
+		if args[0] != nil {
+			arg0 = args[0].(context.Context)
+		}
+		var arg1 string
+		if args[1] != nil {
+			arg1 = args[1].(string)
+		}
+		var arg2 string
+		if args[2] != nil {
+			arg2 = args[2].(string)
+		}
+		run(
+			arg0,
+			arg1,
+			arg2,
+		)
+	})
+	return _c
+}
+
+func (_c *NotificationService_DismissNotification_Call) Return(err error) *NotificationService_DismissNotification_Call {
+	_c.Call.Return(err)
+	return _c
+}
+
+func (_c *NotificationService_DismissNotification_Call) RunAndReturn(run func(ctx context.Context, id string, userID string) error) *NotificationService_DismissNotification_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// EscalateNotifications provides a mock function for the type NotificationService
+func (_mock *NotificationService) EscalateNotifications(ctx context.Context) error {
+	ret := _mock.Called(ctx)
+
+	if len(ret) == 0 {
+		panic("no return value specified for EscalateNotifications")
+	}
+
+	var r0 error
+	if returnFunc, ok := ret.Get(0).(func(context.Context) error); ok {
+		r0 = returnFunc(ctx)
+	} else {
+		r0 = ret.Error(0)
+	}
+	return r0
+}
+
+// NotificationService_EscalateNotifications_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'EscalateNotifications'
+type NotificationService_EscalateNotifications_Call struct {
+	*mock.Call
+}
+
+// EscalateNotifications is a helper method to define mock.On call
+//   - ctx context.Context
+func (_e *NotificationService_Expecter) EscalateNotifications(ctx interface{}) *NotificationService_EscalateNotifications_Call {
+	return &NotificationService_EscalateNotifications_Call{Call: _e.mock.On("EscalateNotifications", ctx)}
+}
+
+func (_c *NotificationService_EscalateNotifications_Call) Run(run func(ctx context.Context)) *NotificationService_EscalateNotifications_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		var arg0 context.Context
+		if args[0] != nil {
+			arg0 = args[0].(context.Context)
+		}
+		run(
+			arg0,
+		)
+	})
+	return _c
+}
+
+func (_c *NotificationService_EscalateNotifications_Call) Return(err error) *NotificationService_EscalateNotifications_Call {
+	_c.Call.Return(err)
+	return _c
+}
+
+func (_c *NotificationService_EscalateNotifications_Call) RunAndReturn(run func(ctx context.Context) error) *NotificationService_EscalateNotifications_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// GetDeadLetter provides a mock function for the type NotificationService
+func (_mock *NotificationService) GetDeadLetter(ctx context.Context, id string) (models.DeadLetter, error) {
+	ret := _mock.Called(ctx, id)
+
+	if len(ret) == 0 {
+		panic("no return value specified for GetDeadLetter")
+	}
+
+	var r0 models.DeadLetter
+	var r1 error
+	if returnFunc, ok := ret.Get(0).(func(context.Context, string) (models.DeadLetter, error)); ok {
+		return returnFunc(ctx, id)
+	}
+	if returnFunc, ok := ret.Get(0).(func(context.Context, string) models.DeadLetter); ok {
+		r0 = returnFunc(ctx, id)
+	} else {
+		r0 = ret.Get(0).(models.DeadLetter)
+	}
+	if returnFunc, ok := ret.Get(1).(func(context.Context, string) error); ok {
+		r1 = returnFunc(ctx, id)
+	} else {
+		r1 = ret.Error(1)
+	}
+	return r0, r1
+}
+
+// NotificationService_GetDeadLetter_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'GetDeadLetter'
+type NotificationService_GetDeadLetter_Call struct {
+	*mock.Call
+}
+
+// GetDeadLetter is a helper method to define mock.On call
+//   - ctx context.Context
+//   - id string
+func (_e *NotificationService_Expecter) GetDeadLetter(ctx interface{}, id interface{}) *NotificationService_GetDeadLetter_Call {
+	return &NotificationService_GetDeadLetter_Call{Call: _e.mock.On("GetDeadLetter", ctx, id)}
+}
+
+func (_c *NotificationService_GetDeadLetter_Call) Run(run func(ctx context.Context, id string)) *NotificationService_GetDeadLetter_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		var arg0 context.Context
+		if args[0] != nil {
+			arg0 = args[0].(context.Context)
+		}
+		var arg1 string
+		if args[1] != nil {
+			arg1 = args[1].(string)
+		}
+		run(
+			arg0,
+			arg1,
+		)
+	})
+	return _c
+}
+
+func (_c *NotificationService_GetDeadLetter_Call) Return(deadLetter models.DeadLetter, err error) *NotificationService_GetDeadLetter_Call {
+	_c.Call.Return(deadLetter, err)
+	return _c
+}
+
+func (_c *NotificationService_GetDeadLetter_Call) RunAndReturn(run func(ctx context.Context, id string) (models.DeadLetter, error)) *NotificationService_GetDeadLetter_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// GetWorkerPoolStats provides a mock function for the type NotificationService
+func (_mock *NotificationService) GetWorkerPoolStats() models.WorkerPoolStats {
+	ret := _mock.Called()
+
+	if len(ret) == 0 {
+		panic("no return value specified for GetWorkerPoolStats")
+	}
+
+	var r0 models.WorkerPoolStats
+	if returnFunc, ok := ret.Get(0).(func() models.WorkerPoolStats); ok {
+		r0 = returnFunc()
+	} else {
+		r0 = ret.Get(0).(models.WorkerPoolStats)
+	}
+	return r0
+}
+
+// NotificationService_GetWorkerPoolStats_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'GetWorkerPoolStats'
+type NotificationService_GetWorkerPoolStats_Call struct {
+	*mock.Call
+}
+
+// GetWorkerPoolStats is a helper method to define mock.On call
+func (_e *NotificationService_Expecter) GetWorkerPoolStats() *NotificationService_GetWorkerPoolStats_Call {
+	return &NotificationService_GetWorkerPoolStats_Call{Call: _e.mock.On("GetWorkerPoolStats")}
+}
+
+func (_c *NotificationService_GetWorkerPoolStats_Call) Run(run func()) *NotificationService_GetWorkerPoolStats_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run()
+	})
+	return _c
+}
+
+func (_c *NotificationService_GetWorkerPoolStats_Call) Return(workerPoolStats models.WorkerPoolStats) *NotificationService_GetWorkerPoolStats_Call {
+	_c.Call.Return(workerPoolStats)
+	return _c
+}
+
+func (_c *NotificationService_GetWorkerPoolStats_Call) RunAndReturn(run func() models.WorkerPoolStats) *NotificationService_GetWorkerPoolStats_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// ListDeadLetters provides a mock function for the type NotificationService
+func (_mock *NotificationService) ListDeadLetters(ctx context.Context, resultSelector query.ResultSelector) ([]models.DeadLetter, uint64, error) {
+	ret := _mock.Called(ctx, resultSelector)
+
+	if len(ret) == 0 {
+		panic("no return value specified for ListDeadLetters")
+	}
+
+	var r0 []models.DeadLetter
+	var r1 uint64
+	var r2 error
+	if returnFunc, ok := ret.Get(0).(func(context.Context, query.ResultSelector) ([]models.DeadLetter, uint64, error)); ok {
+		return returnFunc(ctx, resultSelector)
+	}
+	if returnFunc, ok := ret.Get(0).(func(context.Context, query.ResultSelector) []models.DeadLetter); ok {
+		r0 = returnFunc(ctx, resultSelector)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).([]models.DeadLetter)
+		}
+	}
+	if returnFunc, ok := ret.Get(1).(func(context.Context, query.ResultSelector) uint64); ok {
+		r1 = returnFunc(ctx, resultSelector)
+	} else {
+		r1 = ret.Get(1).(uint64)
+	}
+	if returnFunc, ok := ret.Get(2).(func(context.Context, query.ResultSelector) error); ok {
+		r2 = returnFunc(ctx, resultSelector)
+	} else {
+		r2 = ret.Error(2)
+	}
+	return r0, r1, r2
+}
+
+// NotificationService_ListDeadLetters_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'ListDeadLetters'
+type NotificationService_ListDeadLetters_Call struct {
+	*mock.Call
+}
+
+// ListDeadLetters is a helper method to define mock.On call
+//   - ctx context.Context
+//   - resultSelector query.ResultSelector
+func (_e *NotificationService_Expecter) ListDeadLetters(ctx interface{}, resultSelector interface{}) *NotificationService_ListDeadLetters_Call {
+	return &NotificationService_ListDeadLetters_Call{Call: _e.mock.On("ListDeadLetters", ctx, resultSelector)}
+}
+
+func (_c *NotificationService_ListDeadLetters_Call) Run(run func(ctx context.Context, resultSelector query.ResultSelector)) *NotificationService_ListDeadLetters_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		var arg0 context.Context
+		if args[0] != nil {
+			arg0 = args[0].(context.Context)
+		}
+		var arg1 query.ResultSelector
+		if args[1] != nil {
+			arg1 = args[1].(query.ResultSelector)
+		}
+		run(
+			arg0,
+			arg1,
+		)
+	})
+	return _c
+}
+
+func (_c *NotificationService_ListDeadLetters_Call) Return(deadLetters []models.DeadLetter, totalResult uint64, err error) *NotificationService_ListDeadLetters_Call {
+	_c.Call.Return(deadLetters, totalResult, err)
+	return _c
+}
+
+func (_c *NotificationService_ListDeadLetters_Call) RunAndReturn(run func(ctx context.Context, resultSelector query.ResultSelector) ([]models.DeadLetter, uint64, error)) *NotificationService_ListDeadLetters_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// ListDeliveryAttempts provides a mock function for the type NotificationService
+func (_mock *NotificationService) ListDeliveryAttempts(ctx context.Context, resultSelector query.ResultSelector) ([]models.DeliveryAttempt, uint64, error) {
+	ret := _mock.Called(ctx, resultSelector)
+
+	if len(ret) == 0 {
+		panic("no return value specified for ListDeliveryAttempts")
+	}
+
+	var r0 []models.DeliveryAttempt
+	var r1 uint64
+	var r2 error
+	if returnFunc, ok := ret.Get(0).(func(context.Context, query.ResultSelector) ([]models.DeliveryAttempt, uint64, error)); ok {
+		return returnFunc(ctx, resultSelector)
+	}
+	if returnFunc, ok := ret.Get(0).(func(context.Context, query.ResultSelector) []models.DeliveryAttempt); ok {
+		r0 = returnFunc(ctx, resultSelector)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).([]models.DeliveryAttempt)
+		}
+	}
+	if returnFunc, ok := ret.Get(1).(func(context.Context, query.ResultSelector) uint64); ok {
+		r1 = returnFunc(ctx, resultSelector)
+	} else {
+		r1 = ret.Get(1).(uint64)
+	}
+	if returnFunc, ok := ret.Get(2).(func(context.Context, query.ResultSelector) error); ok {
+		r2 = returnFunc(ctx, resultSelector)
+	} else {
+		r2 = ret.Error(2)
+	}
+	return r0, r1, r2
+}
+
+// NotificationService_ListDeliveryAttempts_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'ListDeliveryAttempts'
+type NotificationService_ListDeliveryAttempts_Call struct {
+	*mock.Call
+}
+
+// ListDeliveryAttempts is a helper method to define mock.On call
+//   - ctx context.Context
+//   - resultSelector query.ResultSelector
+func (_e *NotificationService_Expecter) ListDeliveryAttempts(ctx interface{}, resultSelector interface{}) *NotificationService_ListDeliveryAttempts_Call {
+	return &NotificationService_ListDeliveryAttempts_Call{Call: _e.mock.On("ListDeliveryAttempts", ctx, resultSelector)}
+}
+
+func (_c *NotificationService_ListDeliveryAttempts_Call) Run(run func(ctx context.Context, resultSelector query.ResultSelector)) *NotificationService_ListDeliveryAttempts_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		var arg0 context.Context
+		if args[0] != nil {
+			arg0 = args[0].(context.Context)
+		}
+		var arg1 query.ResultSelector
+		if args[1] != nil {
+			arg1 = args[1].(query.ResultSelector)
+		}
+		run(
+			arg0,
+			arg1,
+		)
+	})
+	return _c
+}
+
+func (_c *NotificationService_ListDeliveryAttempts_Call) Return(attempts []models.DeliveryAttempt, totalResult uint64, err error) *NotificationService_ListDeliveryAttempts_Call {
+	_c.Call.Return(attempts, totalResult, err)
+	return _c
+}
+
+func (_c *NotificationService_ListDeliveryAttempts_Call) RunAndReturn(run func(ctx context.Context, resultSelector query.ResultSelector) ([]models.DeliveryAttempt, uint64, error)) *NotificationService_ListDeliveryAttempts_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// ListNotificationDeliveryAttempts provides a mock function for the type NotificationService
+func (_mock *NotificationService) ListNotificationDeliveryAttempts(ctx context.Context, notificationID string) ([]models.DeliveryAttempt, error) {
+	ret := _mock.Called(ctx, notificationID)
+
+	if len(ret) == 0 {
+		panic("no return value specified for ListNotificationDeliveryAttempts")
+	}
+
+	var r0 []models.DeliveryAttempt
+	var r1 error
+	if returnFunc, ok := ret.Get(0).(func(context.Context, string) ([]models.DeliveryAttempt, error)); ok {
+		return returnFunc(ctx, notificationID)
+	}
+	if returnFunc, ok := ret.Get(0).(func(context.Context, string) []models.DeliveryAttempt); ok {
+		r0 = returnFunc(ctx, notificationID)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).([]models.DeliveryAttempt)
+		}
+	}
+	if returnFunc, ok := ret.Get(1).(func(context.Context, string) error); ok {
+		r1 = returnFunc(ctx, notificationID)
+	} else {
+		r1 = ret.Error(1)
+	}
+	return r0, r1
+}
+
+// NotificationService_ListNotificationDeliveryAttempts_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'ListNotificationDeliveryAttempts'
+type NotificationService_ListNotificationDeliveryAttempts_Call struct {
+	*mock.Call
+}
+
+// ListNotificationDeliveryAttempts is a helper method to define mock.On call
+//   - ctx context.Context
+//   - notificationID string
+func (_e *NotificationService_Expecter) ListNotificationDeliveryAttempts(ctx interface{}, notificationID interface{}) *NotificationService_ListNotificationDeliveryAttempts_Call {
+	return &NotificationService_ListNotificationDeliveryAttempts_Call{Call: _e.mock.On("ListNotificationDeliveryAttempts", ctx, notificationID)}
+}
+
+func (_c *NotificationService_ListNotificationDeliveryAttempts_Call) Run(run func(ctx context.Context, notificationID string)) *NotificationService_ListNotificationDeliveryAttempts_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		var arg0 context.Context
+		if args[0] != nil {
+			arg0 = args[0].(context.Context)
+		}
+		var arg1 string
+		if args[1] != nil {
+			arg1 = args[1].(string)
+		}
+		run(
+			arg0,
+			arg1,
+		)
+	})
+	return _c
+}
+
+func (_c *NotificationService_ListNotificationDeliveryAttempts_Call) Return(deliveryAttempts []models.DeliveryAttempt, err error) *NotificationService_ListNotificationDeliveryAttempts_Call {
+	_c.Call.Return(deliveryAttempts, err)
+	return _c
+}
+
+func (_c *NotificationService_ListNotificationDeliveryAttempts_Call) RunAndReturn(run func(ctx context.Context, notificationID string) ([]models.DeliveryAttempt, error)) *NotificationService_ListNotificationDeliveryAttempts_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// ListNotifications provides a mock function for the type NotificationService
+func (_mock *NotificationService) ListNotifications(ctx context.Context, resultSelector query.ResultSelector, userID string) ([]models.Notification, uint64, error) {
+	ret := _mock.Called(ctx, resultSelector, userID)
+
+	if len(ret) == 0 {
+		panic("no return value specified for ListNotifications")
+	}
+
+	var r0 []models.Notification
+	var r1 uint64
+	var r2 error
+	if returnFunc, ok := ret.Get(0).(func(context.Context, query.ResultSelector, string) ([]models.Notification, uint64, error)); ok {
+		return returnFunc(ctx, resultSelector, userID)
+	}
+	if returnFunc, ok := ret.Get(0).(func(context.Context, query.ResultSelector, string) []models.Notification); ok {
+		r0 = returnFunc(ctx, resultSelector, userID)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).([]models.Notification)
+		}
+	}
+	if returnFunc, ok := ret.Get(1).(func(context.Context, query.ResultSelector, string) uint64); ok {
+		r1 = returnFunc(ctx, resultSelector, userID)
+	} else {
+		r1 = ret.Get(1).(uint64)
+	}
+	if returnFunc, ok := ret.Get(2).(func(context.Context, query.ResultSelector, string) error); ok {
+		r2 = returnFunc(ctx, resultSelector, userID)
+	} else {
+		r2 = ret.Error(2)
+	}
+	return r0, r1, r2
+}
+
+// NotificationService_ListNotifications_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'ListNotifications'
+type NotificationService_ListNotifications_Call struct {
+	*mock.Call
+}
+
+// ListNotifications is a helper method to define mock.On call
+//   - ctx context.Context
+//   - resultSelector query.ResultSelector
+//   - userID string
+func (_e *NotificationService_Expecter) ListNotifications(ctx interface{}, resultSelector interface{}, userID interface{}) *NotificationService_ListNotifications_Call {
+	return &NotificationService_ListNotifications_Call{Call: _e.mock.On("ListNotifications", ctx, resultSelector, userID)}
+}
+
+func (_c *NotificationService_ListNotifications_Call) Run(run func(ctx context.Context, resultSelector query.ResultSelector, userID string)) *NotificationService_ListNotifications_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		var arg0 context.Context
+		if args[0] != nil {
+			arg0 = args[0].(context.Context)
+		}
+		var arg1 query.ResultSelector
+		if args[1] != nil {
+			arg1 = args[1].(query.ResultSelector)
+		}
+		var arg2 string
+		if args[2] != nil {
+			arg2 = args[2].(string)
+		}
+		run(
+			arg0,
+			arg1,
+			arg2,
+		)
+	})
+	return _c
+}
+
+func (_c *NotificationService_ListNotifications_Call) Return(notifications []models.Notification, totalResult uint64, err error) *NotificationService_ListNotifications_Call {
+	_c.Call.Return(notifications, totalResult, err)
+	return _c
+}
+
+func (_c *NotificationService_ListNotifications_Call) RunAndReturn(run func(ctx context.Context, resultSelector query.ResultSelector, userID string) ([]models.Notification, uint64, error)) *NotificationService_ListNotifications_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// MarkAllNotificationsRead provides a mock function for the type NotificationService
+func (_mock *NotificationService) MarkAllNotificationsRead(ctx context.Context, userID string) (int, error) {
+	ret := _mock.Called(ctx, userID)
+
+	if len(ret) == 0 {
+		panic("no return value specified for MarkAllNotificationsRead")
+	}
+
+	var r0 int
+	var r1 error
+	if returnFunc, ok := ret.Get(0).(func(context.Context, string) (int, error)); ok {
+		return returnFunc(ctx, userID)
+	}
+	if returnFunc, ok := ret.Get(0).(func(context.Context, string) int); ok {
+		r0 = returnFunc(ctx, userID)
+	} else {
+		r0 = ret.Get(0).(int)
+	}
+	if returnFunc, ok := ret.Get(1).(func(context.Context, string) error); ok {
+		r1 = returnFunc(ctx, userID)
+	} else {
+		r1 = ret.Error(1)
+	}
+	return r0, r1
+}
+
+// NotificationService_MarkAllNotificationsRead_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'MarkAllNotificationsRead'
+type NotificationService_MarkAllNotificationsRead_Call struct {
+	*mock.Call
+}
+
+// MarkAllNotificationsRead is a helper method to define mock.On call
+//   - ctx context.Context
+//   - userID string
+func (_e *NotificationService_Expecter) MarkAllNotificationsRead(ctx interface{}, userID interface{}) *NotificationService_MarkAllNotificationsRead_Call {
+	return &NotificationService_MarkAllNotificationsRead_Call{Call: _e.mock.On("MarkAllNotificationsRead", ctx, userID)}
+}
+
+func (_c *NotificationService_MarkAllNotificationsRead_Call) Run(run func(ctx context.Context, userID string)) *NotificationService_MarkAllNotificationsRead_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		var arg0 context.Context
+		if args[0] != nil {
+			arg0 = args[0].(context.Context)
+		}
+		var arg1 string
+		if args[1] != nil {
+			arg1 = args[1].(string)
+		}
+		run(
+			arg0,
+			arg1,
+		)
+	})
+	return _c
+}
+
+func (_c *NotificationService_MarkAllNotificationsRead_Call) Return(marked int, err error) *NotificationService_MarkAllNotificationsRead_Call {
+	_c.Call.Return(marked, err)
+	return _c
+}
+
+func (_c *NotificationService_MarkAllNotificationsRead_Call) RunAndReturn(run func(ctx context.Context, userID string) (int, error)) *NotificationService_MarkAllNotificationsRead_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// MarkNotificationRead provides a mock function for the type NotificationService
+func (_mock *NotificationService) MarkNotificationRead(ctx context.Context, id string, userID string) error {
+	ret := _mock.Called(ctx, id, userID)
+
+	if len(ret) == 0 {
+		panic("no return value specified for MarkNotificationRead")
+	}
+
+	var r0 error
+	if returnFunc, ok := ret.Get(0).(func(context.Context, string, string) error); ok {
+		r0 = returnFunc(ctx, id, userID)
+	} else {
+		r0 = ret.Error(0)
+	}
+	return r0
+}
+
+// NotificationService_MarkNotificationRead_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'MarkNotificationRead'
+type NotificationService_MarkNotificationRead_Call struct {
+	*mock.Call
+}
+
+// MarkNotificationRead is a helper method to define mock.On call
+//   - ctx context.Context
+//   - id string
+//   - userID string
+func (_e *NotificationService_Expecter) MarkNotificationRead(ctx interface{}, id interface{}, userID interface{}) *NotificationService_MarkNotificationRead_Call {
+	return &NotificationService_MarkNotificationRead_Call{Call: _e.mock.On("MarkNotificationRead", ctx, id, userID)}
+}
+
+func (_c *NotificationService_MarkNotificationRead_Call) Run(run func(ctx context.Context, id string, userID string)) *NotificationService_MarkNotificationRead_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		var arg0 context.Context
+		if args[0] != nil {
+			arg0 = args[0].(context.Context)
+		}
+		var arg1 string
+		if args[1] != nil {
+			arg1 = args[1].(string)
+		}
+		var arg2 string
+		if args[2] != nil {
+			arg2 = args[2].(string)
+		}
+		run(
+			arg0,
+			arg1,
+			arg2,
+		)
+	})
+	return _c
+}
+
+func (_c *NotificationService_MarkNotificationRead_Call) Return(err error) *NotificationService_MarkNotificationRead_Call {
+	_c.Call.Return(err)
+	return _c
+}
+
+func (_c *NotificationService_MarkNotificationRead_Call) RunAndReturn(run func(ctx context.Context, id string, userID string) error) *NotificationService_MarkNotificationRead_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// ReplayDeadLetter provides a mock function for the type NotificationService
+func (_mock *NotificationService) ReplayDeadLetter(ctx context.Context, id string) error {
+	ret := _mock.Called(ctx, id)
+
+	if len(ret) == 0 {
+		panic("no return value specified for ReplayDeadLetter")
+	}
+
+	var r0 error
+	if returnFunc, ok := ret.Get(0).(func(context.Context, string) error); ok {
+		r0 = returnFunc(ctx, id)
+	} else {
+		r0 = ret.Error(0)
+	}
+	return r0
+}
+
+// NotificationService_ReplayDeadLetter_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'ReplayDeadLetter'
+type NotificationService_ReplayDeadLetter_Call struct {
+	*mock.Call
+}
+
+// ReplayDeadLetter is a helper method to define mock.On call
+//   - ctx context.Context
+//   - id string
+func (_e *NotificationService_Expecter) ReplayDeadLetter(ctx interface{}, id interface{}) *NotificationService_ReplayDeadLetter_Call {
+	return &NotificationService_ReplayDeadLetter_Call{Call: _e.mock.On("ReplayDeadLetter", ctx, id)}
+}
+
+func (_c *NotificationService_ReplayDeadLetter_Call) Run(run func(ctx context.Context, id string)) *NotificationService_ReplayDeadLetter_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		var arg0 context.Context
+		if args[0] != nil {
+			arg0 = args[0].(context.Context)
+		}
+		var arg1 string
+		if args[1] != nil {
+			arg1 = args[1].(string)
+		}
+		run(
+			arg0,
+			arg1,
+		)
+	})
+	return _c
+}
+
+func (_c *NotificationService_ReplayDeadLetter_Call) Return(err error) *NotificationService_ReplayDeadLetter_Call {
+	_c.Call.Return(err)
+	return _c
+}
+
+func (_c *NotificationService_ReplayDeadLetter_Call) RunAndReturn(run func(ctx context.Context, id string) error) *NotificationService_ReplayDeadLetter_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// ReplayDeadLetters provides a mock function for the type NotificationService
+func (_mock *NotificationService) ReplayDeadLetters(ctx context.Context, ids []string) (int, error) {
+	ret := _mock.Called(ctx, ids)
+
+	if len(ret) == 0 {
+		panic("no return value specified for ReplayDeadLetters")
+	}
+
+	var r0 int
+	var r1 error
+	if returnFunc, ok := ret.Get(0).(func(context.Context, []string) (int, error)); ok {
+		return returnFunc(ctx, ids)
+	}
+	if returnFunc, ok := ret.Get(0).(func(context.Context, []string) int); ok {
+		r0 = returnFunc(ctx, ids)
+	} else {
+		r0 = ret.Get(0).(int)
+	}
+	if returnFunc, ok := ret.Get(1).(func(context.Context, []string) error); ok {
+		r1 = returnFunc(ctx, ids)
+	} else {
+		r1 = ret.Error(1)
+	}
+	return r0, r1
+}
+
+// NotificationService_ReplayDeadLetters_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'ReplayDeadLetters'
+type NotificationService_ReplayDeadLetters_Call struct {
+	*mock.Call
+}
+
+// ReplayDeadLetters is a helper method to define mock.On call
+//   - ctx context.Context
+//   - ids []string
+func (_e *NotificationService_Expecter) ReplayDeadLetters(ctx interface{}, ids interface{}) *NotificationService_ReplayDeadLetters_Call {
+	return &NotificationService_ReplayDeadLetters_Call{Call: _e.mock.On("ReplayDeadLetters", ctx, ids)}
+}
+
+func (_c *NotificationService_ReplayDeadLetters_Call) Run(run func(ctx context.Context, ids []string)) *NotificationService_ReplayDeadLetters_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		var arg0 context.Context
+		if args[0] != nil {
+			arg0 = args[0].(context.Context)
+		}
+		var arg1 []string
+		if args[1] != nil {
+			arg1 = args[1].([]string)
+		}
+		run(
+			arg0,
+			arg1,
+		)
+	})
+	return _c
+}
+
+func (_c *NotificationService_ReplayDeadLetters_Call) Return(replayed int, err error) *NotificationService_ReplayDeadLetters_Call {
+	_c.Call.Return(replayed, err)
+	return _c
+}
+
+func (_c *NotificationService_ReplayDeadLetters_Call) RunAndReturn(run func(ctx context.Context, ids []string) (int, error)) *NotificationService_ReplayDeadLetters_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// ResendNotification provides a mock function for the type NotificationService
+func (_mock *NotificationService) ResendNotification(ctx context.Context, notificationID string, target models.ResendTarget) error {
+	ret := _mock.Called(ctx, notificationID, target)
+
+	if len(ret) == 0 {
+		panic("no return value specified for ResendNotification")
+	}
+
+	var r0 error
+	if returnFunc, ok := ret.Get(0).(func(context.Context, string, models.ResendTarget) error); ok {
+		r0 = returnFunc(ctx, notificationID, target)
+	} else {
+		r0 = ret.Error(0)
+	}
+	return r0
+}
+
+// NotificationService_ResendNotification_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'ResendNotification'
+type NotificationService_ResendNotification_Call struct {
+	*mock.Call
+}
+
+// ResendNotification is a helper method to define mock.On call
+//   - ctx context.Context
+//   - notificationID string
+//   - target models.ResendTarget
+func (_e *NotificationService_Expecter) ResendNotification(ctx interface{}, notificationID interface{}, target interface{}) *NotificationService_ResendNotification_Call {
+	return &NotificationService_ResendNotification_Call{Call: _e.mock.On("ResendNotification", ctx, notificationID, target)}
+}
+
+func (_c *NotificationService_ResendNotification_Call) Run(run func(ctx context.Context, notificationID string, target models.ResendTarget)) *NotificationService_ResendNotification_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		var arg0 context.Context
+		if args[0] != nil {
+			arg0 = args[0].(context.Context)
+		}
+		var arg1 string
+		if args[1] != nil {
+			arg1 = args[1].(string)
+		}
+		var arg2 models.ResendTarget
+		if args[2] != nil {
+			arg2 = args[2].(models.ResendTarget)
+		}
+		run(
+			arg0,
+			arg1,
+			arg2,
+		)
+	})
+	return _c
+}
+
+func (_c *NotificationService_ResendNotification_Call) Return(err error) *NotificationService_ResendNotification_Call {
+	_c.Call.Return(err)
 	return _c
 }
 
-func (_c *NotificationService_ListNotifications_Call) RunAndReturn(run func(ctx context.Context, resultSelector query.ResultSelector) ([]models.Notification, uint64, error)) *NotificationService_ListNotifications_Call {
+func (_c *NotificationService_ResendNotification_Call) RunAndReturn(run func(ctx context.Context, notificationID string, target models.ResendTarget) error) *NotificationService_ResendNotification_Call {
 	_c.Call.Return(run)
 	return _c
 }
@@ -38,24 +38,90 @@ func (_m *RuleService) EXPECT() *RuleService_Expecter {
 	return &RuleService_Expecter{mock: &_m.Mock}
 }
 
+// Get provides a mock function for the type RuleService
+func (_mock *RuleService) Get(ctx context.Context, id string) (models.Rule, error) {
+	ret := _mock.Called(ctx, id)
+
+	if len(ret) == 0 {
+		panic("no return value specified for Get")
+	}
+
+	var r0 models.Rule
+	var r1 error
+	if returnFunc, ok := ret.Get(0).(func(context.Context, string) (models.Rule, error)); ok {
+		return returnFunc(ctx, id)
+	}
+	if returnFunc, ok := ret.Get(0).(func(context.Context, string) models.Rule); ok {
+		r0 = returnFunc(ctx, id)
+	} else {
+		r0 = ret.Get(0).(models.Rule)
+	}
+	if returnFunc, ok := ret.Get(1).(func(context.Context, string) error); ok {
+		r1 = returnFunc(ctx, id)
+	} else {
+		r1 = ret.Error(1)
+	}
+	return r0, r1
+}
+
+// RuleService_Get_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'Get'
+type RuleService_Get_Call struct {
+	*mock.Call
+}
+
+// Get is a helper method to define mock.On call
+//   - ctx context.Context
+//   - id string
+func (_e *RuleService_Expecter) Get(ctx interface{}, id interface{}) *RuleService_Get_Call {
+	return &RuleService_Get_Call{Call: _e.mock.On("Get", ctx, id)}
+}
+
+func (_c *RuleService_Get_Call) Run(run func(ctx context.Context, id string)) *RuleService_Get_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		var arg0 context.Context
+		if args[0] != nil {
+			arg0 = args[0].(context.Context)
+		}
+		var arg1 string
+		if args[1] != nil {
+			arg1 = args[1].(string)
+		}
+		run(
+			arg0,
+			arg1,
+		)
+	})
+	return _c
+}
+
+func (_c *RuleService_Get_Call) Return(rule models.Rule, err error) *RuleService_Get_Call {
+	_c.Call.Return(rule, err)
+	return _c
+}
+
+func (_c *RuleService_Get_Call) RunAndReturn(run func(ctx context.Context, id string) (models.Rule, error)) *RuleService_Get_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
 // ProcessRules provides a mock function for the type RuleService
-func (_mock *RuleService) ProcessRules(ctx context.Context, notification models.Notification) ([]models.Action, error) {
+func (_mock *RuleService) ProcessRules(ctx context.Context, notification models.Notification) ([]models.RuleAction, error) {
 	ret := _mock.Called(ctx, notification)
 
 	if len(ret) == 0 {
 		panic("no return value specified for ProcessRules")
 	}
 
-	var r0 []models.Action
+	var r0 []models.RuleAction
 	var r1 error
-	if returnFunc, ok := ret.Get(0).(func(context.Context, models.Notification) ([]models.Action, error)); ok {
+	if returnFunc, ok := ret.Get(0).(func(context.Context, models.Notification) ([]models.RuleAction, error)); ok {
 		return returnFunc(ctx, notification)
 	}
-	if returnFunc, ok := ret.Get(0).(func(context.Context, models.Notification) []models.Action); ok {
+	if returnFunc, ok := ret.Get(0).(func(context.Context, models.Notification) []models.RuleAction); ok {
 		r0 = returnFunc(ctx, notification)
 	} else {
 		if ret.Get(0) != nil {
-			r0 = ret.Get(0).([]models.Action)
+			r0 = ret.Get(0).([]models.RuleAction)
 		}
 	}
 	if returnFunc, ok := ret.Get(1).(func(context.Context, models.Notification) error); ok {
@@ -96,12 +162,12 @@ func (_c *RuleService_ProcessRules_Call) Run(run func(ctx context.Context, notif
 	return _c
 }
 
-func (_c *RuleService_ProcessRules_Call) Return(actions []models.Action, err error) *RuleService_ProcessRules_Call {
-	_c.Call.Return(actions, err)
+func (_c *RuleService_ProcessRules_Call) Return(ruleActions []models.RuleAction, err error) *RuleService_ProcessRules_Call {
+	_c.Call.Return(ruleActions, err)
 	return _c
 }
 
-func (_c *RuleService_ProcessRules_Call) RunAndReturn(run func(ctx context.Context, notification models.Notification) ([]models.Action, error)) *RuleService_ProcessRules_Call {
+func (_c *RuleService_ProcessRules_Call) RunAndReturn(run func(ctx context.Context, notification models.Notification) ([]models.RuleAction, error)) *RuleService_ProcessRules_Call {
 	_c.Call.Return(run)
 	return _c
 }
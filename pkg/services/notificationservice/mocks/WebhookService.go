@@ -5,6 +5,7 @@
 package mocks
 
 import (
+	"github.com/greenbone/opensight-notification-service/pkg/models"
 	mock "github.com/stretchr/testify/mock"
 )
 
@@ -35,17 +36,74 @@ func (_m *WebhookService) EXPECT() *WebhookService_Expecter {
 	return &WebhookService_Expecter{mock: &_m.Mock}
 }
 
+// SendDigest provides a mock function for the type WebhookService
+func (_mock *WebhookService) SendDigest(channel models.NotificationChannel, digest models.DigestMessage) error {
+	ret := _mock.Called(channel, digest)
+
+	if len(ret) == 0 {
+		panic("no return value specified for SendDigest")
+	}
+
+	var r0 error
+	if returnFunc, ok := ret.Get(0).(func(models.NotificationChannel, models.DigestMessage) error); ok {
+		r0 = returnFunc(channel, digest)
+	} else {
+		r0 = ret.Error(0)
+	}
+	return r0
+}
+
+// WebhookService_SendDigest_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'SendDigest'
+type WebhookService_SendDigest_Call struct {
+	*mock.Call
+}
+
+// SendDigest is a helper method to define mock.On call
+//   - channel models.NotificationChannel
+//   - digest models.DigestMessage
+func (_e *WebhookService_Expecter) SendDigest(channel interface{}, digest interface{}) *WebhookService_SendDigest_Call {
+	return &WebhookService_SendDigest_Call{Call: _e.mock.On("SendDigest", channel, digest)}
+}
+
+func (_c *WebhookService_SendDigest_Call) Run(run func(channel models.NotificationChannel, digest models.DigestMessage)) *WebhookService_SendDigest_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		var arg0 models.NotificationChannel
+		if args[0] != nil {
+			arg0 = args[0].(models.NotificationChannel)
+		}
+		var arg1 models.DigestMessage
+		if args[1] != nil {
+			arg1 = args[1].(models.DigestMessage)
+		}
+		run(
+			arg0,
+			arg1,
+		)
+	})
+	return _c
+}
+
+func (_c *WebhookService_SendDigest_Call) Return(err error) *WebhookService_SendDigest_Call {
+	_c.Call.Return(err)
+	return _c
+}
+
+func (_c *WebhookService_SendDigest_Call) RunAndReturn(run func(channel models.NotificationChannel, digest models.DigestMessage) error) *WebhookService_SendDigest_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
 // SendMessage provides a mock function for the type WebhookService
-func (_mock *WebhookService) SendMessage(webhookUrl string, message string) error {
-	ret := _mock.Called(webhookUrl, message)
+func (_mock *WebhookService) SendMessage(channel models.NotificationChannel, message models.ChatMessage) error {
+	ret := _mock.Called(channel, message)
 
 	if len(ret) == 0 {
 		panic("no return value specified for SendMessage")
 	}
 
 	var r0 error
-	if returnFunc, ok := ret.Get(0).(func(string, string) error); ok {
-		r0 = returnFunc(webhookUrl, message)
+	if returnFunc, ok := ret.Get(0).(func(models.NotificationChannel, models.ChatMessage) error); ok {
+		r0 = returnFunc(channel, message)
 	} else {
 		r0 = ret.Error(0)
 	}
@@ -58,21 +116,21 @@ type WebhookService_SendMessage_Call struct {
 }
 
 // SendMessage is a helper method to define mock.On call
-//   - webhookUrl string
-//   - message string
-func (_e *WebhookService_Expecter) SendMessage(webhookUrl interface{}, message interface{}) *WebhookService_SendMessage_Call {
-	return &WebhookService_SendMessage_Call{Call: _e.mock.On("SendMessage", webhookUrl, message)}
+//   - channel models.NotificationChannel
+//   - message models.ChatMessage
+func (_e *WebhookService_Expecter) SendMessage(channel interface{}, message interface{}) *WebhookService_SendMessage_Call {
+	return &WebhookService_SendMessage_Call{Call: _e.mock.On("SendMessage", channel, message)}
 }
 
-func (_c *WebhookService_SendMessage_Call) Run(run func(webhookUrl string, message string)) *WebhookService_SendMessage_Call {
+func (_c *WebhookService_SendMessage_Call) Run(run func(channel models.NotificationChannel, message models.ChatMessage)) *WebhookService_SendMessage_Call {
 	_c.Call.Run(func(args mock.Arguments) {
-		var arg0 string
+		var arg0 models.NotificationChannel
 		if args[0] != nil {
-			arg0 = args[0].(string)
+			arg0 = args[0].(models.NotificationChannel)
 		}
-		var arg1 string
+		var arg1 models.ChatMessage
 		if args[1] != nil {
-			arg1 = args[1].(string)
+			arg1 = args[1].(models.ChatMessage)
 		}
 		run(
 			arg0,
@@ -87,7 +145,7 @@ func (_c *WebhookService_SendMessage_Call) Return(err error) *WebhookService_Sen
 	return _c
 }
 
-func (_c *WebhookService_SendMessage_Call) RunAndReturn(run func(webhookUrl string, message string) error) *WebhookService_SendMessage_Call {
+func (_c *WebhookService_SendMessage_Call) RunAndReturn(run func(channel models.NotificationChannel, message models.ChatMessage) error) *WebhookService_SendMessage_Call {
 	_c.Call.Return(run)
 	return _c
 }
@@ -5,54 +5,163 @@
 package notificationservice
 
 import (
+	"cmp"
 	"context"
+	"errors"
 	"fmt"
 	"math/rand/v2"
-	"regexp"
-	"strings"
+	"slices"
 	"time"
 
 	"github.com/greenbone/opensight-golang-libraries/pkg/logs"
-	"github.com/greenbone/opensight-golang-libraries/pkg/notifications"
 	"github.com/greenbone/opensight-golang-libraries/pkg/query"
+	"github.com/greenbone/opensight-notification-service/pkg/errs"
+	"github.com/greenbone/opensight-notification-service/pkg/markdown"
 	"github.com/greenbone/opensight-notification-service/pkg/models"
 )
 
 const (
-	maxRetries                   = 10
-	baseDelayRetryRuleProcessing = 10 * time.Second
-	baseDelayRetryForwarding     = time.Minute
-	retryPollInterval            = 5 * time.Second // intervall to check for pending send tasks
-	maxRetainedFailedSends       = 400             // buffer size for failed sends, arbitrary limit to avoid memory issues
+	maxRetries               = 10
+	baseDelayRetryForwarding = time.Minute
+	retryPollInterval        = 5 * time.Second // intervall to check for pending send tasks
+	// a claimed send task is reserved for one worker, after this duration it is considered abandoned (e.g. the instance crashed)
+	// and can be claimed again, needs to be sufficiently large to cover the sending of a whole batch of send tasks
+	sendTaskClaimDuration  = 10 * time.Minute
+	sendTaskClaimBatchSize = 10
 )
 
-// multipleNewlinesRegex matches 2 or more consecutive newlines
-var multipleNewlinesRegex = regexp.MustCompile(`\n{2,}`)
+var errInvalidChannelType = errors.New("invalid channel type")
+
+var ErrIntakeQueueFull = errors.New("notification intake queue is full")
+
+var (
+	ErrResendChannelNotFound       = errors.New("channel to re-send the notification to not found")
+	ErrResendRuleNotFound          = errors.New("rule to re-send the notification with not found")
+	ErrResendRecipientRequired     = errors.New("recipient is required for the selected channel")
+	ErrResendRecipientNotSupported = errors.New("recipient is not supported for the selected channel")
+)
 
 type NotificationService interface {
+	// ListNotifications returns the notifications together with their state for the given user.
 	ListNotifications(
 		ctx context.Context,
 		resultSelector query.ResultSelector,
+		userID string,
 	) (notifications []models.Notification, totalResult uint64, err error)
 	CreateNotification(
 		ctx context.Context,
 		notificationIn models.Notification,
 	) (notification models.Notification, err error)
+	// CreateNotificationIdempotent is like CreateNotification, but if the caller already created a notification
+	// with the same idempotency key within the idempotency window, the original notification is returned
+	// and `repeated` is set instead of storing and forwarding it again.
+	CreateNotificationIdempotent(
+		ctx context.Context,
+		notificationIn models.Notification,
+		caller string,
+	) (notification models.Notification, repeated bool, err error)
+	ResendNotification(ctx context.Context, notificationID string, target models.ResendTarget) error
+	ListDeliveryAttempts(
+		ctx context.Context,
+		resultSelector query.ResultSelector,
+	) (attempts []models.DeliveryAttempt, totalResult uint64, err error)
+	ListNotificationDeliveryAttempts(ctx context.Context, notificationID string) ([]models.DeliveryAttempt, error)
+	ListDeadLetters(
+		ctx context.Context,
+		resultSelector query.ResultSelector,
+	) (deadLetters []models.DeadLetter, totalResult uint64, err error)
+	GetDeadLetter(ctx context.Context, id string) (models.DeadLetter, error)
+	ReplayDeadLetter(ctx context.Context, id string) error
+	ReplayDeadLetters(ctx context.Context, ids []string) (replayed int, err error)
+	GetWorkerPoolStats() models.WorkerPoolStats
+	// AcknowledgeNotification marks the notification as acknowledged by the user, this stops its escalation.
+	AcknowledgeNotification(ctx context.Context, id string, userID string) (models.Notification, error)
+	// EscalateNotifications executes the due escalation steps of urgent notifications which are not acknowledged.
+	EscalateNotifications(ctx context.Context) error
+	MarkNotificationRead(ctx context.Context, id string, userID string) error
+	// MarkAllNotificationsRead marks all notifications as read by the user and returns the number of newly read notifications.
+	MarkAllNotificationsRead(ctx context.Context, userID string) (marked int, err error)
+	// DismissNotification marks the notification as dismissed by the user, it is also considered read.
+	DismissNotification(ctx context.Context, id string, userID string) error
+	CountUnreadNotifications(ctx context.Context, userID string) (int, error)
 }
 
 type NotificationRepository interface {
 	ListNotifications(
 		ctx context.Context,
 		resultSelector query.ResultSelector,
+		userID string,
 	) (notifications []models.Notification, totalResult uint64, err error)
-	CreateNotification(ctx context.Context, notification models.Notification) (models.Notification, error)
+	CreateNotification(
+		ctx context.Context,
+		notification models.Notification,
+		sendTasks []models.SendTask,
+	) (models.Notification, []models.SendTask, error)
+	CreateNotificationIdempotent(
+		ctx context.Context,
+		notification models.Notification,
+		sendTasks []models.SendTask,
+		key models.IdempotencyKey,
+		now time.Time,
+		expiredBefore time.Time,
+	) (models.Notification, []models.SendTask, bool, error)
+	GetNotification(ctx context.Context, id string) (models.Notification, error)
+	AcknowledgeNotification(ctx context.Context, id string, userID string, at time.Time) (models.Notification, error)
+	MarkNotificationRead(ctx context.Context, id string, userID string, at time.Time) error
+	MarkAllNotificationsRead(ctx context.Context, userID string, at time.Time) (int, error)
+	DismissNotification(ctx context.Context, id string, userID string, at time.Time) error
+	CountUnreadNotifications(ctx context.Context, userID string) (int, error)
+}
+
+type SendTaskRepository interface {
+	CreateSendTasks(ctx context.Context, notification models.Notification, sendTasks []models.SendTask) ([]models.SendTask, error)
+	ClaimDueSendTasks(ctx context.Context, now time.Time, claimUntil time.Time, limit int) ([]models.SendTask, error)
+	RenewSendTaskClaim(ctx context.Context, id string, claimedUntil time.Time, claimUntil time.Time) (bool, error)
+	RescheduleSendTask(ctx context.Context, id string, attempt int, nextExecution time.Time) error
+	DeleteSendTask(ctx context.Context, id string) error
+}
+
+type DeliveryAttemptRepository interface {
+	CreateDeliveryAttempt(ctx context.Context, attempt models.DeliveryAttempt) error
+	ListDeliveryAttempts(
+		ctx context.Context,
+		resultSelector query.ResultSelector,
+	) (attempts []models.DeliveryAttempt, totalResults uint64, err error)
+	ListDeliveryAttemptsByNotificationID(ctx context.Context, notificationID string) ([]models.DeliveryAttempt, error)
+}
+
+type DeadLetterRepository interface {
+	MoveSendTaskToDeadLetters(ctx context.Context, sendTask models.SendTask, lastError string, deadLetteredAt time.Time) error
+	ListDeadLetters(
+		ctx context.Context,
+		resultSelector query.ResultSelector,
+	) (deadLetters []models.DeadLetter, totalResults uint64, err error)
+	GetDeadLetter(ctx context.Context, id string) (models.DeadLetter, error)
+	ReplayDeadLetters(ctx context.Context, ids []string, nextExecution time.Time) (replayed int, err error)
+}
+
+type EscalationRepository interface {
+	ClaimDueEscalations(ctx context.Context, now time.Time, claimUntil time.Time, limit int) ([]models.Escalation, error)
+	AdvanceEscalation(ctx context.Context, id string, step int, dueAt time.Time) error
+	DeleteEscalation(ctx context.Context, id string) error
 }
 
 type RuleService interface {
-	ProcessRules(ctx context.Context, notification models.Notification) ([]models.Action, error)
+	Get(ctx context.Context, id string) (models.Rule, error)
+	ProcessRules(ctx context.Context, notification models.Notification) ([]models.RuleAction, error)
+}
+
+type TemplateService interface {
+	ResolveTemplate(ctx context.Context, channelType models.ChannelType, ruleID string) (models.MessageTemplate, error)
+}
+
+type OriginService interface {
+	// ResolveLink returns the link to the resource of the notification, empty if the origin provides no link.
+	ResolveLink(ctx context.Context, notification models.Notification) (string, error)
 }
 
 type NotificationChannelService interface {
+	GetNotificationChannelById(ctx context.Context, id string) (models.NotificationChannel, error)
 	GetNotificationChannelByIdAndType(
 		ctx context.Context,
 		id string,
@@ -61,7 +170,32 @@ type NotificationChannelService interface {
 }
 
 type WebhookService interface {
-	SendMessage(webhookUrl string, message string) error
+	SendMessage(channel models.NotificationChannel, message models.ChatMessage) error
+	SendDigest(channel models.NotificationChannel, digest models.DigestMessage) error
+}
+
+// MatrixService posts the messages to Matrix rooms. The transaction ID is kept for retries,
+// so the homeserver drops the repeated message.
+type MatrixService interface {
+	SendMessage(channel models.NotificationChannel, txnID string, message models.ChatMessage) error
+	SendDigest(channel models.NotificationChannel, txnID string, digest models.DigestMessage) error
+}
+
+// OutboundWebhookService calls generic webhooks with the notifications as JSON
+type OutboundWebhookService interface {
+	SendNotification(channel models.NotificationChannel, deliveryID string, notification models.Notification) error
+	SendDigest(channel models.NotificationChannel, deliveryID string, notifications []models.Notification) error
+}
+
+// SyslogService sends the notifications as syslog messages to the collector of a SIEM
+type SyslogService interface {
+	SendMessages(ctx context.Context, channel models.NotificationChannel, messages []models.ChatMessage) error
+}
+
+// IncidentService opens incidents in an incident management tool and resolves them on recovery
+type IncidentService interface {
+	Trigger(channel models.NotificationChannel, dedupKey string, message models.ChatMessage) error
+	Resolve(channel models.NotificationChannel, dedupKey string) error
 }
 
 type MailService interface {
@@ -69,54 +203,115 @@ type MailService interface {
 		ctx context.Context,
 		channel models.NotificationChannel,
 		recipient string,
-		subject string,
-		htmlBody string,
+		message models.MailMessage,
 	) error
 }
 
-type SendTask struct {
-	ctx           context.Context
-	Notification  *models.Notification // avoid copies, as object can be quite large
-	Action        models.Action
-	attempt       int
-	nextExecution time.Time
-}
-
 type notificationService struct {
 	store             NotificationRepository
+	outbox            SendTaskRepository
+	deliveryLog       DeliveryAttemptRepository
+	deadLetters       DeadLetterRepository
+	escalations       EscalationRepository
 	ruleService       RuleService
 	channelService    NotificationChannelService
+	templateService   TemplateService
+	originService     OriginService
 	mailService       MailService
 	mattermostService WebhookService
 	teamsService      WebhookService
+	slackService      WebhookService
+	webhookService    OutboundWebhookService
+	syslogService     SyslogService
+	incidentService   IncidentService
+	pushService       WebhookService
+	matrixService     MatrixService
+
+	idempotencyWindow time.Duration
+	suppressionWindow time.Duration // default for rules without own suppression window, zero disables the suppression
+
+	backpressure   Backpressure
+	intakeQueue    *workQueue[intakeJob]
+	deliveryQueues map[models.ChannelType]*workQueue[models.SendTask]
+
+	// only for tests: allows to shut down the workers to avoid goroutine leaks
+	stopWorkers context.CancelFunc
+}
+
+// intakeJob is an incoming notification waiting for a rule worker
+type intakeJob struct {
+	ctx          context.Context
+	notification models.Notification
+	caller       string
+	result       chan<- intakeResult
+}
 
-	failedSends chan SendTask
-	// only for tests: allows to shut down the forward retries worker to avoid goroutine leaks
-	cancelForwardRetriesWorker context.CancelFunc
+type intakeResult struct {
+	notification models.Notification
+	repeated     bool
+	err          error
 }
 
 func NewNotificationService(
 	store NotificationRepository,
+	outbox SendTaskRepository,
+	deliveryLog DeliveryAttemptRepository,
+	deadLetters DeadLetterRepository,
+	escalations EscalationRepository,
 	ruleService RuleService,
 	channelService NotificationChannelService,
+	templateService TemplateService,
+	originService OriginService,
 	mailService MailService,
 	mattermostService WebhookService,
 	teamsService WebhookService,
+	slackService WebhookService,
+	webhookService OutboundWebhookService,
+	syslogService SyslogService,
+	incidentService IncidentService,
+	pushService WebhookService,
+	matrixService MatrixService,
+	poolConfig WorkerPoolConfig,
+	idempotencyWindow time.Duration,
+	suppressionWindow time.Duration,
 ) NotificationService {
 
 	service := &notificationService{
 		store:             store,
+		outbox:            outbox,
+		deliveryLog:       deliveryLog,
+		deadLetters:       deadLetters,
+		escalations:       escalations,
 		ruleService:       ruleService,
 		channelService:    channelService,
+		templateService:   templateService,
+		originService:     originService,
 		mailService:       mailService,
 		mattermostService: mattermostService,
 		teamsService:      teamsService,
-		failedSends:       make(chan SendTask, maxRetainedFailedSends),
+		slackService:      slackService,
+		webhookService:    webhookService,
+		syslogService:     syslogService,
+		incidentService:   incidentService,
+		pushService:       pushService,
+		matrixService:     matrixService,
+		idempotencyWindow: idempotencyWindow,
+		suppressionWindow: suppressionWindow,
+		backpressure:      poolConfig.Backpressure,
+		intakeQueue:       newWorkQueue[intakeJob](poolConfig.IntakeQueueSize, poolConfig.RuleWorkers),
+		deliveryQueues:    make(map[models.ChannelType]*workQueue[models.SendTask]),
 	}
 
-	contextForwardRetriesWorker, cancel := context.WithCancel(context.Background())
-	service.cancelForwardRetriesWorker = cancel
-	go service.forwardRetriesWorker(contextForwardRetriesWorker)
+	ctxWorkers, cancel := context.WithCancel(context.Background())
+	service.stopWorkers = cancel
+
+	service.intakeQueue.start(ctxWorkers, service.processIntakeJob)
+	for _, channelType := range models.AllowedChannels {
+		queue := newWorkQueue[models.SendTask](poolConfig.DeliveryQueueSize, poolConfig.DeliveryWorkers)
+		queue.start(ctxWorkers, service.forwardQueuedNotification)
+		service.deliveryQueues[channelType] = queue
+	}
+	go service.forwardRetriesWorker(ctxWorkers)
 
 	return service
 }
@@ -124,127 +319,558 @@ func NewNotificationService(
 func (s *notificationService) ListNotifications(
 	ctx context.Context,
 	resultSelector query.ResultSelector,
+	userID string,
 ) (notifications []models.Notification, totalResult uint64, err error) {
-	return s.store.ListNotifications(ctx, resultSelector)
+	return s.store.ListNotifications(ctx, resultSelector, userID)
+}
+
+func (s *notificationService) MarkNotificationRead(ctx context.Context, id string, userID string) error {
+	return s.store.MarkNotificationRead(ctx, id, userID, time.Now())
+}
+
+func (s *notificationService) MarkAllNotificationsRead(ctx context.Context, userID string) (int, error) {
+	return s.store.MarkAllNotificationsRead(ctx, userID, time.Now())
+}
+
+func (s *notificationService) DismissNotification(ctx context.Context, id string, userID string) error {
+	return s.store.DismissNotification(ctx, id, userID, time.Now())
+}
+
+func (s *notificationService) CountUnreadNotifications(ctx context.Context, userID string) (int, error) {
+	return s.store.CountUnreadNotifications(ctx, userID)
+}
+
+func (s *notificationService) ListDeliveryAttempts(
+	ctx context.Context,
+	resultSelector query.ResultSelector,
+) (attempts []models.DeliveryAttempt, totalResult uint64, err error) {
+	return s.deliveryLog.ListDeliveryAttempts(ctx, resultSelector)
+}
+
+func (s *notificationService) ListNotificationDeliveryAttempts(
+	ctx context.Context,
+	notificationID string,
+) ([]models.DeliveryAttempt, error) {
+	return s.deliveryLog.ListDeliveryAttemptsByNotificationID(ctx, notificationID)
 }
 
+func (s *notificationService) ListDeadLetters(
+	ctx context.Context,
+	resultSelector query.ResultSelector,
+) (deadLetters []models.DeadLetter, totalResult uint64, err error) {
+	return s.deadLetters.ListDeadLetters(ctx, resultSelector)
+}
+
+func (s *notificationService) GetDeadLetter(ctx context.Context, id string) (models.DeadLetter, error) {
+	return s.deadLetters.GetDeadLetter(ctx, id)
+}
+
+// ReplayDeadLetter puts the dead letter back into the outbox, it is delivered by the forward retries worker.
+func (s *notificationService) ReplayDeadLetter(ctx context.Context, id string) error {
+	replayed, err := s.deadLetters.ReplayDeadLetters(ctx, []string{id}, time.Now())
+	if err != nil {
+		return fmt.Errorf("failed to replay dead letter: %w", err)
+	}
+	if replayed == 0 {
+		return errs.ErrItemNotFound
+	}
+	return nil
+}
+
+// ReplayDeadLetters puts the dead letters back into the outbox, unknown IDs are skipped.
+func (s *notificationService) ReplayDeadLetters(ctx context.Context, ids []string) (replayed int, err error) {
+	replayed, err = s.deadLetters.ReplayDeadLetters(ctx, ids, time.Now())
+	if err != nil {
+		return 0, fmt.Errorf("failed to replay dead letters: %w", err)
+	}
+	return replayed, nil
+}
+
+// GetWorkerPoolStats returns the current depth of the intake and delivery queues.
+func (s *notificationService) GetWorkerPoolStats() models.WorkerPoolStats {
+	stats := models.WorkerPoolStats{
+		Intake:   s.intakeQueue.stats(),
+		Delivery: make(map[models.ChannelType]models.QueueStats, len(s.deliveryQueues)),
+	}
+	for channelType, queue := range s.deliveryQueues {
+		stats.Delivery[channelType] = queue.stats()
+	}
+	return stats
+}
+
+// CreateNotification hands the notification over to the rule workers and waits for the result.
+// If all workers are busy and the intake queue is full, the configured backpressure applies.
 func (s *notificationService) CreateNotification(
 	ctx context.Context,
 	notificationIn models.Notification,
 ) (models.Notification, error) {
+	notification, _, err := s.CreateNotificationIdempotent(ctx, notificationIn, "")
+	return notification, err
+}
+
+func (s *notificationService) CreateNotificationIdempotent(
+	ctx context.Context,
+	notificationIn models.Notification,
+	caller string,
+) (models.Notification, bool, error) {
+	result := make(chan intakeResult, 1) // buffered, the worker must not block if the caller is gone
+	job := intakeJob{ctx: ctx, notification: notificationIn, caller: caller, result: result}
+
+	switch s.backpressure {
+	case BackpressureBlock:
+		if err := s.intakeQueue.enqueue(ctx, job); err != nil {
+			return models.Notification{}, false, fmt.Errorf("failed to queue notification: %w", err)
+		}
+	default:
+		if !s.intakeQueue.tryEnqueue(job) {
+			logs.Ctx(ctx).Warn().Str("origin", notificationIn.OriginClass).Msg("rejecting notification, intake queue is full")
+			return models.Notification{}, false, ErrIntakeQueueFull
+		}
+	}
+
+	select {
+	case r := <-result:
+		return r.notification, r.repeated, r.err
+	case <-ctx.Done():
+		return models.Notification{}, false, ctx.Err()
+	}
+}
+
+// processIntakeJob is run by the rule workers.
+func (s *notificationService) processIntakeJob(_ context.Context, job intakeJob) {
+	if err := job.ctx.Err(); err != nil {
+		job.result <- intakeResult{err: err} // caller is gone already
+		return
+	}
+	notification, repeated, err := s.createNotification(job.ctx, job.notification, job.caller)
+	job.result <- intakeResult{notification: notification, repeated: repeated, err: err}
+}
 
-	notification, err := s.store.CreateNotification(ctx, notificationIn)
+// createNotification processes the rules, stores the notification and hands the resulting deliveries over to the delivery workers.
+func (s *notificationService) createNotification(
+	ctx context.Context,
+	notificationIn models.Notification,
+	caller string,
+) (notification models.Notification, repeated bool, err error) {
+	actions, err := s.ruleService.ProcessRules(ctx, notificationIn)
 	if err != nil {
-		return models.Notification{}, fmt.Errorf("failed to store notification: %w", err)
-	}
-
-	// only process rules after notification was successfully stored, this avoids forwarding
-	// the notification multiple times if the client retries creating notification
-	go func() {
-		ctxForward := context.WithoutCancel(ctx)
-		attempt := 0
-		for {
-			err := s.processRules(ctxForward, notificationIn)
-			if err == nil {
-				break
-			}
-			logs.Ctx(ctx).Err(err).Int("attempt", attempt).Msg("failed to process rules")
-			if attempt >= maxRetries {
-				logs.Ctx(ctxForward).Error().Err(err).Int("attempt", attempt+1).Msg("Skip processing of rules after maximum of retries")
-				break
+		return models.Notification{}, false, fmt.Errorf("failed to process rules: %w", err)
+	}
+	s.suppressRepeats(notificationIn, actions)
+	newTasks := newSendTasks(actions)
+	startEscalations(notificationIn, actions, newTasks)
+
+	// the send tasks and escalations are stored in the same transaction as the notification, this way no delivery
+	// is lost if the service is stopped and the notification is not forwarded multiple times if the client retries
+	// creating the notification after an error
+	var sendTasks []models.SendTask
+	if notificationIn.IdempotencyKey == "" {
+		notification, sendTasks, err = s.store.CreateNotification(ctx, notificationIn, newTasks)
+	} else {
+		key := models.IdempotencyKey{Caller: caller, Key: notificationIn.IdempotencyKey}
+		now := time.Now()
+		notification, sendTasks, repeated, err = s.store.CreateNotificationIdempotent(
+			ctx, notificationIn, newTasks, key, now, now.Add(-s.idempotencyWindow))
+	}
+	if err != nil {
+		return models.Notification{}, false, fmt.Errorf("failed to store notification: %w", err)
+	}
+	if repeated {
+		logs.Ctx(ctx).Debug().Str("notification", notification.Id).Msg("repeated request, returning the original notification")
+		return notification, true, nil
+	}
+
+	s.dispatch(ctx, sendTasks)
+
+	return notification, false, nil
+}
+
+// ResendNotification forwards an already stored notification again to the given target.
+// The deliveries are handled like the ones of a new notification, i.e. they are retried and recorded in the delivery log.
+func (s *notificationService) ResendNotification(
+	ctx context.Context,
+	notificationID string,
+	target models.ResendTarget,
+) error {
+	notification, err := s.store.GetNotification(ctx, notificationID)
+	if err != nil {
+		return fmt.Errorf("failed to get notification: %w", err)
+	}
+
+	actions, err := s.resendActions(ctx, target)
+	if err != nil {
+		return err
+	}
+
+	sendTasks, err := s.outbox.CreateSendTasks(ctx, notification, newSendTasks(actions))
+	if err != nil {
+		return fmt.Errorf("failed to store send tasks: %w", err)
+	}
+
+	s.dispatch(ctx, sendTasks)
+
+	return nil
+}
+
+// resendActions determines the actions for re-sending a notification, either those of the rule or an ad hoc action for the channel.
+func (s *notificationService) resendActions(ctx context.Context, target models.ResendTarget) ([]models.RuleAction, error) {
+	var actions []models.RuleAction
+
+	if target.RuleID != "" {
+		rule, err := s.ruleService.Get(ctx, target.RuleID)
+		if err != nil {
+			if errors.Is(err, errs.ErrItemNotFound) {
+				return nil, ErrResendRuleNotFound // from perspective of the caller this is an issue with the passed target
 			}
-			time.Sleep(exponentialBackoff(baseDelayRetryRuleProcessing, attempt))
-			attempt++
+			return nil, fmt.Errorf("failed to get rule: %w", err)
 		}
-	}()
+		for _, action := range rule.Action.SplitRecipients() {
+			actions = append(actions, models.RuleAction{RuleID: rule.ID, Action: action})
+		}
+		return actions, nil
+	}
 
-	return notification, nil
+	channel, err := s.channelService.GetNotificationChannelById(ctx, target.ChannelID)
+	if err != nil {
+		if errors.Is(err, errs.ErrItemNotFound) {
+			return nil, ErrResendChannelNotFound
+		}
+		return nil, fmt.Errorf("failed to get channel: %w", err)
+	}
+	if !slices.Contains(models.AllowedChannels, channel.ChannelType) {
+		return nil, ErrResendChannelNotFound
+	}
+
+	if channel.ChannelType.HasRecipient() {
+		if target.Recipient == "" {
+			return nil, ErrResendRecipientRequired
+		}
+	} else if target.Recipient != "" {
+		return nil, ErrResendRecipientNotSupported
+	}
+
+	action := models.Action{
+		Channel: models.ChannelReference{
+			ID:   channel.Id,
+			Name: channel.ChannelName,
+			Type: channel.ChannelType,
+		},
+		Recipient: target.Recipient,
+	}
+	for _, action := range action.SplitRecipients() {
+		actions = append(actions, models.RuleAction{Action: action})
+	}
+	return actions, nil
 }
 
-func (s *notificationService) processRules(ctx context.Context, notification models.Notification) error {
-	actions, err := s.ruleService.ProcessRules(ctx, notification)
-	if err != nil {
-		return fmt.Errorf("failed to process rules: %w", err)
+// suppressRepeats enables the suppression of repeats of the notification for the actions, according to the suppression window of their rule.
+func (s *notificationService) suppressRepeats(notification models.Notification, actions []models.RuleAction) {
+	dedupKey := notification.DedupKey()
+	for i, action := range actions {
+		window := cmp.Or(action.Delivery.SuppressionWindow(), s.suppressionWindow)
+		if window > 0 {
+			actions[i].Suppression = &models.Suppression{DedupKey: dedupKey, Window: window}
+		}
 	}
+}
 
+// newSendTasks creates the send tasks for the actions, they are claimed right away as the first attempt is done immediately.
+// Actions of rules in digest mode are collected instead, the digest is due once its window has passed.
+// Actions of muted rules are collected as well, the summary is due once the rule is not muted anymore.
+func newSendTasks(actions []models.RuleAction) []models.SendTask {
+	now := time.Now()
+	sendTasks := make([]models.SendTask, 0, len(actions))
 	for _, action := range actions {
-		sendTask := SendTask{
-			ctx:          context.WithoutCancel(ctx),
-			Notification: &notification,
-			Action:       action,
+		if !action.CollectUntil.IsZero() {
+			sendTasks = append(sendTasks, models.SendTask{
+				RuleID:        action.RuleID,
+				Action:        action.Action,
+				NextExecution: action.CollectUntil,
+				Collect:       &models.Delivery{Mode: models.DeliveryModeDigest}, // no count threshold, nothing is sent while muted
+				Muted:         true,
+				Suppression:   action.Suppression,
+			})
+			continue
+		}
+		if action.Delivery.Mode == models.DeliveryModeDigest {
+			sendTasks = append(sendTasks, models.SendTask{
+				RuleID:        action.RuleID,
+				Action:        action.Action,
+				NextExecution: now.Add(action.Delivery.DigestWindow()),
+				Collect:       &action.Delivery,
+				Suppression:   action.Suppression,
+			})
+			continue
 		}
-		s.forwardNotification(sendTask)
+		sendTasks = append(sendTasks, models.SendTask{
+			RuleID:        action.RuleID,
+			Action:        action.Action,
+			NextExecution: now,
+			ClaimedUntil:  now.Add(sendTaskClaimDuration),
+			Suppression:   action.Suppression,
+		})
 	}
+	return sendTasks
+}
 
-	return nil
+// dispatch hands the claimed send tasks over to the delivery workers of their channel type.
+// It returns false if a delivery queue is full, the affected send tasks are released
+// and picked up again by the forward retries worker later on.
+func (s *notificationService) dispatch(ctx context.Context, sendTasks []models.SendTask) (allQueued bool) {
+	allQueued = true
+	for _, sendTask := range sendTasks {
+		queue, ok := s.deliveryQueues[sendTask.Action.Channel.Type]
+		if !ok {
+			s.forwardNotification(ctx, sendTask) // can not succeed, no need to occupy a worker
+			continue
+		}
+		if queue.tryEnqueue(sendTask) {
+			continue
+		}
+
+		allQueued = false
+		logs.Ctx(ctx).Warn().
+			Str("sendTask", sendTask.ID).
+			Str("channelType", string(sendTask.Action.Channel.Type)).
+			Msg("delivery queue is full, deferring delivery")
+		err := s.outbox.RescheduleSendTask(ctx, sendTask.ID, sendTask.Attempt, time.Now())
+		if err != nil {
+			// the claim expires eventually, so the task is delivered nevertheless
+			logs.Ctx(ctx).Err(err).Str("sendTask", sendTask.ID).Msg("failed to release send task")
+		}
+	}
+	return allQueued
 }
 
-// forwardNotification sends the notification according to the action.
-// If sending fails, it is scheduled for retry with exponential backoff.
-func (s *notificationService) forwardNotification(sendTask SendTask) {
-	ctx := sendTask.ctx
-	notification := *sendTask.Notification
+// forwardQueuedNotification is run by the delivery workers. A send task might wait in the delivery queue longer than
+// it is claimed for, after that it can be claimed and queued again by the forward retries worker. Therefore the claim
+// is renewed first and the send task is skipped if the claim was lost, it is delivered by whoever claimed it last.
+func (s *notificationService) forwardQueuedNotification(ctx context.Context, sendTask models.SendTask) {
+	claimUntil := time.Now().Add(sendTaskClaimDuration)
+	renewed, err := s.outbox.RenewSendTaskClaim(ctx, sendTask.ID, sendTask.ClaimedUntil, claimUntil)
+	if err != nil {
+		// skipping is safe, the task is claimed again once the claim expired
+		logs.Ctx(ctx).Err(err).Str("sendTask", sendTask.ID).Msg("failed to renew claim of send task, skipping it")
+		return
+	}
+	if !renewed {
+		logs.Ctx(ctx).Debug().Str("sendTask", sendTask.ID).Msg("claim of send task was lost while it was queued, skipping it")
+		return
+	}
+
+	sendTask.ClaimedUntil = claimUntil
+	s.forwardNotification(ctx, sendTask)
+}
+
+// forwardNotification sends the notification according to the action of the claimed send task.
+// On success the send task is removed from the outbox, otherwise it is scheduled for retry with exponential backoff.
+// Send tasks which can not succeed anymore are moved to the dead letters. Each attempt is recorded in the delivery log.
+func (s *notificationService) forwardNotification(ctx context.Context, sendTask models.SendTask) {
+	err := s.send(ctx, sendTask)
+	switch {
+	case err == nil:
+		s.logDeliveryAttempt(ctx, sendTask, models.DeliveryOutcomeSuccess, nil)
+		s.removeSendTask(ctx, sendTask)
+	case errors.Is(err, errInvalidChannelType):
+		// retrying is pointless, the task can never succeed
+		logs.Ctx(ctx).Error().Err(err).Msgf("allowed channel types are %v", models.AllowedChannels)
+		s.logDeliveryAttempt(ctx, sendTask, models.DeliveryOutcomeDropped, err)
+		s.moveToDeadLetters(ctx, sendTask, err)
+	default:
+		s.scheduleRetry(ctx, sendTask, err)
+	}
+}
+
+// send delivers the notification of the send task to the channel of its action.
+func (s *notificationService) send(ctx context.Context, sendTask models.SendTask) error {
+	if len(sendTask.Digest) > 0 {
+		return s.sendDigest(ctx, sendTask)
+	}
+
 	action := sendTask.Action
 
-	subject := createSubject(notification)
-	body := notification.Detail
+	subject, body, err := s.renderMessage(ctx, sendTask)
+	if err != nil {
+		logs.Ctx(ctx).Err(err).Int("attempt", sendTask.Attempt).Msg("failed to get template for forwarding notification")
+		return err
+	}
+	if sendTask.Repeats > 0 {
+		body += fmt.Sprintf("\n\nThis notification was repeated %d times since it was forwarded the last time.", sendTask.Repeats)
+	}
+	link := s.resolveLink(ctx, *sendTask.Notification)
 
 	channel, err := s.channelService.GetNotificationChannelByIdAndType(ctx, action.Channel.ID, action.Channel.Type)
 	if err != nil {
-		logs.Ctx(ctx).Err(err).Int("attempt", sendTask.attempt).Msg("failed to get channel for forwarding notification")
-		s.scheduleRetry(sendTask)
-		return
+		logs.Ctx(ctx).Err(err).Int("attempt", sendTask.Attempt).Msg("failed to get channel for forwarding notification")
+		return fmt.Errorf("failed to get channel: %w", err)
 	}
 
 	switch channelType := action.Channel.Type; channelType {
 	case models.ChannelTypeMail:
-		err := s.mailService.SendMail(ctx, channel, action.Recipient, subject, body)
+		if link != "" {
+			body += "\n\n" + markdown.Link(models.OpenLinkTitle, link)
+		}
+		err = s.mailService.SendMail(ctx, channel, action.Recipient, models.MailMessage{
+			Subject:  subject,
+			HTMLBody: markdown.ToHTML(body),
+			TextBody: markdown.ToText(body),
+			Origin:   sendTask.Notification.Origin,
+			Level:    sendTask.Notification.Level,
+		})
 		if err != nil {
-			logs.Ctx(ctx).Err(err).Int("attempt", sendTask.attempt).Msg("failed to send mail")
-			s.scheduleRetry(sendTask)
+			logs.Ctx(ctx).Err(err).Int("attempt", sendTask.Attempt).Msg("failed to send mail")
+			return fmt.Errorf("failed to send mail: %w", err)
 		}
 	case models.ChannelTypeTeams:
-		err := s.teamsService.SendMessage(*channel.WebhookUrl, convertToMarkDownMessage(subject, body))
+		err = s.teamsService.SendMessage(channel, newChatMessage(subject, body, link, *sendTask.Notification))
 		if err != nil {
-			logs.Ctx(ctx).Err(err).Int("attempt", sendTask.attempt).Msg("failed to send teams message")
-			s.scheduleRetry(sendTask)
+			logs.Ctx(ctx).Err(err).Int("attempt", sendTask.Attempt).Msg("failed to send teams message")
+			return fmt.Errorf("failed to send teams message: %w", err)
 		}
-
 	case models.ChannelTypeMattermost:
-		err := s.mattermostService.SendMessage(*channel.WebhookUrl, convertToMarkDownMessage(subject, body))
+		err = s.mattermostService.SendMessage(channel, newChatMessage(subject, body, link, *sendTask.Notification))
 		if err != nil {
-			logs.Ctx(ctx).Err(err).Int("attempt", sendTask.attempt).Msg("failed to send mattermost message")
-			s.scheduleRetry(sendTask)
+			logs.Ctx(ctx).Err(err).Int("attempt", sendTask.Attempt).Msg("failed to send mattermost message")
+			return fmt.Errorf("failed to send mattermost message: %w", err)
+		}
+	case models.ChannelTypeSlack:
+		err = s.slackService.SendMessage(channel, newChatMessage(subject, body, link, *sendTask.Notification))
+		if err != nil {
+			logs.Ctx(ctx).Err(err).Int("attempt", sendTask.Attempt).Msg("failed to send slack message")
+			return fmt.Errorf("failed to send slack message: %w", err)
+		}
+	case models.ChannelTypeWebhook:
+		// the send task ID is kept for retries, so receivers can drop duplicates
+		err = s.webhookService.SendNotification(channel, sendTask.ID, *sendTask.Notification)
+		if err != nil {
+			logs.Ctx(ctx).Err(err).Int("attempt", sendTask.Attempt).Msg("failed to call webhook")
+			return fmt.Errorf("failed to call webhook: %w", err)
+		}
+	case models.ChannelTypeSyslog:
+		err = s.syslogService.SendMessages(ctx, channel, []models.ChatMessage{
+			newChatMessage(subject, body, link, *sendTask.Notification),
+		})
+		if err != nil {
+			logs.Ctx(ctx).Err(err).Int("attempt", sendTask.Attempt).Msg("failed to send syslog message")
+			return fmt.Errorf("failed to send syslog message: %w", err)
+		}
+	case models.ChannelTypeIncident:
+		err = s.sendIncidentEvent(channel, *sendTask.Notification, newChatMessage(subject, body, link, *sendTask.Notification))
+		if err != nil {
+			logs.Ctx(ctx).Err(err).Int("attempt", sendTask.Attempt).Msg("failed to send incident event")
+			return fmt.Errorf("failed to send incident event: %w", err)
+		}
+	case models.ChannelTypePush:
+		err = s.pushService.SendMessage(channel, newChatMessage(subject, body, link, *sendTask.Notification))
+		if err != nil {
+			logs.Ctx(ctx).Err(err).Int("attempt", sendTask.Attempt).Msg("failed to send push message")
+			return fmt.Errorf("failed to send push message: %w", err)
+		}
+	case models.ChannelTypeMatrix:
+		// the send task ID is kept for retries, so the homeserver drops duplicates
+		err = s.matrixService.SendMessage(channel, sendTask.ID, newChatMessage(subject, body, link, *sendTask.Notification))
+		if err != nil {
+			logs.Ctx(ctx).Err(err).Int("attempt", sendTask.Attempt).Msg("failed to send matrix message")
+			return fmt.Errorf("failed to send matrix message: %w", err)
 		}
-
 	default:
-		logs.Ctx(ctx).Error().Msgf("invalid channel type: %s, allowed are %v", channelType, models.AllowedChannels)
+		return fmt.Errorf("%w: %s", errInvalidChannelType, channelType)
 	}
+
+	return nil
 }
 
-// scheduleRetry calculates the next execution time using exponential backoff and queues the task for retry.
-// If the queue is full or the maximum number of retries has been reached, the message will be dropped.
-func (s *notificationService) scheduleRetry(sendTask SendTask) {
-	if sendTask.attempt >= maxRetries {
-		logs.Ctx(sendTask.ctx).Error().
+// sendIncidentEvent triggers the incident of the origin resource, or resolves it if the notification reports its recovery.
+func (s *notificationService) sendIncidentEvent(
+	channel models.NotificationChannel,
+	notification models.Notification,
+	message models.ChatMessage,
+) error {
+	if notification.Recovered {
+		return s.incidentService.Resolve(channel, notification.IncidentKey())
+	}
+	return s.incidentService.Trigger(channel, notification.IncidentKey(), message)
+}
+
+// scheduleRetry calculates the next execution time using exponential backoff and reschedules the task in the outbox.
+// If the maximum number of retries has been reached, the message is moved to the dead letters.
+func (s *notificationService) scheduleRetry(ctx context.Context, sendTask models.SendTask, sendErr error) {
+	if sendTask.Attempt >= maxRetries {
+		logs.Ctx(ctx).Error().
 			Str("channel", sendTask.Action.Channel.ID).
 			Str("channelName", sendTask.Action.Channel.Name).
 			Str("channelType", string(sendTask.Action.Channel.Type)).
-			Int("retries", sendTask.attempt).
-			Msg("Dropping message after maximum of retries")
+			Int("retries", sendTask.Attempt).
+			Msg("Moving message to dead letters after maximum of retries")
+		s.logDeliveryAttempt(ctx, sendTask, models.DeliveryOutcomeDropped, sendErr)
+		s.moveToDeadLetters(ctx, sendTask, sendErr)
 		return
 	}
-	sendTask.nextExecution = time.Now().Add(exponentialBackoff(baseDelayRetryForwarding, sendTask.attempt))
-	sendTask.attempt++
+	s.logDeliveryAttempt(ctx, sendTask, models.DeliveryOutcomeFailure, sendErr)
+	nextExecution := time.Now().Add(exponentialBackoff(baseDelayRetryForwarding, sendTask.Attempt))
 
-	select {
-	case s.failedSends <- sendTask:
-	default:
-		logs.Ctx(sendTask.ctx).Error().
-			Str("channel", sendTask.Action.Channel.ID).
-			Str("channelName", sendTask.Action.Channel.Name).
-			Str("channelType", string(sendTask.Action.Channel.Type)).
-			Msg("Dropping message, retry queue is full")
+	err := s.outbox.RescheduleSendTask(ctx, sendTask.ID, sendTask.Attempt+1, nextExecution)
+	if err != nil {
+		// the claim expires eventually, so the task is retried nevertheless
+		logs.Ctx(ctx).Err(err).Str("sendTask", sendTask.ID).Msg("failed to reschedule send task")
+	}
+}
+
+// logDeliveryAttempt records the outcome of a delivery attempt. Failing to do so must not affect the delivery itself.
+func (s *notificationService) logDeliveryAttempt(
+	ctx context.Context,
+	sendTask models.SendTask,
+	outcome models.DeliveryOutcome,
+	sendErr error,
+) {
+	if len(sendTask.Digest) > 0 {
+		// the attempt is recorded for each notification, so the delivery log of each of them is complete
+		for _, notification := range sendTask.Digest {
+			task := sendTask
+			task.Notification = &notification
+			task.Digest = nil
+			s.logDeliveryAttempt(ctx, task, outcome, sendErr)
+		}
+		return
+	}
+
+	attempt := models.DeliveryAttempt{
+		NotificationID: sendTask.Notification.Id,
+		RuleID:         sendTask.RuleID,
+		Channel:        sendTask.Action.Channel,
+		Recipient:      sendTask.Action.Recipient,
+		Attempt:        sendTask.Attempt + 1,
+		Timestamp:      time.Now().UTC(),
+		Outcome:        outcome,
+	}
+	if sendErr != nil {
+		attempt.Error = sendErr.Error()
+	}
+
+	err := s.deliveryLog.CreateDeliveryAttempt(ctx, attempt)
+	if err != nil {
+		logs.Ctx(ctx).Err(err).
+			Str("notification", attempt.NotificationID).
+			Str("outcome", string(outcome)).
+			Msg("failed to record delivery attempt")
+	}
+}
+
+// removeSendTask removes a finished send task from the outbox.
+func (s *notificationService) removeSendTask(ctx context.Context, sendTask models.SendTask) {
+	err := s.outbox.DeleteSendTask(ctx, sendTask.ID)
+	if err != nil {
+		// the claim expires eventually, so the notification might be sent again
+		logs.Ctx(ctx).Err(err).Str("sendTask", sendTask.ID).Msg("failed to remove send task from outbox")
+	}
+}
+
+// moveToDeadLetters removes a send task which failed permanently from the outbox and keeps it for a later replay.
+func (s *notificationService) moveToDeadLetters(ctx context.Context, sendTask models.SendTask, sendErr error) {
+	err := s.deadLetters.MoveSendTaskToDeadLetters(ctx, sendTask, sendErr.Error(), time.Now().UTC())
+	if err != nil {
+		// the claim expires eventually, so the task is tried once more and moved again afterwards
+		logs.Ctx(ctx).Err(err).Str("sendTask", sendTask.ID).Msg("failed to move send task to dead letters")
 	}
 }
 
@@ -255,82 +881,87 @@ func exponentialBackoff(baseDelay time.Duration, retryCount int) time.Duration {
 	return backoff + jitter
 }
 
-// forwardRetriesWorker continuously listens for failed send tasks and retries them when their next execution time has come.
+// forwardRetriesWorker periodically claims the due send tasks from the outbox and retries them.
 // Send attempts are only done periodically to save cpu load.
 func (s *notificationService) forwardRetriesWorker(ctx context.Context) {
-	pendingSendTasks := make([]SendTask, 0, maxRetainedFailedSends)
-
 	tick := time.Tick(retryPollInterval)
 	for {
 		select {
-		case sendTask := <-s.failedSends:
-			if len(pendingSendTasks) >= maxRetainedFailedSends {
-				logs.Ctx(sendTask.ctx).Error().
-					Str("channel", sendTask.Action.Channel.ID).
-					Str("channelName", sendTask.Action.Channel.Name).
-					Str("channelType", string(sendTask.Action.Channel.Type)).
-					Msg("Dropping message, retry queue is full")
-				continue
-			}
-			pendingSendTasks = append(pendingSendTasks, sendTask)
 		case <-tick:
-			newIndex := 0
-			for i := range pendingSendTasks {
-				if time.Now().Before(pendingSendTasks[i].nextExecution) {
-					pendingSendTasks[newIndex] = pendingSendTasks[i]
-					newIndex++
-					continue
-				}
-				s.forwardNotification(pendingSendTasks[i])
-			}
-			pendingSendTasks = pendingSendTasks[:newIndex] // remove tasks that were just sent
+			s.forwardDueSendTasks(ctx)
 		case <-ctx.Done():
 			return
 		}
 	}
 }
 
-func createSubject(notification models.Notification) string {
-	var icon string
-	switch notification.Level {
-	case notifications.LevelUrgent, notifications.LevelError:
-		icon = "🔴"
-	case notifications.LevelWarning:
-		icon = "🟡"
-	case notifications.LevelInfo:
-		icon = "🔵"
+// forwardDueSendTasks forwards all due send tasks, they are claimed in batches to allow other instances of the service to share the work.
+func (s *notificationService) forwardDueSendTasks(ctx context.Context) {
+	for ctx.Err() == nil {
+		now := time.Now()
+		sendTasks, err := s.outbox.ClaimDueSendTasks(ctx, now, now.Add(sendTaskClaimDuration), sendTaskClaimBatchSize)
+		if err != nil {
+			logs.Ctx(ctx).Err(err).Msg("failed to claim due send tasks")
+			return
+		}
+		if !s.dispatch(ctx, sendTasks) {
+			return // delivery workers are saturated, continue with the next tick
+		}
+		if len(sendTasks) < sendTaskClaimBatchSize {
+			return
+		}
 	}
+}
 
-	subject := fmt.Sprintf("%s [%s]", notification.Title, notification.Origin)
-	if icon != "" {
-		subject = fmt.Sprintf("%s %s", icon, subject)
+// renderMessage renders subject and body of the notification with the template of the rule and channel type.
+// If the template can not be rendered with the notification, e.g. as a custom field has an unexpected type,
+// the default template is used, so the notification is forwarded nevertheless.
+func (s *notificationService) renderMessage(ctx context.Context, sendTask models.SendTask) (subject, body string, err error) {
+	channelType := sendTask.Action.Channel.Type
+	template, err := s.templateService.ResolveTemplate(ctx, channelType, sendTask.RuleID)
+	if err != nil {
+		return "", "", fmt.Errorf("failed to get template: %w", err)
 	}
 
-	return subject
+	data := models.NewTemplateData(*sendTask.Notification)
+	message, err := template.Render(data)
+	if err != nil {
+		logs.Ctx(ctx).Warn().Err(err).
+			Str("rule", sendTask.RuleID).
+			Str("channelType", string(channelType)).
+			Msg("failed to render template, falling back to the default template")
+		message, err = models.DefaultMessageTemplate(channelType).Render(data)
+		if err != nil {
+			return "", "", fmt.Errorf("failed to render default template: %w", err)
+		}
+	}
+	return message.Subject, message.Body, nil
 }
 
-// convertToMarkDownMessage formats subject and body into valid markdown
-// which is displayed well for both mattermost and teams
-func convertToMarkDownMessage(subject, body string) string {
-	const escapedNewlinePlaceholder = "\x00ESCAPED_NEWLINE\x00"
-	const consecutiveNewlinesPlaceholder = "\x00CONSECUTIVE_NEWLINES\x00"
-
-	// Temporarily replace escaped newlines (\\n) with a placeholder
-	body = strings.ReplaceAll(body, `\\n`, escapedNewlinePlaceholder)
-	// Convert unescaped literal \n strings to actual newlines
-	body = strings.ReplaceAll(body, `\n`, "\n")
-	// Protect all sequences of 2+ consecutive newlines by replacing each with a placeholder
-	body = multipleNewlinesRegex.ReplaceAllStringFunc(body, func(match string) string {
-		return strings.Repeat(consecutiveNewlinesPlaceholder, len(match))
-	})
-	// Now all remaining single newlines become double newlines
-	body = strings.ReplaceAll(body, "\n", "\n\n")
-	// Restore consecutive newlines (each placeholder back to a newline)
-	body = strings.ReplaceAll(body, consecutiveNewlinesPlaceholder, "\n")
-	// Restore escaped newlines as literal \n text
-	body = strings.ReplaceAll(body, escapedNewlinePlaceholder, `\n`)
-
-	markdownMessage := fmt.Sprintf("**%s**\n\n%s", subject, body)
+// newChatMessage prepares the notification for chat channels, the body is prepared with the same pipeline as mails
+func newChatMessage(subject, body, link string, notification models.Notification) models.ChatMessage {
+	return models.ChatMessage{
+		Title:       subject,
+		Text:        markdown.ToMarkdown(body),
+		Level:       notification.Level,
+		Origin:      notification.Origin,
+		OriginClass: notification.OriginClass,
+		Timestamp:   notification.Timestamp,
+		Fields:      notification.MessageFields(),
+		Link:        link,
+	}
+}
 
-	return markdownMessage
+// resolveLink returns the link to the resource of the notification. A message is rather sent without
+// link than not at all, so errors are only logged.
+func (s *notificationService) resolveLink(ctx context.Context, notification models.Notification) string {
+	link, err := s.originService.ResolveLink(ctx, notification)
+	if err != nil {
+		logs.Ctx(ctx).Warn().Err(err).
+			Str("notification", notification.Id).
+			Str("originClass", notification.OriginClass).
+			Msg("failed to resolve link to origin resource, sending message without link")
+		return ""
+	}
+	return link
 }
@@ -6,12 +6,18 @@ package notificationservice
 
 import (
 	"context"
+	"maps"
+	"slices"
+	"strconv"
 	"strings"
+	"sync"
 	"testing"
 	"testing/synctest"
 	"time"
 
 	"github.com/greenbone/opensight-golang-libraries/pkg/notifications"
+	"github.com/greenbone/opensight-notification-service/pkg/errs"
+	"github.com/greenbone/opensight-notification-service/pkg/markdown"
 	"github.com/greenbone/opensight-notification-service/pkg/models"
 	"github.com/greenbone/opensight-notification-service/pkg/services/notificationservice/mocks"
 	"github.com/stretchr/testify/assert"
@@ -19,6 +25,322 @@ import (
 	"github.com/stretchr/testify/require"
 )
 
+// fakeOutbox is an in-memory replacement of the send task repository, it allows to
+// follow the send tasks over all retries without a database
+type fakeOutbox struct {
+	mu           sync.Mutex
+	sendTasks    map[string]models.SendTask
+	nextID       int
+	suppressions map[string]*fakeSuppression
+	repeats      map[string]int      // suppressed repeats per forwarded notification
+	escalations  []models.Escalation // escalations started with the send tasks
+}
+
+type fakeSuppression struct {
+	notificationID  string
+	windowStart     time.Time
+	repeats         int
+	previousRepeats int
+}
+
+func newFakeOutbox() *fakeOutbox {
+	return &fakeOutbox{
+		sendTasks:    make(map[string]models.SendTask),
+		suppressions: make(map[string]*fakeSuppression),
+		repeats:      make(map[string]int),
+	}
+}
+
+// createNotification can be used as implementation of the `CreateNotification` repository mock
+func (o *fakeOutbox) createNotification(
+	ctx context.Context,
+	notification models.Notification,
+	sendTasks []models.SendTask,
+) (models.Notification, []models.SendTask, error) {
+	created, err := o.CreateSendTasks(ctx, notification, sendTasks)
+	return notification, created, err
+}
+
+func (o *fakeOutbox) CreateSendTasks(
+	_ context.Context,
+	notification models.Notification,
+	sendTasks []models.SendTask,
+) ([]models.SendTask, error) {
+	o.mu.Lock()
+	defer o.mu.Unlock()
+
+	created := make([]models.SendTask, 0, len(sendTasks))
+	for _, sendTask := range sendTasks {
+		if sendTask.Suppression != nil {
+			forwardedID, previousRepeats := o.suppressRepeat(notification, sendTask)
+			if forwardedID != notification.Id {
+				o.repeats[forwardedID]++
+				continue
+			}
+			sendTask.Repeats = previousRepeats
+		}
+		if sendTask.Collect != nil {
+			o.collectDigest(notification, sendTask)
+			continue
+		}
+		o.nextID++
+		sendTask.ID = strconv.Itoa(o.nextID)
+		sendTask.Notification = &notification
+		o.sendTasks[sendTask.ID] = sendTask
+		created = append(created, sendTask)
+
+		if !sendTask.EscalateAt.IsZero() && !slices.ContainsFunc(o.escalations, func(e models.Escalation) bool {
+			return e.NotificationID == notification.Id && e.RuleID == sendTask.RuleID
+		}) {
+			o.escalations = append(o.escalations, models.Escalation{
+				NotificationID: notification.Id,
+				RuleID:         sendTask.RuleID,
+				DueAt:          sendTask.EscalateAt,
+			})
+		}
+	}
+	return created, nil
+}
+
+// suppressRepeat tracks the notification in the suppression window of the send task, see the repository for details
+func (o *fakeOutbox) suppressRepeat(notification models.Notification, sendTask models.SendTask) (forwardedID string, previousRepeats int) {
+	key := strings.Join([]string{sendTask.RuleID, sendTask.Action.Channel.ID, sendTask.Action.Recipient, sendTask.Suppression.DedupKey}, "/")
+	now := time.Now()
+
+	suppression, ok := o.suppressions[key]
+	switch {
+	case !ok:
+		o.suppressions[key] = &fakeSuppression{notificationID: notification.Id, windowStart: now}
+	case suppression.windowStart.After(now.Add(-sendTask.Suppression.Window)):
+		suppression.repeats++
+	default:
+		*suppression = fakeSuppression{notificationID: notification.Id, windowStart: now, previousRepeats: suppression.repeats}
+	}
+	return o.suppressions[key].notificationID, o.suppressions[key].previousRepeats
+}
+
+// collectDigest adds the notification to the digest which is collecting for the rule, channel and recipient
+func (o *fakeOutbox) collectDigest(notification models.Notification, sendTask models.SendTask) {
+	for id, collecting := range o.sendTasks {
+		if collecting.Collect != nil && collecting.RuleID == sendTask.RuleID &&
+			collecting.Action.Channel.ID == sendTask.Action.Channel.ID && collecting.Action.Recipient == sendTask.Action.Recipient {
+			collecting.Digest = append(collecting.Digest, notification)
+			if maxCount := collecting.Collect.DigestMaxCount; maxCount > 0 && len(collecting.Digest) >= maxCount {
+				collecting.NextExecution = time.Now()
+			}
+			o.sendTasks[id] = collecting
+			return
+		}
+	}
+
+	o.nextID++
+	sendTask.ID = strconv.Itoa(o.nextID)
+	sendTask.Notification = &notification
+	sendTask.Digest = []models.Notification{notification}
+	o.sendTasks[sendTask.ID] = sendTask
+}
+
+func (o *fakeOutbox) ClaimDueSendTasks(_ context.Context, now time.Time, claimUntil time.Time, limit int) ([]models.SendTask, error) {
+	o.mu.Lock()
+	defer o.mu.Unlock()
+
+	var claimed []models.SendTask
+	for id, sendTask := range o.sendTasks {
+		if len(claimed) >= limit {
+			break
+		}
+		if sendTask.NextExecution.After(now) || sendTask.ClaimedUntil.After(now) {
+			continue
+		}
+		sendTask.ClaimedUntil = claimUntil
+		sendTask.Collect = nil // closes a collecting digest
+		o.sendTasks[id] = sendTask
+		claimed = append(claimed, sendTask)
+	}
+	return claimed, nil
+}
+
+func (o *fakeOutbox) RenewSendTaskClaim(_ context.Context, id string, claimedUntil time.Time, claimUntil time.Time) (bool, error) {
+	o.mu.Lock()
+	defer o.mu.Unlock()
+
+	sendTask, ok := o.sendTasks[id]
+	if !ok || !sendTask.ClaimedUntil.Equal(claimedUntil) {
+		return false, nil
+	}
+	sendTask.ClaimedUntil = claimUntil
+	o.sendTasks[id] = sendTask
+	return true, nil
+}
+
+func (o *fakeOutbox) RescheduleSendTask(_ context.Context, id string, attempt int, nextExecution time.Time) error {
+	o.mu.Lock()
+	defer o.mu.Unlock()
+
+	sendTask := o.sendTasks[id]
+	sendTask.Attempt = attempt
+	sendTask.NextExecution = nextExecution
+	sendTask.ClaimedUntil = time.Time{}
+	o.sendTasks[id] = sendTask
+	return nil
+}
+
+func (o *fakeOutbox) DeleteSendTask(_ context.Context, id string) error {
+	o.mu.Lock()
+	defer o.mu.Unlock()
+
+	delete(o.sendTasks, id)
+	return nil
+}
+
+func (o *fakeOutbox) pendingRecipients() []string {
+	o.mu.Lock()
+	defer o.mu.Unlock()
+
+	recipients := make([]string, 0, len(o.sendTasks))
+	for _, sendTask := range o.sendTasks {
+		recipients = append(recipients, sendTask.Action.Channel.ID+"/"+sendTask.Action.Recipient)
+	}
+	return recipients
+}
+
+func (o *fakeOutbox) unclaimedCount() int {
+	o.mu.Lock()
+	defer o.mu.Unlock()
+
+	count := 0
+	for _, sendTask := range o.sendTasks {
+		if sendTask.ClaimedUntil.IsZero() {
+			count++
+		}
+	}
+	return count
+}
+
+// fakeDeadLetters is an in-memory replacement of the dead letter repository, dead letters are moved from and to the fake outbox
+type fakeDeadLetters struct {
+	mocks.DeadLetterRepository // only moving and replaying is implemented
+
+	outbox      *fakeOutbox
+	deadLetters map[string]models.SendTask
+}
+
+func newFakeDeadLetters(outbox *fakeOutbox) *fakeDeadLetters {
+	return &fakeDeadLetters{outbox: outbox, deadLetters: make(map[string]models.SendTask)}
+}
+
+func (d *fakeDeadLetters) MoveSendTaskToDeadLetters(_ context.Context, sendTask models.SendTask, _ string, _ time.Time) error {
+	d.outbox.mu.Lock()
+	defer d.outbox.mu.Unlock()
+
+	delete(d.outbox.sendTasks, sendTask.ID)
+	d.deadLetters[sendTask.ID] = sendTask
+	return nil
+}
+
+func (d *fakeDeadLetters) ReplayDeadLetters(_ context.Context, ids []string, nextExecution time.Time) (int, error) {
+	d.outbox.mu.Lock()
+	defer d.outbox.mu.Unlock()
+
+	replayed := 0
+	for _, id := range ids {
+		sendTask, ok := d.deadLetters[id]
+		if !ok {
+			continue
+		}
+		delete(d.deadLetters, id)
+		sendTask.Attempt = 0
+		sendTask.NextExecution = nextExecution
+		sendTask.ClaimedUntil = time.Time{}
+		d.outbox.sendTasks[id] = sendTask
+		replayed++
+	}
+	return replayed, nil
+}
+
+func (d *fakeDeadLetters) ids() []string {
+	d.outbox.mu.Lock()
+	defer d.outbox.mu.Unlock()
+
+	return slices.Collect(maps.Keys(d.deadLetters))
+}
+
+// fakeDeliveryLog is an in-memory replacement of the delivery attempt repository
+type fakeDeliveryLog struct {
+	mocks.DeliveryAttemptRepository // only recording of attempts is implemented
+
+	mu       sync.Mutex
+	attempts []models.DeliveryAttempt
+}
+
+func newFakeDeliveryLog() *fakeDeliveryLog {
+	return &fakeDeliveryLog{}
+}
+
+func (l *fakeDeliveryLog) CreateDeliveryAttempt(_ context.Context, attempt models.DeliveryAttempt) error {
+	l.mu.Lock()
+	defer l.mu.Unlock()
+
+	l.attempts = append(l.attempts, attempt)
+	return nil
+}
+
+func (l *fakeDeliveryLog) count(outcome models.DeliveryOutcome) int {
+	l.mu.Lock()
+	defer l.mu.Unlock()
+
+	n := 0
+	for _, attempt := range l.attempts {
+		if attempt.Outcome == outcome {
+			n++
+		}
+	}
+	return n
+}
+
+// outcomesByChannel returns the outcome of the latest attempt per channel
+func (l *fakeDeliveryLog) outcomesByChannel() map[string]models.DeliveryOutcome {
+	l.mu.Lock()
+	defer l.mu.Unlock()
+
+	outcomes := make(map[string]models.DeliveryOutcome)
+	for _, attempt := range l.attempts {
+		outcomes[attempt.Channel.ID] = attempt.Outcome
+	}
+	return outcomes
+}
+
+// fakeTemplates provides the default template for all channel types
+type fakeTemplates struct{}
+
+func (fakeTemplates) ResolveTemplate(_ context.Context, channelType models.ChannelType, _ string) (models.MessageTemplate, error) {
+	return models.DefaultMessageTemplate(channelType), nil
+}
+
+// fakeOrigins provides the links of notifications by origin class
+type fakeOrigins map[string]string
+
+func (f fakeOrigins) ResolveLink(_ context.Context, notification models.Notification) (string, error) {
+	return f[notification.OriginClass], nil
+}
+
+// toRuleActions simulates that each action stems from a separate rule
+func toRuleActions(actions []models.Action) []models.RuleAction {
+	ruleActions := make([]models.RuleAction, 0, len(actions))
+	for i, action := range actions {
+		ruleActions = append(ruleActions, models.RuleAction{RuleID: "rule-" + strconv.Itoa(i), Action: action})
+	}
+	return ruleActions
+}
+
+var testPoolConfig = WorkerPoolConfig{
+	RuleWorkers:       2,
+	IntakeQueueSize:   10,
+	DeliveryWorkers:   2,
+	DeliveryQueueSize: 100,
+	Backpressure:      BackpressureReject,
+}
+
 func Test_NotificationService_CreateNotification_Failure(t *testing.T) {
 
 	// received notification
@@ -31,25 +353,59 @@ func Test_NotificationService_CreateNotification_Failure(t *testing.T) {
 		Level:       notifications.LevelInfo,
 	}
 
-	synctest.Test(t, func(t *testing.T) {
-		mockNotificationRepo := mocks.NewNotificationRepository(t)
-		ruleService := mocks.NewRuleService(t) // no config, any call to the rule service in this test would be wrong behavior
+	actions := []models.Action{{
+		Channel: models.ChannelReference{
+			ID:   "mail-channel-id",
+			Type: models.ChannelTypeMail,
+		},
+		Recipient: "a@example.com",
+	}}
 
-		// setup mock
-		mockNotificationRepo.EXPECT().CreateNotification(mock.Anything, notification).Return(notification, assert.AnError).Once()
+	t.Run("storing notification fails", func(t *testing.T) {
+		synctest.Test(t, func(t *testing.T) {
+			mockNotificationRepo := mocks.NewNotificationRepository(t)
+			ruleService := mocks.NewRuleService(t)
 
-		// no config of further mocks, as they are not expected to be called in this test
-		notificationService := NewNotificationService(
-			mockNotificationRepo, ruleService, nil, nil, nil, nil).(*notificationService)
+			// setup mock
+			ruleService.EXPECT().ProcessRules(mock.Anything, notification).Return(toRuleActions(actions), nil).Once()
+			mockNotificationRepo.EXPECT().CreateNotification(mock.Anything, notification, mock.Anything).
+				Return(notification, nil, assert.AnError).Once()
 
-		defer notificationService.cancelForwardRetriesWorker()
+			// no config of further mocks, as they are not expected to be called in this test
+			notificationService := NewNotificationService(
+				mockNotificationRepo, newFakeOutbox(), newFakeDeliveryLog(), nil, nil, ruleService, nil, fakeTemplates{}, fakeOrigins{}, nil, nil, nil, nil, nil, nil, nil, nil, nil, testPoolConfig, time.Hour, 0).(*notificationService)
 
-		_, err := notificationService.CreateNotification(context.Background(), notification)
-		require.Error(t, err)
+			defer notificationService.stopWorkers()
 
-		synctest.Wait()
+			_, err := notificationService.CreateNotification(context.Background(), notification)
+			require.Error(t, err)
+
+			synctest.Wait()
+		})
 	})
 
+	t.Run("processing rules fails", func(t *testing.T) {
+		synctest.Test(t, func(t *testing.T) {
+			// no config, notification must not be stored without its send tasks
+			mockNotificationRepo := mocks.NewNotificationRepository(t)
+			ruleService := mocks.NewRuleService(t)
+
+			ruleService.EXPECT().ProcessRules(mock.Anything, notification).Return(nil, assert.AnError).Once()
+
+			outbox := newFakeOutbox()
+			notificationService := NewNotificationService(
+				mockNotificationRepo, outbox, newFakeDeliveryLog(), nil, nil, ruleService, nil, fakeTemplates{}, fakeOrigins{}, nil, nil, nil, nil, nil, nil, nil, nil, nil, testPoolConfig, time.Hour, 0).(*notificationService)
+
+			defer notificationService.stopWorkers()
+
+			// the caller gets the error and can retry, neither the notification nor a send task is stored
+			_, err := notificationService.CreateNotification(context.Background(), notification)
+			require.ErrorIs(t, err, assert.AnError)
+
+			synctest.Wait()
+			assert.Empty(t, outbox.pendingRecipients())
+		})
+	})
 }
 
 func Test_NotificationService_CreateNotification_Forwarding(t *testing.T) {
@@ -67,14 +423,24 @@ func Test_NotificationService_CreateNotification_Forwarding(t *testing.T) {
 			Level:       notifications.LevelInfo,
 		}
 
-		matchMailSubject := mock.MatchedBy(func(subject string) bool {
-			return strings.Contains(subject, notification.Title)
+		// the space and the parentheses of the templated link must not end the markdown link of the mail
+		link := "https://opensight.example.com/origin1/resource (1)"
+		origins := fakeOrigins{notification.OriginClass: link}
+
+		bodyWithLink := notification.Detail + "\n\n[Open in OpenSight](https://opensight.example.com/origin1/resource%20%281%29)"
+		matchMail := mock.MatchedBy(func(message models.MailMessage) bool {
+			return strings.Contains(message.Subject, notification.Title) &&
+				message.HTMLBody == markdown.ToHTML(bodyWithLink) &&
+				strings.Contains(message.HTMLBody, `href="https://opensight.example.com/origin1/resource%20%281%29"`) &&
+				message.TextBody == markdown.ToText(bodyWithLink) &&
+				message.Level == notification.Level
 		})
-		matchMessage := mock.MatchedBy(func(message string) bool {
-			return strings.Contains(message, notification.Title) && strings.Contains(message, notification.Detail)
+		matchMessage := mock.MatchedBy(func(message models.ChatMessage) bool {
+			return strings.Contains(message.Title, notification.Title) && strings.Contains(message.Text, notification.Detail) &&
+				message.Link == link
 		})
 
-		// Create three channels, each with a different type
+		// Create nine channels, each with a different type
 		mattermostChannel := models.NotificationChannel{
 			Id:          "mattermost-channel-id",
 			ChannelType: models.ChannelTypeMattermost,
@@ -95,7 +461,52 @@ func Test_NotificationService_CreateNotification_Forwarding(t *testing.T) {
 			ChannelName: "Mail Channel",
 		}
 
-		actions := []models.Action{ // simulates three matching rules
+		slackChannel := models.NotificationChannel{
+			Id:          "slack-channel-id",
+			ChannelType: models.ChannelTypeSlack,
+			ChannelName: "Slack Channel",
+			WebhookUrl:  new("https://hooks.slack.com/services/T0001/B0001/abc"),
+		}
+
+		webhookChannel := models.NotificationChannel{
+			Id:          "webhook-channel-id",
+			ChannelType: models.ChannelTypeWebhook,
+			ChannelName: "Webhook Channel",
+			WebhookUrl:  new("https://tickets.example.com/api/events"),
+		}
+
+		syslogChannel := models.NotificationChannel{
+			Id:          "syslog-channel-id",
+			ChannelType: models.ChannelTypeSyslog,
+			ChannelName: "Syslog Channel",
+			Domain:      new("siem.example.com"),
+		}
+
+		incidentChannel := models.NotificationChannel{
+			Id:          "incident-channel-id",
+			ChannelType: models.ChannelTypeIncident,
+			ChannelName: "Incident Channel",
+			RoutingKey:  new("R0UT1NGK3Y"),
+		}
+
+		pushChannel := models.NotificationChannel{
+			Id:          "push-channel-id",
+			ChannelType: models.ChannelTypePush,
+			ChannelName: "Push Channel",
+			WebhookUrl:  new("https://ntfy.example.com"),
+			PushServer:  new(models.PushServerNtfy),
+			PushTopic:   new("opensight"),
+		}
+
+		matrixChannel := models.NotificationChannel{
+			Id:           "matrix-channel-id",
+			ChannelType:  models.ChannelTypeMatrix,
+			ChannelName:  "Matrix Channel",
+			WebhookUrl:   new("https://matrix.example.org"),
+			MatrixRoomId: new("!room:example.org"),
+		}
+
+		actions := []models.Action{ // simulates nine matching rules
 			{
 				Channel: models.ChannelReference{
 					ID:   mattermostChannel.Id,
@@ -115,6 +526,42 @@ func Test_NotificationService_CreateNotification_Forwarding(t *testing.T) {
 				},
 				Recipient: "a@example.com",
 			},
+			{
+				Channel: models.ChannelReference{
+					ID:   slackChannel.Id,
+					Type: slackChannel.ChannelType,
+				},
+			},
+			{
+				Channel: models.ChannelReference{
+					ID:   webhookChannel.Id,
+					Type: webhookChannel.ChannelType,
+				},
+			},
+			{
+				Channel: models.ChannelReference{
+					ID:   syslogChannel.Id,
+					Type: syslogChannel.ChannelType,
+				},
+			},
+			{
+				Channel: models.ChannelReference{
+					ID:   incidentChannel.Id,
+					Type: incidentChannel.ChannelType,
+				},
+			},
+			{
+				Channel: models.ChannelReference{
+					ID:   pushChannel.Id,
+					Type: pushChannel.ChannelType,
+				},
+			},
+			{
+				Channel: models.ChannelReference{
+					ID:   matrixChannel.Id,
+					Type: matrixChannel.ChannelType,
+				},
+			},
 		}
 
 		// Setup mocks
@@ -124,28 +571,48 @@ func Test_NotificationService_CreateNotification_Forwarding(t *testing.T) {
 		mailService := mocks.NewMailService(t)
 		mattermostService := mocks.NewWebhookService(t)
 		teamsService := mocks.NewWebhookService(t)
+		slackService := mocks.NewWebhookService(t)
+		webhookService := mocks.NewOutboundWebhookService(t)
+		syslogService := mocks.NewSyslogService(t)
+		incidentService := mocks.NewIncidentService(t)
+		pushService := mocks.NewWebhookService(t)
+		matrixService := mocks.NewMatrixService(t)
+		outbox := newFakeOutbox()
 
-		mockNotificationRepo.EXPECT().CreateNotification(mock.Anything, notification).Return(notification, nil).Once()
-		ruleService.EXPECT().ProcessRules(mock.Anything, notification).Return(actions, nil)
+		mockNotificationRepo.EXPECT().CreateNotification(mock.Anything, notification, mock.Anything).
+			RunAndReturn(outbox.createNotification).Once()
+		ruleService.EXPECT().ProcessRules(mock.Anything, notification).Return(toRuleActions(actions), nil)
 
-		// Mock channel service calls - all three channels should be fetched
+		// Mock channel service calls - all nine channels should be fetched
 		channelService.EXPECT().GetNotificationChannelByIdAndType(mock.Anything, mattermostChannel.Id, mattermostChannel.ChannelType).
 			Return(mattermostChannel, nil).Once()
 		channelService.EXPECT().GetNotificationChannelByIdAndType(mock.Anything, teamsChannel.Id, teamsChannel.ChannelType).
 			Return(teamsChannel, nil).Once()
 		channelService.EXPECT().GetNotificationChannelByIdAndType(mock.Anything, mailChannel.Id, mailChannel.ChannelType).
 			Return(mailChannel, nil).Once()
+		channelService.EXPECT().GetNotificationChannelByIdAndType(mock.Anything, slackChannel.Id, slackChannel.ChannelType).
+			Return(slackChannel, nil).Once()
+		channelService.EXPECT().GetNotificationChannelByIdAndType(mock.Anything, webhookChannel.Id, webhookChannel.ChannelType).
+			Return(webhookChannel, nil).Once()
+		channelService.EXPECT().GetNotificationChannelByIdAndType(mock.Anything, syslogChannel.Id, syslogChannel.ChannelType).
+			Return(syslogChannel, nil).Once()
+		channelService.EXPECT().GetNotificationChannelByIdAndType(mock.Anything, incidentChannel.Id, incidentChannel.ChannelType).
+			Return(incidentChannel, nil).Once()
+		channelService.EXPECT().GetNotificationChannelByIdAndType(mock.Anything, pushChannel.Id, pushChannel.ChannelType).
+			Return(pushChannel, nil).Once()
+		channelService.EXPECT().GetNotificationChannelByIdAndType(mock.Anything, matrixChannel.Id, matrixChannel.ChannelType).
+			Return(matrixChannel, nil).Once()
 
 		// Mock forwarding services
 		// Rule/Action 1 (Mattermost) - should succeed
 		mattermostService.EXPECT().SendMessage(
-			*mattermostChannel.WebhookUrl,
+			mattermostChannel,
 			matchMessage,
 		).Return(nil).Once()
 
 		// Rule/Action 2 (Teams) - fails sending
 		teamsService.EXPECT().SendMessage(
-			*teamsChannel.WebhookUrl,
+			teamsChannel,
 			matchMessage,
 		).Return(assert.AnError).Once()
 
@@ -154,29 +621,141 @@ func Test_NotificationService_CreateNotification_Forwarding(t *testing.T) {
 			mock.Anything,
 			mailChannel,
 			"a@example.com",
-			matchMailSubject,
-			notification.Detail,
+			matchMail,
 		).Return(assert.AnError).Once()
 
+		// Rule/Action 4 (Slack) - should succeed
+		slackService.EXPECT().SendMessage(
+			slackChannel,
+			matchMessage,
+		).Return(nil).Once()
+
+		// Rule/Action 5 (generic webhook) - should succeed with the notification as is
+		webhookService.EXPECT().SendNotification(
+			webhookChannel,
+			mock.AnythingOfType("string"),
+			notification,
+		).Return(nil).Once()
+
+		// Rule/Action 6 (syslog) - should succeed
+		syslogService.EXPECT().SendMessages(
+			mock.Anything,
+			syslogChannel,
+			mock.MatchedBy(func(messages []models.ChatMessage) bool {
+				return len(messages) == 1 && matchMessage.Matches(messages[0])
+			}),
+		).Return(nil).Once()
+
+		// Rule/Action 7 (incident) - should trigger the incident of the origin resource
+		incidentService.EXPECT().Trigger(
+			incidentChannel,
+			notification.IncidentKey(),
+			matchMessage,
+		).Return(nil).Once()
+
+		// Rule/Action 8 (push) - should succeed
+		pushService.EXPECT().SendMessage(
+			pushChannel,
+			matchMessage,
+		).Return(nil).Once()
+
+		// Rule/Action 9 (Matrix) - should succeed
+		matrixService.EXPECT().SendMessage(
+			matrixChannel,
+			mock.AnythingOfType("string"),
+			matchMessage,
+		).Return(nil).Once()
+
+		deliveryLog := newFakeDeliveryLog()
+
 		notificationService := NewNotificationService(
 			mockNotificationRepo,
+			outbox,
+			deliveryLog,
+			newFakeDeadLetters(outbox),
+			nil,
 			ruleService,
 			channelService,
+			fakeTemplates{},
+			origins,
 			mailService,
 			mattermostService,
 			teamsService,
+			slackService,
+			webhookService,
+			syslogService,
+			incidentService,
+			pushService,
+			matrixService,
+			testPoolConfig,
+			time.Hour,
+			0,
 		).(*notificationService)
 
-		defer notificationService.cancelForwardRetriesWorker()
+		defer notificationService.stopWorkers()
 
 		// Create notification should succeed even though some forwarding attempts fail
 		_, err := notificationService.CreateNotification(context.Background(), notification)
 		require.NoError(t, err)
 
 		synctest.Wait()
+
+		// only the failed sends are kept for retrying
+		assert.ElementsMatch(t, []string{teamsChannel.Id + "/", mailChannel.Id + "/a@example.com"}, outbox.pendingRecipients())
+
+		// every attempt is recorded
+		assert.Equal(t, map[string]models.DeliveryOutcome{
+			mattermostChannel.Id: models.DeliveryOutcomeSuccess,
+			teamsChannel.Id:      models.DeliveryOutcomeFailure,
+			mailChannel.Id:       models.DeliveryOutcomeFailure,
+			slackChannel.Id:      models.DeliveryOutcomeSuccess,
+			webhookChannel.Id:    models.DeliveryOutcomeSuccess,
+			syslogChannel.Id:     models.DeliveryOutcomeSuccess,
+			incidentChannel.Id:   models.DeliveryOutcomeSuccess,
+			pushChannel.Id:       models.DeliveryOutcomeSuccess,
+			matrixChannel.Id:     models.DeliveryOutcomeSuccess,
+		}, deliveryLog.outcomesByChannel())
 	})
 }
 
+func Test_NotificationService_MatrixRetryKeepsTransactionID(t *testing.T) {
+	// Test verifies that a retry is sent with the transaction ID of the first attempt,
+	// so the homeserver drops it if the first attempt was posted despite the error.
+
+	matrixChannel := models.NotificationChannel{
+		Id:           "matrix-channel-id",
+		ChannelType:  models.ChannelTypeMatrix,
+		ChannelName:  "Matrix Channel",
+		WebhookUrl:   new("https://matrix.example.org"),
+		MatrixRoomId: new("!room:example.org"),
+	}
+	sendTask := models.SendTask{
+		ID:           "send-task-id",
+		Notification: &models.Notification{Id: "notification-id", Title: "Test Notification", Level: notifications.LevelInfo},
+		Action:       models.Action{Channel: models.ChannelReference{ID: matrixChannel.Id, Type: matrixChannel.ChannelType}},
+	}
+
+	channelService := mocks.NewNotificationChannelService(t)
+	matrixService := mocks.NewMatrixService(t)
+	channelService.EXPECT().GetNotificationChannelByIdAndType(mock.Anything, matrixChannel.Id, matrixChannel.ChannelType).
+		Return(matrixChannel, nil).Twice()
+	matrixService.EXPECT().SendMessage(matrixChannel, sendTask.ID, mock.Anything).Return(assert.AnError).Once()
+	matrixService.EXPECT().SendMessage(matrixChannel, sendTask.ID, mock.Anything).Return(nil).Once()
+
+	notificationService := NewNotificationService(
+		nil, newFakeOutbox(), newFakeDeliveryLog(), nil, nil, nil, channelService, fakeTemplates{}, fakeOrigins{}, nil, nil, nil, nil,
+		nil, nil, nil, nil, matrixService, testPoolConfig, time.Hour, 0,
+	).(*notificationService)
+	defer notificationService.stopWorkers()
+
+	err := notificationService.send(context.Background(), sendTask)
+	require.ErrorIs(t, err, assert.AnError)
+
+	sendTask.Attempt++
+	err = notificationService.send(context.Background(), sendTask)
+	require.NoError(t, err)
+}
+
 func Test_NotificationService_RetryLogic_MaxRetriesReached(t *testing.T) {
 	t.Parallel()
 
@@ -189,11 +768,14 @@ func Test_NotificationService_RetryLogic_MaxRetriesReached(t *testing.T) {
 		Level:       notifications.LevelInfo,
 	}
 
-	matchMailSubject := mock.MatchedBy(func(subject string) bool {
-		return strings.Contains(subject, notification.Title)
+	matchMail := mock.MatchedBy(func(message models.MailMessage) bool {
+		return strings.Contains(message.Subject, notification.Title) &&
+			message.HTMLBody == markdown.ToHTML(notification.Detail) &&
+			message.TextBody == markdown.ToText(notification.Detail) &&
+			message.Level == notification.Level
 	})
-	matchMessage := mock.MatchedBy(func(message string) bool {
-		return strings.Contains(message, notification.Title) && strings.Contains(message, notification.Detail)
+	matchMessage := mock.MatchedBy(func(message models.ChatMessage) bool {
+		return strings.Contains(message.Title, notification.Title) && strings.Contains(message.Text, notification.Detail)
 	})
 
 	mailchannel := models.NotificationChannel{
@@ -215,20 +797,17 @@ func Test_NotificationService_RetryLogic_MaxRetriesReached(t *testing.T) {
 	}
 
 	tests := map[string]struct {
-		processRuleFailures int
-		actions             []models.Action
-		mockConfig          func(
+		actions     []models.Action
+		wantDropped int // number of deliveries which were given up
+		mockConfig  func(
 			t *testing.T,
 			channelService *mocks.NotificationChannelService,
 			mailService *mocks.MailService,
 			mattermostService, teamsService *mocks.WebhookService,
 		)
 	}{
-		"ProcessRules is retried up to max retries": {
-			processRuleFailures: maxRetries + 1,
-			// no more mock calls, as operation is aborted
-		},
 		"Getting channel is retried up to max retries": {
+			wantDropped: 1,
 			actions: []models.Action{{
 				Channel: models.ChannelReference{
 					ID:   teamsChannel.Id,
@@ -244,6 +823,7 @@ func Test_NotificationService_RetryLogic_MaxRetriesReached(t *testing.T) {
 			},
 		},
 		"Mail send is retried up to max retries": {
+			wantDropped: 1,
 			actions: []models.Action{
 				{
 					Channel: models.ChannelReference{
@@ -275,38 +855,33 @@ func Test_NotificationService_RetryLogic_MaxRetriesReached(t *testing.T) {
 					mock.Anything,
 					mailchannel,
 					"success@example.com",
-					matchMailSubject,
-					notification.Detail,
+					matchMail,
 				).Return(nil).Once()
 
 				mailService.EXPECT().SendMail(
 					mock.Anything,
 					mailchannel,
 					"failure@example.com",
-					mock.MatchedBy(func(subject string) bool {
-						return strings.Contains(subject, notification.Title)
-					}),
-					notification.Detail,
+					matchMail,
 				).Return(assert.AnError).Times(maxRetries + 1)
 
 				mailService.EXPECT().SendMail(
 					mock.Anything,
 					mailchannel,
 					"maxRetries@example.com",
-					matchMailSubject,
-					notification.Detail,
+					matchMail,
 				).Return(assert.AnError).Times(maxRetries)
 
 				mailService.EXPECT().SendMail(
 					mock.Anything,
 					mailchannel,
 					"maxRetries@example.com",
-					matchMailSubject,
-					notification.Detail,
+					matchMail,
 				).Return(nil).Once()
 			},
 		},
 		"Mattermost send is retried up to max retries": {
+			wantDropped: 1,
 			actions: []models.Action{{
 				Channel: models.ChannelReference{
 					ID:   mattermostChannel.Id,
@@ -321,12 +896,13 @@ func Test_NotificationService_RetryLogic_MaxRetriesReached(t *testing.T) {
 				).Return(mattermostChannel, nil).Times(maxRetries + 1)
 
 				mattermostService.EXPECT().SendMessage(
-					*mattermostChannel.WebhookUrl,
+					mattermostChannel,
 					matchMessage,
 				).Return(assert.AnError).Times(maxRetries + 1)
 			},
 		},
 		"Teams send is retried up to max retries": {
+			wantDropped: 1,
 			actions: []models.Action{{
 				Channel: models.ChannelReference{
 					ID:   teamsChannel.Id,
@@ -341,7 +917,7 @@ func Test_NotificationService_RetryLogic_MaxRetriesReached(t *testing.T) {
 				).Return(teamsChannel, nil).Times(maxRetries + 1)
 
 				teamsService.EXPECT().SendMessage(
-					*teamsChannel.WebhookUrl,
+					teamsChannel,
 					matchMessage,
 				).Return(assert.AnError).Times(maxRetries + 1)
 			},
@@ -358,27 +934,40 @@ func Test_NotificationService_RetryLogic_MaxRetriesReached(t *testing.T) {
 				mailService := mocks.NewMailService(t)
 				mattermostService := mocks.NewWebhookService(t)
 				teamsService := mocks.NewWebhookService(t)
+				outbox := newFakeOutbox()
+				deliveryLog := newFakeDeliveryLog()
+				deadLetters := newFakeDeadLetters(outbox)
 
 				notificationService := NewNotificationService(
 					mockNotificationRepo,
+					outbox,
+					deliveryLog,
+					deadLetters,
+					nil,
 					ruleService,
 					channelService,
+					fakeTemplates{},
+					fakeOrigins{},
 					mailService,
 					mattermostService,
 					teamsService,
+					nil,
+					nil,
+					nil,
+					nil,
+					nil,
+					nil,
+					testPoolConfig,
+					time.Hour,
+					0,
 				).(*notificationService)
 
 				// stop the worker to avoid go routines leak
-				defer notificationService.cancelForwardRetriesWorker()
+				defer notificationService.stopWorkers()
 
-				mockNotificationRepo.EXPECT().CreateNotification(mock.Anything, notification).Return(notification, nil).Once()
-
-				if tt.processRuleFailures > 0 {
-					ruleService.EXPECT().ProcessRules(mock.Anything, notification).Return(nil, assert.AnError).Times(tt.processRuleFailures)
-				}
-				if tt.processRuleFailures <= maxRetries {
-					ruleService.EXPECT().ProcessRules(mock.Anything, notification).Return(tt.actions, nil).Once()
-				}
+				mockNotificationRepo.EXPECT().CreateNotification(mock.Anything, notification, mock.Anything).
+					RunAndReturn(outbox.createNotification).Once()
+				ruleService.EXPECT().ProcessRules(mock.Anything, notification).Return(toRuleActions(tt.actions), nil).Once()
 
 				if tt.mockConfig != nil {
 					tt.mockConfig(t, channelService, mailService, mattermostService, teamsService)
@@ -393,15 +982,19 @@ func Test_NotificationService_RetryLogic_MaxRetriesReached(t *testing.T) {
 				// takes roughly `baseDelayRetryForwarding*(2^(maxRetries+1)-1)`
 				time.Sleep(baseDelayRetryForwarding*(1<<uint(maxRetries+1)-1) + 5*time.Hour)
 				synctest.Wait()
+
+				assert.Empty(t, outbox.pendingRecipients(), "send tasks must be removed after success or max retries")
+				assert.Equal(t, tt.wantDropped, deliveryLog.count(models.DeliveryOutcomeDropped))
+				assert.Len(t, deadLetters.ids(), tt.wantDropped, "dropped send tasks must be kept as dead letters")
 			})
 		})
 	}
 
 }
 
-func Test_NotificationService_RetryLogic_QueueOverflow(t *testing.T) {
-	// Test verifies that at maximum `maxRetainedFailedSends` failed send attempts are retained for retrying.
-	// Failed sends beyond the capacity should be dropped gracefully and not block the service.
+func Test_NotificationService_RetryLogic_PendingSendTasksSurviveRestart(t *testing.T) {
+	// Test verifies that send tasks which failed before a restart of the service
+	// are picked up from the outbox by the new instance of the service.
 
 	notification := models.Notification{
 		Origin:      "Test Origin",
@@ -419,67 +1012,1184 @@ func Test_NotificationService_RetryLogic_QueueOverflow(t *testing.T) {
 		WebhookUrl:  new("https://teams.example.com/webhook"),
 	}
 
-	// create more actions than the queue can hold
-	actions := make([]models.Action, maxRetainedFailedSends+10)
-	for i := range actions {
-		actions[i] = models.Action{
-			Channel: models.ChannelReference{
-				ID:   teamsChannel.Id,
-				Type: teamsChannel.ChannelType,
-			},
-		}
-	}
+	actions := []models.Action{{
+		Channel: models.ChannelReference{
+			ID:   teamsChannel.Id,
+			Type: teamsChannel.ChannelType,
+		},
+	}}
+
+	matchMessage := mock.MatchedBy(func(message models.ChatMessage) bool {
+		return strings.Contains(message.Title, notification.Title)
+	})
 
 	synctest.Test(t, func(t *testing.T) {
+		outbox := newFakeOutbox() // represents the database, outlives the service instances
+
+		// first instance fails to send the notification
 		mockNotificationRepo := mocks.NewNotificationRepository(t)
 		ruleService := mocks.NewRuleService(t)
 		channelService := mocks.NewNotificationChannelService(t)
 		teamsService := mocks.NewWebhookService(t)
 
-		mockNotificationRepo.EXPECT().CreateNotification(mock.Anything, notification).Return(notification, nil).Once()
-		ruleService.EXPECT().ProcessRules(mock.Anything, notification).Return(actions, nil)
-
-		channelService.EXPECT().GetNotificationChannelByIdAndType(
-			mock.Anything,
-			teamsChannel.Id,
-			teamsChannel.ChannelType,
-		).Return(teamsChannel, nil).
-			Times(len(actions) + maxRetainedFailedSends) // initial attempts + retries for the ones that are not dropped
-
-		// Mock SendMessage to fail every time for all initial attempts
-		teamsService.EXPECT().SendMessage(
-			*teamsChannel.WebhookUrl,
-			mock.MatchedBy(func(message string) bool {
-				return strings.Contains(message, notification.Title)
-			}),
-		).Return(assert.AnError).Times(len(actions))
-		// only the first maxRetainedFailedSends are exptected to be retried
-		teamsService.EXPECT().SendMessage(
-			*teamsChannel.WebhookUrl,
-			mock.MatchedBy(func(message string) bool {
-				return strings.Contains(message, notification.Title)
-			}),
-		).Return(nil).Times(maxRetainedFailedSends)
+		mockNotificationRepo.EXPECT().CreateNotification(mock.Anything, notification, mock.Anything).
+			RunAndReturn(outbox.createNotification).Once()
+		ruleService.EXPECT().ProcessRules(mock.Anything, notification).Return(toRuleActions(actions), nil).Once()
+		channelService.EXPECT().GetNotificationChannelByIdAndType(mock.Anything, teamsChannel.Id, teamsChannel.ChannelType).
+			Return(teamsChannel, nil).Once()
+		teamsService.EXPECT().SendMessage(teamsChannel, matchMessage).Return(assert.AnError).Once()
 
-		notificationService := NewNotificationService(
-			mockNotificationRepo,
-			ruleService,
-			channelService,
+		firstService := NewNotificationService(
+			mockNotificationRepo, outbox, newFakeDeliveryLog(), newFakeDeadLetters(outbox), nil, ruleService, channelService, fakeTemplates{}, fakeOrigins{}, nil, nil, teamsService, nil,
 			nil,
 			nil,
-			teamsService,
+			nil,
+			nil,
+			nil,
+			testPoolConfig,
+			time.Hour,
+			0,
 		).(*notificationService)
 
-		defer notificationService.cancelForwardRetriesWorker()
+		_, err := firstService.CreateNotification(context.Background(), notification)
+		require.NoError(t, err)
+		synctest.Wait()
 
-		// Create notification - this triggers 250 failed sends
-		_, err := notificationService.CreateNotification(context.Background(), notification)
-		require.NoError(t, err, "failures in forwarding should not fail notification creation")
+		// stop the first instance before the retry is due
+		firstService.stopWorkers()
+		require.Len(t, outbox.pendingRecipients(), 1)
+
+		// second instance delivers the pending notification
+		channelServiceRestarted := mocks.NewNotificationChannelService(t)
+		teamsServiceRestarted := mocks.NewWebhookService(t)
+
+		channelServiceRestarted.EXPECT().GetNotificationChannelByIdAndType(mock.Anything, teamsChannel.Id, teamsChannel.ChannelType).
+			Return(teamsChannel, nil).Once()
+		teamsServiceRestarted.EXPECT().SendMessage(teamsChannel, matchMessage).Return(nil).Once()
+
+		secondService := NewNotificationService(
+			mocks.NewNotificationRepository(t), outbox, newFakeDeliveryLog(), newFakeDeadLetters(outbox), nil, mocks.NewRuleService(t), channelServiceRestarted, fakeTemplates{}, fakeOrigins{}, nil, nil, teamsServiceRestarted, nil,
+			nil,
+			nil,
+			nil,
+			nil,
+			nil,
+			testPoolConfig,
+			time.Hour,
+			0,
+		).(*notificationService)
+		defer secondService.stopWorkers()
 
-		// wait until all retries have been processed
-		// note: use generous duration, failing with exponential backoff after one retry
-		// takes roughly `baseDelayRetryForwarding`
+		// note: use generous duration, the first retry is due after roughly `baseDelayRetryForwarding`
 		time.Sleep(baseDelayRetryForwarding * 10)
 		synctest.Wait()
+
+		assert.Empty(t, outbox.pendingRecipients())
+	})
+}
+
+func Test_NotificationService_ReplayDeadLetter(t *testing.T) {
+	// Test verifies that a delivery which was given up is delivered again after it is replayed.
+
+	notification := models.Notification{
+		Origin:      "Test Origin",
+		OriginClass: "/serviceID/origin1",
+		Timestamp:   "2024-01-01T00:00:00Z",
+		Title:       "Test Notification",
+		Detail:      "This is a test notification",
+		Level:       notifications.LevelInfo,
+	}
+
+	teamsChannel := models.NotificationChannel{
+		Id:          "teams-channel-id",
+		ChannelType: models.ChannelTypeTeams,
+		ChannelName: "Teams Channel",
+		WebhookUrl:  new("https://teams.example.com/webhook"),
+	}
+
+	actions := []models.Action{{
+		Channel: models.ChannelReference{
+			ID:   teamsChannel.Id,
+			Type: teamsChannel.ChannelType,
+		},
+	}}
+
+	matchMessage := mock.MatchedBy(func(message models.ChatMessage) bool {
+		return strings.Contains(message.Title, notification.Title)
+	})
+
+	synctest.Test(t, func(t *testing.T) {
+		mockNotificationRepo := mocks.NewNotificationRepository(t)
+		ruleService := mocks.NewRuleService(t)
+		channelService := mocks.NewNotificationChannelService(t)
+		teamsService := mocks.NewWebhookService(t)
+		outbox := newFakeOutbox()
+		deadLetters := newFakeDeadLetters(outbox)
+		deliveryLog := newFakeDeliveryLog()
+
+		mockNotificationRepo.EXPECT().CreateNotification(mock.Anything, notification, mock.Anything).
+			RunAndReturn(outbox.createNotification).Once()
+		ruleService.EXPECT().ProcessRules(mock.Anything, notification).Return(toRuleActions(actions), nil).Once()
+		channelService.EXPECT().GetNotificationChannelByIdAndType(mock.Anything, teamsChannel.Id, teamsChannel.ChannelType).
+			Return(teamsChannel, nil).Times(maxRetries + 2)
+		teamsService.EXPECT().SendMessage(teamsChannel, matchMessage).Return(assert.AnError).Times(maxRetries + 1)
+
+		notificationService := NewNotificationService(
+			mockNotificationRepo, outbox, deliveryLog, deadLetters, nil, ruleService, channelService, fakeTemplates{}, fakeOrigins{}, nil, nil, teamsService, nil,
+			nil,
+			nil,
+			nil,
+			nil,
+			nil,
+			testPoolConfig,
+			time.Hour,
+			0,
+		).(*notificationService)
+		defer notificationService.stopWorkers()
+
+		_, err := notificationService.CreateNotification(context.Background(), notification)
+		require.NoError(t, err)
+
+		// note: use generous duration, see retry test
+		time.Sleep(baseDelayRetryForwarding*(1<<uint(maxRetries+1)-1) + 5*time.Hour)
+		synctest.Wait()
+
+		require.Empty(t, outbox.pendingRecipients())
+		deadLetterIDs := deadLetters.ids()
+		require.Len(t, deadLetterIDs, 1)
+
+		// the channel has been fixed in the meantime
+		teamsService.EXPECT().SendMessage(teamsChannel, matchMessage).Return(nil).Once()
+
+		err = notificationService.ReplayDeadLetter(context.Background(), deadLetterIDs[0])
+		require.NoError(t, err)
+
+		time.Sleep(retryPollInterval * 2)
+		synctest.Wait()
+
+		assert.Empty(t, outbox.pendingRecipients())
+		assert.Empty(t, deadLetters.ids())
+		assert.Equal(t, 1, deliveryLog.count(models.DeliveryOutcomeSuccess))
+
+		// a dead letter can only be replayed once
+		err = notificationService.ReplayDeadLetter(context.Background(), deadLetterIDs[0])
+		require.ErrorIs(t, err, errs.ErrItemNotFound)
+	})
+}
+
+func Test_NotificationService_ResendNotification(t *testing.T) {
+	notification := models.Notification{
+		Id:          "57fe22b8-89a4-445f-b6c7-ef9ea724ea48",
+		Origin:      "Test Origin",
+		OriginClass: "/serviceID/origin1",
+		Timestamp:   "2024-01-01T00:00:00Z",
+		Title:       "Test Notification",
+		Detail:      "This is a test notification",
+		Level:       notifications.LevelInfo,
+	}
+
+	mailChannel := models.NotificationChannel{
+		Id:          "0b9e6c4a-2f0e-4a8e-9c61-6c0d1c9a7e11",
+		ChannelType: models.ChannelTypeMail,
+		ChannelName: "Mail Channel",
+	}
+	teamsChannel := models.NotificationChannel{
+		Id:          "2d6b1e8f-3c4a-4f5b-8e9d-0a1b2c3d4e5f",
+		ChannelType: models.ChannelTypeTeams,
+		ChannelName: "Teams Channel",
+		WebhookUrl:  new("https://teams.example.com/webhook"),
+	}
+	mailRule := models.Rule{
+		ID: "3f0c8a52-6c1b-4f7e-9d7a-1b2c3d4e5f60",
+		Action: models.Action{
+			Channel: models.ChannelReference{
+				ID:   mailChannel.Id,
+				Name: mailChannel.ChannelName,
+				Type: mailChannel.ChannelType,
+			},
+			Recipient: "a@example.com,b@example.com",
+		},
+	}
+
+	matchMail := mock.MatchedBy(func(message models.MailMessage) bool {
+		return strings.Contains(message.Subject, notification.Title) &&
+			message.HTMLBody == markdown.ToHTML(notification.Detail) &&
+			message.TextBody == markdown.ToText(notification.Detail) &&
+			message.Level == notification.Level
+	})
+
+	type mocksConfig struct {
+		store          *mocks.NotificationRepository
+		ruleService    *mocks.RuleService
+		channelService *mocks.NotificationChannelService
+		mailService    *mocks.MailService
+		teamsService   *mocks.WebhookService
+	}
+
+	tests := map[string]struct {
+		target         models.ResendTarget
+		mockConfig     func(m mocksConfig)
+		wantErr        error
+		wantDeliveries map[string]models.DeliveryOutcome // by recipient
+		wantRuleID     string
+	}{
+		"resend to channel": {
+			target: models.ResendTarget{ChannelID: teamsChannel.Id},
+			mockConfig: func(m mocksConfig) {
+				m.store.EXPECT().GetNotification(mock.Anything, notification.Id).Return(notification, nil).Once()
+				m.channelService.EXPECT().GetNotificationChannelById(mock.Anything, teamsChannel.Id).Return(teamsChannel, nil).Once()
+				m.channelService.EXPECT().GetNotificationChannelByIdAndType(mock.Anything, teamsChannel.Id, teamsChannel.ChannelType).
+					Return(teamsChannel, nil).Once()
+				m.teamsService.EXPECT().SendMessage(teamsChannel, mock.Anything).Return(nil).Once()
+			},
+			wantDeliveries: map[string]models.DeliveryOutcome{"": models.DeliveryOutcomeSuccess},
+		},
+		"resend according to rule": {
+			target: models.ResendTarget{RuleID: mailRule.ID},
+			mockConfig: func(m mocksConfig) {
+				m.store.EXPECT().GetNotification(mock.Anything, notification.Id).Return(notification, nil).Once()
+				m.ruleService.EXPECT().Get(mock.Anything, mailRule.ID).Return(mailRule, nil).Once()
+				m.channelService.EXPECT().GetNotificationChannelByIdAndType(mock.Anything, mailChannel.Id, mailChannel.ChannelType).
+					Return(mailChannel, nil).Twice()
+				m.mailService.EXPECT().SendMail(mock.Anything, mailChannel, "a@example.com", matchMail).Return(nil).Once()
+				m.mailService.EXPECT().SendMail(mock.Anything, mailChannel, "b@example.com", matchMail).Return(nil).Once()
+			},
+			wantDeliveries: map[string]models.DeliveryOutcome{
+				"a@example.com": models.DeliveryOutcomeSuccess,
+				"b@example.com": models.DeliveryOutcomeSuccess,
+			},
+			wantRuleID: mailRule.ID,
+		},
+		"notification does not exist": {
+			target: models.ResendTarget{ChannelID: teamsChannel.Id},
+			mockConfig: func(m mocksConfig) {
+				m.store.EXPECT().GetNotification(mock.Anything, notification.Id).Return(models.Notification{}, errs.ErrItemNotFound).Once()
+			},
+			wantErr: errs.ErrItemNotFound,
+		},
+		"rule does not exist": {
+			target: models.ResendTarget{RuleID: mailRule.ID},
+			mockConfig: func(m mocksConfig) {
+				m.store.EXPECT().GetNotification(mock.Anything, notification.Id).Return(notification, nil).Once()
+				m.ruleService.EXPECT().Get(mock.Anything, mailRule.ID).Return(models.Rule{}, errs.ErrItemNotFound).Once()
+			},
+			wantErr: ErrResendRuleNotFound,
+		},
+		"channel does not exist": {
+			target: models.ResendTarget{ChannelID: teamsChannel.Id},
+			mockConfig: func(m mocksConfig) {
+				m.store.EXPECT().GetNotification(mock.Anything, notification.Id).Return(notification, nil).Once()
+				m.channelService.EXPECT().GetNotificationChannelById(mock.Anything, teamsChannel.Id).
+					Return(models.NotificationChannel{}, errs.ErrItemNotFound).Once()
+			},
+			wantErr: ErrResendChannelNotFound,
+		},
+		"recipient is required by mail channel": {
+			target: models.ResendTarget{ChannelID: mailChannel.Id},
+			mockConfig: func(m mocksConfig) {
+				m.store.EXPECT().GetNotification(mock.Anything, notification.Id).Return(notification, nil).Once()
+				m.channelService.EXPECT().GetNotificationChannelById(mock.Anything, mailChannel.Id).Return(mailChannel, nil).Once()
+			},
+			wantErr: ErrResendRecipientRequired,
+		},
+		"recipient is not supported by teams channel": {
+			target: models.ResendTarget{ChannelID: teamsChannel.Id, Recipient: "a@example.com"},
+			mockConfig: func(m mocksConfig) {
+				m.store.EXPECT().GetNotification(mock.Anything, notification.Id).Return(notification, nil).Once()
+				m.channelService.EXPECT().GetNotificationChannelById(mock.Anything, teamsChannel.Id).Return(teamsChannel, nil).Once()
+			},
+			wantErr: ErrResendRecipientNotSupported,
+		},
+	}
+
+	for name, tt := range tests {
+		t.Run(name, func(t *testing.T) {
+			synctest.Test(t, func(t *testing.T) {
+				m := mocksConfig{
+					store:          mocks.NewNotificationRepository(t),
+					ruleService:    mocks.NewRuleService(t),
+					channelService: mocks.NewNotificationChannelService(t),
+					mailService:    mocks.NewMailService(t),
+					teamsService:   mocks.NewWebhookService(t),
+				}
+				tt.mockConfig(m)
+				outbox := newFakeOutbox()
+				deliveryLog := newFakeDeliveryLog()
+
+				notificationService := NewNotificationService(
+					m.store, outbox, deliveryLog, newFakeDeadLetters(outbox), nil, m.ruleService, m.channelService, fakeTemplates{}, fakeOrigins{}, m.mailService, nil, m.teamsService, nil,
+					nil,
+					nil,
+					nil,
+					nil,
+					nil,
+					testPoolConfig,
+					time.Hour,
+					0,
+				).(*notificationService)
+				defer notificationService.stopWorkers()
+
+				err := notificationService.ResendNotification(context.Background(), notification.Id, tt.target)
+				synctest.Wait()
+
+				if tt.wantErr != nil {
+					require.ErrorIs(t, err, tt.wantErr)
+					assert.Empty(t, outbox.pendingRecipients())
+					return
+				}
+				require.NoError(t, err)
+				assert.Empty(t, outbox.pendingRecipients())
+
+				gotDeliveries := make(map[string]models.DeliveryOutcome)
+				for _, attempt := range deliveryLog.attempts {
+					assert.Equal(t, notification.Id, attempt.NotificationID)
+					assert.Equal(t, tt.wantRuleID, attempt.RuleID)
+					gotDeliveries[attempt.Recipient] = attempt.Outcome
+				}
+				assert.Equal(t, tt.wantDeliveries, gotDeliveries)
+			})
+		})
+	}
+}
+
+func Test_NotificationService_WorkerPool(t *testing.T) {
+	notification := models.Notification{
+		Origin:      "Test Origin",
+		OriginClass: "/serviceID/origin1",
+		Timestamp:   "2024-01-01T00:00:00Z",
+		Title:       "Test Notification",
+		Detail:      "This is a test notification",
+		Level:       notifications.LevelInfo,
+	}
+
+	poolConfig := WorkerPoolConfig{
+		RuleWorkers:       1,
+		IntakeQueueSize:   1,
+		DeliveryWorkers:   1,
+		DeliveryQueueSize: 1,
+	}
+
+	tests := map[string]struct {
+		backpressure Backpressure
+		wantErr      error
+	}{
+		"reject if intake queue is full": {
+			backpressure: BackpressureReject,
+			wantErr:      ErrIntakeQueueFull,
+		},
+		"block if intake queue is full": {
+			backpressure: BackpressureBlock,
+			wantErr:      context.DeadlineExceeded,
+		},
+	}
+
+	for name, tt := range tests {
+		t.Run(name, func(t *testing.T) {
+			synctest.Test(t, func(t *testing.T) {
+				mockNotificationRepo := mocks.NewNotificationRepository(t)
+				ruleService := mocks.NewRuleService(t)
+				outbox := newFakeOutbox()
+
+				release := make(chan struct{})
+				ruleService.EXPECT().ProcessRules(mock.Anything, notification).
+					RunAndReturn(func(context.Context, models.Notification) ([]models.RuleAction, error) {
+						<-release
+						return nil, nil
+					}).Twice()
+				mockNotificationRepo.EXPECT().CreateNotification(mock.Anything, notification, mock.Anything).
+					RunAndReturn(outbox.createNotification).Twice()
+
+				config := poolConfig
+				config.Backpressure = tt.backpressure
+				notificationService := NewNotificationService(
+					mockNotificationRepo, outbox, newFakeDeliveryLog(), nil, nil, ruleService, nil, fakeTemplates{}, fakeOrigins{}, nil, nil, nil, nil, nil, nil, nil, nil, nil, config, time.Hour, 0,
+				).(*notificationService)
+				defer notificationService.stopWorkers()
+
+				// occupy the only worker and the only slot in the intake queue
+				var wg sync.WaitGroup
+				for range 2 {
+					wg.Go(func() {
+						_, err := notificationService.CreateNotification(context.Background(), notification)
+						assert.NoError(t, err)
+					})
+					synctest.Wait()
+				}
+				stats := notificationService.GetWorkerPoolStats()
+				assert.Equal(t, models.QueueStats{Depth: 1, Capacity: 1, Workers: 1}, stats.Intake)
+
+				ctx, cancel := context.WithTimeout(context.Background(), time.Minute)
+				defer cancel()
+				_, err := notificationService.CreateNotification(ctx, notification)
+				require.ErrorIs(t, err, tt.wantErr)
+
+				close(release)
+				wg.Wait()
+				assert.Zero(t, notificationService.GetWorkerPoolStats().Intake.Depth)
+			})
+		})
+	}
+
+	t.Run("defer delivery if delivery queue is full", func(t *testing.T) {
+		synctest.Test(t, func(t *testing.T) {
+			mockNotificationRepo := mocks.NewNotificationRepository(t)
+			ruleService := mocks.NewRuleService(t)
+			channelService := mocks.NewNotificationChannelService(t)
+			teamsService := mocks.NewWebhookService(t)
+			outbox := newFakeOutbox()
+
+			var actions []models.Action
+			for i := range 3 {
+				channel := models.NotificationChannel{
+					Id:          "teams-channel-" + strconv.Itoa(i),
+					ChannelType: models.ChannelTypeTeams,
+					WebhookUrl:  new("https://teams.example.com/webhook/" + strconv.Itoa(i)),
+				}
+				actions = append(actions, models.Action{
+					Channel: models.ChannelReference{ID: channel.Id, Type: channel.ChannelType},
+				})
+				channelService.EXPECT().GetNotificationChannelByIdAndType(mock.Anything, channel.Id, channel.ChannelType).
+					Return(channel, nil).Once()
+			}
+
+			release := make(chan struct{})
+			teamsService.EXPECT().SendMessage(mock.Anything, mock.Anything).
+				RunAndReturn(func(models.NotificationChannel, models.ChatMessage) error {
+					<-release
+					return nil
+				}).Times(3)
+			ruleService.EXPECT().ProcessRules(mock.Anything, notification).Return(toRuleActions(actions), nil).Once()
+			mockNotificationRepo.EXPECT().CreateNotification(mock.Anything, notification, mock.Anything).
+				RunAndReturn(outbox.createNotification).Once()
+
+			config := poolConfig
+			config.Backpressure = BackpressureReject
+			notificationService := NewNotificationService(
+				mockNotificationRepo, outbox, newFakeDeliveryLog(), nil, nil, ruleService, channelService, fakeTemplates{}, fakeOrigins{}, nil, nil, teamsService, nil, nil, nil, nil, nil, nil, config, time.Hour, 0,
+			).(*notificationService)
+			defer notificationService.stopWorkers()
+
+			_, err := notificationService.CreateNotification(context.Background(), notification)
+			require.NoError(t, err)
+			synctest.Wait()
+
+			// the single worker and queue slot can not take all deliveries, the rest is released for later
+			stats := notificationService.GetWorkerPoolStats()
+			assert.LessOrEqual(t, stats.Delivery[models.ChannelTypeTeams].Depth, 1)
+			assert.Len(t, outbox.pendingRecipients(), 3)
+			assert.NotZero(t, outbox.unclaimedCount(), "at least one delivery must be deferred")
+
+			close(release)
+			time.Sleep(2 * retryPollInterval)
+			synctest.Wait()
+
+			assert.Empty(t, outbox.pendingRecipients(), "deferred delivery is picked up by the forward retries worker")
+		})
+	})
+}
+
+func Test_NotificationService_ClaimExpiresWhileQueued(t *testing.T) {
+	notification := models.Notification{
+		Origin:      "Test Origin",
+		OriginClass: "/serviceID/origin1",
+		Timestamp:   "2024-01-01T00:00:00Z",
+		Title:       "Test Notification",
+		Detail:      "This is a test notification",
+		Level:       notifications.LevelInfo,
+	}
+	channel := models.NotificationChannel{
+		Id:          "teams-channel-id",
+		ChannelType: models.ChannelTypeTeams,
+		WebhookUrl:  new("https://teams.example.com/webhook/1"),
+	}
+	action := models.Action{Channel: models.ChannelReference{ID: channel.Id, Type: channel.ChannelType}}
+
+	synctest.Test(t, func(t *testing.T) {
+		channelService := mocks.NewNotificationChannelService(t)
+		teamsService := mocks.NewWebhookService(t)
+		outbox := newFakeOutbox()
+
+		notificationService := NewNotificationService(
+			nil, outbox, newFakeDeliveryLog(), nil, nil, nil, channelService, fakeTemplates{}, fakeOrigins{}, nil, nil, teamsService, nil, nil, nil, nil, nil, nil, testPoolConfig, time.Hour, 0,
+		).(*notificationService)
+		notificationService.stopWorkers() // the queued task is handed over to the worker by the test
+
+		sendTasks, err := outbox.CreateSendTasks(context.Background(), notification, newSendTasks(toRuleActions([]models.Action{action})))
+		require.NoError(t, err)
+		require.Len(t, sendTasks, 1)
+		queued := sendTasks[0]
+
+		// the task stays in the queue until its claim expired, meanwhile the forward retries worker claims it again
+		time.Sleep(sendTaskClaimDuration + time.Second)
+		reclaimed, err := outbox.ClaimDueSendTasks(context.Background(), time.Now(), time.Now().Add(sendTaskClaimDuration), sendTaskClaimBatchSize)
+		require.NoError(t, err)
+		require.Len(t, reclaimed, 1)
+
+		// the stale copy is skipped, only the one of the last claim is delivered
+		notificationService.forwardQueuedNotification(context.Background(), queued)
+		assert.Len(t, outbox.pendingRecipients(), 1)
+
+		channelService.EXPECT().GetNotificationChannelByIdAndType(mock.Anything, channel.Id, channel.ChannelType).
+			Return(channel, nil).Once()
+		teamsService.EXPECT().SendMessage(channel, mock.Anything).Return(nil).Once()
+		notificationService.forwardQueuedNotification(context.Background(), reclaimed[0])
+		assert.Empty(t, outbox.pendingRecipients())
+	})
+}
+
+func Test_NotificationService_CreateNotificationIdempotent(t *testing.T) {
+	notification := models.Notification{
+		Origin:         "Test Origin",
+		OriginClass:    "/serviceID/origin1",
+		Timestamp:      "2024-01-01T00:00:00Z",
+		Title:          "Test Notification",
+		Detail:         "This is a test notification",
+		Level:          notifications.LevelInfo,
+		IdempotencyKey: "request-1",
+	}
+	wantKey := models.IdempotencyKey{Caller: "service-account-id", Key: "request-1"}
+
+	actions := []models.Action{{
+		Channel: models.ChannelReference{
+			ID:   "teams-channel-id",
+			Type: models.ChannelTypeTeams,
+		},
+	}}
+	teamsChannel := models.NotificationChannel{
+		Id:          "teams-channel-id",
+		ChannelType: models.ChannelTypeTeams,
+		WebhookUrl:  new("https://teams.example.com/webhook"),
+	}
+
+	t.Run("first request is stored and forwarded", func(t *testing.T) {
+		synctest.Test(t, func(t *testing.T) {
+			mockNotificationRepo := mocks.NewNotificationRepository(t)
+			ruleService := mocks.NewRuleService(t)
+			channelService := mocks.NewNotificationChannelService(t)
+			teamsService := mocks.NewWebhookService(t)
+			outbox := newFakeOutbox()
+
+			ruleService.EXPECT().ProcessRules(mock.Anything, notification).Return(toRuleActions(actions), nil).Once()
+			mockNotificationRepo.EXPECT().
+				CreateNotificationIdempotent(mock.Anything, notification, mock.Anything, wantKey, mock.Anything, mock.Anything).
+				RunAndReturn(func(
+					ctx context.Context,
+					n models.Notification,
+					sendTasks []models.SendTask,
+					_ models.IdempotencyKey,
+					now time.Time,
+					expiredBefore time.Time,
+				) (models.Notification, []models.SendTask, bool, error) {
+					assert.Equal(t, time.Hour, now.Sub(expiredBefore), "window is applied")
+					n, created, err := outbox.createNotification(ctx, n, sendTasks)
+					return n, created, false, err
+				}).Once()
+			channelService.EXPECT().GetNotificationChannelByIdAndType(mock.Anything, teamsChannel.Id, teamsChannel.ChannelType).
+				Return(teamsChannel, nil).Once()
+			teamsService.EXPECT().SendMessage(teamsChannel, mock.Anything).Return(nil).Once()
+
+			notificationService := NewNotificationService(
+				mockNotificationRepo, outbox, newFakeDeliveryLog(), nil, nil, ruleService, channelService, fakeTemplates{}, fakeOrigins{}, nil, nil, teamsService, nil, nil, nil, nil, nil, nil, testPoolConfig, time.Hour, 0,
+			).(*notificationService)
+			defer notificationService.stopWorkers()
+
+			_, repeated, err := notificationService.CreateNotificationIdempotent(context.Background(), notification, wantKey.Caller)
+			require.NoError(t, err)
+			assert.False(t, repeated)
+
+			synctest.Wait()
+			assert.Empty(t, outbox.pendingRecipients())
+		})
+	})
+
+	t.Run("repeated request returns the original notification without forwarding", func(t *testing.T) {
+		synctest.Test(t, func(t *testing.T) {
+			mockNotificationRepo := mocks.NewNotificationRepository(t)
+			ruleService := mocks.NewRuleService(t)
+			original := notification
+			original.Id = "original-id"
+
+			ruleService.EXPECT().ProcessRules(mock.Anything, notification).Return(toRuleActions(actions), nil).Once()
+			mockNotificationRepo.EXPECT().
+				CreateNotificationIdempotent(mock.Anything, notification, mock.Anything, wantKey, mock.Anything, mock.Anything).
+				Return(original, nil, true, nil).Once()
+
+			// no channel and webhook mocks, nothing must be forwarded
+			notificationService := NewNotificationService(
+				mockNotificationRepo, newFakeOutbox(), newFakeDeliveryLog(), nil, nil, ruleService, nil, fakeTemplates{}, fakeOrigins{}, nil, nil, nil, nil, nil, nil, nil, nil, nil, testPoolConfig, time.Hour, 0,
+			).(*notificationService)
+			defer notificationService.stopWorkers()
+
+			got, repeated, err := notificationService.CreateNotificationIdempotent(context.Background(), notification, wantKey.Caller)
+			require.NoError(t, err)
+			assert.True(t, repeated)
+			assert.Equal(t, original, got)
+
+			synctest.Wait()
+		})
+	})
+}
+
+func Test_NotificationService_DigestDelivery(t *testing.T) {
+	// Test verifies that notifications of rules in digest mode are collected and
+	// forwarded as a single message once the window has passed or the count threshold is reached.
+
+	mattermostChannel := models.NotificationChannel{
+		Id:          "mattermost-channel-id",
+		ChannelType: models.ChannelTypeMattermost,
+		WebhookUrl:  new("https://mattermost.example.com/webhook"),
+	}
+	newNotification := func(i int, level notifications.Level) models.Notification {
+		return models.Notification{
+			Id:          "notification-" + strconv.Itoa(i),
+			Origin:      "Test Origin",
+			OriginClass: "/serviceID/origin1",
+			Timestamp:   "2024-01-01T00:00:0" + strconv.Itoa(i) + "Z",
+			Title:       "Test Notification " + strconv.Itoa(i),
+			Level:       level,
+		}
+	}
+
+	tests := map[string]struct {
+		delivery    models.Delivery
+		wait        time.Duration
+		wantTitle   string
+		wantSummary string
+	}{
+		"digest is sent after the window": {
+			delivery:    models.Delivery{Mode: models.DeliveryModeDigest, DigestWindowMinutes: 10},
+			wait:        10*time.Minute + 2*retryPollInterval,
+			wantTitle:   "Digest: 3 notifications",
+			wantSummary: "1 error, 2 info",
+		},
+		"digest is sent early once the count threshold is reached": {
+			delivery:    models.Delivery{Mode: models.DeliveryModeDigest, DigestWindowMinutes: 60, DigestMaxCount: 3},
+			wait:        2 * retryPollInterval,
+			wantTitle:   "Digest: 3 notifications",
+			wantSummary: "1 error, 2 info",
+		},
+	}
+
+	for name, tt := range tests {
+		t.Run(name, func(t *testing.T) {
+			synctest.Test(t, func(t *testing.T) {
+				mockNotificationRepo := mocks.NewNotificationRepository(t)
+				ruleService := mocks.NewRuleService(t)
+				channelService := mocks.NewNotificationChannelService(t)
+				mattermostService := mocks.NewWebhookService(t)
+				outbox := newFakeOutbox()
+				deliveryLog := newFakeDeliveryLog()
+
+				ruleActions := []models.RuleAction{{
+					RuleID:   "digest-rule",
+					Action:   models.Action{Channel: models.ChannelReference{ID: mattermostChannel.Id, Type: mattermostChannel.ChannelType}},
+					Delivery: tt.delivery,
+				}}
+				mockNotificationRepo.EXPECT().CreateNotification(mock.Anything, mock.Anything, mock.Anything).
+					RunAndReturn(outbox.createNotification).Times(3)
+				ruleService.EXPECT().ProcessRules(mock.Anything, mock.Anything).Return(ruleActions, nil).Times(3)
+				channelService.EXPECT().GetNotificationChannelByIdAndType(mock.Anything, mattermostChannel.Id, mattermostChannel.ChannelType).
+					Return(mattermostChannel, nil).Once()
+				mattermostService.EXPECT().SendDigest(mattermostChannel, mock.Anything).
+					RunAndReturn(func(_ models.NotificationChannel, digest models.DigestMessage) error {
+						assert.Contains(t, digest.Title, tt.wantTitle)
+						assert.Equal(t, tt.wantSummary, digest.Summary)
+						assert.Len(t, digest.Rows, 3)
+						return nil
+					}).Once()
+
+				notificationService := NewNotificationService(
+					mockNotificationRepo, outbox, deliveryLog, nil, nil, ruleService, channelService, fakeTemplates{}, fakeOrigins{}, nil, mattermostService, nil, nil, nil, nil, nil, nil, nil, testPoolConfig, time.Hour, 0,
+				).(*notificationService)
+				defer notificationService.stopWorkers()
+
+				for i, level := range []notifications.Level{notifications.LevelInfo, notifications.LevelError, notifications.LevelInfo} {
+					_, err := notificationService.CreateNotification(context.Background(), newNotification(i, level))
+					require.NoError(t, err)
+				}
+				synctest.Wait()
+				assert.Equal(t, 0, deliveryLog.count(models.DeliveryOutcomeSuccess), "nothing is sent while collecting")
+
+				time.Sleep(tt.wait)
+				synctest.Wait()
+
+				assert.Empty(t, outbox.pendingRecipients())
+				assert.Equal(t, 3, deliveryLog.count(models.DeliveryOutcomeSuccess), "attempt is recorded per notification")
+			})
+		})
+	}
+}
+
+func Test_createDigest(t *testing.T) {
+	collected := make([]models.Notification, 0, maxDigestRows+1)
+	for i := range maxDigestRows + 1 {
+		level := notifications.LevelWarning
+		if i == 3 {
+			level = notifications.LevelUrgent
+		}
+		collected = append(collected, models.Notification{
+			Origin:    "Origin",
+			Timestamp: "2024-01-01T00:00:00Z",
+			Title:     "Title " + strconv.Itoa(i),
+			Level:     level,
+		})
+	}
+
+	digest := createDigest(collected)
+
+	assert.Equal(t, models.LevelIcon(notifications.LevelUrgent)+" Digest: 51 notifications", digest.Title)
+	assert.Equal(t, "1 urgent, 50 warning (showing the first 50)", digest.Summary)
+	assert.Equal(t, notifications.LevelUrgent, digest.Level)
+	assert.Equal(t, []string{"Time", "Level", "Origin", "Title"}, digest.Columns)
+	require.Len(t, digest.Rows, maxDigestRows)
+	assert.Equal(t, []string{"2024-01-01T00:00:00Z", "warning", "Origin", "Title 0"}, digest.Rows[0])
+}
+
+func Test_renderDigestHTML(t *testing.T) {
+	digest := models.DigestMessage{
+		Summary: "1 info",
+		Columns: []string{"Title"},
+		Rows:    [][]string{{"<script>alert(1)</script>\nsecond line"}},
+	}
+
+	got := renderDigestHTML(digest)
+
+	assert.NotContains(t, got, "<script>")
+	assert.Contains(t, got, "&lt;script&gt;alert(1)&lt;/script&gt; second line")
+	assert.Contains(t, got, "<p>1 info</p>")
+}
+
+func Test_renderDigestHTML_Links(t *testing.T) {
+	digest := models.DigestMessage{
+		Columns: []string{"Title"},
+		Rows:    [][]string{{"first"}, {"second"}},
+		Links:   []string{"https://opensight.example.com/vi/sbom/1?a=1&b=2", ""},
+	}
+
+	got := renderDigestHTML(digest)
+
+	assert.Contains(t, got, ">Link</th>")
+	assert.Contains(t, got, `<a href="https://opensight.example.com/vi/sbom/1?a=1&amp;b=2">Open</a>`)
+	assert.Contains(t, got, `second</td><td style="border:1px solid #ccc;padding:4px;text-align:left"></td>`)
+}
+
+func Test_renderDigestText(t *testing.T) {
+	digest := models.DigestMessage{
+		Summary: "1 info",
+		Columns: []string{"Level", "Title"},
+		Rows:    [][]string{{"info", "<b>first</b>\nsecond line"}},
+	}
+
+	got := renderDigestText(digest)
+
+	assert.Equal(t, "1 info\n\nLevel | Title\ninfo | <b>first</b> second line\n", got)
+
+	digest.Links = []string{"https://opensight.example.com/vi/sbom/1"}
+	got = renderDigestText(digest)
+
+	assert.Equal(t, "1 info\n\nLevel | Title | Link\ninfo | <b>first</b> second line | https://opensight.example.com/vi/sbom/1\n", got)
+}
+
+func Test_digestChatMessages(t *testing.T) {
+	collected := make([]models.Notification, 0, maxDigestRows+1)
+	for i := range maxDigestRows + 1 {
+		collected = append(collected, models.Notification{
+			OriginClass: "/vi/SBOM",
+			Title:       "Notification " + strconv.Itoa(i),
+			Detail:      "**detail**",
+			Level:       notifications.LevelWarning,
+		})
+	}
+	link := "https://opensight.example.com/vi/sbom"
+	service := &notificationService{originService: fakeOrigins{"/vi/SBOM": link}}
+	digest := createDigest(collected)
+	digest.Links = service.resolveDigestLinks(context.Background(), collected[:len(digest.Rows)])
+
+	got := service.digestChatMessages(context.Background(), digest, collected)
+
+	require.Len(t, got, maxDigestRows+1, "notifications not listed in the digest are sent as well")
+	for i, message := range got {
+		assert.Equal(t, collected[i].Title, message.Title)
+		assert.Equal(t, "**detail**", message.Text)
+		assert.Equal(t, link, message.Link)
+	}
+}
+
+func Test_sendIncidentEvent(t *testing.T) {
+	channel := models.NotificationChannel{Id: "incident-channel-id", ChannelType: models.ChannelTypeIncident}
+	notification := models.Notification{
+		OriginClass:      "/vi/SBOM",
+		OriginResourceID: "react",
+		Title:            "New vulnerability",
+		Level:            notifications.LevelError,
+	}
+	message := newChatMessage(notification.Title, notification.Detail, "", notification)
+
+	t.Run("triggers the incident of the origin resource", func(t *testing.T) {
+		incidentService := mocks.NewIncidentService(t)
+		incidentService.EXPECT().Trigger(channel, "/vi/SBOM/react", message).Return(nil).Once()
+		service := &notificationService{incidentService: incidentService}
+
+		require.NoError(t, service.sendIncidentEvent(channel, notification, message))
+	})
+
+	t.Run("resolves the incident on recovery", func(t *testing.T) {
+		recovered := notification
+		recovered.Recovered = true
+		incidentService := mocks.NewIncidentService(t)
+		incidentService.EXPECT().Resolve(channel, "/vi/SBOM/react").Return(assert.AnError).Once()
+		service := &notificationService{incidentService: incidentService}
+
+		require.ErrorIs(t, service.sendIncidentEvent(channel, recovered, message), assert.AnError)
+	})
+}
+
+func Test_newChatMessage(t *testing.T) {
+	notification := models.Notification{
+		Origin:       "SBOM - React",
+		OriginClass:  "/vi/SBOM",
+		Timestamp:    "2024-01-01T00:00:00Z",
+		Level:        notifications.LevelWarning,
+		CustomFields: map[string]any{"host": "10.0.0.1"},
+	}
+
+	got := newChatMessage("New vulnerability", "first line\\nsecond line <b>bold</b>", "https://opensight.example.com/vi/sbom/1", notification)
+
+	assert.Equal(t, models.ChatMessage{
+		Title:       "New vulnerability",
+		Text:        "first line\n\nsecond line bold",
+		Level:       notifications.LevelWarning,
+		Origin:      "SBOM - React",
+		OriginClass: "/vi/SBOM",
+		Timestamp:   "2024-01-01T00:00:00Z",
+		Fields:      []models.MessageField{{Name: "host", Value: "10.0.0.1"}},
+		Link:        "https://opensight.example.com/vi/sbom/1",
+	}, got)
+}
+
+func Test_NotificationService_SuppressRepeats(t *testing.T) {
+	// Test verifies that repeats of a notification within the suppression window are stored, but not forwarded,
+	// and that the next forwarded message mentions the number of suppressed repeats.
+
+	teamsChannel := models.NotificationChannel{
+		Id:          "teams-channel-id",
+		ChannelType: models.ChannelTypeTeams,
+		WebhookUrl:  new("https://teams.example.com/webhook"),
+	}
+	action := models.Action{Channel: models.ChannelReference{ID: teamsChannel.Id, Type: teamsChannel.ChannelType}}
+
+	tests := map[string]struct {
+		ruleWindowMinutes int
+		globalWindow      time.Duration
+	}{
+		"window of the rule": {
+			ruleWindowMinutes: 10,
+		},
+		"global window": {
+			globalWindow: 10 * time.Minute,
+		},
+		"window of the rule takes precedence": {
+			ruleWindowMinutes: 10,
+			globalWindow:      time.Minute,
+		},
+	}
+
+	for name, tt := range tests {
+		t.Run(name, func(t *testing.T) {
+			synctest.Test(t, func(t *testing.T) {
+				mockNotificationRepo := mocks.NewNotificationRepository(t)
+				ruleService := mocks.NewRuleService(t)
+				channelService := mocks.NewNotificationChannelService(t)
+				teamsService := mocks.NewWebhookService(t)
+				outbox := newFakeOutbox()
+
+				ruleActions := []models.RuleAction{{
+					RuleID:   "rule-0",
+					Action:   action,
+					Delivery: models.Delivery{Mode: models.DeliveryModeImmediate, SuppressionWindowMinutes: tt.ruleWindowMinutes},
+				}}
+				created := 0
+				mockNotificationRepo.EXPECT().CreateNotification(mock.Anything, mock.Anything, mock.Anything).
+					RunAndReturn(func(ctx context.Context, n models.Notification, sendTasks []models.SendTask) (models.Notification, []models.SendTask, error) {
+						created++
+						n.Id = "notification-" + strconv.Itoa(created)
+						return outbox.createNotification(ctx, n, sendTasks)
+					}).Times(4)
+				ruleService.EXPECT().ProcessRules(mock.Anything, mock.Anything).
+					RunAndReturn(func(context.Context, models.Notification) ([]models.RuleAction, error) {
+						return slices.Clone(ruleActions), nil
+					}).Times(4)
+				channelService.EXPECT().GetNotificationChannelByIdAndType(mock.Anything, teamsChannel.Id, teamsChannel.ChannelType).
+					Return(teamsChannel, nil).Times(2)
+				teamsService.EXPECT().SendMessage(teamsChannel, mock.MatchedBy(func(message models.ChatMessage) bool {
+					return !strings.Contains(message.Text, "repeated")
+				})).Return(nil).Once()
+				teamsService.EXPECT().SendMessage(teamsChannel, mock.MatchedBy(func(message models.ChatMessage) bool {
+					return strings.Contains(message.Text, "repeated 2 times")
+				})).Return(nil).Once()
+
+				notificationService := NewNotificationService(
+					mockNotificationRepo, outbox, newFakeDeliveryLog(), nil, nil, ruleService, channelService, fakeTemplates{}, fakeOrigins{}, nil, nil, teamsService, nil,
+					nil,
+					nil,
+					nil,
+					nil,
+					nil,
+					testPoolConfig, time.Hour, tt.globalWindow,
+				).(*notificationService)
+				defer notificationService.stopWorkers()
+
+				notification := models.Notification{
+					Origin:      "Test Origin",
+					OriginClass: "/serviceID/origin1",
+					Timestamp:   "2024-01-01T00:00:00Z",
+					Title:       "Disk full",
+					Detail:      "The disk is full",
+					Level:       notifications.LevelError,
+				}
+
+				// the first notification is forwarded, the repeats within the window are stored only
+				for range 3 {
+					_, err := notificationService.CreateNotification(context.Background(), notification)
+					require.NoError(t, err)
+					synctest.Wait()
+					time.Sleep(2 * time.Minute)
+				}
+				assert.Equal(t, 2, outbox.repeats["notification-1"])
+
+				// after the window the notification is forwarded again
+				time.Sleep(10 * time.Minute)
+				_, err := notificationService.CreateNotification(context.Background(), notification)
+				require.NoError(t, err)
+				synctest.Wait()
+
+				assert.Empty(t, outbox.pendingRecipients())
+			})
+		})
+	}
+}
+
+func Test_NotificationService_MutedSummary(t *testing.T) {
+	// Test verifies that notifications of a muted rule are forwarded as summary once the muted period is over.
+
+	synctest.Test(t, func(t *testing.T) {
+		mattermostChannel := models.NotificationChannel{
+			Id:          "mattermost-channel-id",
+			ChannelType: models.ChannelTypeMattermost,
+			WebhookUrl:  new("https://mattermost.example.com/webhook"),
+		}
+
+		mockNotificationRepo := mocks.NewNotificationRepository(t)
+		ruleService := mocks.NewRuleService(t)
+		channelService := mocks.NewNotificationChannelService(t)
+		mattermostService := mocks.NewWebhookService(t)
+		outbox := newFakeOutbox()
+
+		ruleActions := []models.RuleAction{{
+			RuleID:       "muted-rule",
+			Action:       models.Action{Channel: models.ChannelReference{ID: mattermostChannel.Id, Type: mattermostChannel.ChannelType}},
+			CollectUntil: time.Now().Add(8 * time.Hour),
+		}}
+		mockNotificationRepo.EXPECT().CreateNotification(mock.Anything, mock.Anything, mock.Anything).
+			RunAndReturn(outbox.createNotification).Times(2)
+		ruleService.EXPECT().ProcessRules(mock.Anything, mock.Anything).Return(ruleActions, nil).Times(2)
+
+		notificationService := NewNotificationService(
+			mockNotificationRepo, outbox, newFakeDeliveryLog(), nil, nil, ruleService, channelService, fakeTemplates{}, fakeOrigins{}, nil, mattermostService, nil, nil, nil, nil, nil, nil, nil, testPoolConfig, time.Hour, 0,
+		).(*notificationService)
+		defer notificationService.stopWorkers()
+
+		for range 2 {
+			_, err := notificationService.CreateNotification(context.Background(), models.Notification{
+				Origin:    "Test Origin",
+				Timestamp: "2024-01-01T00:00:00Z",
+				Title:     "Test Notification",
+				Level:     notifications.LevelInfo,
+			})
+			require.NoError(t, err)
+		}
+
+		// nothing is forwarded while muted
+		time.Sleep(8*time.Hour - time.Minute)
+		synctest.Wait()
+
+		channelService.EXPECT().GetNotificationChannelByIdAndType(mock.Anything, mattermostChannel.Id, mattermostChannel.ChannelType).
+			Return(mattermostChannel, nil).Once()
+		mattermostService.EXPECT().SendDigest(mattermostChannel, mock.MatchedBy(func(digest models.DigestMessage) bool {
+			return len(digest.Rows) == 2
+		})).Return(nil).Once()
+
+		time.Sleep(time.Minute + 2*retryPollInterval)
+		synctest.Wait()
+
+		assert.Empty(t, outbox.pendingRecipients())
+	})
+}
+
+func Test_StartEscalations(t *testing.T) {
+	// Test verifies that only the immediate send tasks of urgent problems are escalated, a recovery is not.
+
+	actions := []models.RuleAction{
+		{RuleID: "escalating-rule", Escalation: []models.EscalationStep{{DelayMinutes: 15}}},
+		{RuleID: "other-rule"},
+	}
+	newTasks := func() []models.SendTask {
+		return []models.SendTask{
+			{RuleID: "escalating-rule"},
+			{RuleID: "escalating-rule", Collect: &models.Delivery{Mode: models.DeliveryModeDigest}},
+			{RuleID: "other-rule"},
+		}
+	}
+
+	tests := map[string]struct {
+		notification models.Notification
+		escalated    []bool
+	}{
+		"urgent":          {notification: models.Notification{Level: notifications.LevelUrgent}, escalated: []bool{true, false, false}},
+		"not urgent":      {notification: models.Notification{Level: notifications.LevelError}, escalated: []bool{false, false, false}},
+		"urgent recovery": {notification: models.Notification{Level: notifications.LevelUrgent, Recovered: true}, escalated: []bool{false, false, false}},
+	}
+	for name, tt := range tests {
+		t.Run(name, func(t *testing.T) {
+			sendTasks := newTasks()
+			startEscalations(tt.notification, actions, sendTasks)
+
+			for i, sendTask := range sendTasks {
+				assert.Equal(t, tt.escalated[i], !sendTask.EscalateAt.IsZero(), "send task %d", i)
+			}
+		})
+	}
+}
+
+func Test_NotificationService_Escalation(t *testing.T) {
+	// Test verifies that an urgent notification which is not acknowledged is escalated step by step.
+
+	notification := models.Notification{
+		Id:        "notification-id",
+		Origin:    "Test Origin",
+		Timestamp: "2024-01-01T00:00:00Z",
+		Title:     "Test Notification",
+		Level:     notifications.LevelUrgent,
+	}
+
+	mattermostChannel := models.NotificationChannel{
+		Id:          "mattermost-channel-id",
+		ChannelType: models.ChannelTypeMattermost,
+		ChannelName: "Mattermost Channel",
+		WebhookUrl:  new("https://mattermost.example.com/webhook"),
+	}
+	teamsChannel := models.NotificationChannel{
+		Id:          "teams-channel-id",
+		ChannelType: models.ChannelTypeTeams,
+		ChannelName: "Teams Channel",
+		WebhookUrl:  new("https://teams.example.com/webhook"),
+	}
+
+	rule := models.Rule{
+		ID:     "escalating-rule",
+		Active: true,
+		Action: models.Action{Channel: models.ChannelReference{ID: mattermostChannel.Id, Type: mattermostChannel.ChannelType}},
+		Escalation: []models.EscalationStep{
+			{DelayMinutes: 15, Action: models.Action{Channel: models.ChannelReference{ID: teamsChannel.Id}}},
+			{DelayMinutes: 30, Action: models.Action{Channel: models.ChannelReference{ID: mattermostChannel.Id}}},
+		},
+	}
+
+	matchMessage := mock.MatchedBy(func(message models.ChatMessage) bool {
+		return strings.Contains(message.Title, notification.Title)
+	})
+
+	synctest.Test(t, func(t *testing.T) {
+		mockNotificationRepo := mocks.NewNotificationRepository(t)
+		escalationRepo := mocks.NewEscalationRepository(t)
+		ruleService := mocks.NewRuleService(t)
+		channelService := mocks.NewNotificationChannelService(t)
+		mattermostService := mocks.NewWebhookService(t)
+		teamsService := mocks.NewWebhookService(t)
+		outbox := newFakeOutbox()
+
+		notificationService := NewNotificationService(
+			mockNotificationRepo, outbox, newFakeDeliveryLog(), nil, escalationRepo, ruleService, channelService, fakeTemplates{}, fakeOrigins{}, nil, mattermostService, teamsService, nil,
+			nil,
+			nil,
+			nil,
+			nil,
+			nil,
+			testPoolConfig, time.Hour, 0,
+		).(*notificationService)
+		defer notificationService.stopWorkers()
+
+		// the notification is forwarded with the action of the rule and the escalation starts
+		start := time.Now()
+		ruleService.EXPECT().ProcessRules(mock.Anything, notification).Return([]models.RuleAction{{
+			RuleID:     rule.ID,
+			Action:     rule.Action,
+			Escalation: rule.Escalation,
+		}}, nil).Once()
+		mockNotificationRepo.EXPECT().CreateNotification(mock.Anything, notification, mock.Anything).
+			RunAndReturn(outbox.createNotification).Once()
+		channelService.EXPECT().GetNotificationChannelByIdAndType(mock.Anything, mattermostChannel.Id, mattermostChannel.ChannelType).
+			Return(mattermostChannel, nil).Once()
+		mattermostService.EXPECT().SendMessage(mattermostChannel, matchMessage).Return(nil).Once()
+
+		_, err := notificationService.CreateNotification(context.Background(), notification)
+		require.NoError(t, err)
+		synctest.Wait()
+		assert.Equal(t, []models.Escalation{{
+			NotificationID: notification.Id,
+			RuleID:         rule.ID,
+			Step:           0,
+			DueAt:          start.Add(15 * time.Minute),
+		}}, outbox.escalations, "escalation is started together with the send task")
+
+		// first step forwards to teams and schedules the second step
+		time.Sleep(15 * time.Minute)
+		now := time.Now()
+		escalationRepo.EXPECT().ClaimDueEscalations(mock.Anything, now, now.Add(escalationClaimDuration), escalationClaimBatchSize).
+			Return([]models.Escalation{{ID: "escalation-id", NotificationID: notification.Id, RuleID: rule.ID, Step: 0}}, nil).Once()
+		ruleService.EXPECT().Get(mock.Anything, rule.ID).Return(rule, nil).Once()
+		mockNotificationRepo.EXPECT().GetNotification(mock.Anything, notification.Id).Return(notification, nil).Once()
+		channelService.EXPECT().GetNotificationChannelById(mock.Anything, teamsChannel.Id).Return(teamsChannel, nil).Once()
+		channelService.EXPECT().GetNotificationChannelByIdAndType(mock.Anything, teamsChannel.Id, teamsChannel.ChannelType).
+			Return(teamsChannel, nil).Once()
+		teamsService.EXPECT().SendMessage(teamsChannel, matchMessage).Return(nil).Once()
+		escalationRepo.EXPECT().AdvanceEscalation(mock.Anything, "escalation-id", 1, now.Add(30*time.Minute)).Return(nil).Once()
+
+		err = notificationService.EscalateNotifications(context.Background())
+		require.NoError(t, err)
+		synctest.Wait()
+
+		// last step forwards to mattermost and ends the escalation
+		time.Sleep(30 * time.Minute)
+		now = time.Now()
+		escalationRepo.EXPECT().ClaimDueEscalations(mock.Anything, now, now.Add(escalationClaimDuration), escalationClaimBatchSize).
+			Return([]models.Escalation{{ID: "escalation-id", NotificationID: notification.Id, RuleID: rule.ID, Step: 1}}, nil).Once()
+		ruleService.EXPECT().Get(mock.Anything, rule.ID).Return(rule, nil).Once()
+		mockNotificationRepo.EXPECT().GetNotification(mock.Anything, notification.Id).Return(notification, nil).Once()
+		channelService.EXPECT().GetNotificationChannelById(mock.Anything, mattermostChannel.Id).Return(mattermostChannel, nil).Once()
+		channelService.EXPECT().GetNotificationChannelByIdAndType(mock.Anything, mattermostChannel.Id, mattermostChannel.ChannelType).
+			Return(mattermostChannel, nil).Once()
+		mattermostService.EXPECT().SendMessage(mattermostChannel, matchMessage).Return(nil).Once()
+		escalationRepo.EXPECT().DeleteEscalation(mock.Anything, "escalation-id").Return(nil).Once()
+
+		err = notificationService.EscalateNotifications(context.Background())
+		require.NoError(t, err)
+		synctest.Wait()
+
+		assert.Empty(t, outbox.pendingRecipients())
+	})
+
+	t.Run("escalation ends if the rule was deleted", func(t *testing.T) {
+		escalationRepo := mocks.NewEscalationRepository(t)
+		ruleService := mocks.NewRuleService(t)
+
+		escalationRepo.EXPECT().ClaimDueEscalations(mock.Anything, mock.Anything, mock.Anything, escalationClaimBatchSize).
+			Return([]models.Escalation{{ID: "escalation-id", NotificationID: notification.Id, RuleID: rule.ID}}, nil).Once()
+		ruleService.EXPECT().Get(mock.Anything, rule.ID).Return(models.Rule{}, errs.ErrItemNotFound).Once()
+		escalationRepo.EXPECT().DeleteEscalation(mock.Anything, "escalation-id").Return(nil).Once()
+
+		notificationService := &notificationService{escalations: escalationRepo, ruleService: ruleService}
+		err := notificationService.EscalateNotifications(context.Background())
+		require.NoError(t, err)
 	})
 }
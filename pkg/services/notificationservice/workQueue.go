@@ -0,0 +1,85 @@
+// SPDX-FileCopyrightText: 2026 Greenbone AG <https://greenbone.net>
+//
+// SPDX-License-Identifier: AGPL-3.0-or-later
+
+package notificationservice
+
+import (
+	"context"
+
+	"github.com/greenbone/opensight-notification-service/pkg/models"
+)
+
+// Backpressure determines how the intake of notifications behaves if the intake queue is full.
+type Backpressure string
+
+const (
+	BackpressureReject Backpressure = "reject" // creating a notification fails with [ErrIntakeQueueFull]
+	BackpressureBlock  Backpressure = "block"  // creating a notification waits until there is space in the queue or the request is canceled
+)
+
+// WorkerPoolConfig limits the number of notifications which are processed concurrently.
+type WorkerPoolConfig struct {
+	RuleWorkers       int // number of workers processing rules and storing incoming notifications
+	IntakeQueueSize   int // number of incoming notifications which can wait for a rule worker
+	DeliveryWorkers   int // number of workers per channel type forwarding notifications
+	DeliveryQueueSize int // number of deliveries per channel type which can wait for a delivery worker
+	Backpressure      Backpressure
+}
+
+// workQueue is a bounded queue of jobs which is processed by a fixed number of workers.
+type workQueue[T any] struct {
+	jobs    chan T
+	workers int
+}
+
+func newWorkQueue[T any](size int, workers int) *workQueue[T] {
+	return &workQueue[T]{
+		jobs:    make(chan T, size),
+		workers: workers,
+	}
+}
+
+// start launches the workers, they stop once the context is canceled.
+func (q *workQueue[T]) start(ctx context.Context, process func(ctx context.Context, job T)) {
+	for range q.workers {
+		go func() {
+			for {
+				select {
+				case job := <-q.jobs:
+					process(ctx, job)
+				case <-ctx.Done():
+					return
+				}
+			}
+		}()
+	}
+}
+
+// tryEnqueue adds the job to the queue, it returns false if the queue is full.
+func (q *workQueue[T]) tryEnqueue(job T) bool {
+	select {
+	case q.jobs <- job:
+		return true
+	default:
+		return false
+	}
+}
+
+// enqueue adds the job to the queue, it waits for free space until the context is canceled.
+func (q *workQueue[T]) enqueue(ctx context.Context, job T) error {
+	select {
+	case q.jobs <- job:
+		return nil
+	case <-ctx.Done():
+		return ctx.Err()
+	}
+}
+
+func (q *workQueue[T]) stats() models.QueueStats {
+	return models.QueueStats{
+		Depth:    len(q.jobs),
+		Capacity: cap(q.jobs),
+		Workers:  q.workers,
+	}
+}
@@ -38,6 +38,72 @@ func (_m *OriginRepository) EXPECT() *OriginRepository_Expecter {
 	return &OriginRepository_Expecter{mock: &_m.Mock}
 }
 
+// GetOriginByClass provides a mock function for the type OriginRepository
+func (_mock *OriginRepository) GetOriginByClass(ctx context.Context, class string) (entities.Origin, error) {
+	ret := _mock.Called(ctx, class)
+
+	if len(ret) == 0 {
+		panic("no return value specified for GetOriginByClass")
+	}
+
+	var r0 entities.Origin
+	var r1 error
+	if returnFunc, ok := ret.Get(0).(func(context.Context, string) (entities.Origin, error)); ok {
+		return returnFunc(ctx, class)
+	}
+	if returnFunc, ok := ret.Get(0).(func(context.Context, string) entities.Origin); ok {
+		r0 = returnFunc(ctx, class)
+	} else {
+		r0 = ret.Get(0).(entities.Origin)
+	}
+	if returnFunc, ok := ret.Get(1).(func(context.Context, string) error); ok {
+		r1 = returnFunc(ctx, class)
+	} else {
+		r1 = ret.Error(1)
+	}
+	return r0, r1
+}
+
+// OriginRepository_GetOriginByClass_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'GetOriginByClass'
+type OriginRepository_GetOriginByClass_Call struct {
+	*mock.Call
+}
+
+// GetOriginByClass is a helper method to define mock.On call
+//   - ctx context.Context
+//   - class string
+func (_e *OriginRepository_Expecter) GetOriginByClass(ctx interface{}, class interface{}) *OriginRepository_GetOriginByClass_Call {
+	return &OriginRepository_GetOriginByClass_Call{Call: _e.mock.On("GetOriginByClass", ctx, class)}
+}
+
+func (_c *OriginRepository_GetOriginByClass_Call) Run(run func(ctx context.Context, class string)) *OriginRepository_GetOriginByClass_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		var arg0 context.Context
+		if args[0] != nil {
+			arg0 = args[0].(context.Context)
+		}
+		var arg1 string
+		if args[1] != nil {
+			arg1 = args[1].(string)
+		}
+		run(
+			arg0,
+			arg1,
+		)
+	})
+	return _c
+}
+
+func (_c *OriginRepository_GetOriginByClass_Call) Return(origin entities.Origin, err error) *OriginRepository_GetOriginByClass_Call {
+	_c.Call.Return(origin, err)
+	return _c
+}
+
+func (_c *OriginRepository_GetOriginByClass_Call) RunAndReturn(run func(ctx context.Context, class string) (entities.Origin, error)) *OriginRepository_GetOriginByClass_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
 // ListOrigins provides a mock function for the type OriginRepository
 func (_mock *OriginRepository) ListOrigins(ctx context.Context) ([]entities.Origin, error) {
 	ret := _mock.Called(ctx)
@@ -6,21 +6,31 @@ package originservice
 
 import (
 	"context"
+	"errors"
+	"net/url"
+	"strings"
 
 	"github.com/greenbone/opensight-notification-service/pkg/entities"
+	"github.com/greenbone/opensight-notification-service/pkg/errs"
+	"github.com/greenbone/opensight-notification-service/pkg/models"
 )
 
 type OriginRepository interface {
 	UpsertOrigins(ctx context.Context, serviceID string, origins []entities.Origin) error
 	ListOrigins(ctx context.Context) ([]entities.Origin, error)
+	GetOriginByClass(ctx context.Context, class string) (entities.Origin, error)
 }
 
 type OriginService struct {
-	store OriginRepository
+	store         OriginRepository
+	publicBaseUrl string // URL under which OpenSight is reachable for the recipients of messages
 }
 
-func NewOriginService(store OriginRepository) *OriginService {
-	return &OriginService{store: store}
+func NewOriginService(store OriginRepository, publicBaseUrl string) *OriginService {
+	return &OriginService{
+		store:         store,
+		publicBaseUrl: strings.TrimRight(publicBaseUrl, "/"),
+	}
 }
 
 func (s *OriginService) UpsertOrigins(ctx context.Context, serviceID string, origins []entities.Origin) error {
@@ -30,3 +40,42 @@ func (s *OriginService) UpsertOrigins(ctx context.Context, serviceID string, ori
 func (s *OriginService) ListOrigins(ctx context.Context) ([]entities.Origin, error) {
 	return s.store.ListOrigins(ctx)
 }
+
+// ResolveLink returns the link to the resource of the notification, built from the URL template of its origin.
+// The link is empty if the origin is unknown or has no URL template, if the template requires a resource ID
+// but the notification has none, or if the template is a relative path and no public base URL is configured.
+func (s *OriginService) ResolveLink(ctx context.Context, notification models.Notification) (string, error) {
+	if notification.OriginClass == "" {
+		return "", nil
+	}
+
+	origin, err := s.store.GetOriginByClass(ctx, notification.OriginClass)
+	if err != nil {
+		if errors.Is(err, errs.ErrItemNotFound) {
+			return "", nil
+		}
+		return "", err
+	}
+
+	return s.buildLink(origin.UrlTemplate, notification.OriginResourceID), nil
+}
+
+func (s *OriginService) buildLink(urlTemplate string, resourceID string) string {
+	if urlTemplate == "" {
+		return ""
+	}
+	if strings.Contains(urlTemplate, models.OriginResourceIDPlaceholder) {
+		if resourceID == "" {
+			return ""
+		}
+		urlTemplate = strings.ReplaceAll(urlTemplate, models.OriginResourceIDPlaceholder, url.PathEscape(resourceID))
+	}
+
+	if strings.HasPrefix(urlTemplate, "/") {
+		if s.publicBaseUrl == "" {
+			return ""
+		}
+		return s.publicBaseUrl + urlTemplate
+	}
+	return urlTemplate
+}
@@ -0,0 +1,94 @@
+// SPDX-FileCopyrightText: 2026 Greenbone AG <https://greenbone.net>
+//
+// SPDX-License-Identifier: AGPL-3.0-or-later
+
+package originservice
+
+import (
+	"context"
+	"testing"
+
+	"github.com/greenbone/opensight-notification-service/pkg/entities"
+	"github.com/greenbone/opensight-notification-service/pkg/errs"
+	"github.com/greenbone/opensight-notification-service/pkg/models"
+	"github.com/greenbone/opensight-notification-service/pkg/services/originservice/mocks"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/mock"
+	"github.com/stretchr/testify/require"
+)
+
+func TestOriginService_ResolveLink(t *testing.T) {
+	tests := map[string]struct {
+		publicBaseUrl string
+		urlTemplate   string
+		resourceID    string
+		originErr     error
+		wantLink      string
+		wantErr       bool
+	}{
+		"relative template": {
+			publicBaseUrl: "https://opensight.example.com/",
+			urlTemplate:   "/vi/sbom/{resourceID}",
+			resourceID:    "a b/c",
+			wantLink:      "https://opensight.example.com/vi/sbom/a%20b%2Fc",
+		},
+		"absolute template": {
+			urlTemplate: "https://scanner.example.com/reports/{resourceID}?tab=results",
+			resourceID:  "1",
+			wantLink:    "https://scanner.example.com/reports/1?tab=results",
+		},
+		"template without placeholder": {
+			publicBaseUrl: "https://opensight.example.com",
+			urlTemplate:   "/vi/sbom",
+			wantLink:      "https://opensight.example.com/vi/sbom",
+		},
+		"relative template without public base URL": {
+			urlTemplate: "/vi/sbom/{resourceID}",
+			resourceID:  "1",
+		},
+		"template requires resource ID": {
+			publicBaseUrl: "https://opensight.example.com",
+			urlTemplate:   "/vi/sbom/{resourceID}",
+		},
+		"origin without template": {
+			publicBaseUrl: "https://opensight.example.com",
+			resourceID:    "1",
+		},
+		"unknown origin": {
+			publicBaseUrl: "https://opensight.example.com",
+			resourceID:    "1",
+			originErr:     errs.ErrItemNotFound,
+		},
+		"repository error": {
+			originErr: assert.AnError,
+			wantErr:   true,
+		},
+	}
+
+	for name, tt := range tests {
+		t.Run(name, func(t *testing.T) {
+			store := mocks.NewOriginRepository(t)
+			store.EXPECT().GetOriginByClass(mock.Anything, "/vi/SBOM").
+				Return(entities.Origin{Class: "/vi/SBOM", UrlTemplate: tt.urlTemplate}, tt.originErr).Once()
+
+			service := NewOriginService(store, tt.publicBaseUrl)
+			link, err := service.ResolveLink(context.Background(), models.Notification{
+				OriginClass:      "/vi/SBOM",
+				OriginResourceID: tt.resourceID,
+			})
+			if tt.wantErr {
+				require.Error(t, err)
+				return
+			}
+			require.NoError(t, err)
+			assert.Equal(t, tt.wantLink, link)
+		})
+	}
+
+	t.Run("notification without origin class", func(t *testing.T) {
+		service := NewOriginService(mocks.NewOriginRepository(t), "https://opensight.example.com")
+		link, err := service.ResolveLink(context.Background(), models.Notification{OriginResourceID: "1"})
+		require.NoError(t, err)
+		assert.Empty(t, link)
+	})
+}
@@ -9,12 +9,13 @@ import (
 	"errors"
 	"fmt"
 	"slices"
-	"strings"
+	"time"
 
 	"github.com/greenbone/opensight-golang-libraries/pkg/notifications"
 	"github.com/greenbone/opensight-notification-service/pkg/entities"
 	"github.com/greenbone/opensight-notification-service/pkg/errs"
 	"github.com/greenbone/opensight-notification-service/pkg/models"
+	"github.com/greenbone/opensight-notification-service/pkg/translation"
 )
 
 var ErrRuleLimitReached = fmt.Errorf("alert rule limit reached")
@@ -147,7 +148,31 @@ func (s *RuleService) GetAllRuleOptions(ctx context.Context) (*models.RuleOption
 func (s *RuleService) validateRule(ctx context.Context, rule models.Rule) error {
 	err1 := s.validateAction(ctx, rule.Action)
 	err2 := s.validateOrigins(ctx, rule.Trigger.Origins)
-	return errors.Join(err1, err2)
+	err3 := s.validateEscalation(ctx, rule.Escalation)
+	return errors.Join(err1, err2, err3)
+}
+
+// validateEscalation checks the actions of the escalation steps, the issues are reported per step.
+func (s *RuleService) validateEscalation(ctx context.Context, steps []models.EscalationStep) error {
+	errs := make(models.ValidationErrors)
+	for i, step := range steps {
+		err := s.validateAction(ctx, step.Action)
+		switch {
+		case err == nil:
+		case errors.Is(err, ErrChannelNotFound):
+			errs[fmt.Sprintf("escalation[%d].action.channel.id", i)] = translation.ChannelNotFound
+		case errors.Is(err, ErrRecipientRequired):
+			errs[fmt.Sprintf("escalation[%d].action.recipient", i)] = translation.RecipientRequiredForChannel
+		case errors.Is(err, ErrRecipientNotSupported):
+			errs[fmt.Sprintf("escalation[%d].action.recipient", i)] = translation.RecipientNotSupportedForChannel
+		default:
+			return err
+		}
+	}
+	if len(errs) > 0 {
+		return errs
+	}
+	return nil
 }
 
 func (s *RuleService) validateAction(ctx context.Context, action models.Action) error {
@@ -198,28 +223,34 @@ func deactivateRuleIfInvalid(rule models.Rule) models.Rule {
 	return rule
 }
 
-func (s *RuleService) ProcessRules(ctx context.Context, notification models.Notification) ([]models.Action, error) {
+func (s *RuleService) ProcessRules(ctx context.Context, notification models.Notification) ([]models.RuleAction, error) {
 	rules, err := s.List(ctx)
 	if err != nil {
 		return nil, fmt.Errorf("failed to list rules: %w", err)
 	}
 
-	var actions []models.Action
+	now := time.Now()
+	var actions []models.RuleAction
 	for _, rule := range rules {
 		if !rule.Active {
 			continue
 		}
 
-		if rule.IsTriggered(notification) {
-			if rule.Action.Channel.Type.HasRecipient() {
-				// recipient(s) can be a comma separated list
-				for recipient := range strings.SplitSeq(rule.Action.Recipient, `,`) {
-					action := rule.Action
-					action.Recipient = strings.TrimSpace(recipient)
-					actions = append(actions, action)
-				}
-			} else {
-				actions = append(actions, rule.Action)
+		if rule.IsTriggered(notification, now) {
+			// a muted rule is only triggered if the notifications are collected for a summary,
+			// recoveries resolve the incident right away
+			var collectUntil time.Time
+			if !rule.ResolvesIncident(notification) {
+				collectUntil, _ = rule.Mute.MutedUntil(notification.Level, now)
+			}
+			for _, action := range rule.Action.SplitRecipients() {
+				actions = append(actions, models.RuleAction{
+					RuleID:       rule.ID,
+					Action:       action,
+					Delivery:     rule.Delivery,
+					CollectUntil: collectUntil,
+					Escalation:   rule.Escalation,
+				})
 			}
 		}
 	}
@@ -9,6 +9,7 @@ import (
 	"errors"
 	"fmt"
 	"testing"
+	"time"
 
 	"github.com/greenbone/opensight-golang-libraries/pkg/notifications"
 	"github.com/greenbone/opensight-notification-service/pkg/entities"
@@ -64,11 +65,14 @@ func Test_ProcessRules(t *testing.T) {
 		Detail:      "This is a test notification",
 		Level:       notifications.LevelInfo,
 	}
+	// maintenance window which is active while the test runs
+	maintenanceStart := time.Date(2000, 1, 1, 0, 0, 0, 0, time.UTC)
+	maintenanceEnd := time.Date(2100, 1, 1, 0, 0, 0, 0, time.UTC)
 
 	tests := map[string]struct {
 		rules       []models.Rule
 		ruleRepoErr error
-		wantActions []models.Action
+		wantActions []models.RuleAction
 		wantErr     bool
 	}{
 		"multiple rules trigger": {
@@ -87,26 +91,29 @@ func Test_ProcessRules(t *testing.T) {
 					r.Active = false
 				}),
 				ruleValid(func(r *models.Rule) { // triggers
+					r.ID = "3f0c8a52-6c1b-4f7e-9d7a-1b2c3d4e5f60"
 					r.Trigger = models.Trigger{
 						Origins: []models.OriginReference{{Class: notification.OriginClass}},
 						Levels:  []notifications.Level{notification.Level},
 					}
 				}),
 				ruleValid(func(r *models.Rule) { // triggers
+					r.ID = "7b9d1e24-0a3f-4c5b-8e6d-2f1a0b9c8d7e"
 					r.Trigger = models.Trigger{
 						Origins: []models.OriginReference{{Class: notification.OriginClass}},
 						Levels:  []notifications.Level{notification.Level},
 					}
 				}),
 			},
-			wantActions: []models.Action{
-				ruleValid().Action,
-				ruleValid().Action,
+			wantActions: []models.RuleAction{
+				{RuleID: "3f0c8a52-6c1b-4f7e-9d7a-1b2c3d4e5f60", Action: ruleValid().Action},
+				{RuleID: "7b9d1e24-0a3f-4c5b-8e6d-2f1a0b9c8d7e", Action: ruleValid().Action},
 			},
 		},
 		"returns one action per recipient": {
 			rules: []models.Rule{
 				ruleValid(func(r *models.Rule) { // triggers with 2 recipients
+					r.ID = "3f0c8a52-6c1b-4f7e-9d7a-1b2c3d4e5f60"
 					r.Trigger = models.Trigger{
 						Origins: []models.OriginReference{{Class: notification.OriginClass}},
 						Levels:  []notifications.Level{notification.Level},
@@ -121,22 +128,81 @@ func Test_ProcessRules(t *testing.T) {
 					}
 				}),
 			},
-			wantActions: []models.Action{
+			wantActions: []models.RuleAction{
 				{
-					Channel: models.ChannelReference{
-						ID:   "a1b2c3d4-e5f6-4a7b-8c9d-0e1f2a3b4c5d",
-						Name: "Mail-Channel",
-						Type: models.ChannelTypeMail,
+					RuleID: "3f0c8a52-6c1b-4f7e-9d7a-1b2c3d4e5f60",
+					Action: models.Action{
+						Channel: models.ChannelReference{
+							ID:   "a1b2c3d4-e5f6-4a7b-8c9d-0e1f2a3b4c5d",
+							Name: "Mail-Channel",
+							Type: models.ChannelTypeMail,
+						},
+						Recipient: "a@example.com",
 					},
-					Recipient: "a@example.com",
 				},
 				{
-					Channel: models.ChannelReference{
-						ID:   "a1b2c3d4-e5f6-4a7b-8c9d-0e1f2a3b4c5d",
-						Name: "Mail-Channel",
-						Type: models.ChannelTypeMail,
+					RuleID: "3f0c8a52-6c1b-4f7e-9d7a-1b2c3d4e5f60",
+					Action: models.Action{
+						Channel: models.ChannelReference{
+							ID:   "a1b2c3d4-e5f6-4a7b-8c9d-0e1f2a3b4c5d",
+							Name: "Mail-Channel",
+							Type: models.ChannelTypeMail,
+						},
+						Recipient: "b@example.com",
 					},
-					Recipient: "b@example.com",
+				},
+			},
+		},
+		"actions carry the delivery settings of the rule": {
+			rules: []models.Rule{
+				ruleValid(func(r *models.Rule) {
+					r.ID = "3f0c8a52-6c1b-4f7e-9d7a-1b2c3d4e5f60"
+					r.Trigger = models.Trigger{
+						Origins: []models.OriginReference{{Class: notification.OriginClass}},
+						Levels:  []notifications.Level{notification.Level},
+					}
+					r.Delivery = models.Delivery{Mode: models.DeliveryModeDigest, DigestWindowMinutes: 30}
+				}),
+			},
+			wantActions: []models.RuleAction{
+				{
+					RuleID:   "3f0c8a52-6c1b-4f7e-9d7a-1b2c3d4e5f60",
+					Action:   ruleValid().Action,
+					Delivery: models.Delivery{Mode: models.DeliveryModeDigest, DigestWindowMinutes: 30},
+				},
+			},
+		},
+		"muted rule does not trigger": {
+			rules: []models.Rule{
+				ruleValid(func(r *models.Rule) {
+					r.Trigger = models.Trigger{
+						Origins: []models.OriginReference{{Class: notification.OriginClass}},
+						Levels:  []notifications.Level{notification.Level},
+					}
+					r.Mute = models.Mute{MaintenanceWindows: []models.MaintenanceWindow{{Start: maintenanceStart, End: maintenanceEnd}}}
+				}),
+			},
+			wantActions: nil,
+		},
+		"muted rule collects the notification for a summary": {
+			rules: []models.Rule{
+				ruleValid(func(r *models.Rule) {
+					r.ID = "3f0c8a52-6c1b-4f7e-9d7a-1b2c3d4e5f60"
+					r.Trigger = models.Trigger{
+						Origins: []models.OriginReference{{Class: notification.OriginClass}},
+						Levels:  []notifications.Level{notification.Level},
+					}
+					r.Mute = models.Mute{
+						MaintenanceWindows: []models.MaintenanceWindow{{Start: maintenanceStart, End: maintenanceEnd}},
+						Summary:            true,
+					}
+				}),
+			},
+			wantActions: []models.RuleAction{
+				{
+					RuleID:       "3f0c8a52-6c1b-4f7e-9d7a-1b2c3d4e5f60",
+					Action:       ruleValid().Action,
+					CollectUntil: maintenanceEnd,
 				},
 			},
 		},
@@ -171,6 +237,44 @@ func Test_ProcessRules(t *testing.T) {
 	}
 }
 
+func Test_ProcessRules_RecoveryResolvesIncident(t *testing.T) {
+	// the rule forwards urgent problems to an incident channel and is muted, the recovery is forwarded right away
+	recovery := models.Notification{
+		Origin:      "Test Origin",
+		OriginClass: "/serviceID/origin1",
+		Timestamp:   "2024-01-01T00:00:00Z",
+		Title:       "Test Notification",
+		Detail:      "The problem is over",
+		Level:       notifications.LevelInfo,
+		Recovered:   true,
+	}
+	rule := ruleValid(func(r *models.Rule) {
+		r.ID = "3f0c8a52-6c1b-4f7e-9d7a-1b2c3d4e5f60"
+		r.Trigger = models.Trigger{
+			Origins: []models.OriginReference{{Class: recovery.OriginClass}},
+			Levels:  []notifications.Level{notifications.LevelUrgent},
+		}
+		r.Action.Channel.Type = models.ChannelTypeIncident
+		r.Mute = models.Mute{
+			MaintenanceWindows: []models.MaintenanceWindow{{
+				Start: time.Date(2000, 1, 1, 0, 0, 0, 0, time.UTC),
+				End:   time.Date(2100, 1, 1, 0, 0, 0, 0, time.UTC),
+			}},
+			Summary: true,
+		}
+	})
+
+	ruleRepo := mocks.NewRuleRepository(t)
+	ruleService, err := NewRuleService(ruleRepo, nil, initOriginRepoMock(t), 10)
+	require.NoError(t, err)
+	ruleRepo.EXPECT().List(mock.Anything).Return([]models.Rule{rule}, nil).Once()
+
+	gotActions, err := ruleService.ProcessRules(context.Background(), recovery)
+	require.NoError(t, err)
+
+	require.Equal(t, []models.RuleAction{{RuleID: rule.ID, Action: rule.Action}}, gotActions)
+}
+
 // initOriginRepoMock creates the mock and sets up the expectation for the UpsertOrigins call that happens during RuleService initialization
 func initOriginRepoMock(t *testing.T) *mocks.OriginRepository {
 	mockOriginRepo := mocks.NewOriginRepository(t)
@@ -367,6 +471,40 @@ func TestRuleService_Create_Failure(t *testing.T) {
 	}
 }
 
+func TestRuleService_Create_InvalidEscalation(t *testing.T) {
+	mockRuleRepo := mocks.NewRuleRepository(t)
+	mockChannelRepo := mocks.NewNotificationChannelRepository(t)
+	mockOriginRepo := initOriginRepoMock(t)
+
+	service, err := NewRuleService(mockRuleRepo, mockChannelRepo, mockOriginRepo, 10)
+	require.NoError(t, err)
+
+	mailChannelID := "c3d4e5f6-a7b8-4c9d-8e0f-1a2b3c4d5e6f"
+	rule := ruleValid(func(r *models.Rule) {
+		r.Escalation = []models.EscalationStep{
+			{DelayMinutes: 15, Action: models.Action{Channel: models.ChannelReference{ID: "non-existent-channel"}}},
+			{DelayMinutes: 30, Action: models.Action{Channel: models.ChannelReference{ID: mailChannelID}}},
+		}
+	})
+
+	mockRuleRepo.EXPECT().List(mock.Anything).Return([]models.Rule{}, nil)
+	mockChannelRepo.EXPECT().GetNotificationChannelById(mock.Anything, channel.Id).Return(channel, nil).Once()
+	mockChannelRepo.EXPECT().GetNotificationChannelById(mock.Anything, "non-existent-channel").
+		Return(models.NotificationChannel{}, errs.ErrItemNotFound).Once()
+	mockChannelRepo.EXPECT().GetNotificationChannelById(mock.Anything, mailChannelID).
+		Return(models.NotificationChannel{Id: mailChannelID, ChannelType: models.ChannelTypeMail}, nil).Once()
+	mockOriginRepo.EXPECT().ListOrigins(mock.Anything).Return([]entities.Origin{{Class: "test"}}, nil).Once()
+
+	_, err = service.Create(context.Background(), rule)
+
+	var validationErrors models.ValidationErrors
+	require.ErrorAs(t, err, &validationErrors)
+	assert.Equal(t, models.ValidationErrors{
+		"escalation[0].action.channel.id": "Channel does not exist.",
+		"escalation[1].action.recipient":  "Recipient is required for the selected channel.",
+	}, validationErrors)
+}
+
 func TestRuleService_Get_InvalidRuleDeactivated(t *testing.T) {
 	t.Parallel()
 	tests := map[string]struct {
@@ -692,10 +830,40 @@ func TestRuleService_GetAllRuleOptionsFiltered(t *testing.T) {
 						{ChannelType: models.ChannelTypeTeams, ChannelName: "Teams Channel 1"},
 					},
 				},
+				models.ChannelTypeSlack: {
+					channels: []models.NotificationChannel{
+						{ChannelType: models.ChannelTypeSlack, ChannelName: "Slack Channel 1"},
+					},
+				},
+				models.ChannelTypeWebhook: {
+					channels: []models.NotificationChannel{
+						{ChannelType: models.ChannelTypeWebhook, ChannelName: "Webhook Channel 1"},
+					},
+				},
+				models.ChannelTypeSyslog: {
+					channels: []models.NotificationChannel{
+						{ChannelType: models.ChannelTypeSyslog, ChannelName: "Syslog Channel 1"},
+					},
+				},
+				models.ChannelTypeIncident: {
+					channels: []models.NotificationChannel{
+						{ChannelType: models.ChannelTypeIncident, ChannelName: "Incident Channel 1"},
+					},
+				},
+				models.ChannelTypePush: {
+					channels: []models.NotificationChannel{
+						{ChannelType: models.ChannelTypePush, ChannelName: "Push Channel 1"},
+					},
+				},
+				models.ChannelTypeMatrix: {
+					channels: []models.NotificationChannel{
+						{ChannelType: models.ChannelTypeMatrix, ChannelName: "Matrix Channel 1"},
+					},
+				},
 			},
 			wantErr:          false,
 			wantOriginCount:  2,
-			wantChannelCount: 4,
+			wantChannelCount: 10,
 			wantLevels:       notifications.AllowedLevels,
 		},
 		"returns empty origins and no channels": {
@@ -707,6 +875,12 @@ func TestRuleService_GetAllRuleOptionsFiltered(t *testing.T) {
 				models.ChannelTypeMail:       {channels: []models.NotificationChannel{}},
 				models.ChannelTypeMattermost: {channels: []models.NotificationChannel{}},
 				models.ChannelTypeTeams:      {channels: []models.NotificationChannel{}},
+				models.ChannelTypeSlack:      {channels: []models.NotificationChannel{}},
+				models.ChannelTypeWebhook:    {channels: []models.NotificationChannel{}},
+				models.ChannelTypeSyslog:     {channels: []models.NotificationChannel{}},
+				models.ChannelTypeIncident:   {channels: []models.NotificationChannel{}},
+				models.ChannelTypePush:       {channels: []models.NotificationChannel{}},
+				models.ChannelTypeMatrix:     {channels: []models.NotificationChannel{}},
 			},
 			wantErr:          false,
 			wantOriginCount:  0,
@@ -725,7 +899,13 @@ func TestRuleService_GetAllRuleOptionsFiltered(t *testing.T) {
 						{ChannelType: models.ChannelTypeMattermost, ChannelName: "Mattermost Only"},
 					},
 				},
-				models.ChannelTypeTeams: {channels: []models.NotificationChannel{}},
+				models.ChannelTypeTeams:    {channels: []models.NotificationChannel{}},
+				models.ChannelTypeSlack:    {channels: []models.NotificationChannel{}},
+				models.ChannelTypeWebhook:  {channels: []models.NotificationChannel{}},
+				models.ChannelTypeSyslog:   {channels: []models.NotificationChannel{}},
+				models.ChannelTypeIncident: {channels: []models.NotificationChannel{}},
+				models.ChannelTypePush:     {channels: []models.NotificationChannel{}},
+				models.ChannelTypeMatrix:   {channels: []models.NotificationChannel{}},
 			},
 			wantErr:          false,
 			wantOriginCount:  1,
@@ -765,7 +945,7 @@ func TestRuleService_GetAllRuleOptionsFiltered(t *testing.T) {
 			mockOriginRepo.EXPECT().ListOrigins(mock.Anything).Return(tt.mockOriginRepoList.origins, tt.mockOriginRepoList.err).Once()
 
 			if tt.mockChannelListByType != nil {
-				for _, channelType := range []models.ChannelType{models.ChannelTypeMail, models.ChannelTypeMattermost, models.ChannelTypeTeams} {
+				for _, channelType := range models.AllowedChannels {
 					if call, ok := tt.mockChannelListByType[channelType]; ok {
 						mockChannelRepo.EXPECT().ListNotificationChannelsByType(mock.Anything, channelType).
 							Return(call.channels, call.err).Once()
@@ -0,0 +1,127 @@
+// SPDX-FileCopyrightText: 2026 Greenbone AG <https://greenbone.net>
+//
+// SPDX-License-Identifier: AGPL-3.0-or-later
+
+package templateservice
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"slices"
+
+	"github.com/greenbone/opensight-notification-service/pkg/errs"
+	"github.com/greenbone/opensight-notification-service/pkg/models"
+)
+
+var ErrRenderFailed = errors.New("template can not be rendered with the notification")
+
+type TemplateRepository interface {
+	ListTemplates(ctx context.Context) ([]models.MessageTemplate, error)
+	GetTemplate(ctx context.Context, channelType models.ChannelType) (models.MessageTemplate, error)
+	UpsertTemplate(ctx context.Context, template models.MessageTemplate) (models.MessageTemplate, error)
+	DeleteTemplate(ctx context.Context, channelType models.ChannelType) error
+	GetRuleTemplate(ctx context.Context, ruleID string) (*models.TemplateOverride, error)
+}
+
+type TemplateService struct {
+	store TemplateRepository
+}
+
+func NewTemplateService(store TemplateRepository) *TemplateService {
+	return &TemplateService{store: store}
+}
+
+// ListTemplates returns the template of each channel type, the default template if it was not changed.
+func (s *TemplateService) ListTemplates(ctx context.Context) ([]models.MessageTemplate, error) {
+	stored, err := s.store.ListTemplates(ctx)
+	if err != nil {
+		return nil, fmt.Errorf("failed to list templates: %w", err)
+	}
+
+	templates := make([]models.MessageTemplate, 0, len(models.AllowedChannels))
+	for _, channelType := range models.AllowedChannels {
+		i := slices.IndexFunc(stored, func(t models.MessageTemplate) bool { return t.ChannelType == channelType })
+		if i < 0 {
+			templates = append(templates, models.DefaultMessageTemplate(channelType))
+			continue
+		}
+		templates = append(templates, stored[i])
+	}
+	return templates, nil
+}
+
+// GetTemplate returns the template of the channel type, the default template if it was not changed.
+func (s *TemplateService) GetTemplate(ctx context.Context, channelType models.ChannelType) (models.MessageTemplate, error) {
+	if !slices.Contains(models.AllowedChannels, channelType) {
+		return models.MessageTemplate{}, errs.ErrItemNotFound
+	}
+
+	template, err := s.store.GetTemplate(ctx, channelType)
+	if errors.Is(err, errs.ErrItemNotFound) {
+		return models.DefaultMessageTemplate(channelType), nil
+	}
+	if err != nil {
+		return models.MessageTemplate{}, fmt.Errorf("failed to get template: %w", err)
+	}
+	return template, nil
+}
+
+// UpdateTemplate replaces the template of the channel type, the template must be validated beforehand.
+func (s *TemplateService) UpdateTemplate(ctx context.Context, channelType models.ChannelType, template models.MessageTemplate) (models.MessageTemplate, error) {
+	if !slices.Contains(models.AllowedChannels, channelType) {
+		return models.MessageTemplate{}, errs.ErrItemNotFound
+	}
+
+	template.ChannelType = channelType
+	return s.store.UpsertTemplate(ctx, template)
+}
+
+// ResetTemplate restores the default template of the channel type.
+func (s *TemplateService) ResetTemplate(ctx context.Context, channelType models.ChannelType) (models.MessageTemplate, error) {
+	if !slices.Contains(models.AllowedChannels, channelType) {
+		return models.MessageTemplate{}, errs.ErrItemNotFound
+	}
+
+	err := s.store.DeleteTemplate(ctx, channelType)
+	if err != nil {
+		return models.MessageTemplate{}, err
+	}
+	return models.DefaultMessageTemplate(channelType), nil
+}
+
+// ResolveTemplate returns the template for messages of the rule to channels of the given type.
+// The template override of the rule takes precedence over the template of the channel type.
+// A deleted rule or an empty rule ID, e.g. for re-sent notifications, leaves the template of the channel type.
+func (s *TemplateService) ResolveTemplate(ctx context.Context, channelType models.ChannelType, ruleID string) (models.MessageTemplate, error) {
+	template, err := s.GetTemplate(ctx, channelType)
+	if err != nil {
+		return models.MessageTemplate{}, err
+	}
+	if ruleID == "" {
+		return template, nil
+	}
+
+	override, err := s.store.GetRuleTemplate(ctx, ruleID)
+	if errors.Is(err, errs.ErrItemNotFound) {
+		return template, nil
+	}
+	if err != nil {
+		return models.MessageTemplate{}, fmt.Errorf("failed to get template of rule: %w", err)
+	}
+	return template.WithOverride(override), nil
+}
+
+// PreviewTemplate renders the template with the given notification, or with a sample notification if it is nil.
+func (s *TemplateService) PreviewTemplate(template models.MessageTemplate, notification *models.Notification) (models.TemplatePreview, error) {
+	data := models.SampleTemplateData()
+	if notification != nil {
+		data = models.NewTemplateData(*notification)
+	}
+
+	preview, err := template.Render(data)
+	if err != nil {
+		return models.TemplatePreview{}, ErrRenderFailed
+	}
+	return preview, nil
+}
@@ -12,14 +12,83 @@ const (
 	MailhubIsRequired          = "A mailhub is required."
 	MailSenderIsRequired       = "A sender email is required."
 	ValidEmailSenderIsRequired = "A valid sender email is required."
+	InvalidMailAuthMethod      = "The authentication method must be password or xoauth2."
+	TokenUrlIsRequired         = "A token endpoint URL is required."
+	ValidTokenUrlIsRequired    = "Please enter a valid token endpoint URL."
+	ClientIdIsRequired         = "A client ID is required."
+	ClientSecretIsRequired     = "A client secret is required."
+	TooManyOAuthScopes         = "At most 10 scopes are allowed."
+	InvalidOAuthScope          = "Scopes must not be longer than 256 characters and must not contain spaces."
+	MailOAuthTokenFailed       = "Unable to get an access token from the token endpoint."
+	InvalidMailTlsMode         = "The TLS mode must be implicit, starttls, opportunistic or none."
+	InvalidMailTlsMinVersion   = "The minimum TLS version must be 1.0, 1.1, 1.2 or 1.3."
+	InvalidCaCertificate       = "Please enter valid PEM encoded CA certificates."
+	InvalidCertFingerprint     = "Please enter a valid SHA-256 fingerprint of the certificate."
+	CertificatesRequireTls     = "CA certificates and fingerprints can only be used with TLS."
 
 	// Mattermost
 	MattermostChannelLimitReached     = "Mattermost channel limit reached."
 	MattermostChannelNameAlreadyExist = "Mattermost channel name already exists."
+	MattermostUsernameTooLong         = "The username must not be longer than 64 characters."
+	ValidIconUrlIsRequired            = "Please enter a valid http or https URL for the icon."
+	ValidMattermostChannelIsRequired  = "Please enter a valid channel name, e.g. town-square, or @username."
 
 	// Microsoft Teams
 	TeamsChannelLimitReached     = "MS Teams channel limit reached."
 	TeamsChannelNameAlreadyExist = "MS Teams channel name already exists."
+
+	// Slack
+	SlackChannelLimitReached     = "Slack channel limit reached."
+	SlackChannelNameAlreadyExist = "Slack channel name already exists."
+
+	// Generic webhook
+	WebhookChannelLimitReached     = "Webhook channel limit reached."
+	WebhookChannelNameAlreadyExist = "Webhook channel name already exists."
+	InvalidWebhookMethod           = "The HTTP method must be POST, PUT or PATCH."
+	TooManyWebhookHeaders          = "At most 20 headers are allowed."
+	InvalidWebhookHeaderName       = "Invalid header name, headers set by the service can not be overridden."
+	InvalidWebhookHeaderValue      = "The header value must not contain line breaks and must not be longer than 4096 characters."
+	InvalidWebhookBodyTemplate     = "The body template must render valid JSON."
+	InvalidWebhookSecret           = "The secret must have between 16 and 512 characters, a secret with whsec_ prefix must be base64 encoded."
+
+	// Syslog
+	SyslogChannelLimitReached     = "Syslog channel limit reached."
+	SyslogChannelNameAlreadyExist = "Syslog channel name already exists."
+	HostIsRequired                = "A host is required."
+	ValidHostIsRequired           = "Please enter a valid host name or IP address."
+	InvalidSyslogTransport        = "The transport must be udp, tcp or tls."
+	InvalidSyslogFacility         = "The facility must be between 0 and 23."
+	InvalidSyslogAppName          = "The app name must consist of at most 48 printable ASCII characters without spaces."
+	SyslogServerUnreachable       = "Syslog server is unreachable."
+
+	// Incident
+	IncidentChannelLimitReached     = "Incident channel limit reached."
+	IncidentChannelNameAlreadyExist = "Incident channel name already exists."
+	ValidEventsUrlIsRequired        = "Please enter a valid events API URL."
+	RoutingKeyIsRequired            = "A routing key is required."
+	InvalidRoutingKey               = "The routing key must consist of at most 255 printable ASCII characters without spaces."
+
+	// Push
+	PushChannelLimitReached     = "Push channel limit reached."
+	PushChannelNameAlreadyExist = "Push channel name already exists."
+	ServerUrlIsRequired         = "A server URL is required."
+	ValidServerUrlIsRequired    = "Please enter a valid server URL."
+	InvalidPushServer           = "The push server must be ntfy or gotify."
+	PushTopicIsRequired         = "A topic is required."
+	InvalidPushTopic            = "The topic must consist of at most 64 letters, digits, - and _."
+	TooManyPushTags             = "At most 10 tags are allowed."
+	InvalidPushTag              = "Tags must have at most 64 characters and must not contain commas."
+	AppTokenIsRequired          = "An app token is required."
+	InvalidAccessToken          = "The access token must consist of at most 512 printable ASCII characters without spaces."
+
+	// Matrix
+	MatrixChannelLimitReached     = "Matrix channel limit reached."
+	MatrixChannelNameAlreadyExist = "Matrix channel name already exists."
+	HomeserverUrlIsRequired       = "A homeserver URL is required."
+	ValidHomeserverUrlIsRequired  = "Please enter a valid homeserver URL."
+	RoomIdIsRequired              = "A room ID is required."
+	InvalidRoomId                 = "Please enter a valid room ID like !abc:example.org."
+	AccessTokenIsRequired         = "An access token is required."
 )
 
 // Rules
@@ -42,4 +111,60 @@ const (
 	RuleNameAlreadyExists = "Alert rule name already exists."
 	OriginsNotFound       = "One or more origins do not exist."
 	ChannelNotFound       = "Channel does not exist."
+
+	InvalidDeliveryMode   = "Invalid delivery mode."
+	InvalidDigestWindow   = "The digest window must be between 1 minute and 7 days."
+	InvalidDigestMaxCount = "The digest count threshold must be between 2 and 1000."
+
+	InvalidSuppressionWindow = "The suppression window must be between 0 minutes and 7 days."
+
+	InvalidTimeZone                 = "Invalid time zone, an IANA time zone like Europe/Berlin is expected."
+	InvalidTimeOfDay                = "Invalid time, the format HH:MM is expected."
+	InvalidWeekday                  = "Invalid weekday."
+	MaintenanceWindowEndBeforeStart = "The end of the maintenance window must be after its start."
+
+	TooManyEscalationSteps = "Too many escalation steps, at most 10 are allowed."
+	InvalidEscalationDelay = "The escalation delay must be between 1 minute and 7 days."
+)
+
+// Message templates
+const (
+	TemplateSubjectIsRequired = "A subject template is required."
+	TemplateBodyIsRequired    = "A body template is required."
+	TemplateSubjectTooLong    = "The subject template must not be longer than 1000 characters."
+	TemplateBodyTooLong       = "The body template must not be longer than 20000 characters."
+	InvalidTemplateSyntax     = "The template can not be parsed, please check the syntax."
+	TemplateRenderFailed      = "The template can not be rendered, please check the referenced fields."
+)
+
+// Dead letters
+const (
+	DeadLetterIDsAreRequired = "At least one dead letter ID is required."
+	TooManyDeadLetterIDs     = "Too many dead letter IDs, at most 1000 can be replayed at once."
+)
+
+// Re-send of notifications
+const (
+	ChannelOrRuleIsRequired  = "Either a channel or a rule is required."
+	RecipientRequiresChannel = "A recipient can only be set together with a channel."
+	RuleNotFound             = "Alert rule does not exist."
+)
+
+// Worker pool
+const (
+	NotificationIntakeQueueFull = "The service is busy, please try again later."
+)
+
+// Origins
+const (
+	UrlTemplateTooLong         = "The URL template must not be longer than 2048 characters."
+	InvalidUrlTemplate         = "Please enter a valid URL template."
+	UrlTemplateMustBeHttp      = "The URL template must be an http or https URL."
+	UrlTemplateMustBeUrlOrPath = "The URL template must be an absolute URL or a path starting with /."
+)
+
+// Idempotency keys
+const (
+	IdempotencyKeyMismatch = "The idempotency key in the header and in the body differ."
+	IdempotencyKeyTooLong  = "The idempotency key must not be longer than 255 characters."
 )
@@ -0,0 +1,167 @@
+package incidentcontroller
+
+import (
+	"net/http"
+
+	"github.com/gin-gonic/gin"
+	"github.com/greenbone/opensight-golang-libraries/pkg/errorResponses"
+	"github.com/greenbone/opensight-notification-service/pkg/models"
+	"github.com/greenbone/opensight-notification-service/pkg/services/notificationchannelservice"
+	"github.com/greenbone/opensight-notification-service/pkg/translation"
+	"github.com/greenbone/opensight-notification-service/pkg/web/errmap"
+	"github.com/greenbone/opensight-notification-service/pkg/web/ginEx"
+	"github.com/greenbone/opensight-notification-service/pkg/web/iam"
+	"github.com/greenbone/opensight-notification-service/pkg/web/incidentcontroller/incidentdto"
+	"github.com/greenbone/opensight-notification-service/pkg/web/middleware"
+)
+
+type IncidentController struct {
+	notificationChannelServicer notificationchannelservice.NotificationChannelService
+	incidentChannelService      notificationchannelservice.IncidentChannelService
+}
+
+func NewIncidentController(
+	router gin.IRouter,
+	notificationChannelServicer notificationchannelservice.NotificationChannelService,
+	incidentChannelService notificationchannelservice.IncidentChannelService,
+	auth gin.HandlerFunc,
+	registry *errmap.Registry,
+) *IncidentController {
+	ctrl := &IncidentController{
+		notificationChannelServicer: notificationChannelServicer,
+		incidentChannelService:      incidentChannelService,
+	}
+
+	group := router.Group("/notification-channel/incident").
+		Use(middleware.AuthorizeRoles(auth, iam.OsiAdmin, iam.NotificationAdmin)...)
+
+	group.POST("", ctrl.createIncidentChannel)
+	group.GET("", ctrl.listIncidentChannels)
+	group.PUT("/:id", ctrl.updateIncidentChannel)
+	group.DELETE("/:id", ctrl.deleteIncidentChannel)
+
+	ctrl.configureMappings(registry)
+	return ctrl
+}
+
+func (ic *IncidentController) configureMappings(r *errmap.Registry) {
+	r.Register(
+		notificationchannelservice.ErrIncidentChannelLimitReached,
+		http.StatusUnprocessableEntity,
+		errorResponses.NewErrorGenericResponse(translation.IncidentChannelLimitReached),
+	)
+	r.Register(
+		notificationchannelservice.ErrListIncidentChannels,
+		http.StatusInternalServerError,
+		errorResponses.ErrorInternalResponse,
+	)
+	r.Register(
+		notificationchannelservice.ErrIncidentChannelNameExists,
+		http.StatusBadRequest,
+		errorResponses.NewErrorGenericResponse(translation.IncidentChannelNameAlreadyExist),
+	)
+	r.Register(
+		notificationchannelservice.ErrIncidentRoutingKeyIsRequired,
+		http.StatusBadRequest,
+		errorResponses.NewErrorGenericResponse(translation.RoutingKeyIsRequired),
+	)
+}
+
+// CreateIncidentChannel
+//
+//	@Summary		Create Incident Channel
+//	@Description	Create a new incident notification channel, the routing key is required
+//	@Tags			incident-channel
+//	@Accept			json
+//	@Produce		json
+//	@Security		KeycloakAuth
+//	@Param			IncidentChannel	body		incidentdto.IncidentNotificationChannelRequest	true	"Incident channel to add"
+//	@Success		201			{object}	incidentdto.IncidentNotificationChannelResponse
+//	@Failure		400			{object}	map[string]string
+//	@Failure		500			{object}	map[string]string
+//	@Router			/notification-channel/incident [post]
+func (ic *IncidentController) createIncidentChannel(c *gin.Context) {
+	var channel incidentdto.IncidentNotificationChannelRequest
+	if !ginEx.BindAndValidateBody(c, &channel) {
+		return
+	}
+
+	incidentChannel, err := ic.incidentChannelService.CreateIncidentChannel(c, channel)
+	if ginEx.AddError(c, err) {
+		return
+	}
+
+	c.JSON(http.StatusCreated, incidentChannel)
+}
+
+// ListIncidentChannels
+//
+//	@Summary		List Incident Channels
+//	@Description	List incident notification channels
+//	@Tags			incident-channel
+//	@Produce		json
+//	@Security		KeycloakAuth
+//	@Success		200		{array}		incidentdto.IncidentNotificationChannelResponse
+//	@Failure		500		{object}	map[string]string
+//	@Router			/notification-channel/incident [get]
+func (ic *IncidentController) listIncidentChannels(c *gin.Context) {
+	channels, err := ic.notificationChannelServicer.ListNotificationChannelsByType(c, models.ChannelTypeIncident)
+	if ginEx.AddError(c, err) {
+		return
+	}
+
+	c.JSON(http.StatusOK, incidentdto.MapNotificationChannelsToIncident(channels))
+}
+
+// UpdateIncidentChannel
+//
+//	@Summary		Update Incident Channel
+//	@Description	Update an existing incident notification channel
+//	@Tags			incident-channel
+//	@Accept			json
+//	@Produce		json
+//	@Security		KeycloakAuth
+//	@Param			id			path		string						true	"Incident channel ID"
+//	@Param			IncidentChannel	body		incidentdto.IncidentNotificationChannelRequest	true	"Incident channel to update"
+//	@Success		200			{object}	incidentdto.IncidentNotificationChannelResponse
+//	@Failure		400			{object}	map[string]string
+//	@Failure		404 		{object}    map[string]string
+//	@Failure		500			{object}	map[string]string
+//	@Router			/notification-channel/incident/{id} [put]
+func (ic *IncidentController) updateIncidentChannel(c *gin.Context) {
+	id := c.Param("id")
+
+	var channel incidentdto.IncidentNotificationChannelRequest
+	if !ginEx.BindAndValidateBody(c, &channel) {
+		return
+	}
+
+	updated, err := ic.incidentChannelService.UpdateIncidentChannel(c, id, channel)
+	if ginEx.AddError(c, err) {
+		return
+	}
+
+	c.JSON(http.StatusOK, updated)
+}
+
+// DeleteIncidentChannel
+//
+//	@Summary		Delete Incident Channel
+//	@Description	Delete a incident notification channel
+//	@Tags			incident-channel
+//	@Security		KeycloakAuth
+//	@Param			id	path	string	true	"Incident channel ID"
+//	@Success		204	"Deleted successfully"
+//	@Failure		404 {object}    map[string]string
+//	@Failure		500	{object}	map[string]string
+//	@Router			/notification-channel/incident/{id} [delete]
+func (ic *IncidentController) deleteIncidentChannel(c *gin.Context) {
+	id := c.Param("id")
+
+	err := ic.notificationChannelServicer.DeleteNotificationChannel(c, id)
+	if ginEx.AddError(c, err) {
+		return
+	}
+
+	c.Status(http.StatusNoContent)
+}
@@ -0,0 +1,41 @@
+package incidentdto
+
+import (
+	"github.com/greenbone/opensight-notification-service/pkg/helper"
+	"github.com/greenbone/opensight-notification-service/pkg/models"
+)
+
+// MapNotificationChannelToIncident maps NotificationChannel to IncidentNotificationChannelResponse.
+func MapNotificationChannelToIncident(channel models.NotificationChannel) IncidentNotificationChannelResponse {
+	eventsUrl := helper.SafeDereference(channel.WebhookUrl)
+	if eventsUrl == "" {
+		eventsUrl = models.DefaultEventsUrl
+	}
+
+	return IncidentNotificationChannelResponse{
+		Id:            channel.Id,
+		ChannelName:   channel.ChannelName,
+		Description:   helper.SafeDereference(channel.Description),
+		EventsUrl:     eventsUrl,
+		HasRoutingKey: helper.SafeDereference(channel.RoutingKey) != "",
+	}
+}
+
+func MapIncidentToNotificationChannel(channel IncidentNotificationChannelRequest) models.NotificationChannel {
+	return models.NotificationChannel{
+		ChannelType: models.ChannelTypeIncident,
+		ChannelName: channel.ChannelName,
+		Description: &channel.Description,
+		WebhookUrl:  &channel.EventsUrl,
+		RoutingKey:  channel.RoutingKey,
+	}
+}
+
+// MapNotificationChannelsToIncident maps a slice of NotificationChannel to IncidentNotificationChannelResponse.
+func MapNotificationChannelsToIncident(channels []models.NotificationChannel) []IncidentNotificationChannelResponse {
+	incidentChannels := make([]IncidentNotificationChannelResponse, 0, len(channels))
+	for _, ch := range channels {
+		incidentChannels = append(incidentChannels, MapNotificationChannelToIncident(ch))
+	}
+	return incidentChannels
+}
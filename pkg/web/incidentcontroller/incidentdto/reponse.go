@@ -0,0 +1,11 @@
+package incidentdto
+
+// IncidentNotificationChannelResponse incident notification channel response.
+// The routing key is never returned, hasRoutingKey tells whether it is set.
+type IncidentNotificationChannelResponse struct {
+	Id            string `json:"id"`
+	ChannelName   string `json:"channelName"`
+	Description   string `json:"description"`
+	EventsUrl     string `json:"eventsUrl"`
+	HasRoutingKey bool   `json:"hasRoutingKey"`
+}
@@ -0,0 +1,52 @@
+package incidentdto
+
+import (
+	"strings"
+
+	"github.com/greenbone/opensight-notification-service/pkg/models"
+	"github.com/greenbone/opensight-notification-service/pkg/policy"
+	"github.com/greenbone/opensight-notification-service/pkg/translation"
+)
+
+// IncidentNotificationChannelRequest incident notification channel request.
+// Without events URL the events API of PagerDuty is used. The routing key is write-only,
+// it is required on creation and not changed if omitted on update.
+type IncidentNotificationChannelRequest struct {
+	ChannelName string  `json:"channelName"`
+	Description string  `json:"description"`
+	EventsUrl   string  `json:"eventsUrl"`
+	RoutingKey  *string `json:"routingKey"`
+}
+
+func (r *IncidentNotificationChannelRequest) Cleanup() {
+	r.ChannelName = strings.TrimSpace(r.ChannelName)
+	r.Description = strings.TrimSpace(r.Description)
+	r.EventsUrl = strings.TrimSpace(r.EventsUrl)
+	if r.EventsUrl == "" {
+		r.EventsUrl = models.DefaultEventsUrl
+	}
+	if r.RoutingKey != nil {
+		routingKey := strings.TrimSpace(*r.RoutingKey)
+		r.RoutingKey = &routingKey
+	}
+}
+
+func (r IncidentNotificationChannelRequest) Validate() models.ValidationErrors {
+	errs := make(models.ValidationErrors)
+
+	if r.ChannelName == "" {
+		errs["channelName"] = translation.ChannelNameIsRequired
+	}
+	if _, err := policy.WebhookUrlPolicy(r.EventsUrl); err != nil {
+		errs["eventsUrl"] = translation.ValidEventsUrlIsRequired
+	}
+	if r.RoutingKey != nil {
+		if *r.RoutingKey == "" {
+			errs["routingKey"] = translation.RoutingKeyIsRequired
+		} else if err := policy.RoutingKeyPolicy(*r.RoutingKey); err != nil {
+			errs["routingKey"] = translation.InvalidRoutingKey
+		}
+	}
+
+	return errs
+}
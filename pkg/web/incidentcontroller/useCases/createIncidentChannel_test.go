@@ -0,0 +1,122 @@
+package usesCases
+
+import (
+	"net/http"
+	"testing"
+
+	"github.com/greenbone/opensight-golang-libraries/pkg/httpassert"
+	"github.com/greenbone/opensight-notification-service/pkg/web/iam"
+	"github.com/greenbone/opensight-notification-service/pkg/web/integrationTests"
+	"github.com/stretchr/testify/require"
+)
+
+func TestCreateIncidentChannel(t *testing.T) {
+	t.Run("Create incident channel", func(t *testing.T) {
+		t.Parallel()
+
+		router, db := setupTestRouter(t)
+		defer db.Close()
+
+		var incidentId string
+
+		// Create incident channel
+		httpassert.New(t, router).Post("/notification-channel/incident").
+			AuthJwt(integrationTests.CreateJwtTokenWithRole(iam.NotificationAdmin)).
+			JsonContent(`{
+				"channelName": "incident1",
+				"description": "This is a test incident channel",
+				"eventsUrl": "https://alerts.example.com/v2/enqueue",
+				"routingKey": "R0UT1NGK3Y"
+			}`).
+			Expect().
+			StatusCode(http.StatusCreated).
+			JsonPath("$.id", httpassert.ExtractTo(&incidentId)).
+			JsonTemplate(`{
+				"id": "d9cc9be2-7b4d-4c6f-991d-a40cfe002ceb",
+				"channelName": "incident1",
+				"description": "This is a test incident channel",
+				"eventsUrl": "https://alerts.example.com/v2/enqueue",
+				"hasRoutingKey": true
+			}`, map[string]any{
+				"id": httpassert.IgnoreJsonValue,
+			})
+		require.NotEmpty(t, incidentId)
+	})
+
+	t.Run("Create incident channel with invalid settings returns an error", func(t *testing.T) {
+		t.Parallel()
+
+		router, db := setupTestRouter(t)
+		defer db.Close()
+
+		// Create incident channel
+		httpassert.New(t, router).Post("/notification-channel/incident").
+			AuthJwt(integrationTests.CreateJwtTokenWithRole(iam.NotificationAdmin)).
+			JsonContent(`{
+				"channelName": "incident1",
+				"eventsUrl": "ftp://alerts.example.com",
+				"routingKey": "routing key"
+			}`).
+			Expect().
+			StatusCode(http.StatusBadRequest).
+			Json(`{
+				"type": "greenbone/validation-error",
+				"title": "",
+				"errors": {
+					"eventsUrl": "Please enter a valid events API URL.",
+					"routingKey": "The routing key must consist of at most 255 printable ASCII characters without spaces."
+				}
+			}`)
+	})
+
+	t.Run("Create incident channel without routing key returns an error", func(t *testing.T) {
+		t.Parallel()
+
+		router, db := setupTestRouter(t)
+		defer db.Close()
+
+		// Create incident channel
+		httpassert.New(t, router).Post("/notification-channel/incident").
+			AuthJwt(integrationTests.CreateJwtTokenWithRole(iam.NotificationAdmin)).
+			JsonContent(`{
+				"channelName": "incident1"
+			}`).
+			Expect().
+			StatusCode(http.StatusBadRequest).
+			Json(`{
+				"type": "greenbone/generic-error",
+				"title": "A routing key is required."
+			}`)
+	})
+
+	t.Run("Create incident channel with an existing name return an error", func(t *testing.T) {
+		t.Parallel()
+
+		router, db := setupTestRouter(t)
+		defer db.Close()
+
+		// Create incident channel
+		httpassert.New(t, router).Post("/notification-channel/incident").
+			AuthJwt(integrationTests.CreateJwtTokenWithRole(iam.NotificationAdmin)).
+			JsonContent(`{
+				"channelName": "incident 1",
+				"routingKey": "R0UT1NGK3Y"
+			}`).
+			Expect().
+			StatusCode(http.StatusCreated)
+
+		// Create incident channel with the same name
+		httpassert.New(t, router).Post("/notification-channel/incident").
+			AuthJwt(integrationTests.CreateJwtTokenWithRole(iam.NotificationAdmin)).
+			JsonContent(`{
+				"channelName": "incident 1",
+				"routingKey": "R0UT1NGK3Y"
+			}`).
+			Expect().
+			StatusCode(http.StatusBadRequest).
+			Json(`{
+				"type": "greenbone/generic-error",
+				"title": "Incident channel name already exists."
+			}`)
+	})
+}
@@ -0,0 +1,69 @@
+package usesCases
+
+import (
+	"net/http"
+	"testing"
+
+	"github.com/gin-gonic/gin"
+	"github.com/greenbone/keycloak-client-golang/auth"
+	"github.com/greenbone/opensight-golang-libraries/pkg/httpassert"
+	"github.com/greenbone/opensight-notification-service/pkg/services/notificationchannelservice"
+	"github.com/greenbone/opensight-notification-service/pkg/web/errmap"
+	"github.com/greenbone/opensight-notification-service/pkg/web/iam"
+	"github.com/greenbone/opensight-notification-service/pkg/web/incidentcontroller"
+	"github.com/greenbone/opensight-notification-service/pkg/web/integrationTests"
+	"github.com/greenbone/opensight-notification-service/pkg/web/testhelper"
+	"github.com/jmoiron/sqlx"
+	"github.com/stretchr/testify/require"
+)
+
+func setupTestRouter(t *testing.T) (*gin.Engine, *sqlx.DB) {
+	repo, db := testhelper.SetupNotificationChannelTestEnv(t)
+	svc := notificationchannelservice.NewNotificationChannelService(repo)
+	incidentSvc := notificationchannelservice.NewIncidentChannelService(svc, 20)
+	registry := errmap.NewRegistry()
+	router := testhelper.NewTestWebEngine(registry)
+
+	authMiddleware, err := auth.NewGinAuthMiddleware(integrationTests.NewTestJwtParser())
+	require.NoError(t, err)
+
+	incidentcontroller.NewIncidentController(router, svc, incidentSvc, authMiddleware, registry)
+
+	return router, db
+}
+
+func TestDeleteIncidentChannel(t *testing.T) {
+	t.Run("Delete an incident channel", func(t *testing.T) {
+		t.Parallel()
+
+		router, db := setupTestRouter(t)
+		defer db.Close()
+
+		var incidentId string
+
+		// Create incident channel
+		httpassert.New(t, router).Post("/notification-channel/incident").
+			AuthJwt(integrationTests.CreateJwtTokenWithRole(iam.NotificationAdmin)).
+			JsonContent(`{
+				"channelName": "incident1",
+				"routingKey": "R0UT1NGK3Y"
+			}`).
+			Expect().
+			StatusCode(http.StatusCreated).
+			JsonPath("$.id", httpassert.ExtractTo(&incidentId))
+		require.NotEmpty(t, incidentId)
+
+		// Delete incident channel
+		httpassert.New(t, router).Deletef("/notification-channel/incident/%s", incidentId).
+			AuthJwt(integrationTests.CreateJwtTokenWithRole(iam.NotificationAdmin)).
+			Expect().
+			StatusCode(http.StatusNoContent)
+
+		// List incident channels
+		httpassert.New(t, router).Get("/notification-channel/incident").
+			AuthJwt(integrationTests.CreateJwtTokenWithRole(iam.NotificationAdmin)).
+			Expect().
+			StatusCode(http.StatusOK).
+			Json(`[]`)
+	})
+}
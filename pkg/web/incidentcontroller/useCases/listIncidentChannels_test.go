@@ -0,0 +1,47 @@
+package usesCases
+
+import (
+	"net/http"
+	"testing"
+
+	"github.com/greenbone/opensight-golang-libraries/pkg/httpassert"
+	"github.com/greenbone/opensight-notification-service/pkg/web/iam"
+	"github.com/greenbone/opensight-notification-service/pkg/web/integrationTests"
+)
+
+func TestListIncidentChannels(t *testing.T) {
+	t.Run("List incident channels", func(t *testing.T) {
+		t.Parallel()
+
+		router, db := setupTestRouter(t)
+		defer db.Close()
+
+		// Create incident channel
+		httpassert.New(t, router).Post("/notification-channel/incident").
+			AuthJwt(integrationTests.CreateJwtTokenWithRole(iam.NotificationAdmin)).
+			JsonContent(`{
+				"channelName": "incident1",
+				"description": "This is a test incident channel",
+				"routingKey": "R0UT1NGK3Y"
+			}`).
+			Expect().
+			StatusCode(http.StatusCreated)
+
+		// List incident channels, the routing key is not returned
+		httpassert.New(t, router).Get("/notification-channel/incident").
+			AuthJwt(integrationTests.CreateJwtTokenWithRole(iam.NotificationAdmin)).
+			Expect().
+			StatusCode(http.StatusOK).
+			JsonTemplate(`[
+				{
+					"id": "fb46613b-4bf8-45c7-ad6f-e83e5ced8b81",
+					"channelName": "incident1",
+					"description": "This is a test incident channel",
+					"eventsUrl": "https://events.pagerduty.com/v2/enqueue",
+					"hasRoutingKey": true
+				}
+			]`, map[string]any{
+				"$.0.id": httpassert.IgnoreJsonValue,
+			})
+	})
+}
@@ -0,0 +1,92 @@
+package usesCases
+
+import (
+	"net/http"
+	"testing"
+
+	"github.com/greenbone/opensight-golang-libraries/pkg/httpassert"
+	"github.com/greenbone/opensight-notification-service/pkg/web/iam"
+	"github.com/greenbone/opensight-notification-service/pkg/web/integrationTests"
+	"github.com/stretchr/testify/require"
+)
+
+func TestUpdateIncidentChannel(t *testing.T) {
+	t.Run("Update incident channel keeps the routing key if omitted", func(t *testing.T) {
+		t.Parallel()
+
+		router, db := setupTestRouter(t)
+		defer db.Close()
+
+		var incidentId string
+
+		// Create incident channel
+		httpassert.New(t, router).Post("/notification-channel/incident").
+			AuthJwt(integrationTests.CreateJwtTokenWithRole(iam.NotificationAdmin)).
+			JsonContent(`{
+				"channelName": "incident1",
+				"routingKey": "R0UT1NGK3Y"
+			}`).
+			Expect().
+			StatusCode(http.StatusCreated).
+			JsonPath("$.id", httpassert.ExtractTo(&incidentId))
+		require.NotEmpty(t, incidentId)
+
+		// Update incident channel
+		httpassert.New(t, router).Putf("/notification-channel/incident/%s", incidentId).
+			AuthJwt(integrationTests.CreateJwtTokenWithRole(iam.NotificationAdmin)).
+			JsonContent(`{
+				"channelName": "incident2",
+				"description": "This is a test incident channel changed",
+				"eventsUrl": "https://alerts.example.com/v2/enqueue"
+			}`).
+			Expect().
+			StatusCode(http.StatusOK).
+			JsonTemplate(`{
+				"id": "fb46613b-4bf8-45c7-ad6f-e83e5ced8b81",
+				"channelName": "incident2",
+				"description": "This is a test incident channel changed",
+				"eventsUrl": "https://alerts.example.com/v2/enqueue",
+				"hasRoutingKey": true
+			}`, map[string]any{
+				"$.id": incidentId,
+			})
+	})
+
+	t.Run("Update incident channel with an empty routing key returns an error", func(t *testing.T) {
+		t.Parallel()
+
+		router, db := setupTestRouter(t)
+		defer db.Close()
+
+		var incidentId string
+
+		// Create incident channel
+		httpassert.New(t, router).Post("/notification-channel/incident").
+			AuthJwt(integrationTests.CreateJwtTokenWithRole(iam.NotificationAdmin)).
+			JsonContent(`{
+				"channelName": "incident1",
+				"routingKey": "R0UT1NGK3Y"
+			}`).
+			Expect().
+			StatusCode(http.StatusCreated).
+			JsonPath("$.id", httpassert.ExtractTo(&incidentId))
+		require.NotEmpty(t, incidentId)
+
+		// Update incident channel
+		httpassert.New(t, router).Putf("/notification-channel/incident/%s", incidentId).
+			AuthJwt(integrationTests.CreateJwtTokenWithRole(iam.NotificationAdmin)).
+			JsonContent(`{
+				"channelName": "incident1",
+				"routingKey": " "
+			}`).
+			Expect().
+			StatusCode(http.StatusBadRequest).
+			Json(`{
+				"type": "greenbone/validation-error",
+				"title": "",
+				"errors": {
+					"routingKey": "A routing key is required."
+				}
+			}`)
+	})
+}
@@ -30,6 +30,7 @@ const (
 	labelOccurrenceFilterField  = "Occurrence"
 	labelLevelFilterField       = "Level"
 	labelOriginFilterField      = "Origin"
+	labelReadStateFilterField   = "Read state"
 )
 
 // Compare operators
@@ -78,4 +79,8 @@ var (
 		Label: labelLevelFilterField,
 		Value: dtos.LevelFieldName,
 	}
+	ReadStateFilterRequestName = filter.ReadableValue[string]{
+		Label: labelReadStateFilterField,
+		Value: dtos.ReadStateFieldName,
+	}
 )
@@ -7,6 +7,7 @@ import (
 	"github.com/gin-gonic/gin"
 	"github.com/greenbone/opensight-golang-libraries/pkg/errorResponses"
 	"github.com/greenbone/opensight-notification-service/pkg/services/notificationchannelservice"
+	"github.com/greenbone/opensight-notification-service/pkg/translation"
 	"github.com/greenbone/opensight-notification-service/pkg/web/errmap"
 	"github.com/greenbone/opensight-notification-service/pkg/web/ginEx"
 	"github.com/greenbone/opensight-notification-service/pkg/web/iam"
@@ -54,6 +55,11 @@ func (mc *CheckMailServerController) configureMappings(r errmap.ErrorRegistry) {
 		http.StatusUnprocessableEntity,
 		errorResponses.NewErrorGenericResponse("Server is unreachable"),
 	)
+	r.Register(
+		notificationchannelservice.ErrMailOAuthToken,
+		http.StatusUnprocessableEntity,
+		errorResponses.NewErrorGenericResponse(translation.MailOAuthTokenFailed),
+	)
 }
 
 // CheckMailServer
@@ -49,7 +49,7 @@ func TestCheckMailServer(t *testing.T) {
 				"domain": "example.com",
 				"port": 123,
 				"isAuthenticationRequired": true,
-				"isTlsEnforced": false,
+				"tlsMode": "starttls",
 				"username": "testUser",
 				"password": "123"
 			}`).
@@ -103,7 +103,7 @@ func TestCheckMailServer(t *testing.T) {
 				"domain": "example.com",
 				"port": 123,
 				"isAuthenticationRequired": true,
-				"isTlsEnforced": false,
+				"tlsMode": "starttls",
 				"username": "",
 				"password": ""
 			}`).
@@ -120,6 +120,135 @@ func TestCheckMailServer(t *testing.T) {
 			}`)
 	})
 
+	t.Run("client credentials are required for xoauth2 instead of the password", func(t *testing.T) {
+		engine, _ := setup(t)
+
+		httpassert.New(t, engine).
+			Post("/notification-channel/mail/check").
+			Content(`{
+				"domain": "example.com",
+				"port": 587,
+				"isAuthenticationRequired": true,
+				"authMethod": "xoauth2",
+				"username": "alerts@example.com",
+				"oauthTokenUrl": "not a url",
+				"oauthScopes": ["https://outlook.office365.com/.default", "two scopes"]
+			}`).
+			AuthJwt(integrationTests.CreateJwtTokenWithRole(iam.NotificationAdmin)).
+			Expect().
+			StatusCode(http.StatusBadRequest).
+			Json(`{
+				"type": "greenbone/validation-error",
+				"title": "",
+				"errors": {
+					"oauthTokenUrl": "Please enter a valid token endpoint URL.",
+					"oauthClientId": "A client ID is required.",
+					"oauthClientSecret": "A client secret is required.",
+					"oauthScopes": "Scopes must not be longer than 256 characters and must not contain spaces."
+				}
+			}`)
+	})
+
+	t.Run("invalid authentication method", func(t *testing.T) {
+		engine, _ := setup(t)
+
+		httpassert.New(t, engine).
+			Post("/notification-channel/mail/check").
+			Content(`{
+				"domain": "example.com",
+				"port": 587,
+				"authMethod": "ntlm"
+			}`).
+			AuthJwt(integrationTests.CreateJwtTokenWithRole(iam.NotificationAdmin)).
+			Expect().
+			StatusCode(http.StatusBadRequest).
+			Json(`{
+				"type": "greenbone/validation-error",
+				"title": "",
+				"errors": {
+					"authMethod": "The authentication method must be password or xoauth2."
+				}
+			}`)
+	})
+
+	t.Run("invalid tls settings", func(t *testing.T) {
+		engine, _ := setup(t)
+
+		httpassert.New(t, engine).
+			Post("/notification-channel/mail/check").
+			Content(`{
+				"domain": "example.com",
+				"port": 587,
+				"tlsMode": "ssl",
+				"tlsMinVersion": "1.4",
+				"caCertificate": "not a certificate",
+				"certFingerprint": "AB:CD"
+			}`).
+			AuthJwt(integrationTests.CreateJwtTokenWithRole(iam.NotificationAdmin)).
+			Expect().
+			StatusCode(http.StatusBadRequest).
+			Json(`{
+				"type": "greenbone/validation-error",
+				"title": "",
+				"errors": {
+					"tlsMode": "The TLS mode must be implicit, starttls, opportunistic or none.",
+					"tlsMinVersion": "The minimum TLS version must be 1.0, 1.1, 1.2 or 1.3.",
+					"caCertificate": "Please enter valid PEM encoded CA certificates.",
+					"certFingerprint": "Please enter a valid SHA-256 fingerprint of the certificate."
+				}
+			}`)
+	})
+
+	t.Run("pinned certificate requires tls", func(t *testing.T) {
+		engine, _ := setup(t)
+
+		httpassert.New(t, engine).
+			Post("/notification-channel/mail/check").
+			Content(`{
+				"domain": "example.com",
+				"port": 25,
+				"tlsMode": "none",
+				"certFingerprint": "` + strings.Repeat("AB:", 31) + `AB"
+			}`).
+			AuthJwt(integrationTests.CreateJwtTokenWithRole(iam.NotificationAdmin)).
+			Expect().
+			StatusCode(http.StatusBadRequest).
+			Json(`{
+				"type": "greenbone/validation-error",
+				"title": "",
+				"errors": {
+					"certFingerprint": "CA certificates and fingerprints can only be used with TLS."
+				}
+			}`)
+	})
+
+	t.Run("return the error if no access token can be requested", func(t *testing.T) {
+		engine, notificationChannelServicer := setup(t)
+
+		notificationChannelServicer.EXPECT().CheckNotificationChannelConnectivity(mock.Anything, mock.Anything).
+			Return(notificationchannelservice.ErrMailOAuthToken)
+
+		httpassert.New(t, engine).
+			Post("/notification-channel/mail/check").
+			Content(`{
+				"domain": "example.com",
+				"port": 587,
+				"isAuthenticationRequired": true,
+				"authMethod": "xoauth2",
+				"username": "alerts@example.com",
+				"oauthTokenUrl": "https://login.example.com/tenant/oauth2/v2.0/token",
+				"oauthClientId": "client-id",
+				"oauthClientSecret": "client-secret"
+			}`).
+			AuthJwt(integrationTests.CreateJwtTokenWithRole(iam.NotificationAdmin)).
+			Expect().
+			StatusCode(http.StatusUnprocessableEntity).
+			Json(`{
+				"type": "greenbone/generic-error",
+				"title": "Unable to get an access token from the token endpoint."
+			}`)
+	})
+
 	t.Run("return the error if the mail server check fails", func(t *testing.T) {
 		engine, notificationChannelServicer := setup(t)
 
@@ -132,7 +261,7 @@ func TestCheckMailServer(t *testing.T) {
 				"domain": "example.com",
 				"port": 123,
 				"isAuthenticationRequired": false,
-				"isTlsEnforced": false
+				"tlsMode": "starttls"
 			}`).
 			AuthJwt(integrationTests.CreateJwtTokenWithRole(iam.NotificationAdmin)).
 			Expect().
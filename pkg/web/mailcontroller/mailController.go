@@ -8,6 +8,7 @@ import (
 	"github.com/greenbone/opensight-golang-libraries/pkg/errorResponses"
 	"github.com/greenbone/opensight-notification-service/pkg/models"
 	"github.com/greenbone/opensight-notification-service/pkg/services/notificationchannelservice"
+	"github.com/greenbone/opensight-notification-service/pkg/translation"
 	"github.com/greenbone/opensight-notification-service/pkg/web/errmap"
 	"github.com/greenbone/opensight-notification-service/pkg/web/ginEx"
 	"github.com/greenbone/opensight-notification-service/pkg/web/iam"
@@ -52,6 +53,11 @@ func (mc *MailController) configureMappings(r errmap.ErrorRegistry) {
 		http.StatusUnprocessableEntity,
 		errorResponses.NewErrorGenericResponse("Server is unreachable"),
 	)
+	r.Register(
+		notificationchannelservice.ErrMailOAuthToken,
+		http.StatusUnprocessableEntity,
+		errorResponses.NewErrorGenericResponse(translation.MailOAuthTokenFailed),
+	)
 }
 
 func (mc *MailController) registerRoutes(router gin.IRouter, auth gin.HandlerFunc) {
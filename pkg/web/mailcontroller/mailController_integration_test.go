@@ -43,7 +43,7 @@ func TestIntegration_MailController_CRUD(t *testing.T) {
 			JsonPath("$.domain", "example.com").
 			JsonPath("$.port", float64(25)).
 			JsonPath("$.isAuthenticationRequired", true).
-			JsonPath("$.isTlsEnforced", false).
+			JsonPath("$.tlsMode", "starttls").
 			JsonPath("$.username", "user").
 			JsonPath("$.maxEmailAttachmentSizeMb", float64(10)).
 			JsonPath("$.maxEmailIncludeSizeMb", float64(5)).
@@ -62,7 +62,7 @@ func TestIntegration_MailController_CRUD(t *testing.T) {
 			JsonPath("$[0].domain", "example.com").
 			JsonPath("$[0].port", float64(25)).
 			JsonPath("$[0].isAuthenticationRequired", true).
-			JsonPath("$[0].isTlsEnforced", false).
+			JsonPath("$[0].tlsMode", "starttls").
 			JsonPath("$[0].username", "user").
 			JsonPath("$[0].maxEmailAttachmentSizeMb", float64(10)).
 			JsonPath("$[0].maxEmailIncludeSizeMb", float64(5)).
@@ -84,7 +84,7 @@ func TestIntegration_MailController_CRUD(t *testing.T) {
 			JsonPath("$.domain", "example.com").
 			JsonPath("$.port", float64(25)).
 			JsonPath("$.isAuthenticationRequired", true).
-			JsonPath("$.isTlsEnforced", false).
+			JsonPath("$.tlsMode", "starttls").
 			JsonPath("$.username", "user").
 			JsonPath("$.maxEmailAttachmentSizeMb", float64(10)).
 			JsonPath("$.maxEmailIncludeSizeMb", float64(5)).
@@ -169,7 +169,7 @@ func TestIntegration_MailController_CRUD(t *testing.T) {
 func setupTestRouter(t *testing.T) (*gin.Engine, *sqlx.DB) {
 	repo, db := testhelper.SetupNotificationChannelTestEnv(t)
 	svc := notificationchannelservice.NewNotificationChannelService(repo)
-	mailService := notificationchannelservice.NewMailService()
+	mailService := notificationchannelservice.NewMailService(nil, http.DefaultClient)
 	mailSvc := notificationchannelservice.NewMailChannelService(svc, mailService, 1)
 
 	registry := errmap.NewRegistry()
@@ -32,7 +32,8 @@ func getValidNotificationChannel() models.NotificationChannel {
 		Domain:                   new("example.com"),
 		Port:                     new(25),
 		IsAuthenticationRequired: new(true),
-		IsTlsEnforced:            new(true),
+		MailTlsMode:              new(models.MailTlsImplicit),
+		MailTlsMinVersion:        new(models.MailTlsVersion12),
 		Username:                 new("user"),
 		Password:                 new("pass"),
 		MaxEmailAttachmentSizeMb: new(10),
@@ -1,17 +1,43 @@
 package maildto
 
-import "github.com/greenbone/opensight-notification-service/pkg/models"
+import (
+	"github.com/greenbone/opensight-notification-service/pkg/helper"
+	"github.com/greenbone/opensight-notification-service/pkg/models"
+)
 
 // MapNotificationChannelToMail maps NotificationChannel to MailNotificationChannelResponse.
+// Channels created before XOAUTH2 was supported authenticate with a password.
 func MapNotificationChannelToMail(channel models.NotificationChannel) MailNotificationChannelResponse {
+	authMethod := helper.SafeDereference(channel.MailAuthMethod)
+	if authMethod == "" {
+		authMethod = models.MailAuthPassword
+	}
+
+	tlsMode := helper.SafeDereference(channel.MailTlsMode)
+	if tlsMode == "" {
+		tlsMode = models.MailTlsStartTls
+	}
+
+	tlsMinVersion := helper.SafeDereference(channel.MailTlsMinVersion)
+	if tlsMinVersion == "" {
+		tlsMinVersion = models.MailTlsVersion12
+	}
+
 	return MailNotificationChannelResponse{
 		Id:                       channel.Id,
 		ChannelName:              channel.ChannelName,
 		Domain:                   *channel.Domain,
 		Port:                     *channel.Port,
 		IsAuthenticationRequired: *channel.IsAuthenticationRequired,
-		IsTlsEnforced:            *channel.IsTlsEnforced,
+		TlsMode:                  tlsMode,
+		TlsMinVersion:            tlsMinVersion,
+		CaCertificate:            channel.MailCaCertificate,
+		CertFingerprint:          channel.MailCertFingerprint,
+		AuthMethod:               authMethod,
 		Username:                 channel.Username,
+		OAuthTokenUrl:            channel.OAuthTokenUrl,
+		OAuthClientId:            channel.OAuthClientId,
+		OAuthScopes:              channel.OAuthScopes,
 		MaxEmailAttachmentSizeMb: channel.MaxEmailAttachmentSizeMb,
 		MaxEmailIncludeSizeMb:    channel.MaxEmailIncludeSizeMb,
 		SenderEmailAddress:       *channel.SenderEmailAddress,
@@ -26,9 +52,17 @@ func MapMailToNotificationChannel(mail MailNotificationChannelRequest) models.No
 		Domain:                   &mail.Domain,
 		Port:                     &mail.Port,
 		IsAuthenticationRequired: &mail.IsAuthenticationRequired,
-		IsTlsEnforced:            &mail.IsTlsEnforced,
+		MailTlsMode:              &mail.TlsMode,
+		MailTlsMinVersion:        &mail.TlsMinVersion,
+		MailCaCertificate:        helper.ToNullablePtr(mail.CaCertificate),
+		MailCertFingerprint:      helper.ToNullablePtr(mail.CertFingerprint),
+		MailAuthMethod:           &mail.AuthMethod,
 		Username:                 mail.Username,
 		Password:                 mail.Password,
+		OAuthTokenUrl:            helper.ToNullablePtr(mail.OAuthTokenUrl),
+		OAuthClientId:            helper.ToNullablePtr(mail.OAuthClientId),
+		OAuthClientSecret:        mail.OAuthClientSecret,
+		OAuthScopes:              mail.OAuthScopes,
 		MaxEmailAttachmentSizeMb: mail.MaxEmailAttachmentSizeMb,
 		MaxEmailIncludeSizeMb:    mail.MaxEmailIncludeSizeMb,
 		SenderEmailAddress:       &mail.SenderEmailAddress,
@@ -2,21 +2,34 @@ package maildto
 
 import (
 	"net/mail"
+	"slices"
 	"strings"
 
+	"github.com/greenbone/opensight-notification-service/pkg/helper"
 	"github.com/greenbone/opensight-notification-service/pkg/models"
+	"github.com/greenbone/opensight-notification-service/pkg/policy"
 	"github.com/greenbone/opensight-notification-service/pkg/translation"
 	"github.com/rs/zerolog/log"
 )
 
-// CheckMailServerRequest check mail server request
+// CheckMailServerRequest check mail server request.
+// With the xoauth2 authentication method the username is the mailbox to authenticate as, the access token
+// is requested from the token endpoint with the client credentials instead of using a password.
 type CheckMailServerRequest struct {
-	Domain                   string `json:"domain"`
-	Port                     int    `json:"port"`
-	IsAuthenticationRequired bool   `json:"isAuthenticationRequired" default:"false"`
-	IsTlsEnforced            bool   `json:"isTlsEnforced" default:"false"`
-	Username                 string `json:"username"`
-	Password                 string `json:"password"`
+	Domain                   string   `json:"domain"`
+	Port                     int      `json:"port"`
+	IsAuthenticationRequired bool     `json:"isAuthenticationRequired" default:"false"`
+	TlsMode                  string   `json:"tlsMode" enums:"implicit,starttls,opportunistic,none" default:"starttls"`
+	TlsMinVersion            string   `json:"tlsMinVersion" enums:"1.0,1.1,1.2,1.3" default:"1.2"`
+	CaCertificate            string   `json:"caCertificate"`
+	CertFingerprint          string   `json:"certFingerprint"`
+	AuthMethod               string   `json:"authMethod" enums:"password,xoauth2" default:"password"`
+	Username                 string   `json:"username"`
+	Password                 string   `json:"password"`
+	OAuthTokenUrl            string   `json:"oauthTokenUrl"`
+	OAuthClientId            string   `json:"oauthClientId"`
+	OAuthClientSecret        string   `json:"oauthClientSecret"`
+	OAuthScopes              []string `json:"oauthScopes"`
 }
 
 func (v CheckMailServerRequest) ToModel() models.NotificationChannel {
@@ -24,14 +37,27 @@ func (v CheckMailServerRequest) ToModel() models.NotificationChannel {
 		Domain:                   &v.Domain,
 		Port:                     &v.Port,
 		IsAuthenticationRequired: &v.IsAuthenticationRequired,
-		IsTlsEnforced:            &v.IsTlsEnforced,
+		MailTlsMode:              &v.TlsMode,
+		MailTlsMinVersion:        &v.TlsMinVersion,
+		MailCaCertificate:        &v.CaCertificate,
+		MailCertFingerprint:      &v.CertFingerprint,
+		MailAuthMethod:           &v.AuthMethod,
 		Username:                 &v.Username,
 		Password:                 &v.Password,
+		OAuthTokenUrl:            &v.OAuthTokenUrl,
+		OAuthClientId:            &v.OAuthClientId,
+		OAuthClientSecret:        &v.OAuthClientSecret,
+		OAuthScopes:              v.OAuthScopes,
 	}
 }
 
 func (r *CheckMailServerRequest) Cleanup() {
 	r.Domain = strings.TrimSpace(r.Domain)
+	r.AuthMethod, r.OAuthTokenUrl, r.OAuthClientId, r.OAuthScopes = cleanupMailAuth(
+		r.AuthMethod, r.OAuthTokenUrl, r.OAuthClientId, r.OAuthScopes)
+	r.TlsMode, r.TlsMinVersion, r.CaCertificate, r.CertFingerprint = cleanupMailTls(
+		r.TlsMode, r.TlsMinVersion, r.CaCertificate, r.CertFingerprint)
+	r.OAuthClientSecret = strings.TrimSpace(r.OAuthClientSecret)
 }
 
 func (v CheckMailServerRequest) Validate() models.ValidationErrors {
@@ -48,22 +74,38 @@ func (v CheckMailServerRequest) Validate() models.ValidationErrors {
 		if v.Username == "" {
 			errors["username"] = translation.UsernameIsRequired
 		}
-		if v.Password == "" {
+		if v.AuthMethod == models.MailAuthXOAuth2 {
+			validateOAuth(errors, v.OAuthTokenUrl, v.OAuthClientId, v.OAuthScopes)
+			if v.OAuthClientSecret == "" {
+				errors["oauthClientSecret"] = translation.ClientSecretIsRequired
+			}
+		} else if v.Password == "" {
 			errors["password"] = translation.PasswordIsRequired
 		}
 	}
+	validateMailAuthMethod(errors, v.AuthMethod)
+	validateMailTls(errors, v.TlsMode, v.TlsMinVersion, v.CaCertificate, v.CertFingerprint)
 
 	return errors
 }
 
-// CheckMailServerEntityRequest check mail server entity request
+// CheckMailServerEntityRequest check mail server entity request.
+// The stored password or client secret of the mail channel is used if none is given.
 type CheckMailServerEntityRequest struct {
-	Domain                   string `json:"domain"`
-	Port                     int    `json:"port"`
-	IsAuthenticationRequired bool   `json:"isAuthenticationRequired" default:"false"`
-	IsTlsEnforced            bool   `json:"isTlsEnforced" default:"false"`
-	Username                 string `json:"username"`
-	Password                 string `json:"password"`
+	Domain                   string   `json:"domain"`
+	Port                     int      `json:"port"`
+	IsAuthenticationRequired bool     `json:"isAuthenticationRequired" default:"false"`
+	TlsMode                  string   `json:"tlsMode" enums:"implicit,starttls,opportunistic,none" default:"starttls"`
+	TlsMinVersion            string   `json:"tlsMinVersion" enums:"1.0,1.1,1.2,1.3" default:"1.2"`
+	CaCertificate            string   `json:"caCertificate"`
+	CertFingerprint          string   `json:"certFingerprint"`
+	AuthMethod               string   `json:"authMethod" enums:"password,xoauth2" default:"password"`
+	Username                 string   `json:"username"`
+	Password                 string   `json:"password"`
+	OAuthTokenUrl            string   `json:"oauthTokenUrl"`
+	OAuthClientId            string   `json:"oauthClientId"`
+	OAuthClientSecret        string   `json:"oauthClientSecret"`
+	OAuthScopes              []string `json:"oauthScopes"`
 }
 
 func (v CheckMailServerEntityRequest) ToModel() models.NotificationChannel {
@@ -71,14 +113,27 @@ func (v CheckMailServerEntityRequest) ToModel() models.NotificationChannel {
 		Domain:                   &v.Domain,
 		Port:                     &v.Port,
 		IsAuthenticationRequired: &v.IsAuthenticationRequired,
-		IsTlsEnforced:            &v.IsTlsEnforced,
+		MailTlsMode:              &v.TlsMode,
+		MailTlsMinVersion:        &v.TlsMinVersion,
+		MailCaCertificate:        &v.CaCertificate,
+		MailCertFingerprint:      &v.CertFingerprint,
+		MailAuthMethod:           &v.AuthMethod,
 		Username:                 &v.Username,
 		Password:                 &v.Password,
+		OAuthTokenUrl:            &v.OAuthTokenUrl,
+		OAuthClientId:            &v.OAuthClientId,
+		OAuthClientSecret:        &v.OAuthClientSecret,
+		OAuthScopes:              v.OAuthScopes,
 	}
 }
 
 func (r *CheckMailServerEntityRequest) Cleanup() {
 	r.Domain = strings.TrimSpace(r.Domain)
+	r.AuthMethod, r.OAuthTokenUrl, r.OAuthClientId, r.OAuthScopes = cleanupMailAuth(
+		r.AuthMethod, r.OAuthTokenUrl, r.OAuthClientId, r.OAuthScopes)
+	r.TlsMode, r.TlsMinVersion, r.CaCertificate, r.CertFingerprint = cleanupMailTls(
+		r.TlsMode, r.TlsMinVersion, r.CaCertificate, r.CertFingerprint)
+	r.OAuthClientSecret = strings.TrimSpace(r.OAuthClientSecret)
 }
 
 func (v CheckMailServerEntityRequest) Validate() models.ValidationErrors {
@@ -95,30 +150,51 @@ func (v CheckMailServerEntityRequest) Validate() models.ValidationErrors {
 		if v.Username == "" {
 			errs["username"] = translation.UsernameIsRequired
 		}
+		if v.AuthMethod == models.MailAuthXOAuth2 {
+			validateOAuth(errs, v.OAuthTokenUrl, v.OAuthClientId, v.OAuthScopes)
+		}
 	}
+	validateMailAuthMethod(errs, v.AuthMethod)
+	validateMailTls(errs, v.TlsMode, v.TlsMinVersion, v.CaCertificate, v.CertFingerprint)
 
 	return errs
 }
 
-// MailNotificationChannelRequest mail notification channel request
+// MailNotificationChannelRequest mail notification channel request.
+// Like the password, the client secret is write-only and not changed if omitted on update.
 type MailNotificationChannelRequest struct {
-	Id                       string  `json:"id" readonly:"true"`
-	ChannelName              string  `json:"channelName"`
-	Domain                   string  `json:"domain"`
-	Port                     int     `json:"port"`
-	IsAuthenticationRequired bool    `json:"isAuthenticationRequired" default:"false"`
-	IsTlsEnforced            bool    `json:"isTlsEnforced" default:"false"`
-	Username                 *string `json:"username,omitempty"`
-	Password                 *string `json:"password,omitempty"`
-	MaxEmailAttachmentSizeMb *int    `json:"maxEmailAttachmentSizeMb,omitempty"`
-	MaxEmailIncludeSizeMb    *int    `json:"maxEmailIncludeSizeMb,omitempty"`
-	SenderEmailAddress       string  `json:"senderEmailAddress"`
+	Id                       string   `json:"id" readonly:"true"`
+	ChannelName              string   `json:"channelName"`
+	Domain                   string   `json:"domain"`
+	Port                     int      `json:"port"`
+	IsAuthenticationRequired bool     `json:"isAuthenticationRequired" default:"false"`
+	TlsMode                  string   `json:"tlsMode" enums:"implicit,starttls,opportunistic,none" default:"starttls"`
+	TlsMinVersion            string   `json:"tlsMinVersion" enums:"1.0,1.1,1.2,1.3" default:"1.2"`
+	CaCertificate            string   `json:"caCertificate,omitempty"`
+	CertFingerprint          string   `json:"certFingerprint,omitempty"`
+	AuthMethod               string   `json:"authMethod" enums:"password,xoauth2" default:"password"`
+	Username                 *string  `json:"username,omitempty"`
+	Password                 *string  `json:"password,omitempty"`
+	OAuthTokenUrl            string   `json:"oauthTokenUrl,omitempty"`
+	OAuthClientId            string   `json:"oauthClientId,omitempty"`
+	OAuthClientSecret        *string  `json:"oauthClientSecret,omitempty"`
+	OAuthScopes              []string `json:"oauthScopes,omitempty"`
+	MaxEmailAttachmentSizeMb *int     `json:"maxEmailAttachmentSizeMb,omitempty"`
+	MaxEmailIncludeSizeMb    *int     `json:"maxEmailIncludeSizeMb,omitempty"`
+	SenderEmailAddress       string   `json:"senderEmailAddress"`
 }
 
 func (r *MailNotificationChannelRequest) Cleanup() {
 	r.Domain = strings.TrimSpace(r.Domain)
 	r.SenderEmailAddress = strings.TrimSpace(r.SenderEmailAddress)
 	r.ChannelName = strings.TrimSpace(r.ChannelName)
+	r.AuthMethod, r.OAuthTokenUrl, r.OAuthClientId, r.OAuthScopes = cleanupMailAuth(
+		r.AuthMethod, r.OAuthTokenUrl, r.OAuthClientId, r.OAuthScopes)
+	r.TlsMode, r.TlsMinVersion, r.CaCertificate, r.CertFingerprint = cleanupMailTls(
+		r.TlsMode, r.TlsMinVersion, r.CaCertificate, r.CertFingerprint)
+	if r.OAuthClientSecret != nil {
+		r.OAuthClientSecret = helper.ToPtr(strings.TrimSpace(*r.OAuthClientSecret))
+	}
 }
 
 func (r MailNotificationChannelRequest) Validate() models.ValidationErrors {
@@ -146,5 +222,102 @@ func (r MailNotificationChannelRequest) Validate() models.ValidationErrors {
 		errMap["channelName"] = translation.ChannelNameIsRequired
 	}
 
+	if r.IsAuthenticationRequired && r.AuthMethod == models.MailAuthXOAuth2 {
+		if helper.SafeDereference(r.Username) == "" {
+			errMap["username"] = translation.UsernameIsRequired
+		}
+		validateOAuth(errMap, r.OAuthTokenUrl, r.OAuthClientId, r.OAuthScopes)
+	}
+	validateMailAuthMethod(errMap, r.AuthMethod)
+	validateMailTls(errMap, r.TlsMode, r.TlsMinVersion, r.CaCertificate, r.CertFingerprint)
+
 	return errMap
 }
+
+// cleanupMailAuth defaults the authentication method to password and drops empty scopes
+func cleanupMailAuth(authMethod, tokenUrl, clientId string, scopes []string) (string, string, string, []string) {
+	authMethod = strings.ToLower(strings.TrimSpace(authMethod))
+	if authMethod == "" {
+		authMethod = models.MailAuthPassword
+	}
+
+	cleanScopes := make([]string, 0, len(scopes))
+	for _, scope := range scopes {
+		if scope = strings.TrimSpace(scope); scope != "" {
+			cleanScopes = append(cleanScopes, scope)
+		}
+	}
+	return authMethod, strings.TrimSpace(tokenUrl), strings.TrimSpace(clientId), cleanScopes
+}
+
+func validateMailAuthMethod(errs models.ValidationErrors, authMethod string) {
+	if !slices.Contains(models.AllowedMailAuthMethods, authMethod) {
+		errs["authMethod"] = translation.InvalidMailAuthMethod
+	}
+}
+
+// validateOAuth checks the settings of the client credentials flow, except the client secret
+func validateOAuth(errs models.ValidationErrors, tokenUrl, clientId string, scopes []string) {
+	if tokenUrl == "" {
+		errs["oauthTokenUrl"] = translation.TokenUrlIsRequired
+	} else if _, err := policy.WebhookUrlPolicy(tokenUrl); err != nil {
+		errs["oauthTokenUrl"] = translation.ValidTokenUrlIsRequired
+	}
+
+	if clientId == "" {
+		errs["oauthClientId"] = translation.ClientIdIsRequired
+	}
+
+	if len(scopes) > models.MaxOAuthScopes {
+		errs["oauthScopes"] = translation.TooManyOAuthScopes
+	}
+	for _, scope := range scopes {
+		if err := policy.OAuthScopePolicy(scope); err != nil {
+			errs["oauthScopes"] = translation.InvalidOAuthScope
+			break
+		}
+	}
+}
+
+// cleanupMailTls defaults to required STARTTLS with at least TLS 1.2. The fingerprint is accepted as
+// printed by openssl, with colons and in upper case.
+func cleanupMailTls(tlsMode, minVersion, caCertificate, fingerprint string) (string, string, string, string) {
+	tlsMode = strings.ToLower(strings.TrimSpace(tlsMode))
+	if tlsMode == "" {
+		tlsMode = models.MailTlsStartTls
+	}
+
+	minVersion = strings.TrimSpace(minVersion)
+	if minVersion == "" {
+		minVersion = models.MailTlsVersion12
+	}
+
+	fingerprint = strings.ToLower(strings.NewReplacer(":", "", " ", "").Replace(strings.TrimSpace(fingerprint)))
+	return tlsMode, minVersion, strings.TrimSpace(caCertificate), fingerprint
+}
+
+func validateMailTls(errs models.ValidationErrors, tlsMode, minVersion, caCertificate, fingerprint string) {
+	if !slices.Contains(models.AllowedMailTlsModes, tlsMode) {
+		errs["tlsMode"] = translation.InvalidMailTlsMode
+	}
+
+	if !slices.Contains(models.AllowedMailTlsVersions, minVersion) {
+		errs["tlsMinVersion"] = translation.InvalidMailTlsMinVersion
+	}
+
+	if caCertificate != "" {
+		if _, err := policy.CaCertificatePolicy(caCertificate); err != nil {
+			errs["caCertificate"] = translation.InvalidCaCertificate
+		} else if tlsMode == models.MailTlsNone {
+			errs["caCertificate"] = translation.CertificatesRequireTls
+		}
+	}
+
+	if fingerprint != "" {
+		if err := policy.CertFingerprintPolicy(fingerprint); err != nil {
+			errs["certFingerprint"] = translation.InvalidCertFingerprint
+		} else if tlsMode == models.MailTlsNone {
+			errs["certFingerprint"] = translation.CertificatesRequireTls
+		}
+	}
+}
@@ -1,14 +1,21 @@
 package maildto
 
 type MailNotificationChannelResponse struct {
-	Id                       string  `json:"id,omitempty"`
-	ChannelName              string  `json:"channelName"`
-	Domain                   string  `json:"domain"`
-	Port                     int     `json:"port"`
-	IsAuthenticationRequired bool    `json:"isAuthenticationRequired" default:"false"`
-	IsTlsEnforced            bool    `json:"isTlsEnforced" default:"false"`
-	Username                 *string `json:"username,omitempty"`
-	MaxEmailAttachmentSizeMb *int    `json:"maxEmailAttachmentSizeMb,omitempty"`
-	MaxEmailIncludeSizeMb    *int    `json:"maxEmailIncludeSizeMb,omitempty"`
-	SenderEmailAddress       string  `json:"senderEmailAddress"`
+	Id                       string   `json:"id,omitempty"`
+	ChannelName              string   `json:"channelName"`
+	Domain                   string   `json:"domain"`
+	Port                     int      `json:"port"`
+	IsAuthenticationRequired bool     `json:"isAuthenticationRequired" default:"false"`
+	TlsMode                  string   `json:"tlsMode"`
+	TlsMinVersion            string   `json:"tlsMinVersion"`
+	CaCertificate            *string  `json:"caCertificate,omitempty"`
+	CertFingerprint          *string  `json:"certFingerprint,omitempty"`
+	AuthMethod               string   `json:"authMethod"`
+	Username                 *string  `json:"username,omitempty"`
+	OAuthTokenUrl            *string  `json:"oauthTokenUrl,omitempty"`
+	OAuthClientId            *string  `json:"oauthClientId,omitempty"`
+	OAuthScopes              []string `json:"oauthScopes,omitempty"`
+	MaxEmailAttachmentSizeMb *int     `json:"maxEmailAttachmentSizeMb,omitempty"`
+	MaxEmailIncludeSizeMb    *int     `json:"maxEmailIncludeSizeMb,omitempty"`
+	SenderEmailAddress       string   `json:"senderEmailAddress"`
 }
@@ -0,0 +1,208 @@
+package matrixcontroller
+
+import (
+	"errors"
+	"net/http"
+
+	"github.com/gin-gonic/gin"
+	"github.com/greenbone/opensight-golang-libraries/pkg/errorResponses"
+	"github.com/greenbone/opensight-notification-service/pkg/models"
+	"github.com/greenbone/opensight-notification-service/pkg/services/notificationchannelservice"
+	"github.com/greenbone/opensight-notification-service/pkg/translation"
+	"github.com/greenbone/opensight-notification-service/pkg/web/errmap"
+	"github.com/greenbone/opensight-notification-service/pkg/web/ginEx"
+	"github.com/greenbone/opensight-notification-service/pkg/web/iam"
+	"github.com/greenbone/opensight-notification-service/pkg/web/matrixcontroller/matrixdto"
+	"github.com/greenbone/opensight-notification-service/pkg/web/middleware"
+)
+
+type MatrixController struct {
+	notificationChannelServicer notificationchannelservice.NotificationChannelService
+	matrixChannelService        notificationchannelservice.MatrixChannelService
+}
+
+func NewMatrixController(
+	router gin.IRouter,
+	notificationChannelServicer notificationchannelservice.NotificationChannelService,
+	matrixChannelService notificationchannelservice.MatrixChannelService,
+	auth gin.HandlerFunc,
+	registry *errmap.Registry,
+) *MatrixController {
+	ctrl := &MatrixController{
+		notificationChannelServicer: notificationChannelServicer,
+		matrixChannelService:        matrixChannelService,
+	}
+
+	group := router.Group("/notification-channel/matrix").
+		Use(middleware.AuthorizeRoles(auth, iam.OsiAdmin, iam.NotificationAdmin)...)
+	group.Use(errorHandler(gin.ErrorTypePrivate))
+
+	group.POST("", ctrl.createMatrixChannel)
+	group.GET("", ctrl.listMatrixChannels)
+	group.PUT("/:id", ctrl.updateMatrixChannel)
+	group.DELETE("/:id", ctrl.deleteMatrixChannel)
+	group.POST("/check", ctrl.sendMatrixTestMessage)
+
+	ctrl.configureMappings(registry)
+	return ctrl
+}
+
+func errorHandler(errorType gin.ErrorType) gin.HandlerFunc {
+	return func(c *gin.Context) {
+		c.Next()
+		for _, errorValue := range c.Errors.ByType(errorType) {
+			if errors.Is(errorValue, notificationchannelservice.ErrMatrixMessageDelivery) {
+				c.AbortWithStatusJSON(http.StatusBadRequest, errorResponses.NewErrorGenericResponse(errorValue.Error()))
+				return
+			}
+		}
+	}
+}
+
+func (mc *MatrixController) configureMappings(r *errmap.Registry) {
+	r.Register(
+		notificationchannelservice.ErrMatrixChannelLimitReached,
+		http.StatusUnprocessableEntity,
+		errorResponses.NewErrorGenericResponse(translation.MatrixChannelLimitReached),
+	)
+	r.Register(
+		notificationchannelservice.ErrListMatrixChannels,
+		http.StatusInternalServerError,
+		errorResponses.ErrorInternalResponse,
+	)
+	r.Register(
+		notificationchannelservice.ErrMatrixChannelNameExists,
+		http.StatusBadRequest,
+		errorResponses.NewErrorGenericResponse(translation.MatrixChannelNameAlreadyExist),
+	)
+	r.Register(
+		notificationchannelservice.ErrMatrixAccessTokenIsRequired,
+		http.StatusBadRequest,
+		errorResponses.NewErrorGenericResponse(translation.AccessTokenIsRequired),
+	)
+}
+
+// CreateMatrixChannel
+//
+//	@Summary		Create Matrix Channel
+//	@Description	Create a new Matrix notification channel posting to a room
+//	@Tags			matrix-channel
+//	@Accept			json
+//	@Produce		json
+//	@Security		KeycloakAuth
+//	@Param			MatrixChannel	body		matrixdto.MatrixNotificationChannelRequest	true	"Matrix channel to add"
+//	@Success		201			{object}	matrixdto.MatrixNotificationChannelResponse
+//	@Failure		400			{object}	map[string]string
+//	@Failure		500			{object}	map[string]string
+//	@Router			/notification-channel/matrix [post]
+func (mc *MatrixController) createMatrixChannel(c *gin.Context) {
+	var channel matrixdto.MatrixNotificationChannelRequest
+	if !ginEx.BindAndValidateBody(c, &channel) {
+		return
+	}
+
+	matrixChannel, err := mc.matrixChannelService.CreateMatrixChannel(c, channel)
+	if ginEx.AddError(c, err) {
+		return
+	}
+
+	c.JSON(http.StatusCreated, matrixChannel)
+}
+
+// ListMatrixChannels
+//
+//	@Summary		List Matrix Channels
+//	@Description	List Matrix notification channels
+//	@Tags			matrix-channel
+//	@Produce		json
+//	@Security		KeycloakAuth
+//	@Success		200		{array}		matrixdto.MatrixNotificationChannelResponse
+//	@Failure		500		{object}	map[string]string
+//	@Router			/notification-channel/matrix [get]
+func (mc *MatrixController) listMatrixChannels(c *gin.Context) {
+	channels, err := mc.notificationChannelServicer.ListNotificationChannelsByType(c, models.ChannelTypeMatrix)
+	if ginEx.AddError(c, err) {
+		return
+	}
+
+	c.JSON(http.StatusOK, matrixdto.MapNotificationChannelsToMatrix(channels))
+}
+
+// UpdateMatrixChannel
+//
+//	@Summary		Update Matrix Channel
+//	@Description	Update an existing Matrix notification channel
+//	@Tags			matrix-channel
+//	@Accept			json
+//	@Produce		json
+//	@Security		KeycloakAuth
+//	@Param			id			path		string						true	"Matrix channel ID"
+//	@Param			MatrixChannel	body		matrixdto.MatrixNotificationChannelRequest	true	"Matrix channel to update"
+//	@Success		200			{object}	matrixdto.MatrixNotificationChannelResponse
+//	@Failure		400			{object}	map[string]string
+//	@Failure		404 		{object}    map[string]string
+//	@Failure		500			{object}	map[string]string
+//	@Router			/notification-channel/matrix/{id} [put]
+func (mc *MatrixController) updateMatrixChannel(c *gin.Context) {
+	id := c.Param("id")
+
+	var channel matrixdto.MatrixNotificationChannelRequest
+	if !ginEx.BindAndValidateBody(c, &channel) {
+		return
+	}
+
+	updated, err := mc.matrixChannelService.UpdateMatrixChannel(c, id, channel)
+	if ginEx.AddError(c, err) {
+		return
+	}
+
+	c.JSON(http.StatusOK, updated)
+}
+
+// DeleteMatrixChannel
+//
+//		@Summary		Delete Matrix Channel
+//		@Description	Delete a Matrix notification channel
+//		@Tags			matrix-channel
+//		@Security		KeycloakAuth
+//		@Param			id	path	string	true	"Matrix channel ID"
+//		@Success		204	"Deleted successfully"
+//		@Failure		500	{object}	map[string]string
+//	    @Failure		404 {object}    map[string]string
+//		@Router			/notification-channel/matrix/{id} [delete]
+func (mc *MatrixController) deleteMatrixChannel(c *gin.Context) {
+	id := c.Param("id")
+
+	err := mc.notificationChannelServicer.DeleteNotificationChannel(c, id)
+	if ginEx.AddError(c, err) {
+		return
+	}
+
+	c.Status(http.StatusNoContent)
+}
+
+// SendMatrixTestMessage
+//
+//	@Summary		Check Matrix room
+//	@Description	Check if a test message can be posted to the Matrix room
+//	@Tags			matrix-channel
+//	@Accept			json
+//	@Produce		json
+//	@Security		KeycloakAuth
+//	@Param			MatrixChannel	body	matrixdto.MatrixNotificationChannelCheckRequest	true	"Matrix room to check"
+//	@Success		204 "Matrix test message sent successfully"
+//	@Failure		400			{object}	map[string]string
+//	@Router			/notification-channel/matrix/check [post]
+func (mc *MatrixController) sendMatrixTestMessage(c *gin.Context) {
+	var channel matrixdto.MatrixNotificationChannelCheckRequest
+	if !ginEx.BindAndValidateBody(c, &channel) {
+		return
+	}
+
+	err := mc.matrixChannelService.SendMatrixTestMessage(matrixdto.MapMatrixCheckToNotificationChannel(channel))
+	if ginEx.AddError(c, err) {
+		return
+	}
+
+	c.Status(http.StatusNoContent)
+}
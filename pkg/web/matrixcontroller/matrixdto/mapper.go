@@ -0,0 +1,48 @@
+package matrixdto
+
+import (
+	"github.com/greenbone/opensight-notification-service/pkg/helper"
+	"github.com/greenbone/opensight-notification-service/pkg/models"
+)
+
+// MapNotificationChannelToMatrix maps NotificationChannel to MatrixNotificationChannelResponse.
+func MapNotificationChannelToMatrix(channel models.NotificationChannel) MatrixNotificationChannelResponse {
+	return MatrixNotificationChannelResponse{
+		Id:             channel.Id,
+		ChannelName:    channel.ChannelName,
+		Description:    helper.SafeDereference(channel.Description),
+		HomeserverUrl:  helper.SafeDereference(channel.WebhookUrl),
+		RoomId:         helper.SafeDereference(channel.MatrixRoomId),
+		HasAccessToken: helper.SafeDereference(channel.AccessToken) != "",
+	}
+}
+
+func MapMatrixToNotificationChannel(channel MatrixNotificationChannelRequest) models.NotificationChannel {
+	return models.NotificationChannel{
+		ChannelType:  models.ChannelTypeMatrix,
+		ChannelName:  channel.ChannelName,
+		Description:  &channel.Description,
+		WebhookUrl:   &channel.HomeserverUrl,
+		MatrixRoomId: &channel.RoomId,
+		AccessToken:  channel.AccessToken,
+	}
+}
+
+// MapMatrixCheckToNotificationChannel maps the check request to the channel the test message is sent to.
+func MapMatrixCheckToNotificationChannel(channel MatrixNotificationChannelCheckRequest) models.NotificationChannel {
+	return models.NotificationChannel{
+		ChannelType:  models.ChannelTypeMatrix,
+		WebhookUrl:   &channel.HomeserverUrl,
+		MatrixRoomId: &channel.RoomId,
+		AccessToken:  &channel.AccessToken,
+	}
+}
+
+// MapNotificationChannelsToMatrix maps a slice of NotificationChannel to MatrixNotificationChannelResponse.
+func MapNotificationChannelsToMatrix(channels []models.NotificationChannel) []MatrixNotificationChannelResponse {
+	matrixChannels := make([]MatrixNotificationChannelResponse, 0, len(channels))
+	for _, ch := range channels {
+		matrixChannels = append(matrixChannels, MapNotificationChannelToMatrix(ch))
+	}
+	return matrixChannels
+}
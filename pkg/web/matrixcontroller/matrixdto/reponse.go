@@ -0,0 +1,12 @@
+package matrixdto
+
+// MatrixNotificationChannelResponse matrix notification channel response.
+// The access token is never returned, hasAccessToken tells whether it is set.
+type MatrixNotificationChannelResponse struct {
+	Id             string `json:"id"`
+	ChannelName    string `json:"channelName"`
+	Description    string `json:"description"`
+	HomeserverUrl  string `json:"homeserverUrl"`
+	RoomId         string `json:"roomId"`
+	HasAccessToken bool   `json:"hasAccessToken"`
+}
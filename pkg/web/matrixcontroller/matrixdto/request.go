@@ -0,0 +1,92 @@
+package matrixdto
+
+import (
+	"strings"
+
+	"github.com/greenbone/opensight-notification-service/pkg/models"
+	"github.com/greenbone/opensight-notification-service/pkg/policy"
+	"github.com/greenbone/opensight-notification-service/pkg/translation"
+)
+
+// MatrixNotificationChannelRequest matrix notification channel request.
+// The messages are posted to the room with the access token of a user which joined the room.
+// The access token is write-only: it is not changed if omitted on update, an empty token removes it.
+type MatrixNotificationChannelRequest struct {
+	ChannelName   string  `json:"channelName"`
+	Description   string  `json:"description"`
+	HomeserverUrl string  `json:"homeserverUrl"`
+	RoomId        string  `json:"roomId"`
+	AccessToken   *string `json:"accessToken"`
+}
+
+func (r *MatrixNotificationChannelRequest) Cleanup() {
+	r.ChannelName = strings.TrimSpace(r.ChannelName)
+	r.Description = strings.TrimSpace(r.Description)
+	r.HomeserverUrl = strings.TrimSpace(r.HomeserverUrl)
+	r.RoomId = strings.TrimSpace(r.RoomId)
+	if r.AccessToken != nil {
+		token := strings.TrimSpace(*r.AccessToken)
+		r.AccessToken = &token
+	}
+}
+
+func (r MatrixNotificationChannelRequest) Validate() models.ValidationErrors {
+	errs := make(models.ValidationErrors)
+
+	if r.ChannelName == "" {
+		errs["channelName"] = translation.ChannelNameIsRequired
+	}
+
+	validateMatrixRoom(errs, r.HomeserverUrl, r.RoomId)
+
+	if r.AccessToken != nil && *r.AccessToken != "" {
+		if err := policy.AccessTokenPolicy(*r.AccessToken); err != nil {
+			errs["accessToken"] = translation.InvalidAccessToken
+		}
+	}
+
+	return errs
+}
+
+// MatrixNotificationChannelCheckRequest matrix notification channel check request
+type MatrixNotificationChannelCheckRequest struct {
+	HomeserverUrl string `json:"homeserverUrl"`
+	RoomId        string `json:"roomId"`
+	AccessToken   string `json:"accessToken"`
+}
+
+func (r *MatrixNotificationChannelCheckRequest) Cleanup() {
+	r.HomeserverUrl = strings.TrimSpace(r.HomeserverUrl)
+	r.RoomId = strings.TrimSpace(r.RoomId)
+	r.AccessToken = strings.TrimSpace(r.AccessToken)
+}
+
+func (r *MatrixNotificationChannelCheckRequest) Validate() models.ValidationErrors {
+	errs := make(models.ValidationErrors)
+
+	validateMatrixRoom(errs, r.HomeserverUrl, r.RoomId)
+
+	if r.AccessToken == "" {
+		errs["accessToken"] = translation.AccessTokenIsRequired
+	} else if err := policy.AccessTokenPolicy(r.AccessToken); err != nil {
+		errs["accessToken"] = translation.InvalidAccessToken
+	}
+
+	return errs
+}
+
+func validateMatrixRoom(errs models.ValidationErrors, homeserverUrl, roomId string) {
+	if homeserverUrl == "" {
+		errs["homeserverUrl"] = translation.HomeserverUrlIsRequired
+	} else {
+		if _, err := policy.WebhookUrlPolicy(homeserverUrl); err != nil {
+			errs["homeserverUrl"] = translation.ValidHomeserverUrlIsRequired
+		}
+	}
+
+	if roomId == "" {
+		errs["roomId"] = translation.RoomIdIsRequired
+	} else if err := policy.MatrixRoomIdPolicy(roomId); err != nil {
+		errs["roomId"] = translation.InvalidRoomId
+	}
+}
@@ -0,0 +1,139 @@
+package usesCases
+
+import (
+	"io"
+	"net/http"
+	"testing"
+
+	"github.com/gin-gonic/gin"
+	"github.com/greenbone/keycloak-client-golang/auth"
+	"github.com/greenbone/opensight-golang-libraries/pkg/httpassert"
+	"github.com/greenbone/opensight-notification-service/pkg/services/notificationchannelservice"
+	"github.com/greenbone/opensight-notification-service/pkg/web/errmap"
+	"github.com/greenbone/opensight-notification-service/pkg/web/iam"
+	"github.com/greenbone/opensight-notification-service/pkg/web/integrationTests"
+	"github.com/greenbone/opensight-notification-service/pkg/web/matrixcontroller"
+	"github.com/greenbone/opensight-notification-service/pkg/web/testhelper"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+type roundTripperFunc func(*http.Request) (*http.Response, error)
+
+func (f roundTripperFunc) RoundTrip(req *http.Request) (*http.Response, error) {
+	return f(req)
+}
+
+func setup(t *testing.T, transport http.Client) *gin.Engine {
+	t.Helper()
+
+	repo, db := testhelper.SetupNotificationChannelTestEnv(t)
+	svc := notificationchannelservice.NewNotificationChannelService(repo)
+	matrixService := notificationchannelservice.NewMatrixService(&transport)
+	matrixChannelSvc := notificationchannelservice.NewMatrixChannelService(svc, 20, matrixService)
+	registry := errmap.NewRegistry()
+	router := testhelper.NewTestWebEngine(registry)
+
+	authMiddleware, err := auth.NewGinAuthMiddleware(integrationTests.NewTestJwtParser())
+	require.NoError(t, err)
+
+	matrixcontroller.NewMatrixController(router, svc, matrixChannelSvc, authMiddleware, registry)
+	defer db.Close()
+	return router
+}
+
+func TestCheckMatrixChannel(t *testing.T) {
+	t.Run("Check matrix channel", func(t *testing.T) {
+		t.Parallel()
+
+		var gotRequest *http.Request
+		var gotBody []byte
+		transport := http.Client{
+			Transport: roundTripperFunc(func(r *http.Request) (*http.Response, error) {
+				gotRequest = r
+				gotBody, _ = io.ReadAll(r.Body)
+				return &http.Response{
+					StatusCode: http.StatusOK,
+					Body:       http.NoBody,
+					Header:     make(http.Header),
+				}, nil
+			}),
+		}
+		router := setup(t, transport)
+
+		// Check matrix channel
+		httpassert.New(t, router).Post("/notification-channel/matrix/check").
+			AuthJwt(integrationTests.CreateJwtTokenWithRole(iam.NotificationAdmin)).
+			JsonContent(`{
+				"homeserverUrl": "https://matrix.example.org/",
+				"roomId": "!room:example.org",
+				"accessToken": "syt_abc"
+			}`).
+			Expect().
+			StatusCode(http.StatusNoContent)
+
+		require.NotNil(t, gotRequest)
+		assert.Equal(t, http.MethodPut, gotRequest.Method)
+		assert.Regexp(t, `^/_matrix/client/v3/rooms/!room:example.org/send/m.room.message/[-0-9a-f]{36}$`, gotRequest.URL.Path)
+		assert.Equal(t, "Bearer syt_abc", gotRequest.Header.Get("Authorization"))
+		assert.Contains(t, string(gotBody), `"format":"org.matrix.custom.html"`)
+	})
+
+	t.Run("Check matrix channel with homeserver response 403", func(t *testing.T) {
+		t.Parallel()
+
+		transport := http.Client{
+			Transport: roundTripperFunc(func(r *http.Request) (*http.Response, error) {
+				return &http.Response{
+					StatusCode: http.StatusForbidden,
+					Body:       http.NoBody,
+					Header:     make(http.Header),
+					Status:     "403 Forbidden",
+				}, nil
+			}),
+		}
+		router := setup(t, transport)
+
+		// Check matrix channel
+		httpassert.New(t, router).Post("/notification-channel/matrix/check").
+			AuthJwt(integrationTests.CreateJwtTokenWithRole(iam.NotificationAdmin)).
+			JsonContent(`{
+				"homeserverUrl": "https://matrix.example.org",
+				"roomId": "!room:example.org",
+				"accessToken": "syt_abc"
+			}`).
+			Expect().
+			StatusCode(http.StatusBadRequest).
+			Json(`{
+				"type": "greenbone/generic-error",
+				"title": "matrix message could not be send: http status: 403 Forbidden"
+			}`)
+	})
+
+	t.Run("Check matrix channel without required fields", func(t *testing.T) {
+		t.Parallel()
+
+		transport := http.Client{
+			Transport: roundTripperFunc(func(r *http.Request) (*http.Response, error) {
+				return nil, nil
+			}),
+		}
+		router := setup(t, transport)
+
+		// Check matrix channel
+		httpassert.New(t, router).Post("/notification-channel/matrix/check").
+			AuthJwt(integrationTests.CreateJwtTokenWithRole(iam.NotificationAdmin)).
+			JsonContent(`{}`).
+			Expect().
+			StatusCode(http.StatusBadRequest).
+			Json(`{
+				"title":"",
+				"type":"greenbone/validation-error",
+				"errors": {
+					"homeserverUrl": "A homeserver URL is required.",
+					"roomId": "A room ID is required.",
+					"accessToken": "An access token is required."
+				}
+			}`)
+	})
+}
@@ -0,0 +1,132 @@
+package usesCases
+
+import (
+	"net/http"
+	"testing"
+
+	"github.com/greenbone/opensight-golang-libraries/pkg/httpassert"
+	"github.com/greenbone/opensight-notification-service/pkg/web/iam"
+	"github.com/greenbone/opensight-notification-service/pkg/web/integrationTests"
+	"github.com/stretchr/testify/require"
+)
+
+func TestCreateMatrixChannel(t *testing.T) {
+	t.Run("Create matrix channel", func(t *testing.T) {
+		t.Parallel()
+
+		router, db := setupTestRouter(t)
+		defer db.Close()
+
+		var matrixId string
+
+		// Create matrix channel
+		httpassert.New(t, router).Post("/notification-channel/matrix").
+			AuthJwt(integrationTests.CreateJwtTokenWithRole(iam.NotificationAdmin)).
+			JsonContent(`{
+				"channelName": "matrix1",
+				"description": "This is a test matrix channel",
+				"homeserverUrl": "https://matrix.example.org",
+				"roomId": " !room:example.org ",
+				"accessToken": "syt_abc"
+			}`).
+			Expect().
+			StatusCode(http.StatusCreated).
+			JsonPath("$.id", httpassert.ExtractTo(&matrixId)).
+			JsonTemplate(`{
+				"id": "d9cc9be2-7b4d-4c6f-991d-a40cfe002ceb",
+				"channelName": "matrix1",
+				"description": "This is a test matrix channel",
+				"homeserverUrl": "https://matrix.example.org",
+				"roomId": "!room:example.org",
+				"hasAccessToken": true
+			}`, map[string]any{
+				"id": httpassert.IgnoreJsonValue,
+			})
+		require.NotEmpty(t, matrixId)
+	})
+
+	t.Run("Create matrix channel with invalid settings returns an error", func(t *testing.T) {
+		t.Parallel()
+
+		router, db := setupTestRouter(t)
+		defer db.Close()
+
+		// Create matrix channel
+		httpassert.New(t, router).Post("/notification-channel/matrix").
+			AuthJwt(integrationTests.CreateJwtTokenWithRole(iam.NotificationAdmin)).
+			JsonContent(`{
+				"channelName": "matrix1",
+				"homeserverUrl": "matrix.example.org",
+				"roomId": "#alerts:example.org",
+				"accessToken": "syt abc"
+			}`).
+			Expect().
+			StatusCode(http.StatusBadRequest).
+			Json(`{
+				"type": "greenbone/validation-error",
+				"title": "",
+				"errors": {
+					"homeserverUrl": "Please enter a valid homeserver URL.",
+					"roomId": "Please enter a valid room ID like !abc:example.org.",
+					"accessToken": "The access token must consist of at most 512 printable ASCII characters without spaces."
+				}
+			}`)
+	})
+
+	t.Run("Create matrix channel without access token returns an error", func(t *testing.T) {
+		t.Parallel()
+
+		router, db := setupTestRouter(t)
+		defer db.Close()
+
+		// Create matrix channel
+		httpassert.New(t, router).Post("/notification-channel/matrix").
+			AuthJwt(integrationTests.CreateJwtTokenWithRole(iam.NotificationAdmin)).
+			JsonContent(`{
+				"channelName": "matrix1",
+				"homeserverUrl": "https://matrix.example.org",
+				"roomId": "!room:example.org"
+			}`).
+			Expect().
+			StatusCode(http.StatusBadRequest).
+			Json(`{
+				"type": "greenbone/generic-error",
+				"title": "An access token is required."
+			}`)
+	})
+
+	t.Run("Create matrix channel with an existing name return an error", func(t *testing.T) {
+		t.Parallel()
+
+		router, db := setupTestRouter(t)
+		defer db.Close()
+
+		// Create matrix channel
+		httpassert.New(t, router).Post("/notification-channel/matrix").
+			AuthJwt(integrationTests.CreateJwtTokenWithRole(iam.NotificationAdmin)).
+			JsonContent(`{
+				"channelName": "matrix 1",
+				"homeserverUrl": "https://matrix.example.org",
+				"roomId": "!room:example.org",
+				"accessToken": "syt_abc"
+			}`).
+			Expect().
+			StatusCode(http.StatusCreated)
+
+		// Create matrix channel with the same name
+		httpassert.New(t, router).Post("/notification-channel/matrix").
+			AuthJwt(integrationTests.CreateJwtTokenWithRole(iam.NotificationAdmin)).
+			JsonContent(`{
+				"channelName": "matrix 1",
+				"homeserverUrl": "https://matrix.example.org",
+				"roomId": "!room:example.org",
+				"accessToken": "syt_abc"
+			}`).
+			Expect().
+			StatusCode(http.StatusBadRequest).
+			Json(`{
+				"type": "greenbone/generic-error",
+				"title": "Matrix channel name already exists."
+			}`)
+	})
+}
@@ -0,0 +1,73 @@
+package usesCases
+
+import (
+	"net/http"
+	"testing"
+	"time"
+
+	"github.com/gin-gonic/gin"
+	"github.com/greenbone/keycloak-client-golang/auth"
+	"github.com/greenbone/opensight-golang-libraries/pkg/httpassert"
+	"github.com/greenbone/opensight-notification-service/pkg/services/notificationchannelservice"
+	"github.com/greenbone/opensight-notification-service/pkg/web/errmap"
+	"github.com/greenbone/opensight-notification-service/pkg/web/iam"
+	"github.com/greenbone/opensight-notification-service/pkg/web/integrationTests"
+	"github.com/greenbone/opensight-notification-service/pkg/web/matrixcontroller"
+	"github.com/greenbone/opensight-notification-service/pkg/web/testhelper"
+	"github.com/jmoiron/sqlx"
+	"github.com/stretchr/testify/require"
+)
+
+func setupTestRouter(t *testing.T) (*gin.Engine, *sqlx.DB) {
+	repo, db := testhelper.SetupNotificationChannelTestEnv(t)
+	svc := notificationchannelservice.NewNotificationChannelService(repo)
+	matrixService := notificationchannelservice.NewMatrixService(&http.Client{Timeout: 15 * time.Second})
+	matrixSvc := notificationchannelservice.NewMatrixChannelService(svc, 20, matrixService)
+	registry := errmap.NewRegistry()
+	router := testhelper.NewTestWebEngine(registry)
+
+	authMiddleware, err := auth.NewGinAuthMiddleware(integrationTests.NewTestJwtParser())
+	require.NoError(t, err)
+
+	matrixcontroller.NewMatrixController(router, svc, matrixSvc, authMiddleware, registry)
+
+	return router, db
+}
+
+func TestDeleteMatrixChannel(t *testing.T) {
+	t.Run("Delete a matrix channel", func(t *testing.T) {
+		t.Parallel()
+
+		router, db := setupTestRouter(t)
+		defer db.Close()
+
+		var matrixId string
+
+		// Create matrix channel
+		httpassert.New(t, router).Post("/notification-channel/matrix").
+			AuthJwt(integrationTests.CreateJwtTokenWithRole(iam.NotificationAdmin)).
+			JsonContent(`{
+				"channelName": "matrix1",
+				"homeserverUrl": "https://matrix.example.org",
+				"roomId": "!room:example.org",
+				"accessToken": "syt_abc"
+			}`).
+			Expect().
+			StatusCode(http.StatusCreated).
+			JsonPath("$.id", httpassert.ExtractTo(&matrixId))
+		require.NotEmpty(t, matrixId)
+
+		// Delete matrix channel
+		httpassert.New(t, router).Deletef("/notification-channel/matrix/%s", matrixId).
+			AuthJwt(integrationTests.CreateJwtTokenWithRole(iam.NotificationAdmin)).
+			Expect().
+			StatusCode(http.StatusNoContent)
+
+		// List matrix channels
+		httpassert.New(t, router).Get("/notification-channel/matrix").
+			AuthJwt(integrationTests.CreateJwtTokenWithRole(iam.NotificationAdmin)).
+			Expect().
+			StatusCode(http.StatusOK).
+			Json(`[]`)
+	})
+}
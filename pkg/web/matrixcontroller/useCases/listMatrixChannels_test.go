@@ -0,0 +1,50 @@
+package usesCases
+
+import (
+	"net/http"
+	"testing"
+
+	"github.com/greenbone/opensight-golang-libraries/pkg/httpassert"
+	"github.com/greenbone/opensight-notification-service/pkg/web/iam"
+	"github.com/greenbone/opensight-notification-service/pkg/web/integrationTests"
+)
+
+func TestListMatrixChannels(t *testing.T) {
+	t.Run("List matrix channels", func(t *testing.T) {
+		t.Parallel()
+
+		router, db := setupTestRouter(t)
+		defer db.Close()
+
+		// Create matrix channel
+		httpassert.New(t, router).Post("/notification-channel/matrix").
+			AuthJwt(integrationTests.CreateJwtTokenWithRole(iam.NotificationAdmin)).
+			JsonContent(`{
+				"channelName": "matrix1",
+				"description": "This is a test matrix channel",
+				"homeserverUrl": "https://matrix.example.org",
+				"roomId": "!room:example.org",
+				"accessToken": "syt_abc"
+			}`).
+			Expect().
+			StatusCode(http.StatusCreated)
+
+		// List matrix channels, the access token is not returned
+		httpassert.New(t, router).Get("/notification-channel/matrix").
+			AuthJwt(integrationTests.CreateJwtTokenWithRole(iam.NotificationAdmin)).
+			Expect().
+			StatusCode(http.StatusOK).
+			JsonTemplate(`[
+				{
+					"id": "fb46613b-4bf8-45c7-ad6f-e83e5ced8b81",
+					"channelName": "matrix1",
+					"description": "This is a test matrix channel",
+					"homeserverUrl": "https://matrix.example.org",
+					"roomId": "!room:example.org",
+					"hasAccessToken": true
+				}
+			]`, map[string]any{
+				"$.0.id": httpassert.IgnoreJsonValue,
+			})
+	})
+}
@@ -0,0 +1,97 @@
+package usesCases
+
+import (
+	"net/http"
+	"testing"
+
+	"github.com/greenbone/opensight-golang-libraries/pkg/httpassert"
+	"github.com/greenbone/opensight-notification-service/pkg/web/iam"
+	"github.com/greenbone/opensight-notification-service/pkg/web/integrationTests"
+	"github.com/stretchr/testify/require"
+)
+
+func TestUpdateMatrixChannel(t *testing.T) {
+	t.Run("Update matrix channel keeps the access token if omitted", func(t *testing.T) {
+		t.Parallel()
+
+		router, db := setupTestRouter(t)
+		defer db.Close()
+
+		var matrixId string
+
+		// Create matrix channel
+		httpassert.New(t, router).Post("/notification-channel/matrix").
+			AuthJwt(integrationTests.CreateJwtTokenWithRole(iam.NotificationAdmin)).
+			JsonContent(`{
+				"channelName": "matrix1",
+				"homeserverUrl": "https://matrix.example.org",
+				"roomId": "!room:example.org",
+				"accessToken": "syt_abc"
+			}`).
+			Expect().
+			StatusCode(http.StatusCreated).
+			JsonPath("$.id", httpassert.ExtractTo(&matrixId))
+		require.NotEmpty(t, matrixId)
+
+		// Update matrix channel
+		httpassert.New(t, router).Putf("/notification-channel/matrix/%s", matrixId).
+			AuthJwt(integrationTests.CreateJwtTokenWithRole(iam.NotificationAdmin)).
+			JsonContent(`{
+				"channelName": "matrix2",
+				"description": "This is a test matrix channel changed",
+				"homeserverUrl": "https://chat.example.org",
+				"roomId": "!alerts:example.org"
+			}`).
+			Expect().
+			StatusCode(http.StatusOK).
+			JsonTemplate(`{
+				"id": "fb46613b-4bf8-45c7-ad6f-e83e5ced8b81",
+				"channelName": "matrix2",
+				"description": "This is a test matrix channel changed",
+				"homeserverUrl": "https://chat.example.org",
+				"roomId": "!alerts:example.org",
+				"hasAccessToken": true
+			}`, map[string]any{
+				"$.id": matrixId,
+			})
+	})
+
+	t.Run("Update matrix channel removing the access token returns an error", func(t *testing.T) {
+		t.Parallel()
+
+		router, db := setupTestRouter(t)
+		defer db.Close()
+
+		var matrixId string
+
+		// Create matrix channel
+		httpassert.New(t, router).Post("/notification-channel/matrix").
+			AuthJwt(integrationTests.CreateJwtTokenWithRole(iam.NotificationAdmin)).
+			JsonContent(`{
+				"channelName": "matrix1",
+				"homeserverUrl": "https://matrix.example.org",
+				"roomId": "!room:example.org",
+				"accessToken": "syt_abc"
+			}`).
+			Expect().
+			StatusCode(http.StatusCreated).
+			JsonPath("$.id", httpassert.ExtractTo(&matrixId))
+		require.NotEmpty(t, matrixId)
+
+		// Update matrix channel
+		httpassert.New(t, router).Putf("/notification-channel/matrix/%s", matrixId).
+			AuthJwt(integrationTests.CreateJwtTokenWithRole(iam.NotificationAdmin)).
+			JsonContent(`{
+				"channelName": "matrix1",
+				"homeserverUrl": "https://matrix.example.org",
+				"roomId": "!room:example.org",
+				"accessToken": ""
+			}`).
+			Expect().
+			StatusCode(http.StatusBadRequest).
+			Json(`{
+				"type": "greenbone/generic-error",
+				"title": "An access token is required."
+			}`)
+	})
+}
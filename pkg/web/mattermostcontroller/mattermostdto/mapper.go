@@ -12,15 +12,21 @@ func MapNotificationChannelToMattermost(channel models.NotificationChannel) Matt
 		ChannelName: channel.ChannelName,
 		WebhookUrl:  helper.SafeDereference(channel.WebhookUrl),
 		Description: helper.SafeDereference(channel.Description),
+		Username:    helper.SafeDereference(channel.WebhookUsername),
+		IconUrl:     helper.SafeDereference(channel.WebhookIconUrl),
+		Channel:     helper.SafeDereference(channel.WebhookChannel),
 	}
 }
 
 func MapMattermostToNotificationChannel(mail MattermostNotificationChannelRequest) models.NotificationChannel {
 	return models.NotificationChannel{
-		ChannelType: models.ChannelTypeMattermost,
-		ChannelName: mail.ChannelName,
-		WebhookUrl:  &mail.WebhookUrl,
-		Description: &mail.Description,
+		ChannelType:     models.ChannelTypeMattermost,
+		ChannelName:     mail.ChannelName,
+		WebhookUrl:      &mail.WebhookUrl,
+		Description:     &mail.Description,
+		WebhookUsername: helper.ToNullablePtr(mail.Username),
+		WebhookIconUrl:  helper.ToNullablePtr(mail.IconUrl),
+		WebhookChannel:  helper.ToNullablePtr(mail.Channel),
 	}
 }
 
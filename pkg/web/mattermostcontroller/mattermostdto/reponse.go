@@ -5,4 +5,7 @@ type MattermostNotificationChannelResponse struct {
 	ChannelName string `json:"channelName"`
 	WebhookUrl  string `json:"webhookUrl"`
 	Description string `json:"description"`
+	Username    string `json:"username,omitempty"`
+	IconUrl     string `json:"iconUrl,omitempty"`
+	Channel     string `json:"channel,omitempty"`
 }
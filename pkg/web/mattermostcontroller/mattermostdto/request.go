@@ -2,23 +2,34 @@ package mattermostdto
 
 import (
 	"strings"
+	"unicode/utf8"
 
 	"github.com/greenbone/opensight-notification-service/pkg/models"
 	"github.com/greenbone/opensight-notification-service/pkg/policy"
 	"github.com/greenbone/opensight-notification-service/pkg/translation"
 )
 
-// MattermostNotificationChannelRequest mattermost notification channel request
+// maxUsernameLength is the maximum length of Mattermost usernames
+const maxUsernameLength = 64
+
+// MattermostNotificationChannelRequest mattermost notification channel request.
+// The username, icon and channel overrides are only applied if they are enabled in the Mattermost integration settings.
 type MattermostNotificationChannelRequest struct {
 	ChannelName string `json:"channelName"`
 	WebhookUrl  string `json:"webhookUrl"`
 	Description string `json:"description"`
+	Username    string `json:"username,omitempty"` // posts are shown with this username instead of the one of the webhook
+	IconUrl     string `json:"iconUrl,omitempty"`  // posts are shown with this profile picture instead of the one of the webhook
+	Channel     string `json:"channel,omitempty"`  // posts go to this channel instead of the one of the webhook, e.g. town-square or @username
 }
 
 func (m *MattermostNotificationChannelRequest) Cleanup() {
 	m.ChannelName = strings.TrimSpace(m.ChannelName)
 	m.WebhookUrl = strings.TrimSpace(m.WebhookUrl)
 	m.Description = strings.TrimSpace(m.Description)
+	m.Username = strings.TrimSpace(m.Username)
+	m.IconUrl = strings.TrimSpace(m.IconUrl)
+	m.Channel = strings.TrimSpace(m.Channel)
 }
 
 func (m MattermostNotificationChannelRequest) Validate() models.ValidationErrors {
@@ -36,6 +47,22 @@ func (m MattermostNotificationChannelRequest) Validate() models.ValidationErrors
 		}
 	}
 
+	if utf8.RuneCountInString(m.Username) > maxUsernameLength {
+		errs["username"] = translation.MattermostUsernameTooLong
+	}
+
+	if m.IconUrl != "" {
+		if err := policy.IconUrlPolicy(m.IconUrl); err != nil {
+			errs["iconUrl"] = translation.ValidIconUrlIsRequired
+		}
+	}
+
+	if m.Channel != "" {
+		if err := policy.MattermostChannelPolicy(m.Channel); err != nil {
+			errs["channel"] = translation.ValidMattermostChannelIsRequired
+		}
+	}
+
 	return errs
 }
 
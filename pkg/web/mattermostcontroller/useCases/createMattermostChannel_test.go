@@ -41,6 +41,65 @@ func TestCreateMattermostChannel(t *testing.T) {
 		require.NotEmpty(t, mattermostId)
 	})
 
+	t.Run("Create mattermost channel with overrides", func(t *testing.T) {
+		t.Parallel()
+
+		router, db := setupTestRouter(t)
+		defer db.Close()
+
+		// Create mattermost channel
+		httpassert.New(t, router).Post("/notification-channel/mattermost").
+			AuthJwt(integrationTests.CreateJwtTokenWithRole(iam.NotificationAdmin)).
+			JsonContent(`{
+				"channelName": "mattermost1",
+				"webhookUrl": "https://example.com/hooks/id1",
+				"description": "This is a test mattermost channel",
+				"username": "OpenSight",
+				"iconUrl": "https://example.com/icon.png",
+				"channel": "security-alerts"
+			}`).
+			Expect().
+			StatusCode(http.StatusCreated).
+			JsonTemplate(`{
+				"id": "d9cc9be2-7b4d-4c6f-991d-a40cfe002ceb",
+				"channelName": "mattermost1",
+				"webhookUrl": "https://example.com/hooks/id1",
+				"description": "This is a test mattermost channel",
+				"username": "OpenSight",
+				"iconUrl": "https://example.com/icon.png",
+				"channel": "security-alerts"
+			}`, map[string]any{
+				"id": httpassert.IgnoreJsonValue,
+			})
+	})
+
+	t.Run("Create mattermost channel with invalid overrides returns an error", func(t *testing.T) {
+		t.Parallel()
+
+		router, db := setupTestRouter(t)
+		defer db.Close()
+
+		// Create mattermost channel
+		httpassert.New(t, router).Post("/notification-channel/mattermost").
+			AuthJwt(integrationTests.CreateJwtTokenWithRole(iam.NotificationAdmin)).
+			JsonContent(`{
+				"channelName": "a",
+				"webhookUrl": "https://example.com/hooks/id1",
+				"iconUrl": "icon.png",
+				"channel": "Security Alerts"
+			}`).
+			Expect().
+			StatusCode(http.StatusBadRequest).
+			Json(`{
+				"type": "greenbone/validation-error",
+				"title": "",
+				"errors": {
+					"iconUrl": "Please enter a valid http or https URL for the icon.",
+					"channel": "Please enter a valid channel name, e.g. town-square, or @username."
+				}
+			}`)
+	})
+
 	t.Run("Create mattermost channel with invalid webhook URL returns an error", func(t *testing.T) {
 		t.Parallel()
 
@@ -0,0 +1,163 @@
+// SPDX-FileCopyrightText: 2026 Greenbone AG <https://greenbone.net>
+//
+// SPDX-License-Identifier: AGPL-3.0-or-later
+
+package notificationcontroller
+
+import (
+	"net/http"
+
+	"github.com/gin-gonic/gin"
+	"github.com/greenbone/opensight-golang-libraries/pkg/query"
+	"github.com/greenbone/opensight-notification-service/pkg/models"
+	"github.com/greenbone/opensight-notification-service/pkg/translation"
+	"github.com/greenbone/opensight-notification-service/pkg/web"
+	"github.com/greenbone/opensight-notification-service/pkg/web/ginEx"
+	"github.com/greenbone/opensight-notification-service/pkg/web/helper"
+	"github.com/samber/lo"
+)
+
+// maxReplayDeadLetters limits the number of dead letters which can be replayed with one request
+const maxReplayDeadLetters = 1000
+
+type ReplayDeadLettersRequest struct {
+	IDs []string `json:"ids"`
+}
+
+func (r ReplayDeadLettersRequest) Validate() models.ValidationErrors {
+	switch {
+	case len(r.IDs) == 0:
+		return models.ValidationErrors{"ids": translation.DeadLetterIDsAreRequired}
+	case len(r.IDs) > maxReplayDeadLetters:
+		return models.ValidationErrors{"ids": translation.TooManyDeadLetterIDs}
+	}
+	return nil
+}
+
+type ReplayDeadLettersResponse struct {
+	Replayed int `json:"replayed"` // number of replayed dead letters, unknown IDs are skipped
+}
+
+// ListDeadLetters
+//
+//	@Summary		List Dead Letters
+//	@Description	Returns a list of deliveries which failed permanently matching the provided filters
+//	@Tags			dead-letter
+//	@Accept			json
+//	@Produce		json
+//	@Security		KeycloakAuth
+//	@Param			MatchCriterias	body		query.ResultSelector	true	"filters, paging and sorting"
+//	@Success		200				{object}	query.ResponseListWithMetadata[models.DeadLetter]
+//	@Header			all				{string}	api-version	"API version"
+//	@Router			/dead-letters [put]
+func (c *NotificationController) ListDeadLetters(gc *gin.Context) {
+	gc.Header(web.APIVersionKey, web.APIVersion)
+	resultSelector, err := helper.PrepareResultSelector(gc, DeadLettersRequestOptions, AllowedDeadLettersSortFields, helper.ResultSelectorDefaults(DefaultDeadLettersSortingRequest))
+	if ginEx.AddError(gc, err) {
+		return
+	}
+
+	deadLetters, totalResults, err := c.notificationService.ListDeadLetters(gc, resultSelector)
+	if ginEx.AddError(gc, err) {
+		return
+	}
+
+	gc.JSON(http.StatusOK, query.ResponseListWithMetadata[models.DeadLetter]{
+		Metadata: query.NewMetadata(resultSelector, totalResults),
+		Data:     deadLetters,
+	})
+}
+
+// GetDeadLetterOptions
+//
+//	@Summary		Dead letter filter options
+//	@Description	Get filter options for listing dead letters
+//	@Tags			dead-letter
+//	@Produce		json
+//	@Security		KeycloakAuth
+//	@Success		200	{object}	query.ResponseWithMetadata[[]query.FilterOption]
+//	@Header			all	{string}	api-version	"API version"
+//	@Router			/dead-letters/options [get]
+func (c *NotificationController) GetDeadLetterOptions(gc *gin.Context) {
+	gc.Header(web.APIVersionKey, web.APIVersion)
+	requestOptions := lo.Map(DeadLettersRequestOptions, web.ToFilterOption)
+	gc.JSON(http.StatusOK, query.ResponseWithMetadata[[]query.FilterOption]{Data: requestOptions})
+}
+
+// GetDeadLetter
+//
+//	@Summary		Get Dead Letter
+//	@Description	Returns the dead letter including the notification and the last error
+//	@Tags			dead-letter
+//	@Produce		json
+//	@Security		KeycloakAuth
+//	@Param			id	path		string	true	"unique ID of the dead letter"
+//	@Success		200	{object}	query.ResponseWithMetadata[models.DeadLetter]
+//	@Failure		400	{object}	errorResponses.ErrorResponse	"invalid id"
+//	@Failure		404	{object}	errorResponses.ErrorResponse	"dead letter not found"
+//	@Header			all	{string}	api-version	"API version"
+//	@Router			/dead-letters/{id} [get]
+func (c *NotificationController) GetDeadLetter(gc *gin.Context) {
+	gc.Header(web.APIVersionKey, web.APIVersion)
+
+	deadLetter, err := c.notificationService.GetDeadLetter(gc, gc.Param("id"))
+	if ginEx.AddError(gc, err) {
+		return
+	}
+
+	gc.JSON(http.StatusOK, query.ResponseWithMetadata[models.DeadLetter]{Data: deadLetter})
+}
+
+// ReplayDeadLetter
+//
+//	@Summary		Replay Dead Letter
+//	@Description	Delivers the dead letter again, e.g. after the channel has been fixed. It is removed from the dead letters and retried like a new delivery.
+//	@Tags			dead-letter
+//	@Security		KeycloakAuth
+//	@Param			id	path	string	true	"unique ID of the dead letter"
+//	@Success		204	"dead letter replayed"
+//	@Failure		400	{object}	errorResponses.ErrorResponse	"invalid id"
+//	@Failure		404	{object}	errorResponses.ErrorResponse	"dead letter not found"
+//	@Header			all	{string}	api-version	"API version"
+//	@Router			/dead-letters/{id}/replay [post]
+func (c *NotificationController) ReplayDeadLetter(gc *gin.Context) {
+	gc.Header(web.APIVersionKey, web.APIVersion)
+
+	err := c.notificationService.ReplayDeadLetter(gc, gc.Param("id"))
+	if ginEx.AddError(gc, err) {
+		return
+	}
+
+	gc.Status(http.StatusNoContent)
+}
+
+// ReplayDeadLetters
+//
+//	@Summary		Replay Dead Letters
+//	@Description	Delivers the given dead letters again. Unknown IDs are skipped, e.g. if they have been replayed in the meantime.
+//	@Tags			dead-letter
+//	@Accept			json
+//	@Produce		json
+//	@Security		KeycloakAuth
+//	@Param			ReplayDeadLettersRequest	body		ReplayDeadLettersRequest	true	"IDs of the dead letters to replay"
+//	@Success		200							{object}	query.ResponseWithMetadata[ReplayDeadLettersResponse]
+//	@Failure		400							{object}	errorResponses.ErrorResponse	"invalid ids"
+//	@Header			all							{string}	api-version	"API version"
+//	@Router			/dead-letters/replay [post]
+func (c *NotificationController) ReplayDeadLetters(gc *gin.Context) {
+	gc.Header(web.APIVersionKey, web.APIVersion)
+
+	var request ReplayDeadLettersRequest
+	if !ginEx.BindAndValidateBody(gc, &request) {
+		return
+	}
+
+	replayed, err := c.notificationService.ReplayDeadLetters(gc, request.IDs)
+	if ginEx.AddError(gc, err) {
+		return
+	}
+
+	gc.JSON(http.StatusOK, query.ResponseWithMetadata[ReplayDeadLettersResponse]{
+		Data: ReplayDeadLettersResponse{Replayed: replayed},
+	})
+}
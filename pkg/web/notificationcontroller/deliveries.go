@@ -0,0 +1,101 @@
+// SPDX-FileCopyrightText: 2026 Greenbone AG <https://greenbone.net>
+//
+// SPDX-License-Identifier: AGPL-3.0-or-later
+
+package notificationcontroller
+
+import (
+	"net/http"
+
+	"github.com/gin-gonic/gin"
+	"github.com/greenbone/opensight-golang-libraries/pkg/query"
+	"github.com/greenbone/opensight-notification-service/pkg/models"
+	"github.com/greenbone/opensight-notification-service/pkg/web"
+	"github.com/greenbone/opensight-notification-service/pkg/web/ginEx"
+	"github.com/greenbone/opensight-notification-service/pkg/web/helper"
+	"github.com/samber/lo"
+)
+
+// ListNotificationDeliveries
+//
+//	@Summary		List deliveries of a notification
+//	@Description	Returns all attempts to forward the notification to a channel, oldest first
+//	@Tags			notification
+//	@Produce		json
+//	@Security		KeycloakAuth
+//	@Param			id	path		string	true	"unique ID of the notification"
+//	@Success		200	{object}	query.ResponseWithMetadata[[]models.DeliveryAttempt]
+//	@Failure		400	{object}	errorResponses.ErrorResponse	"invalid id"
+//	@Header			all	{string}	api-version	"API version"
+//	@Router			/notifications/{id}/deliveries [get]
+func (c *NotificationController) ListNotificationDeliveries(gc *gin.Context) {
+	gc.Header(web.APIVersionKey, web.APIVersion)
+
+	attempts, err := c.notificationService.ListNotificationDeliveryAttempts(gc, gc.Param("id"))
+	if ginEx.AddError(gc, err) {
+		return
+	}
+
+	gc.JSON(http.StatusOK, query.ResponseWithMetadata[[]models.DeliveryAttempt]{Data: attempts})
+}
+
+// ListDeliveries
+//
+//	@Summary		List Deliveries
+//	@Description	Returns a list of attempts to forward notifications to channels matching the provided filters
+//	@Tags			notification
+//	@Accept			json
+//	@Produce		json
+//	@Security		KeycloakAuth
+//	@Param			MatchCriterias	body		query.ResultSelector	true	"filters, paging and sorting"
+//	@Success		200				{object}	query.ResponseListWithMetadata[models.DeliveryAttempt]
+//	@Header			all				{string}	api-version	"API version"
+//	@Router			/deliveries [put]
+func (c *NotificationController) ListDeliveries(gc *gin.Context) {
+	gc.Header(web.APIVersionKey, web.APIVersion)
+	resultSelector, err := helper.PrepareResultSelector(gc, DeliveriesRequestOptions, AllowedDeliveriesSortFields, helper.ResultSelectorDefaults(DefaultDeliveriesSortingRequest))
+	if ginEx.AddError(gc, err) {
+		return
+	}
+
+	attempts, totalResults, err := c.notificationService.ListDeliveryAttempts(gc, resultSelector)
+	if ginEx.AddError(gc, err) {
+		return
+	}
+
+	gc.JSON(http.StatusOK, query.ResponseListWithMetadata[models.DeliveryAttempt]{
+		Metadata: query.NewMetadata(resultSelector, totalResults),
+		Data:     attempts,
+	})
+}
+
+// GetDeliveryOptions
+//
+//	@Summary		Delivery filter options
+//	@Description	Get filter options for listing deliveries
+//	@Tags			notification
+//	@Produce		json
+//	@Security		KeycloakAuth
+//	@Success		200	{object}	query.ResponseWithMetadata[[]query.FilterOption]
+//	@Header			all	{string}	api-version	"API version"
+//	@Router			/deliveries/options [get]
+func (c *NotificationController) GetDeliveryOptions(gc *gin.Context) {
+	gc.Header(web.APIVersionKey, web.APIVersion)
+	requestOptions := lo.Map(DeliveriesRequestOptions, web.ToFilterOption)
+	gc.JSON(http.StatusOK, query.ResponseWithMetadata[[]query.FilterOption]{Data: requestOptions})
+}
+
+// GetDeliveryQueues
+//
+//	@Summary		Delivery queues
+//	@Description	Get the current depth of the queues of incoming notifications and of pending deliveries per channel type
+//	@Tags			notification
+//	@Produce		json
+//	@Security		KeycloakAuth
+//	@Success		200	{object}	query.ResponseWithMetadata[models.WorkerPoolStats]
+//	@Header			all	{string}	api-version	"API version"
+//	@Router			/deliveries/queues [get]
+func (c *NotificationController) GetDeliveryQueues(gc *gin.Context) {
+	gc.Header(web.APIVersionKey, web.APIVersion)
+	gc.JSON(http.StatusOK, query.ResponseWithMetadata[models.WorkerPoolStats]{Data: c.notificationService.GetWorkerPoolStats()})
+}
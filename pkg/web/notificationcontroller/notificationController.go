@@ -7,13 +7,18 @@ package notificationcontroller
 import (
 	"net/http"
 
+	"github.com/greenbone/opensight-notification-service/pkg/repository/notificationrepository"
 	"github.com/greenbone/opensight-notification-service/pkg/services/notificationservice"
+	"github.com/greenbone/opensight-notification-service/pkg/translation"
+	"github.com/greenbone/opensight-notification-service/pkg/web/errmap"
 	"github.com/greenbone/opensight-notification-service/pkg/web/ginEx"
 	"github.com/greenbone/opensight-notification-service/pkg/web/iam"
 	"github.com/greenbone/opensight-notification-service/pkg/web/middleware"
 	"github.com/samber/lo"
 
 	"github.com/gin-gonic/gin"
+	"github.com/greenbone/keycloak-client-golang/auth"
+	"github.com/greenbone/opensight-golang-libraries/pkg/errorResponses"
 	"github.com/greenbone/opensight-golang-libraries/pkg/query"
 	"github.com/greenbone/opensight-notification-service/pkg/models"
 	"github.com/greenbone/opensight-notification-service/pkg/web"
@@ -28,31 +33,103 @@ func AddNotificationController(
 	router gin.IRouter,
 	notificationService notificationservice.NotificationService,
 	auth gin.HandlerFunc,
+	registry *errmap.Registry,
 ) {
 	ctrl := &NotificationController{
 		notificationService: notificationService,
 	}
+	ctrl.configureMappings(registry)
 
 	groupPath := "/notifications"
 
 	router.Group(groupPath).Use(middleware.AuthorizeRoles(auth, iam.OsiViewer, iam.OsiUser, iam.OsiAdmin, iam.NotificationAdmin)...).
 		PUT("", ctrl.ListNotifications).
-		GET("/options", ctrl.GetOptions)
+		GET("/options", ctrl.GetOptions).
+		GET("/unread-count", ctrl.GetUnreadCount).
+		POST("/read", ctrl.MarkAllNotificationsRead).
+		POST("/:id/read", ctrl.MarkNotificationRead).
+		POST("/:id/dismiss", ctrl.DismissNotification)
+	router.Group(groupPath).Use(middleware.AuthorizeRoles(auth, iam.OsiUser, iam.OsiAdmin, iam.NotificationAdmin)...).
+		POST("/:id/acknowledge", ctrl.AcknowledgeNotification)
+	router.Group(groupPath).Use(middleware.AuthorizeRoles(auth, iam.OsiAdmin, iam.NotificationAdmin)...).
+		GET("/:id/deliveries", ctrl.ListNotificationDeliveries).
+		POST("/:id/resend", ctrl.ResendNotification)
+
+	router.Group("/deliveries").Use(middleware.AuthorizeRoles(auth, iam.OsiAdmin, iam.NotificationAdmin)...).
+		PUT("", ctrl.ListDeliveries).
+		GET("/options", ctrl.GetDeliveryOptions).
+		GET("/queues", ctrl.GetDeliveryQueues)
+
+	router.Group("/dead-letters").Use(middleware.AuthorizeRoles(auth, iam.OsiAdmin, iam.NotificationAdmin)...).
+		PUT("", ctrl.ListDeadLetters).
+		GET("/options", ctrl.GetDeadLetterOptions).
+		GET("/:id", ctrl.GetDeadLetter).
+		POST("/:id/replay", ctrl.ReplayDeadLetter).
+		POST("/replay", ctrl.ReplayDeadLetters)
+
 	// only to be used by other backend services
 	router.Group(groupPath).Use(middleware.AuthorizeRoles(auth, iam.Notification)...).
 		POST("", ctrl.CreateNotification)
 }
 
+func (c *NotificationController) configureMappings(r *errmap.Registry) {
+	r.Register(
+		notificationrepository.ErrInvalidID,
+		http.StatusBadRequest,
+		errorResponses.NewErrorValidationResponse(translation.InvalidID, "", nil),
+	)
+	r.Register(
+		notificationservice.ErrResendChannelNotFound,
+		http.StatusBadRequest,
+		errorResponses.NewErrorValidationResponse("", "",
+			map[string]string{"channelID": translation.ChannelNotFound}),
+	)
+	r.Register(
+		notificationservice.ErrResendRuleNotFound,
+		http.StatusBadRequest,
+		errorResponses.NewErrorValidationResponse("", "",
+			map[string]string{"ruleID": translation.RuleNotFound}),
+	)
+	r.Register(
+		notificationservice.ErrResendRecipientRequired,
+		http.StatusBadRequest,
+		errorResponses.NewErrorValidationResponse("", "",
+			map[string]string{"recipient": translation.RecipientRequiredForChannel}),
+	)
+	r.Register(
+		notificationservice.ErrResendRecipientNotSupported,
+		http.StatusBadRequest,
+		errorResponses.NewErrorValidationResponse("", "",
+			map[string]string{"recipient": translation.RecipientNotSupportedForChannel}),
+	)
+	r.Register(
+		notificationservice.ErrIntakeQueueFull,
+		http.StatusServiceUnavailable,
+		errorResponses.NewErrorGenericResponse(translation.NotificationIntakeQueueFull),
+	)
+}
+
+// idempotencyKeyHeader allows to safely retry the creation of a notification, alternative to the `idempotencyKey` field
+const idempotencyKeyHeader = "Idempotency-Key"
+
+const maxIdempotencyKeyLength = 255
+
 // CreateNotification
 //
 //	@Summary		Create Notification
-//	@Description	Create a new notification. It will always be stored by the notification service and it will possibly also trigger actions like sending mails, depending on the cofigured rules.
+//	@Description	Create a new notification. It is stored by the notification service together with the actions it triggers, like sending mails, depending on the configured rules. If the rules can not be evaluated, the notification is not stored and an error is returned, the request can be retried.
+//	@Description	A request can be safely retried by passing an idempotency key, either as header or in the body. If the calling service already created a notification with the same key within the idempotency window, the original notification is returned with status 200 instead of creating it again.
 //	@Tags			notification
 //	@Accept			json
 //	@Produce		json
 //	@Security		KeycloakAuth
+//	@Param			Idempotency-Key	header		string				false	"unique key of the request, takes precedence over the `idempotencyKey` field"
 //	@Param			Notification	body		models.Notification	true	"notification to add"
+//	@Success		200				{object}	query.ResponseWithMetadata[models.Notification]	"repeated request, the notification was created before"
 //	@Success		201				{object}	query.ResponseWithMetadata[models.Notification]
+//	@Failure		400				{object}	errorResponses.ErrorResponse	"invalid notification or idempotency key"
+//	@Failure		500				{object}	errorResponses.ErrorResponse	"the notification could not be stored or the rules could not be evaluated, nothing was stored"
+//	@Failure		503				{object}	errorResponses.ErrorResponse	"too many notifications are processed at the moment, try again later"
 //	@Header			all				{string}	api-version	"API version"
 //	@Router			/notifications [post]
 func (c *NotificationController) CreateNotification(gc *gin.Context) {
@@ -63,18 +140,99 @@ func (c *NotificationController) CreateNotification(gc *gin.Context) {
 		return
 	}
 
-	notificationNew, err := c.notificationService.CreateNotification(gc, notification)
+	if key := gc.GetHeader(idempotencyKeyHeader); key != "" {
+		if notification.IdempotencyKey != "" && notification.IdempotencyKey != key {
+			ginEx.AddError(gc, models.ValidationErrors{"idempotencyKey": translation.IdempotencyKeyMismatch})
+			return
+		}
+		if len(key) > maxIdempotencyKeyLength {
+			ginEx.AddError(gc, models.ValidationErrors{"idempotencyKey": translation.IdempotencyKeyTooLong})
+			return
+		}
+		notification.IdempotencyKey = key
+	}
+
+	// keys are scoped per calling service, i.e. per service account
+	userContext, err := auth.GetUserContext(gc)
+	if ginEx.AddError(gc, err) {
+		return
+	}
+
+	notificationNew, repeated, err := c.notificationService.CreateNotificationIdempotent(gc, notification, userContext.UserID)
 	if ginEx.AddError(gc, err) {
 		return
 	}
 
-	gc.JSON(http.StatusCreated, query.ResponseWithMetadata[models.Notification]{Data: notificationNew})
+	status := http.StatusCreated
+	if repeated {
+		status = http.StatusOK
+	}
+	gc.JSON(status, query.ResponseWithMetadata[models.Notification]{Data: notificationNew})
+}
+
+// ResendNotification
+//
+//	@Summary		Re-send Notification
+//	@Description	Forward a stored notification again, either to a channel or to the action of a rule (regardless of its trigger). The deliveries are done asynchronously and can be followed in the delivery log.
+//	@Tags			notification
+//	@Accept			json
+//	@Security		KeycloakAuth
+//	@Param			id				path	string				true	"unique ID of the notification"
+//	@Param			ResendTarget	body	models.ResendTarget	true	"channel or rule to forward the notification to"
+//	@Success		202				"deliveries scheduled"
+//	@Failure		400				{object}	errorResponses.ErrorResponse	"invalid target"
+//	@Failure		404				{object}	errorResponses.ErrorResponse	"notification not found"
+//	@Header			all				{string}	api-version	"API version"
+//	@Router			/notifications/{id}/resend [post]
+func (c *NotificationController) ResendNotification(gc *gin.Context) {
+	gc.Header(web.APIVersionKey, web.APIVersion)
+
+	var target models.ResendTarget
+	if !ginEx.BindAndValidateBody(gc, &target) {
+		return
+	}
+
+	err := c.notificationService.ResendNotification(gc, gc.Param("id"), target)
+	if ginEx.AddError(gc, err) {
+		return
+	}
+
+	gc.Status(http.StatusAccepted)
+}
+
+// AcknowledgeNotification
+//
+//	@Summary		Acknowledge Notification
+//	@Description	Mark a notification as acknowledged by the current user. This stops the escalation of an urgent notification. Acknowledging a notification again keeps the first acknowledgement.
+//	@Tags			notification
+//	@Produce		json
+//	@Security		KeycloakAuth
+//	@Param			id	path		string	true	"unique ID of the notification"
+//	@Success		200	{object}	query.ResponseWithMetadata[models.Notification]
+//	@Failure		400	{object}	errorResponses.ErrorResponse	"invalid ID"
+//	@Failure		404	{object}	errorResponses.ErrorResponse	"notification not found"
+//	@Header			all	{string}	api-version	"API version"
+//	@Router			/notifications/{id}/acknowledge [post]
+func (c *NotificationController) AcknowledgeNotification(gc *gin.Context) {
+	gc.Header(web.APIVersionKey, web.APIVersion)
+
+	userContext, err := auth.GetUserContext(gc)
+	if ginEx.AddError(gc, err) {
+		return
+	}
+
+	notification, err := c.notificationService.AcknowledgeNotification(gc, gc.Param("id"), userContext.UserID)
+	if ginEx.AddError(gc, err) {
+		return
+	}
+
+	gc.JSON(http.StatusOK, query.ResponseWithMetadata[models.Notification]{Data: notification})
 }
 
 // ListNotifications
 //
 //	@Summary		List Notifications
-//	@Description	Returns a list of notifications matching the provided filters
+//	@Description	Returns a list of notifications matching the provided filters, together with their state for the current user
 //	@Tags			notification
 //	@Accept			json
 //	@Produce		json
@@ -91,7 +249,12 @@ func (c *NotificationController) ListNotifications(gc *gin.Context) {
 		return
 	}
 
-	notifications, totalResults, err := c.notificationService.ListNotifications(gc, resultSelector)
+	userContext, err := auth.GetUserContext(gc)
+	if ginEx.AddError(gc, err) {
+		return
+	}
+
+	notifications, totalResults, err := c.notificationService.ListNotifications(gc, resultSelector, userContext.UserID)
 	if ginEx.AddError(gc, err) {
 		return
 	}
@@ -5,9 +5,12 @@
 package notificationcontroller
 
 import (
+	"bytes"
+	"encoding/json"
 	"errors"
 	"net/http"
 	"net/http/httptest"
+	"strings"
 	"testing"
 	"time"
 
@@ -15,6 +18,9 @@ import (
 	"github.com/greenbone/keycloak-client-golang/auth"
 	"github.com/greenbone/opensight-golang-libraries/pkg/httpassert"
 	"github.com/greenbone/opensight-golang-libraries/pkg/query/sorting"
+	"github.com/greenbone/opensight-notification-service/pkg/errs"
+	"github.com/greenbone/opensight-notification-service/pkg/repository/notificationrepository"
+	"github.com/greenbone/opensight-notification-service/pkg/services/notificationservice"
 	"github.com/greenbone/opensight-notification-service/pkg/services/notificationservice/dtos"
 	"github.com/greenbone/opensight-notification-service/pkg/services/notificationservice/mocks"
 	"github.com/greenbone/opensight-notification-service/pkg/web/errmap"
@@ -30,6 +36,9 @@ import (
 	"github.com/stretchr/testify/require"
 )
 
+// testCallerID is the subject of the tokens created by the integration test helpers
+const testCallerID = "1927ed8a-3f1f-4846-8433-db290ea5ff90"
+
 func getNotification() models.Notification {
 	return models.Notification{
 		Id:          "57fe22b8-89a4-445f-b6c7-ef9ea724ea48",
@@ -49,7 +58,7 @@ func setup(t *testing.T) (*gin.Engine, *mocks.NotificationService) {
 	authMiddleware, err := auth.NewGinAuthMiddleware(integrationTests.NewTestJwtParser())
 	require.NoError(t, err)
 	mockNotificationService := mocks.NewNotificationService(t)
-	AddNotificationController(router, mockNotificationService, authMiddleware)
+	AddNotificationController(router, mockNotificationService, authMiddleware, registry)
 
 	return router, mockNotificationService
 }
@@ -172,7 +181,7 @@ func TestListNotifications(t *testing.T) {
 			router, mockNotificationService := setup(t)
 
 			if tt.want.serviceCall {
-				mockNotificationService.EXPECT().ListNotifications(mock.Anything, tt.want.serviceArg).
+				mockNotificationService.EXPECT().ListNotifications(mock.Anything, tt.want.serviceArg, testCallerID).
 					Return(tt.mockReturn.items, tt.mockReturn.totalResults, tt.mockReturn.err).
 					Once()
 			}
@@ -199,6 +208,10 @@ func TestListNotifications_Permissions(t *testing.T) {
 	}{
 		{"List notifications channels", http.MethodPut, "/notifications"},
 		{"Get options", http.MethodGet, "/notifications/options"},
+		{"Get unread count", http.MethodGet, "/notifications/unread-count"},
+		{"Mark all notifications read", http.MethodPost, "/notifications/read"},
+		{"Mark notification read", http.MethodPost, "/notifications/57fe22b8-89a4-445f-b6c7-ef9ea724ea48/read"},
+		{"Dismiss notification", http.MethodPost, "/notifications/57fe22b8-89a4-445f-b6c7-ef9ea724ea48/dismiss"},
 	}
 
 	tests := []struct {
@@ -218,7 +231,11 @@ func TestListNotifications_Permissions(t *testing.T) {
 			t.Run(ep.name+" as "+tt.role, func(t *testing.T) {
 				t.Parallel()
 
-				router, _ := setup(t)
+				router, mockNotificationService := setup(t)
+				mockNotificationService.EXPECT().CountUnreadNotifications(mock.Anything, mock.Anything).Maybe().Return(0, nil)
+				mockNotificationService.EXPECT().MarkAllNotificationsRead(mock.Anything, mock.Anything).Maybe().Return(0, nil)
+				mockNotificationService.EXPECT().MarkNotificationRead(mock.Anything, mock.Anything, mock.Anything).Maybe().Return(nil)
+				mockNotificationService.EXPECT().DismissNotification(mock.Anything, mock.Anything, mock.Anything).Maybe().Return(nil)
 
 				req, _ := http.NewRequest(ep.method, ep.path, nil)
 				req.Header.Set("Authorization", "Bearer "+integrationTests.CreateJwtTokenWithRole(tt.role))
@@ -278,6 +295,15 @@ func TestCreateNotification(t *testing.T) {
 				responseCode:           http.StatusInternalServerError,
 			},
 		},
+		{
+			name:                 "return service unavailable if the intake queue is full",
+			notificationToCreate: someNotification,
+			mockServiceReturn:    mockServiceReturn{item: models.Notification{}, err: notificationservice.ErrIntakeQueueFull},
+			want: want{
+				notificationServiceArg: new(someNotification),
+				responseCode:           http.StatusServiceUnavailable,
+			},
+		},
 		{
 			name:                 "don't create a notification if mandatory parameters not set",
 			notificationToCreate: models.Notification{},
@@ -294,8 +320,8 @@ func TestCreateNotification(t *testing.T) {
 			router, mockNotificationService := setup(t)
 
 			if tt.want.notificationServiceArg != nil {
-				mockNotificationService.EXPECT().CreateNotification(mock.Anything, *tt.want.notificationServiceArg).
-					Return(tt.mockServiceReturn.item, tt.mockServiceReturn.err).
+				mockNotificationService.EXPECT().CreateNotificationIdempotent(mock.Anything, *tt.want.notificationServiceArg, testCallerID).
+					Return(tt.mockServiceReturn.item, false, tt.mockServiceReturn.err).
 					Once()
 			}
 
@@ -311,3 +337,551 @@ func TestCreateNotification(t *testing.T) {
 		})
 	}
 }
+
+func TestCreateNotification_IdempotencyKey(t *testing.T) {
+	notification := getNotification()
+	notification.Id = ""
+
+	tests := map[string]struct {
+		headerKey    string
+		bodyKey      string
+		wantKey      string // key passed to the service, empty if the service is not called
+		mockRepeated bool
+		wantStatus   int
+	}{
+		"key from header": {
+			headerKey:  "request-1",
+			wantKey:    "request-1",
+			wantStatus: http.StatusCreated,
+		},
+		"key from body": {
+			bodyKey:    "request-1",
+			wantKey:    "request-1",
+			wantStatus: http.StatusCreated,
+		},
+		"same key in header and body": {
+			headerKey:  "request-1",
+			bodyKey:    "request-1",
+			wantKey:    "request-1",
+			wantStatus: http.StatusCreated,
+		},
+		"repeated request returns the original notification": {
+			headerKey:    "request-1",
+			wantKey:      "request-1",
+			mockRepeated: true,
+			wantStatus:   http.StatusOK,
+		},
+		"different keys in header and body": {
+			headerKey:  "request-1",
+			bodyKey:    "request-2",
+			wantStatus: http.StatusBadRequest,
+		},
+		"key in header is too long": {
+			headerKey:  strings.Repeat("a", 256),
+			wantStatus: http.StatusBadRequest,
+		},
+		"key in body is too long": {
+			bodyKey:    strings.Repeat("a", 256),
+			wantStatus: http.StatusBadRequest,
+		},
+	}
+
+	for name, tt := range tests {
+		t.Run(name, func(t *testing.T) {
+			router, mockNotificationService := setup(t)
+
+			if tt.wantKey != "" {
+				wantNotification := notification
+				wantNotification.IdempotencyKey = tt.wantKey
+				mockNotificationService.EXPECT().CreateNotificationIdempotent(mock.Anything, wantNotification, testCallerID).
+					Return(getNotification(), tt.mockRepeated, nil).
+					Once()
+			}
+
+			notificationIn := notification
+			notificationIn.IdempotencyKey = tt.bodyKey
+			body, err := json.Marshal(notificationIn)
+			require.NoError(t, err)
+
+			req, _ := http.NewRequest(http.MethodPost, "/notifications", bytes.NewReader(body))
+			req.Header.Set("Content-Type", "application/json")
+			req.Header.Set("Authorization", "Bearer "+integrationTests.CreateJwtTokenWithRole(iam.Notification))
+			if tt.headerKey != "" {
+				req.Header.Set("Idempotency-Key", tt.headerKey)
+			}
+			w := httptest.NewRecorder()
+			router.ServeHTTP(w, req)
+
+			require.Equal(t, tt.wantStatus, w.Code)
+		})
+	}
+}
+
+func TestListDeliveries_Permissions(t *testing.T) {
+	t.Parallel()
+
+	var endpoints = []struct {
+		name   string
+		method string
+		path   string
+	}{
+		{"List deliveries of notification", http.MethodGet, "/notifications/57fe22b8-89a4-445f-b6c7-ef9ea724ea48/deliveries"},
+		{"Resend notification", http.MethodPost, "/notifications/57fe22b8-89a4-445f-b6c7-ef9ea724ea48/resend"},
+		{"List deliveries", http.MethodPut, "/deliveries"},
+		{"Get delivery options", http.MethodGet, "/deliveries/options"},
+		{"Get delivery queues", http.MethodGet, "/deliveries/queues"},
+	}
+
+	tests := []struct {
+		role      string
+		wantAllow bool
+	}{
+		{iam.OsiViewer, false},
+		{iam.OsiUser, false},
+		{iam.OsiAdmin, true},
+		{iam.NotificationAdmin, true},
+		{iam.Notification, false},
+	}
+
+	for _, tt := range tests {
+		for _, ep := range endpoints {
+			t.Run(ep.name+" as "+tt.role, func(t *testing.T) {
+				t.Parallel()
+
+				router, mockNotificationService := setup(t)
+				mockNotificationService.EXPECT().ListNotificationDeliveryAttempts(mock.Anything, mock.Anything).Maybe().Return(nil, nil)
+				mockNotificationService.EXPECT().ListDeliveryAttempts(mock.Anything, mock.Anything).Maybe().Return(nil, 0, nil)
+				mockNotificationService.EXPECT().GetWorkerPoolStats().Maybe().Return(models.WorkerPoolStats{})
+
+				req, _ := http.NewRequest(ep.method, ep.path, nil)
+				req.Header.Set("Authorization", "Bearer "+integrationTests.CreateJwtTokenWithRole(tt.role))
+				w := httptest.NewRecorder()
+				router.ServeHTTP(w, req)
+
+				if tt.wantAllow {
+					require.NotEqual(t, http.StatusUnauthorized, w.Code)
+					require.NotEqual(t, http.StatusForbidden, w.Code)
+				} else {
+					require.Equal(t, http.StatusForbidden, w.Code)
+				}
+			})
+		}
+	}
+}
+
+func TestListNotificationDeliveries(t *testing.T) {
+	notificationID := getNotification().Id
+	someAttempt := models.DeliveryAttempt{
+		ID:             "0b8f3d2e-5a41-4c7e-9f10-6d2c8b7a1e34",
+		NotificationID: notificationID,
+		Channel: models.ChannelReference{
+			ID:   "2d6b1e8f-3c4a-4f5b-8e9d-0a1b2c3d4e5f",
+			Name: "ops mail",
+			Type: models.ChannelTypeMail,
+		},
+		Recipient: "ops@example.com",
+		Attempt:   1,
+		Timestamp: time.Date(2026, 1, 2, 3, 4, 5, 0, time.UTC),
+		Outcome:   models.DeliveryOutcomeSuccess,
+	}
+
+	tests := []struct {
+		name           string
+		mockAttempts   []models.DeliveryAttempt
+		mockErr        error
+		wantStatusCode int
+		wantResponse   query.ResponseWithMetadata[[]models.DeliveryAttempt]
+	}{
+		{
+			name:           "returns the delivery attempts of the notification",
+			mockAttempts:   []models.DeliveryAttempt{someAttempt},
+			wantStatusCode: http.StatusOK,
+			wantResponse:   query.ResponseWithMetadata[[]models.DeliveryAttempt]{Data: []models.DeliveryAttempt{someAttempt}},
+		},
+		{
+			name:           "return bad request on invalid id",
+			mockErr:        notificationrepository.ErrInvalidID,
+			wantStatusCode: http.StatusBadRequest,
+		},
+		{
+			name:           "return internal server error on service failure",
+			mockErr:        errors.New("internal service error"),
+			wantStatusCode: http.StatusInternalServerError,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			router, mockNotificationService := setup(t)
+
+			mockNotificationService.EXPECT().ListNotificationDeliveryAttempts(mock.Anything, notificationID).
+				Return(tt.mockAttempts, tt.mockErr).
+				Once()
+
+			var gotResponse query.ResponseWithMetadata[[]models.DeliveryAttempt]
+			httpassert.New(t, router).Get("/notifications/" + notificationID + "/deliveries").
+				AuthJwt(integrationTests.CreateJwtTokenWithRole(iam.NotificationAdmin)).
+				Expect().
+				StatusCode(tt.wantStatusCode).
+				GetJsonBodyObject(&gotResponse)
+			require.Equal(t, tt.wantResponse, gotResponse)
+		})
+	}
+}
+
+func TestGetDeliveryQueues(t *testing.T) {
+	router, mockNotificationService := setup(t)
+
+	stats := models.WorkerPoolStats{
+		Intake: models.QueueStats{Depth: 3, Capacity: 100, Workers: 4},
+		Delivery: map[models.ChannelType]models.QueueStats{
+			models.ChannelTypeMail:  {Depth: 0, Capacity: 500, Workers: 4},
+			models.ChannelTypeTeams: {Depth: 12, Capacity: 500, Workers: 4},
+		},
+	}
+	mockNotificationService.EXPECT().GetWorkerPoolStats().Return(stats).Once()
+
+	var gotResponse query.ResponseWithMetadata[models.WorkerPoolStats]
+	httpassert.New(t, router).Get("/deliveries/queues").
+		AuthJwt(integrationTests.CreateJwtTokenWithRole(iam.NotificationAdmin)).
+		Expect().
+		StatusCode(http.StatusOK).
+		GetJsonBodyObject(&gotResponse)
+	require.Equal(t, query.ResponseWithMetadata[models.WorkerPoolStats]{Data: stats}, gotResponse)
+}
+
+func TestDeadLetters_Permissions(t *testing.T) {
+	t.Parallel()
+
+	deadLetterID := "0b8f3d2e-5a41-4c7e-9f10-6d2c8b7a1e34"
+	var endpoints = []struct {
+		name   string
+		method string
+		path   string
+	}{
+		{"List dead letters", http.MethodPut, "/dead-letters"},
+		{"Get dead letter options", http.MethodGet, "/dead-letters/options"},
+		{"Get dead letter", http.MethodGet, "/dead-letters/" + deadLetterID},
+		{"Replay dead letter", http.MethodPost, "/dead-letters/" + deadLetterID + "/replay"},
+		{"Replay dead letters", http.MethodPost, "/dead-letters/replay"},
+	}
+
+	tests := []struct {
+		role      string
+		wantAllow bool
+	}{
+		{iam.OsiViewer, false},
+		{iam.OsiUser, false},
+		{iam.OsiAdmin, true},
+		{iam.NotificationAdmin, true},
+		{iam.Notification, false},
+	}
+
+	for _, tt := range tests {
+		for _, ep := range endpoints {
+			t.Run(ep.name+" as "+tt.role, func(t *testing.T) {
+				t.Parallel()
+
+				router, mockNotificationService := setup(t)
+				mockNotificationService.EXPECT().GetDeadLetter(mock.Anything, mock.Anything).Maybe().Return(models.DeadLetter{}, nil)
+				mockNotificationService.EXPECT().ReplayDeadLetter(mock.Anything, mock.Anything).Maybe().Return(nil)
+
+				req, _ := http.NewRequest(ep.method, ep.path, nil)
+				req.Header.Set("Authorization", "Bearer "+integrationTests.CreateJwtTokenWithRole(tt.role))
+				w := httptest.NewRecorder()
+				router.ServeHTTP(w, req)
+
+				if tt.wantAllow {
+					require.NotEqual(t, http.StatusUnauthorized, w.Code)
+					require.NotEqual(t, http.StatusForbidden, w.Code)
+				} else {
+					require.Equal(t, http.StatusForbidden, w.Code)
+				}
+			})
+		}
+	}
+}
+
+func TestReplayDeadLetter(t *testing.T) {
+	deadLetterID := "0b8f3d2e-5a41-4c7e-9f10-6d2c8b7a1e34"
+
+	tests := []struct {
+		name           string
+		mockErr        error
+		wantStatusCode int
+	}{
+		{
+			name:           "dead letter is replayed",
+			wantStatusCode: http.StatusNoContent,
+		},
+		{
+			name:           "return not found if the dead letter does not exist",
+			mockErr:        errs.ErrItemNotFound,
+			wantStatusCode: http.StatusNotFound,
+		},
+		{
+			name:           "return bad request on invalid id",
+			mockErr:        notificationrepository.ErrInvalidID,
+			wantStatusCode: http.StatusBadRequest,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			router, mockNotificationService := setup(t)
+
+			mockNotificationService.EXPECT().ReplayDeadLetter(mock.Anything, deadLetterID).Return(tt.mockErr).Once()
+
+			httpassert.New(t, router).Post("/dead-letters/" + deadLetterID + "/replay").
+				AuthJwt(integrationTests.CreateJwtTokenWithRole(iam.NotificationAdmin)).
+				Expect().
+				StatusCode(tt.wantStatusCode)
+		})
+	}
+}
+
+func TestReplayDeadLetters(t *testing.T) {
+	ids := []string{"0b8f3d2e-5a41-4c7e-9f10-6d2c8b7a1e34", "9a1c2b3d-4e5f-4a6b-8c7d-0e1f2a3b4c5d"}
+
+	tests := []struct {
+		name           string
+		request        ReplayDeadLettersRequest
+		wantReplayed   int
+		wantStatusCode int
+		wantResponse   query.ResponseWithMetadata[ReplayDeadLettersResponse]
+	}{
+		{
+			name:           "dead letters are replayed",
+			request:        ReplayDeadLettersRequest{IDs: ids},
+			wantReplayed:   1,
+			wantStatusCode: http.StatusOK,
+			wantResponse:   query.ResponseWithMetadata[ReplayDeadLettersResponse]{Data: ReplayDeadLettersResponse{Replayed: 1}},
+		},
+		{
+			name:           "return bad request if no ids are given",
+			request:        ReplayDeadLettersRequest{},
+			wantStatusCode: http.StatusBadRequest,
+		},
+		{
+			name:           "return bad request if too many ids are given",
+			request:        ReplayDeadLettersRequest{IDs: make([]string, maxReplayDeadLetters+1)},
+			wantStatusCode: http.StatusBadRequest,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			router, mockNotificationService := setup(t)
+
+			if tt.wantStatusCode == http.StatusOK {
+				mockNotificationService.EXPECT().ReplayDeadLetters(mock.Anything, tt.request.IDs).Return(tt.wantReplayed, nil).Once()
+			}
+
+			var gotResponse query.ResponseWithMetadata[ReplayDeadLettersResponse]
+			httpassert.New(t, router).Post("/dead-letters/replay").
+				AuthJwt(integrationTests.CreateJwtTokenWithRole(iam.NotificationAdmin)).
+				JsonContentObject(tt.request).
+				Expect().
+				StatusCode(tt.wantStatusCode).
+				GetJsonBodyObject(&gotResponse)
+			require.Equal(t, tt.wantResponse, gotResponse)
+		})
+	}
+}
+
+func TestResendNotification(t *testing.T) {
+	notificationID := getNotification().Id
+	channelTarget := models.ResendTarget{ChannelID: "2d6b1e8f-3c4a-4f5b-8e9d-0a1b2c3d4e5f"}
+
+	tests := []struct {
+		name           string
+		target         models.ResendTarget
+		serviceCall    bool
+		mockErr        error
+		wantStatusCode int
+	}{
+		{
+			name:           "deliveries are scheduled",
+			target:         channelTarget,
+			serviceCall:    true,
+			wantStatusCode: http.StatusAccepted,
+		},
+		{
+			name:           "return bad request on invalid target",
+			target:         models.ResendTarget{},
+			wantStatusCode: http.StatusBadRequest,
+		},
+		{
+			name:           "return bad request if channel does not exist",
+			target:         channelTarget,
+			serviceCall:    true,
+			mockErr:        notificationservice.ErrResendChannelNotFound,
+			wantStatusCode: http.StatusBadRequest,
+		},
+		{
+			name:           "return not found if notification does not exist",
+			target:         channelTarget,
+			serviceCall:    true,
+			mockErr:        errs.ErrItemNotFound,
+			wantStatusCode: http.StatusNotFound,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			router, mockNotificationService := setup(t)
+
+			if tt.serviceCall {
+				mockNotificationService.EXPECT().ResendNotification(mock.Anything, notificationID, tt.target).
+					Return(tt.mockErr).
+					Once()
+			}
+
+			httpassert.New(t, router).Post("/notifications/" + notificationID + "/resend").
+				AuthJwt(integrationTests.CreateJwtTokenWithRole(iam.NotificationAdmin)).
+				JsonContentObject(tt.target).
+				Expect().
+				StatusCode(tt.wantStatusCode)
+		})
+	}
+}
+
+func TestAcknowledgeNotification_Permissions(t *testing.T) {
+	t.Parallel()
+
+	tests := []struct {
+		role      string
+		wantAllow bool
+	}{
+		{iam.OsiViewer, false},
+		{iam.OsiUser, true},
+		{iam.OsiAdmin, true},
+		{iam.NotificationAdmin, true},
+		{iam.Notification, false},
+	}
+
+	for _, tt := range tests {
+		t.Run("Acknowledge notification as "+tt.role, func(t *testing.T) {
+			t.Parallel()
+
+			router, mockNotificationService := setup(t)
+			mockNotificationService.EXPECT().AcknowledgeNotification(mock.Anything, mock.Anything, mock.Anything).Maybe().
+				Return(models.Notification{}, nil)
+
+			req, _ := http.NewRequest(http.MethodPost, "/notifications/57fe22b8-89a4-445f-b6c7-ef9ea724ea48/acknowledge", nil)
+			req.Header.Set("Authorization", "Bearer "+integrationTests.CreateJwtTokenWithRole(tt.role))
+			w := httptest.NewRecorder()
+			router.ServeHTTP(w, req)
+
+			if tt.wantAllow {
+				require.NotEqual(t, http.StatusUnauthorized, w.Code)
+				require.NotEqual(t, http.StatusForbidden, w.Code)
+			} else {
+				require.Equal(t, http.StatusForbidden, w.Code)
+			}
+		})
+	}
+}
+
+func TestAcknowledgeNotification(t *testing.T) {
+	notificationID := getNotification().Id
+	acknowledged := getNotification()
+	acknowledged.AcknowledgedAt = new(time.Date(2024, 1, 1, 12, 0, 0, 0, time.UTC))
+	acknowledged.AcknowledgedBy = testCallerID
+
+	tests := []struct {
+		name           string
+		mockErr        error
+		wantStatusCode int
+	}{
+		{
+			name:           "notification is acknowledged",
+			wantStatusCode: http.StatusOK,
+		},
+		{
+			name:           "return bad request on invalid ID",
+			mockErr:        notificationrepository.ErrInvalidID,
+			wantStatusCode: http.StatusBadRequest,
+		},
+		{
+			name:           "return not found if notification does not exist",
+			mockErr:        errs.ErrItemNotFound,
+			wantStatusCode: http.StatusNotFound,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			router, mockNotificationService := setup(t)
+
+			mockNotificationService.EXPECT().AcknowledgeNotification(mock.Anything, notificationID, testCallerID).
+				Return(acknowledged, tt.mockErr).
+				Once()
+
+			response := httpassert.New(t, router).Post("/notifications/" + notificationID + "/acknowledge").
+				AuthJwt(integrationTests.CreateJwtTokenWithRole(iam.OsiUser)).
+				Expect().
+				StatusCode(tt.wantStatusCode)
+			if tt.mockErr == nil {
+				response.JsonPath("$.data.acknowledgedBy", testCallerID)
+			}
+		})
+	}
+}
+
+func TestNotificationUserState(t *testing.T) {
+	notificationID := getNotification().Id
+
+	t.Run("mark notification read", func(t *testing.T) {
+		router, mockNotificationService := setup(t)
+		mockNotificationService.EXPECT().MarkNotificationRead(mock.Anything, notificationID, testCallerID).Return(nil).Once()
+
+		httpassert.New(t, router).Post("/notifications/" + notificationID + "/read").
+			AuthJwt(integrationTests.CreateJwtTokenWithRole(iam.OsiViewer)).
+			Expect().
+			StatusCode(http.StatusNoContent)
+	})
+
+	t.Run("return not found if notification does not exist", func(t *testing.T) {
+		router, mockNotificationService := setup(t)
+		mockNotificationService.EXPECT().MarkNotificationRead(mock.Anything, notificationID, testCallerID).
+			Return(errs.ErrItemNotFound).Once()
+
+		httpassert.New(t, router).Post("/notifications/" + notificationID + "/read").
+			AuthJwt(integrationTests.CreateJwtTokenWithRole(iam.OsiViewer)).
+			Expect().
+			StatusCode(http.StatusNotFound)
+	})
+
+	t.Run("dismiss notification", func(t *testing.T) {
+		router, mockNotificationService := setup(t)
+		mockNotificationService.EXPECT().DismissNotification(mock.Anything, notificationID, testCallerID).Return(nil).Once()
+
+		httpassert.New(t, router).Post("/notifications/" + notificationID + "/dismiss").
+			AuthJwt(integrationTests.CreateJwtTokenWithRole(iam.OsiViewer)).
+			Expect().
+			StatusCode(http.StatusNoContent)
+	})
+
+	t.Run("mark all notifications read", func(t *testing.T) {
+		router, mockNotificationService := setup(t)
+		mockNotificationService.EXPECT().MarkAllNotificationsRead(mock.Anything, testCallerID).Return(5, nil).Once()
+
+		httpassert.New(t, router).Post("/notifications/read").
+			AuthJwt(integrationTests.CreateJwtTokenWithRole(iam.OsiViewer)).
+			Expect().
+			StatusCode(http.StatusOK).
+			JsonPath("$.data.marked", 5)
+	})
+
+	t.Run("get unread count", func(t *testing.T) {
+		router, mockNotificationService := setup(t)
+		mockNotificationService.EXPECT().CountUnreadNotifications(mock.Anything, testCallerID).Return(3, nil).Once()
+
+		httpassert.New(t, router).Get("/notifications/unread-count").
+			AuthJwt(integrationTests.CreateJwtTokenWithRole(iam.OsiViewer)).
+			Expect().
+			StatusCode(http.StatusOK).
+			JsonPath("$.data.unread", 3)
+	})
+}
@@ -7,8 +7,10 @@ package notificationcontroller
 import (
 	"github.com/greenbone/opensight-golang-libraries/pkg/query/filter"
 	"github.com/greenbone/opensight-golang-libraries/pkg/query/sorting"
+	"github.com/greenbone/opensight-notification-service/pkg/models"
 	"github.com/greenbone/opensight-notification-service/pkg/services/notificationservice/dtos"
 	"github.com/greenbone/opensight-notification-service/pkg/web"
+	"github.com/samber/lo"
 )
 
 var NotificationsRequestOptions = []filter.RequestOption{
@@ -69,11 +71,187 @@ var NotificationsRequestOptions = []filter.RequestOption{
 		Values:      []string{"info", "warning", "error"},
 		MultiSelect: true,
 	},
+	{
+		Name: web.ReadStateFilterRequestName,
+		Control: filter.RequestOptionType{
+			Type: filter.ControlTypeEnum,
+		},
+		Operators: filter.SortedReadableValues(
+			web.OperatorEqual,
+		),
+		Values:      lo.Map(models.AllowedReadStates, func(s models.ReadState, _ int) string { return string(s) }),
+		MultiSelect: true,
+	},
 }
 
-var AllowedNotificationsSortFields = []string{dtos.NameField, dtos.DescriptionFieldName, dtos.OccurrenceFieldName, dtos.LevelFieldName, dtos.OriginFieldName}
+var AllowedNotificationsSortFields = []string{dtos.NameField, dtos.DescriptionFieldName, dtos.OccurrenceFieldName, dtos.LevelFieldName, dtos.OriginFieldName, dtos.RepeatsFieldName}
 
 var DefaultSortingRequest = &sorting.Request{
 	SortColumn:    dtos.OccurrenceFieldName, // default sort by latest notification
 	SortDirection: sorting.DirectionDescending,
 }
+
+var DeliveriesRequestOptions = []filter.RequestOption{
+	{
+		Name: filter.ReadableValue[string]{Label: "Notification", Value: dtos.DeliveryNotificationIDField},
+		Control: filter.RequestOptionType{
+			Type: filter.ControlTypeString,
+		},
+		Operators: filter.SortedReadableValues(
+			web.OperatorEqual,
+		),
+		MultiSelect: true,
+	},
+	{
+		Name: filter.ReadableValue[string]{Label: "Rule", Value: dtos.DeliveryRuleIDField},
+		Control: filter.RequestOptionType{
+			Type: filter.ControlTypeString,
+		},
+		Operators: filter.SortedReadableValues(
+			web.OperatorEqual,
+		),
+		MultiSelect: true,
+	},
+	{
+		Name: filter.ReadableValue[string]{Label: "Channel", Value: dtos.DeliveryChannelNameField},
+		Control: filter.RequestOptionType{
+			Type: filter.ControlTypeString,
+		},
+		Operators: filter.SortedReadableValues(
+			web.OperatorEqual,
+			web.OperatorContains,
+			web.OperatorBeginsWith,
+		),
+		MultiSelect: true,
+	},
+	{
+		Name: filter.ReadableValue[string]{Label: "Channel type", Value: dtos.DeliveryChannelTypeField},
+		Control: filter.RequestOptionType{
+			Type: filter.ControlTypeEnum,
+		},
+		Operators: filter.SortedReadableValues(
+			web.OperatorEqual,
+		),
+		Values:      lo.Map(models.AllowedChannels, func(t models.ChannelType, _ int) string { return string(t) }),
+		MultiSelect: true,
+	},
+	{
+		Name: filter.ReadableValue[string]{Label: "Recipient", Value: dtos.DeliveryRecipientField},
+		Control: filter.RequestOptionType{
+			Type: filter.ControlTypeString,
+		},
+		Operators: filter.SortedReadableValues(
+			web.OperatorEqual,
+			web.OperatorContains,
+			web.OperatorBeginsWith,
+		),
+		MultiSelect: true,
+	},
+	{
+		Name: filter.ReadableValue[string]{Label: "Time", Value: dtos.DeliveryTimestampField},
+		Control: filter.RequestOptionType{
+			Type: filter.ControlTypeDateTime,
+		},
+		Operators: filter.SortedReadableValues(
+			web.OperatorBefore,
+			web.OperatorAfter,
+		),
+	},
+	{
+		Name: filter.ReadableValue[string]{Label: "Outcome", Value: dtos.DeliveryOutcomeField},
+		Control: filter.RequestOptionType{
+			Type: filter.ControlTypeEnum,
+		},
+		Operators: filter.SortedReadableValues(
+			web.OperatorEqual,
+		),
+		Values: []string{
+			string(models.DeliveryOutcomeSuccess),
+			string(models.DeliveryOutcomeFailure),
+			string(models.DeliveryOutcomeDropped),
+		},
+		MultiSelect: true,
+	},
+}
+
+var AllowedDeliveriesSortFields = []string{dtos.DeliveryTimestampField, dtos.DeliveryChannelNameField, dtos.DeliveryRecipientField, dtos.DeliveryOutcomeField}
+
+var DefaultDeliveriesSortingRequest = &sorting.Request{
+	SortColumn:    dtos.DeliveryTimestampField, // default sort by latest attempt
+	SortDirection: sorting.DirectionDescending,
+}
+
+var DeadLettersRequestOptions = []filter.RequestOption{
+	{
+		Name: filter.ReadableValue[string]{Label: "Notification", Value: dtos.DeadLetterNotificationIDField},
+		Control: filter.RequestOptionType{
+			Type: filter.ControlTypeString,
+		},
+		Operators: filter.SortedReadableValues(
+			web.OperatorEqual,
+		),
+		MultiSelect: true,
+	},
+	{
+		Name: filter.ReadableValue[string]{Label: "Rule", Value: dtos.DeadLetterRuleIDField},
+		Control: filter.RequestOptionType{
+			Type: filter.ControlTypeString,
+		},
+		Operators: filter.SortedReadableValues(
+			web.OperatorEqual,
+		),
+		MultiSelect: true,
+	},
+	{
+		Name: filter.ReadableValue[string]{Label: "Channel", Value: dtos.DeadLetterChannelNameField},
+		Control: filter.RequestOptionType{
+			Type: filter.ControlTypeString,
+		},
+		Operators: filter.SortedReadableValues(
+			web.OperatorEqual,
+			web.OperatorContains,
+			web.OperatorBeginsWith,
+		),
+		MultiSelect: true,
+	},
+	{
+		Name: filter.ReadableValue[string]{Label: "Channel type", Value: dtos.DeadLetterChannelTypeField},
+		Control: filter.RequestOptionType{
+			Type: filter.ControlTypeEnum,
+		},
+		Operators: filter.SortedReadableValues(
+			web.OperatorEqual,
+		),
+		Values:      lo.Map(models.AllowedChannels, func(t models.ChannelType, _ int) string { return string(t) }),
+		MultiSelect: true,
+	},
+	{
+		Name: filter.ReadableValue[string]{Label: "Recipient", Value: dtos.DeadLetterRecipientField},
+		Control: filter.RequestOptionType{
+			Type: filter.ControlTypeString,
+		},
+		Operators: filter.SortedReadableValues(
+			web.OperatorEqual,
+			web.OperatorContains,
+			web.OperatorBeginsWith,
+		),
+		MultiSelect: true,
+	},
+	{
+		Name: filter.ReadableValue[string]{Label: "Time", Value: dtos.DeadLetterTimestampField},
+		Control: filter.RequestOptionType{
+			Type: filter.ControlTypeDateTime,
+		},
+		Operators: filter.SortedReadableValues(
+			web.OperatorBefore,
+			web.OperatorAfter,
+		),
+	},
+}
+
+var AllowedDeadLettersSortFields = []string{dtos.DeadLetterTimestampField, dtos.DeadLetterChannelNameField, dtos.DeadLetterRecipientField}
+
+var DefaultDeadLettersSortingRequest = &sorting.Request{
+	SortColumn:    dtos.DeadLetterTimestampField, // default sort by latest dead letter
+	SortDirection: sorting.DirectionDescending,
+}
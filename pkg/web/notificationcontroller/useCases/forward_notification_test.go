@@ -6,8 +6,10 @@ package usecases
 
 import (
 	"context"
+	"encoding/json"
 	"fmt"
 	"net/http"
+	"net/http/httptest"
 	"strings"
 	"testing"
 	"time"
@@ -15,17 +17,21 @@ import (
 	"github.com/gin-gonic/gin"
 	"github.com/greenbone/keycloak-client-golang/auth"
 	"github.com/greenbone/opensight-golang-libraries/pkg/httpassert"
+	"github.com/greenbone/opensight-golang-libraries/pkg/query"
 	"github.com/greenbone/opensight-notification-service/pkg/config"
 	"github.com/greenbone/opensight-notification-service/pkg/models"
 	"github.com/greenbone/opensight-notification-service/pkg/pgtesting"
 	"github.com/greenbone/opensight-notification-service/pkg/repository/notificationrepository"
 	"github.com/greenbone/opensight-notification-service/pkg/repository/originrepository"
 	"github.com/greenbone/opensight-notification-service/pkg/repository/rulerepository"
+	"github.com/greenbone/opensight-notification-service/pkg/repository/templaterepository"
 	"github.com/greenbone/opensight-notification-service/pkg/security"
 	"github.com/greenbone/opensight-notification-service/pkg/services/notificationchannelservice"
 	"github.com/greenbone/opensight-notification-service/pkg/services/notificationchannelservice/mocks"
 	"github.com/greenbone/opensight-notification-service/pkg/services/notificationservice"
+	"github.com/greenbone/opensight-notification-service/pkg/services/originservice"
 	"github.com/greenbone/opensight-notification-service/pkg/services/ruleservice"
+	"github.com/greenbone/opensight-notification-service/pkg/services/templateservice"
 	"github.com/greenbone/opensight-notification-service/pkg/web/errmap"
 	"github.com/greenbone/opensight-notification-service/pkg/web/iam"
 	"github.com/greenbone/opensight-notification-service/pkg/web/integrationTests"
@@ -50,12 +56,22 @@ func setup(t *testing.T) (
 	})
 	notificationRepo, err := notificationrepository.NewNotificationRepository(db)
 	require.NoError(t, err)
+	sendTaskRepo, err := notificationrepository.NewSendTaskRepository(db)
+	require.NoError(t, err)
+	deliveryAttemptRepo, err := notificationrepository.NewDeliveryAttemptRepository(db)
+	require.NoError(t, err)
+	deadLetterRepo, err := notificationrepository.NewDeadLetterRepository(db)
+	require.NoError(t, err)
+	escalationRepo, err := notificationrepository.NewEscalationRepository(db)
+	require.NoError(t, err)
 	channelRepo, err := notificationrepository.NewNotificationChannelRepository(db, encryptMgr)
 	require.NoError(t, err)
 	ruleRepo, err := rulerepository.NewRuleRepository(db)
 	require.NoError(t, err)
 	originRepo, err := originrepository.NewOriginRepository(db)
 	require.NoError(t, err)
+	templateRepo, err := templaterepository.NewTemplateRepository(db)
+	require.NoError(t, err)
 
 	// setup services
 	mockMailService := mocks.NewMailService(t)
@@ -65,14 +81,31 @@ func setup(t *testing.T) (
 	mailChannelService := notificationchannelservice.NewMailChannelService(channelService, mockMailService, mailLimit)
 	ruleService, err := ruleservice.NewRuleService(ruleRepo, channelRepo, originRepo, ruleLimit)
 	require.NoError(t, err)
+	templateSvc := templateservice.NewTemplateService(templateRepo)
+	originSvc := originservice.NewOriginService(originRepo, "https://opensight.example.com")
 
 	notificationSvc := notificationservice.NewNotificationService(
 		notificationRepo,
+		sendTaskRepo,
+		deliveryAttemptRepo,
+		deadLetterRepo,
+		escalationRepo,
 		ruleService,
 		channelService,
+		templateSvc,
+		originSvc,
 		mockMailService,
 		nil,
 		nil,
+		nil,
+		nil,
+		nil,
+		nil,
+		nil,
+		nil,
+		notificationservice.WorkerPoolConfig{RuleWorkers: 1, IntakeQueueSize: 10, DeliveryWorkers: 1, DeliveryQueueSize: 10},
+		time.Hour,
+		0,
 	)
 
 	registry := errmap.NewRegistry()
@@ -82,7 +115,7 @@ func setup(t *testing.T) (
 
 	mailcontroller.NewMailController(router, channelService, mailChannelService, authMiddleware, registry)
 	rulecontroller.NewRuleController(router, ruleService, authMiddleware, registry)
-	notificationcontroller.AddNotificationController(router, notificationSvc, authMiddleware)
+	notificationcontroller.AddNotificationController(router, notificationSvc, authMiddleware, registry)
 
 	return router, mockMailService
 }
@@ -150,13 +183,12 @@ func TestForwardNotification(t *testing.T) {
 			mock.Anything,
 			mock.Anything,
 			recipient,
-			mock.MatchedBy(func(subject string) bool {
-				return strings.Contains(subject, notification.Title)
-			}),
-			mock.MatchedBy(func(body string) bool {
-				return strings.Contains(body, notification.Detail)
+			mock.MatchedBy(func(message models.MailMessage) bool {
+				return strings.Contains(message.Subject, notification.Title) &&
+					strings.Contains(message.HTMLBody, notification.Detail) &&
+					strings.Contains(message.TextBody, notification.Detail)
 			}),
-		).RunAndReturn(func(ctx context.Context, channel models.NotificationChannel, recipient, subject, htmlBody string) error {
+		).RunAndReturn(func(ctx context.Context, channel models.NotificationChannel, recipient string, message models.MailMessage) error {
 			notificationReceived <- recipient
 			return nil
 		}).Times(1)
@@ -166,12 +198,15 @@ func TestForwardNotification(t *testing.T) {
 	}
 
 	// create notification that should trigger the rule and be forwarded to all recipients
+	var notificationID string
 	httpassert.New(t, router).
 		Post("/notifications").
 		JsonContentObject(notification).
 		AuthJwt(integrationTests.CreateJwtTokenWithRole(iam.Notification)).
 		Expect().
-		StatusCode(http.StatusCreated)
+		StatusCode(http.StatusCreated).
+		JsonPath("$.data.id", httpassert.ExtractTo(&notificationID))
+	require.NotEmpty(t, notificationID)
 
 	// Wait for both notifications to be forwarded or timeout
 	var receivedRecipients []string
@@ -190,4 +225,26 @@ func TestForwardNotification(t *testing.T) {
 	}
 
 	require.ElementsMatch(t, expectedRecipients, receivedRecipients)
+
+	// deliveries are recorded right after sending
+	var deliveries query.ResponseWithMetadata[[]models.DeliveryAttempt]
+	require.Eventually(t, func() bool {
+		req := httptest.NewRequest(http.MethodGet, "/notifications/"+notificationID+"/deliveries", nil)
+		req.Header.Set("Authorization", "Bearer "+integrationTests.CreateJwtTokenWithRole(iam.NotificationAdmin))
+		w := httptest.NewRecorder()
+		router.ServeHTTP(w, req)
+		return w.Code == http.StatusOK &&
+			json.Unmarshal(w.Body.Bytes(), &deliveries) == nil &&
+			len(deliveries.Data) == len(expectedRecipients)
+	}, 2*time.Second, 50*time.Millisecond)
+
+	var deliveredRecipients []string
+	for _, delivery := range deliveries.Data {
+		require.Equal(t, models.DeliveryOutcomeSuccess, delivery.Outcome)
+		require.Equal(t, ruleID, delivery.RuleID)
+		require.Equal(t, mailChannelID, delivery.Channel.ID)
+		require.Equal(t, 1, delivery.Attempt)
+		deliveredRecipients = append(deliveredRecipients, delivery.Recipient)
+	}
+	require.ElementsMatch(t, expectedRecipients, deliveredRecipients)
 }
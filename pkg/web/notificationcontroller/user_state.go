@@ -0,0 +1,135 @@
+// SPDX-FileCopyrightText: 2026 Greenbone AG <https://greenbone.net>
+//
+// SPDX-License-Identifier: AGPL-3.0-or-later
+
+package notificationcontroller
+
+import (
+	"net/http"
+
+	"github.com/gin-gonic/gin"
+	"github.com/greenbone/keycloak-client-golang/auth"
+	"github.com/greenbone/opensight-golang-libraries/pkg/query"
+	"github.com/greenbone/opensight-notification-service/pkg/web"
+	"github.com/greenbone/opensight-notification-service/pkg/web/ginEx"
+)
+
+type UnreadCountResponse struct {
+	Unread int `json:"unread"` // number of notifications not read by the current user
+}
+
+type MarkAllReadResponse struct {
+	Marked int `json:"marked"` // number of notifications which were unread before
+}
+
+// MarkNotificationRead
+//
+//	@Summary		Mark Notification as read
+//	@Description	Marks the notification as read by the current user. Marking it again keeps the time it was read first.
+//	@Tags			notification
+//	@Security		KeycloakAuth
+//	@Param			id	path	string	true	"unique ID of the notification"
+//	@Success		204	"notification marked as read"
+//	@Failure		400	{object}	errorResponses.ErrorResponse	"invalid ID"
+//	@Failure		404	{object}	errorResponses.ErrorResponse	"notification not found"
+//	@Header			all	{string}	api-version	"API version"
+//	@Router			/notifications/{id}/read [post]
+func (c *NotificationController) MarkNotificationRead(gc *gin.Context) {
+	gc.Header(web.APIVersionKey, web.APIVersion)
+
+	userContext, err := auth.GetUserContext(gc)
+	if ginEx.AddError(gc, err) {
+		return
+	}
+
+	err = c.notificationService.MarkNotificationRead(gc, gc.Param("id"), userContext.UserID)
+	if ginEx.AddError(gc, err) {
+		return
+	}
+
+	gc.Status(http.StatusNoContent)
+}
+
+// MarkAllNotificationsRead
+//
+//	@Summary		Mark all Notifications as read
+//	@Description	Marks all notifications as read by the current user.
+//	@Tags			notification
+//	@Produce		json
+//	@Security		KeycloakAuth
+//	@Success		200	{object}	query.ResponseWithMetadata[MarkAllReadResponse]
+//	@Header			all	{string}	api-version	"API version"
+//	@Router			/notifications/read [post]
+func (c *NotificationController) MarkAllNotificationsRead(gc *gin.Context) {
+	gc.Header(web.APIVersionKey, web.APIVersion)
+
+	userContext, err := auth.GetUserContext(gc)
+	if ginEx.AddError(gc, err) {
+		return
+	}
+
+	marked, err := c.notificationService.MarkAllNotificationsRead(gc, userContext.UserID)
+	if ginEx.AddError(gc, err) {
+		return
+	}
+
+	gc.JSON(http.StatusOK, query.ResponseWithMetadata[MarkAllReadResponse]{
+		Data: MarkAllReadResponse{Marked: marked},
+	})
+}
+
+// DismissNotification
+//
+//	@Summary		Dismiss Notification
+//	@Description	Marks the notification as dismissed by the current user, e.g. to hide it in the frontend. A dismissed notification is also read.
+//	@Tags			notification
+//	@Security		KeycloakAuth
+//	@Param			id	path	string	true	"unique ID of the notification"
+//	@Success		204	"notification dismissed"
+//	@Failure		400	{object}	errorResponses.ErrorResponse	"invalid ID"
+//	@Failure		404	{object}	errorResponses.ErrorResponse	"notification not found"
+//	@Header			all	{string}	api-version	"API version"
+//	@Router			/notifications/{id}/dismiss [post]
+func (c *NotificationController) DismissNotification(gc *gin.Context) {
+	gc.Header(web.APIVersionKey, web.APIVersion)
+
+	userContext, err := auth.GetUserContext(gc)
+	if ginEx.AddError(gc, err) {
+		return
+	}
+
+	err = c.notificationService.DismissNotification(gc, gc.Param("id"), userContext.UserID)
+	if ginEx.AddError(gc, err) {
+		return
+	}
+
+	gc.Status(http.StatusNoContent)
+}
+
+// GetUnreadCount
+//
+//	@Summary		Unread Notifications count
+//	@Description	Returns the number of notifications which are not read by the current user, e.g. for a badge in the frontend.
+//	@Tags			notification
+//	@Produce		json
+//	@Security		KeycloakAuth
+//	@Success		200	{object}	query.ResponseWithMetadata[UnreadCountResponse]
+//	@Header			all	{string}	api-version	"API version"
+//	@Router			/notifications/unread-count [get]
+func (c *NotificationController) GetUnreadCount(gc *gin.Context) {
+	gc.Header(web.APIVersionKey, web.APIVersion)
+
+	userContext, err := auth.GetUserContext(gc)
+	if ginEx.AddError(gc, err) {
+		return
+	}
+
+	unread, err := c.notificationService.CountUnreadNotifications(gc, userContext.UserID)
+	if ginEx.AddError(gc, err) {
+		return
+	}
+
+	gc.JSON(http.StatusOK, query.ResponseWithMetadata[UnreadCountResponse]{
+		Data: UnreadCountResponse{Unread: unread},
+	})
+}
@@ -50,7 +50,7 @@ func (c *OriginController) RegisterRoutes(router gin.IRouter, auth gin.HandlerFu
 // RegisterOrigins
 //
 //	@Summary		Register Origins
-//	@Description	Registers a set of origins in the given service. Replaces origins of this service if they already existed. The origins can be ulitized to set trigger conditions for actions.
+//	@Description	Registers a set of origins in the given service. Replaces origins of this service if they already existed. The origins can be ulitized to set trigger conditions for actions. With a URL template, forwarded messages link to the resource of the notification.
 //	@Tags			origin
 //	@Accept			json
 //	@Produce		json
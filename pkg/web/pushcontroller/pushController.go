@@ -0,0 +1,208 @@
+package pushcontroller
+
+import (
+	"errors"
+	"net/http"
+
+	"github.com/gin-gonic/gin"
+	"github.com/greenbone/opensight-golang-libraries/pkg/errorResponses"
+	"github.com/greenbone/opensight-notification-service/pkg/models"
+	"github.com/greenbone/opensight-notification-service/pkg/services/notificationchannelservice"
+	"github.com/greenbone/opensight-notification-service/pkg/translation"
+	"github.com/greenbone/opensight-notification-service/pkg/web/errmap"
+	"github.com/greenbone/opensight-notification-service/pkg/web/ginEx"
+	"github.com/greenbone/opensight-notification-service/pkg/web/iam"
+	"github.com/greenbone/opensight-notification-service/pkg/web/middleware"
+	"github.com/greenbone/opensight-notification-service/pkg/web/pushcontroller/pushdto"
+)
+
+type PushController struct {
+	notificationChannelServicer notificationchannelservice.NotificationChannelService
+	pushChannelService          notificationchannelservice.PushChannelService
+}
+
+func NewPushController(
+	router gin.IRouter,
+	notificationChannelServicer notificationchannelservice.NotificationChannelService,
+	pushChannelService notificationchannelservice.PushChannelService,
+	auth gin.HandlerFunc,
+	registry *errmap.Registry,
+) *PushController {
+	ctrl := &PushController{
+		notificationChannelServicer: notificationChannelServicer,
+		pushChannelService:          pushChannelService,
+	}
+
+	group := router.Group("/notification-channel/push").
+		Use(middleware.AuthorizeRoles(auth, iam.OsiAdmin, iam.NotificationAdmin)...)
+	group.Use(errorHandler(gin.ErrorTypePrivate))
+
+	group.POST("", ctrl.createPushChannel)
+	group.GET("", ctrl.listPushChannels)
+	group.PUT("/:id", ctrl.updatePushChannel)
+	group.DELETE("/:id", ctrl.deletePushChannel)
+	group.POST("/check", ctrl.sendPushTestMessage)
+
+	ctrl.configureMappings(registry)
+	return ctrl
+}
+
+func errorHandler(errorType gin.ErrorType) gin.HandlerFunc {
+	return func(c *gin.Context) {
+		c.Next()
+		for _, errorValue := range c.Errors.ByType(errorType) {
+			if errors.Is(errorValue, notificationchannelservice.ErrPushMessageDelivery) {
+				c.AbortWithStatusJSON(http.StatusBadRequest, errorResponses.NewErrorGenericResponse(errorValue.Error()))
+				return
+			}
+		}
+	}
+}
+
+func (pc *PushController) configureMappings(r *errmap.Registry) {
+	r.Register(
+		notificationchannelservice.ErrPushChannelLimitReached,
+		http.StatusUnprocessableEntity,
+		errorResponses.NewErrorGenericResponse(translation.PushChannelLimitReached),
+	)
+	r.Register(
+		notificationchannelservice.ErrListPushChannels,
+		http.StatusInternalServerError,
+		errorResponses.ErrorInternalResponse,
+	)
+	r.Register(
+		notificationchannelservice.ErrPushChannelNameExists,
+		http.StatusBadRequest,
+		errorResponses.NewErrorGenericResponse(translation.PushChannelNameAlreadyExist),
+	)
+	r.Register(
+		notificationchannelservice.ErrPushAppTokenIsRequired,
+		http.StatusBadRequest,
+		errorResponses.NewErrorGenericResponse(translation.AppTokenIsRequired),
+	)
+}
+
+// CreatePushChannel
+//
+//	@Summary		Create Push Channel
+//	@Description	Create a new push notification channel for a ntfy or Gotify server
+//	@Tags			push-channel
+//	@Accept			json
+//	@Produce		json
+//	@Security		KeycloakAuth
+//	@Param			PushChannel	body		pushdto.PushNotificationChannelRequest	true	"Push channel to add"
+//	@Success		201			{object}	pushdto.PushNotificationChannelResponse
+//	@Failure		400			{object}	map[string]string
+//	@Failure		500			{object}	map[string]string
+//	@Router			/notification-channel/push [post]
+func (pc *PushController) createPushChannel(c *gin.Context) {
+	var channel pushdto.PushNotificationChannelRequest
+	if !ginEx.BindAndValidateBody(c, &channel) {
+		return
+	}
+
+	pushChannel, err := pc.pushChannelService.CreatePushChannel(c, channel)
+	if ginEx.AddError(c, err) {
+		return
+	}
+
+	c.JSON(http.StatusCreated, pushChannel)
+}
+
+// ListPushChannels
+//
+//	@Summary		List Push Channels
+//	@Description	List push notification channels
+//	@Tags			push-channel
+//	@Produce		json
+//	@Security		KeycloakAuth
+//	@Success		200		{array}		pushdto.PushNotificationChannelResponse
+//	@Failure		500		{object}	map[string]string
+//	@Router			/notification-channel/push [get]
+func (pc *PushController) listPushChannels(c *gin.Context) {
+	channels, err := pc.notificationChannelServicer.ListNotificationChannelsByType(c, models.ChannelTypePush)
+	if ginEx.AddError(c, err) {
+		return
+	}
+
+	c.JSON(http.StatusOK, pushdto.MapNotificationChannelsToPush(channels))
+}
+
+// UpdatePushChannel
+//
+//	@Summary		Update Push Channel
+//	@Description	Update an existing push notification channel
+//	@Tags			push-channel
+//	@Accept			json
+//	@Produce		json
+//	@Security		KeycloakAuth
+//	@Param			id			path		string						true	"Push channel ID"
+//	@Param			PushChannel	body		pushdto.PushNotificationChannelRequest	true	"Push channel to update"
+//	@Success		200			{object}	pushdto.PushNotificationChannelResponse
+//	@Failure		400			{object}	map[string]string
+//	@Failure		404 		{object}    map[string]string
+//	@Failure		500			{object}	map[string]string
+//	@Router			/notification-channel/push/{id} [put]
+func (pc *PushController) updatePushChannel(c *gin.Context) {
+	id := c.Param("id")
+
+	var channel pushdto.PushNotificationChannelRequest
+	if !ginEx.BindAndValidateBody(c, &channel) {
+		return
+	}
+
+	updated, err := pc.pushChannelService.UpdatePushChannel(c, id, channel)
+	if ginEx.AddError(c, err) {
+		return
+	}
+
+	c.JSON(http.StatusOK, updated)
+}
+
+// DeletePushChannel
+//
+//		@Summary		Delete Push Channel
+//		@Description	Delete a push notification channel
+//		@Tags			push-channel
+//		@Security		KeycloakAuth
+//		@Param			id	path	string	true	"Push channel ID"
+//		@Success		204	"Deleted successfully"
+//		@Failure		500	{object}	map[string]string
+//	    @Failure		404 {object}    map[string]string
+//		@Router			/notification-channel/push/{id} [delete]
+func (pc *PushController) deletePushChannel(c *gin.Context) {
+	id := c.Param("id")
+
+	err := pc.notificationChannelServicer.DeleteNotificationChannel(c, id)
+	if ginEx.AddError(c, err) {
+		return
+	}
+
+	c.Status(http.StatusNoContent)
+}
+
+// SendPushTestMessage
+//
+//	@Summary		Check push server
+//	@Description	Check if a test message can be published to the ntfy or Gotify server
+//	@Tags			push-channel
+//	@Accept			json
+//	@Produce		json
+//	@Security		KeycloakAuth
+//	@Param			PushChannel	body	pushdto.PushNotificationChannelCheckRequest	true	"Push server to check"
+//	@Success		204 "Push test message sent successfully"
+//	@Failure		400			{object}	map[string]string
+//	@Router			/notification-channel/push/check [post]
+func (pc *PushController) sendPushTestMessage(c *gin.Context) {
+	var channel pushdto.PushNotificationChannelCheckRequest
+	if !ginEx.BindAndValidateBody(c, &channel) {
+		return
+	}
+
+	err := pc.pushChannelService.SendPushTestMessage(pushdto.MapPushCheckToNotificationChannel(channel))
+	if ginEx.AddError(c, err) {
+		return
+	}
+
+	c.Status(http.StatusNoContent)
+}
@@ -0,0 +1,59 @@
+package pushdto
+
+import (
+	"github.com/greenbone/opensight-notification-service/pkg/helper"
+	"github.com/greenbone/opensight-notification-service/pkg/models"
+)
+
+// MapNotificationChannelToPush maps NotificationChannel to PushNotificationChannelResponse.
+func MapNotificationChannelToPush(channel models.NotificationChannel) PushNotificationChannelResponse {
+	tags := channel.PushTags
+	if tags == nil {
+		tags = []string{}
+	}
+
+	return PushNotificationChannelResponse{
+		Id:             channel.Id,
+		ChannelName:    channel.ChannelName,
+		Description:    helper.SafeDereference(channel.Description),
+		ServerUrl:      helper.SafeDereference(channel.WebhookUrl),
+		Server:         helper.SafeDereference(channel.PushServer),
+		Topic:          helper.SafeDereference(channel.PushTopic),
+		Tags:           tags,
+		HasAccessToken: helper.SafeDereference(channel.AccessToken) != "",
+	}
+}
+
+func MapPushToNotificationChannel(channel PushNotificationChannelRequest) models.NotificationChannel {
+	return models.NotificationChannel{
+		ChannelType: models.ChannelTypePush,
+		ChannelName: channel.ChannelName,
+		Description: &channel.Description,
+		WebhookUrl:  &channel.ServerUrl,
+		PushServer:  &channel.Server,
+		PushTopic:   helper.ToNullablePtr(channel.Topic),
+		PushTags:    channel.Tags,
+		AccessToken: channel.AccessToken,
+	}
+}
+
+// MapPushCheckToNotificationChannel maps the check request to the channel the test message is sent to.
+func MapPushCheckToNotificationChannel(channel PushNotificationChannelCheckRequest) models.NotificationChannel {
+	return models.NotificationChannel{
+		ChannelType: models.ChannelTypePush,
+		WebhookUrl:  &channel.ServerUrl,
+		PushServer:  &channel.Server,
+		PushTopic:   helper.ToNullablePtr(channel.Topic),
+		PushTags:    channel.Tags,
+		AccessToken: helper.ToNullablePtr(channel.AccessToken),
+	}
+}
+
+// MapNotificationChannelsToPush maps a slice of NotificationChannel to PushNotificationChannelResponse.
+func MapNotificationChannelsToPush(channels []models.NotificationChannel) []PushNotificationChannelResponse {
+	pushChannels := make([]PushNotificationChannelResponse, 0, len(channels))
+	for _, ch := range channels {
+		pushChannels = append(pushChannels, MapNotificationChannelToPush(ch))
+	}
+	return pushChannels
+}
@@ -0,0 +1,14 @@
+package pushdto
+
+// PushNotificationChannelResponse push notification channel response.
+// The access token is never returned, hasAccessToken tells whether it is set.
+type PushNotificationChannelResponse struct {
+	Id             string   `json:"id"`
+	ChannelName    string   `json:"channelName"`
+	Description    string   `json:"description"`
+	ServerUrl      string   `json:"serverUrl"`
+	Server         string   `json:"server"`
+	Topic          string   `json:"topic"`
+	Tags           []string `json:"tags"`
+	HasAccessToken bool     `json:"hasAccessToken"`
+}
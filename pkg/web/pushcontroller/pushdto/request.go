@@ -0,0 +1,133 @@
+package pushdto
+
+import (
+	"slices"
+	"strings"
+
+	"github.com/greenbone/opensight-notification-service/pkg/models"
+	"github.com/greenbone/opensight-notification-service/pkg/policy"
+	"github.com/greenbone/opensight-notification-service/pkg/translation"
+)
+
+// PushNotificationChannelRequest push notification channel request.
+// ntfy publishes to the topic, Gotify to the application of the access token, which is required for Gotify.
+// The access token is write-only: it is not changed if omitted on update, an empty token removes it.
+type PushNotificationChannelRequest struct {
+	ChannelName string   `json:"channelName"`
+	Description string   `json:"description"`
+	ServerUrl   string   `json:"serverUrl"`
+	Server      string   `json:"server" enums:"ntfy,gotify"`
+	Topic       string   `json:"topic"`
+	Tags        []string `json:"tags"`
+	AccessToken *string  `json:"accessToken"`
+}
+
+func (r *PushNotificationChannelRequest) Cleanup() {
+	r.ChannelName = strings.TrimSpace(r.ChannelName)
+	r.Description = strings.TrimSpace(r.Description)
+	r.ServerUrl, r.Server, r.Topic, r.Tags = cleanupPush(r.ServerUrl, r.Server, r.Topic, r.Tags)
+	if r.AccessToken != nil {
+		token := strings.TrimSpace(*r.AccessToken)
+		r.AccessToken = &token
+	}
+}
+
+func (r PushNotificationChannelRequest) Validate() models.ValidationErrors {
+	errs := make(models.ValidationErrors)
+
+	if r.ChannelName == "" {
+		errs["channelName"] = translation.ChannelNameIsRequired
+	}
+
+	validatePush(errs, r.ServerUrl, r.Server, r.Topic, r.Tags)
+
+	if r.AccessToken != nil && *r.AccessToken != "" {
+		if err := policy.AccessTokenPolicy(*r.AccessToken); err != nil {
+			errs["accessToken"] = translation.InvalidAccessToken
+		}
+	}
+
+	return errs
+}
+
+// PushNotificationChannelCheckRequest push notification channel check request
+type PushNotificationChannelCheckRequest struct {
+	ServerUrl   string   `json:"serverUrl"`
+	Server      string   `json:"server" enums:"ntfy,gotify"`
+	Topic       string   `json:"topic"`
+	Tags        []string `json:"tags"`
+	AccessToken string   `json:"accessToken"`
+}
+
+func (r *PushNotificationChannelCheckRequest) Cleanup() {
+	r.ServerUrl, r.Server, r.Topic, r.Tags = cleanupPush(r.ServerUrl, r.Server, r.Topic, r.Tags)
+	r.AccessToken = strings.TrimSpace(r.AccessToken)
+}
+
+func (r *PushNotificationChannelCheckRequest) Validate() models.ValidationErrors {
+	errs := make(models.ValidationErrors)
+
+	validatePush(errs, r.ServerUrl, r.Server, r.Topic, r.Tags)
+
+	if r.AccessToken != "" {
+		if err := policy.AccessTokenPolicy(r.AccessToken); err != nil {
+			errs["accessToken"] = translation.InvalidAccessToken
+		}
+	} else if r.Server == models.PushServerGotify {
+		errs["accessToken"] = translation.AppTokenIsRequired
+	}
+
+	return errs
+}
+
+// cleanupPush defaults the server to ntfy and drops the topic and empty tags. Gotify has no topics.
+func cleanupPush(serverUrl, server, topic string, tags []string) (string, string, string, []string) {
+	server = strings.ToLower(strings.TrimSpace(server))
+	if server == "" {
+		server = models.PushServerNtfy
+	}
+	topic = strings.TrimSpace(topic)
+	if server == models.PushServerGotify {
+		topic = ""
+	}
+
+	cleanTags := make([]string, 0, len(tags))
+	for _, tag := range tags {
+		if tag = strings.TrimSpace(tag); tag != "" {
+			cleanTags = append(cleanTags, tag)
+		}
+	}
+	return strings.TrimSpace(serverUrl), server, topic, cleanTags
+}
+
+func validatePush(errs models.ValidationErrors, serverUrl, server, topic string, tags []string) {
+	if serverUrl == "" {
+		errs["serverUrl"] = translation.ServerUrlIsRequired
+	} else {
+		if _, err := policy.WebhookUrlPolicy(serverUrl); err != nil {
+			errs["serverUrl"] = translation.ValidServerUrlIsRequired
+		}
+	}
+
+	if !slices.Contains(models.AllowedPushServers, server) {
+		errs["server"] = translation.InvalidPushServer
+	}
+
+	if server == models.PushServerNtfy {
+		if topic == "" {
+			errs["topic"] = translation.PushTopicIsRequired
+		} else if err := policy.PushTopicPolicy(topic); err != nil {
+			errs["topic"] = translation.InvalidPushTopic
+		}
+	}
+
+	if len(tags) > models.MaxPushTags {
+		errs["tags"] = translation.TooManyPushTags
+	}
+	for _, tag := range tags {
+		if err := policy.PushTagPolicy(tag); err != nil {
+			errs["tags"] = translation.InvalidPushTag
+			break
+		}
+	}
+}
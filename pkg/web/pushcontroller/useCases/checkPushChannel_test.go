@@ -0,0 +1,169 @@
+package usesCases
+
+import (
+	"io"
+	"net/http"
+	"testing"
+
+	"github.com/gin-gonic/gin"
+	"github.com/greenbone/keycloak-client-golang/auth"
+	"github.com/greenbone/opensight-golang-libraries/pkg/httpassert"
+	"github.com/greenbone/opensight-notification-service/pkg/services/notificationchannelservice"
+	"github.com/greenbone/opensight-notification-service/pkg/web/errmap"
+	"github.com/greenbone/opensight-notification-service/pkg/web/iam"
+	"github.com/greenbone/opensight-notification-service/pkg/web/integrationTests"
+	"github.com/greenbone/opensight-notification-service/pkg/web/pushcontroller"
+	"github.com/greenbone/opensight-notification-service/pkg/web/testhelper"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+type roundTripperFunc func(*http.Request) (*http.Response, error)
+
+func (f roundTripperFunc) RoundTrip(req *http.Request) (*http.Response, error) {
+	return f(req)
+}
+
+func setup(t *testing.T, transport http.Client) *gin.Engine {
+	t.Helper()
+
+	repo, db := testhelper.SetupNotificationChannelTestEnv(t)
+	svc := notificationchannelservice.NewNotificationChannelService(repo)
+	pushService := notificationchannelservice.NewPushService(&transport)
+	pushChannelSvc := notificationchannelservice.NewPushChannelService(svc, 20, pushService)
+	registry := errmap.NewRegistry()
+	router := testhelper.NewTestWebEngine(registry)
+
+	authMiddleware, err := auth.NewGinAuthMiddleware(integrationTests.NewTestJwtParser())
+	require.NoError(t, err)
+
+	pushcontroller.NewPushController(router, svc, pushChannelSvc, authMiddleware, registry)
+	defer db.Close()
+	return router
+}
+
+func TestCheckPushChannel(t *testing.T) {
+	t.Run("Check ntfy push channel", func(t *testing.T) {
+		t.Parallel()
+
+		var gotRequest *http.Request
+		var gotBody []byte
+		transport := http.Client{
+			Transport: roundTripperFunc(func(r *http.Request) (*http.Response, error) {
+				gotRequest = r
+				gotBody, _ = io.ReadAll(r.Body)
+				return &http.Response{
+					StatusCode: http.StatusOK,
+					Body:       http.NoBody,
+					Header:     make(http.Header),
+				}, nil
+			}),
+		}
+		router := setup(t, transport)
+
+		// Check push channel
+		httpassert.New(t, router).Post("/notification-channel/push/check").
+			AuthJwt(integrationTests.CreateJwtTokenWithRole(iam.NotificationAdmin)).
+			JsonContent(`{
+				"serverUrl": "https://ntfy.example.com",
+				"topic": "opensight",
+				"tags": ["warning"],
+				"accessToken": "tk_abc"
+			}`).
+			Expect().
+			StatusCode(http.StatusNoContent)
+
+		require.NotNil(t, gotRequest)
+		assert.Equal(t, "https://ntfy.example.com", gotRequest.URL.String())
+		assert.Equal(t, "Bearer tk_abc", gotRequest.Header.Get("Authorization"))
+		assert.Contains(t, string(gotBody), `"topic":"opensight"`)
+	})
+
+	t.Run("Check gotify push channel", func(t *testing.T) {
+		t.Parallel()
+
+		var gotRequest *http.Request
+		transport := http.Client{
+			Transport: roundTripperFunc(func(r *http.Request) (*http.Response, error) {
+				gotRequest = r
+				return &http.Response{
+					StatusCode: http.StatusOK,
+					Body:       http.NoBody,
+					Header:     make(http.Header),
+				}, nil
+			}),
+		}
+		router := setup(t, transport)
+
+		// Check push channel
+		httpassert.New(t, router).Post("/notification-channel/push/check").
+			AuthJwt(integrationTests.CreateJwtTokenWithRole(iam.NotificationAdmin)).
+			JsonContent(`{
+				"serverUrl": "https://gotify.example.com/",
+				"server": "gotify",
+				"accessToken": "AbCdEf123"
+			}`).
+			Expect().
+			StatusCode(http.StatusNoContent)
+
+		require.NotNil(t, gotRequest)
+		assert.Equal(t, "https://gotify.example.com/message", gotRequest.URL.String())
+		assert.Equal(t, "AbCdEf123", gotRequest.Header.Get("X-Gotify-Key"))
+	})
+
+	t.Run("Check push channel with push server response 403", func(t *testing.T) {
+		t.Parallel()
+
+		transport := http.Client{
+			Transport: roundTripperFunc(func(r *http.Request) (*http.Response, error) {
+				return &http.Response{
+					StatusCode: http.StatusForbidden,
+					Body:       http.NoBody,
+					Header:     make(http.Header),
+					Status:     "403 Forbidden",
+				}, nil
+			}),
+		}
+		router := setup(t, transport)
+
+		// Check push channel
+		httpassert.New(t, router).Post("/notification-channel/push/check").
+			AuthJwt(integrationTests.CreateJwtTokenWithRole(iam.NotificationAdmin)).
+			JsonContent(`{
+				"serverUrl": "https://ntfy.example.com",
+				"topic": "opensight"
+			}`).
+			Expect().
+			StatusCode(http.StatusBadRequest).
+			Json(`{
+				"type": "greenbone/generic-error",
+				"title": "push message could not be send: http status: 403 Forbidden"
+			}`)
+	})
+
+	t.Run("Check push channel without required fields", func(t *testing.T) {
+		t.Parallel()
+
+		transport := http.Client{
+			Transport: roundTripperFunc(func(r *http.Request) (*http.Response, error) {
+				return nil, nil
+			}),
+		}
+		router := setup(t, transport)
+
+		// Check push channel
+		httpassert.New(t, router).Post("/notification-channel/push/check").
+			AuthJwt(integrationTests.CreateJwtTokenWithRole(iam.NotificationAdmin)).
+			JsonContent(`{"server": "gotify"}`).
+			Expect().
+			StatusCode(http.StatusBadRequest).
+			Json(`{
+				"title":"",
+				"type":"greenbone/validation-error",
+				"errors": {
+					"serverUrl": "A server URL is required.",
+					"accessToken": "An app token is required."
+				}
+			}`)
+	})
+}
@@ -0,0 +1,136 @@
+package usesCases
+
+import (
+	"net/http"
+	"testing"
+
+	"github.com/greenbone/opensight-golang-libraries/pkg/httpassert"
+	"github.com/greenbone/opensight-notification-service/pkg/web/iam"
+	"github.com/greenbone/opensight-notification-service/pkg/web/integrationTests"
+	"github.com/stretchr/testify/require"
+)
+
+func TestCreatePushChannel(t *testing.T) {
+	t.Run("Create push channel", func(t *testing.T) {
+		t.Parallel()
+
+		router, db := setupTestRouter(t)
+		defer db.Close()
+
+		var pushId string
+
+		// Create push channel
+		httpassert.New(t, router).Post("/notification-channel/push").
+			AuthJwt(integrationTests.CreateJwtTokenWithRole(iam.NotificationAdmin)).
+			JsonContent(`{
+				"channelName": "push1",
+				"description": "This is a test push channel",
+				"serverUrl": "https://ntfy.example.com",
+				"server": "NTFY",
+				"topic": "opensight",
+				"tags": ["warning", " ", "opensight"],
+				"accessToken": "tk_abc"
+			}`).
+			Expect().
+			StatusCode(http.StatusCreated).
+			JsonPath("$.id", httpassert.ExtractTo(&pushId)).
+			JsonTemplate(`{
+				"id": "d9cc9be2-7b4d-4c6f-991d-a40cfe002ceb",
+				"channelName": "push1",
+				"description": "This is a test push channel",
+				"serverUrl": "https://ntfy.example.com",
+				"server": "ntfy",
+				"topic": "opensight",
+				"tags": ["warning", "opensight"],
+				"hasAccessToken": true
+			}`, map[string]any{
+				"id": httpassert.IgnoreJsonValue,
+			})
+		require.NotEmpty(t, pushId)
+	})
+
+	t.Run("Create push channel with invalid settings returns an error", func(t *testing.T) {
+		t.Parallel()
+
+		router, db := setupTestRouter(t)
+		defer db.Close()
+
+		// Create push channel
+		httpassert.New(t, router).Post("/notification-channel/push").
+			AuthJwt(integrationTests.CreateJwtTokenWithRole(iam.NotificationAdmin)).
+			JsonContent(`{
+				"channelName": "push1",
+				"serverUrl": "ntfy.example.com",
+				"topic": "open sight",
+				"tags": ["a,b"],
+				"accessToken": "tk abc"
+			}`).
+			Expect().
+			StatusCode(http.StatusBadRequest).
+			Json(`{
+				"type": "greenbone/validation-error",
+				"title": "",
+				"errors": {
+					"serverUrl": "Please enter a valid server URL.",
+					"topic": "The topic must consist of at most 64 letters, digits, - and _.",
+					"tags": "Tags must have at most 64 characters and must not contain commas.",
+					"accessToken": "The access token must consist of at most 512 printable ASCII characters without spaces."
+				}
+			}`)
+	})
+
+	t.Run("Create gotify push channel without app token returns an error", func(t *testing.T) {
+		t.Parallel()
+
+		router, db := setupTestRouter(t)
+		defer db.Close()
+
+		// Create push channel
+		httpassert.New(t, router).Post("/notification-channel/push").
+			AuthJwt(integrationTests.CreateJwtTokenWithRole(iam.NotificationAdmin)).
+			JsonContent(`{
+				"channelName": "push1",
+				"serverUrl": "https://gotify.example.com",
+				"server": "gotify"
+			}`).
+			Expect().
+			StatusCode(http.StatusBadRequest).
+			Json(`{
+				"type": "greenbone/generic-error",
+				"title": "An app token is required."
+			}`)
+	})
+
+	t.Run("Create push channel with an existing name return an error", func(t *testing.T) {
+		t.Parallel()
+
+		router, db := setupTestRouter(t)
+		defer db.Close()
+
+		// Create push channel
+		httpassert.New(t, router).Post("/notification-channel/push").
+			AuthJwt(integrationTests.CreateJwtTokenWithRole(iam.NotificationAdmin)).
+			JsonContent(`{
+				"channelName": "push 1",
+				"serverUrl": "https://ntfy.example.com",
+				"topic": "opensight"
+			}`).
+			Expect().
+			StatusCode(http.StatusCreated)
+
+		// Create push channel with the same name
+		httpassert.New(t, router).Post("/notification-channel/push").
+			AuthJwt(integrationTests.CreateJwtTokenWithRole(iam.NotificationAdmin)).
+			JsonContent(`{
+				"channelName": "push 1",
+				"serverUrl": "https://ntfy.example.com",
+				"topic": "opensight"
+			}`).
+			Expect().
+			StatusCode(http.StatusBadRequest).
+			Json(`{
+				"type": "greenbone/generic-error",
+				"title": "Push channel name already exists."
+			}`)
+	})
+}
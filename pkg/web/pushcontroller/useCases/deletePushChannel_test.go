@@ -0,0 +1,72 @@
+package usesCases
+
+import (
+	"net/http"
+	"testing"
+	"time"
+
+	"github.com/gin-gonic/gin"
+	"github.com/greenbone/keycloak-client-golang/auth"
+	"github.com/greenbone/opensight-golang-libraries/pkg/httpassert"
+	"github.com/greenbone/opensight-notification-service/pkg/services/notificationchannelservice"
+	"github.com/greenbone/opensight-notification-service/pkg/web/errmap"
+	"github.com/greenbone/opensight-notification-service/pkg/web/iam"
+	"github.com/greenbone/opensight-notification-service/pkg/web/integrationTests"
+	"github.com/greenbone/opensight-notification-service/pkg/web/pushcontroller"
+	"github.com/greenbone/opensight-notification-service/pkg/web/testhelper"
+	"github.com/jmoiron/sqlx"
+	"github.com/stretchr/testify/require"
+)
+
+func setupTestRouter(t *testing.T) (*gin.Engine, *sqlx.DB) {
+	repo, db := testhelper.SetupNotificationChannelTestEnv(t)
+	svc := notificationchannelservice.NewNotificationChannelService(repo)
+	pushService := notificationchannelservice.NewPushService(&http.Client{Timeout: 15 * time.Second})
+	pushSvc := notificationchannelservice.NewPushChannelService(svc, 20, pushService)
+	registry := errmap.NewRegistry()
+	router := testhelper.NewTestWebEngine(registry)
+
+	authMiddleware, err := auth.NewGinAuthMiddleware(integrationTests.NewTestJwtParser())
+	require.NoError(t, err)
+
+	pushcontroller.NewPushController(router, svc, pushSvc, authMiddleware, registry)
+
+	return router, db
+}
+
+func TestDeletePushChannel(t *testing.T) {
+	t.Run("Delete a push channel", func(t *testing.T) {
+		t.Parallel()
+
+		router, db := setupTestRouter(t)
+		defer db.Close()
+
+		var pushId string
+
+		// Create push channel
+		httpassert.New(t, router).Post("/notification-channel/push").
+			AuthJwt(integrationTests.CreateJwtTokenWithRole(iam.NotificationAdmin)).
+			JsonContent(`{
+				"channelName": "push1",
+				"serverUrl": "https://ntfy.example.com",
+				"topic": "opensight"
+			}`).
+			Expect().
+			StatusCode(http.StatusCreated).
+			JsonPath("$.id", httpassert.ExtractTo(&pushId))
+		require.NotEmpty(t, pushId)
+
+		// Delete push channel
+		httpassert.New(t, router).Deletef("/notification-channel/push/%s", pushId).
+			AuthJwt(integrationTests.CreateJwtTokenWithRole(iam.NotificationAdmin)).
+			Expect().
+			StatusCode(http.StatusNoContent)
+
+		// List push channels
+		httpassert.New(t, router).Get("/notification-channel/push").
+			AuthJwt(integrationTests.CreateJwtTokenWithRole(iam.NotificationAdmin)).
+			Expect().
+			StatusCode(http.StatusOK).
+			Json(`[]`)
+	})
+}
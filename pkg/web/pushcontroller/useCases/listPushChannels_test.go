@@ -0,0 +1,52 @@
+package usesCases
+
+import (
+	"net/http"
+	"testing"
+
+	"github.com/greenbone/opensight-golang-libraries/pkg/httpassert"
+	"github.com/greenbone/opensight-notification-service/pkg/web/iam"
+	"github.com/greenbone/opensight-notification-service/pkg/web/integrationTests"
+)
+
+func TestListPushChannels(t *testing.T) {
+	t.Run("List push channels", func(t *testing.T) {
+		t.Parallel()
+
+		router, db := setupTestRouter(t)
+		defer db.Close()
+
+		// Create push channel
+		httpassert.New(t, router).Post("/notification-channel/push").
+			AuthJwt(integrationTests.CreateJwtTokenWithRole(iam.NotificationAdmin)).
+			JsonContent(`{
+				"channelName": "push1",
+				"description": "This is a test push channel",
+				"serverUrl": "https://gotify.example.com",
+				"server": "gotify",
+				"accessToken": "AbCdEf123"
+			}`).
+			Expect().
+			StatusCode(http.StatusCreated)
+
+		// List push channels, the access token is not returned
+		httpassert.New(t, router).Get("/notification-channel/push").
+			AuthJwt(integrationTests.CreateJwtTokenWithRole(iam.NotificationAdmin)).
+			Expect().
+			StatusCode(http.StatusOK).
+			JsonTemplate(`[
+				{
+					"id": "fb46613b-4bf8-45c7-ad6f-e83e5ced8b81",
+					"channelName": "push1",
+					"description": "This is a test push channel",
+					"serverUrl": "https://gotify.example.com",
+					"server": "gotify",
+					"topic": "",
+					"tags": [],
+					"hasAccessToken": true
+				}
+			]`, map[string]any{
+				"$.0.id": httpassert.IgnoreJsonValue,
+			})
+	})
+}
@@ -0,0 +1,99 @@
+package usesCases
+
+import (
+	"net/http"
+	"testing"
+
+	"github.com/greenbone/opensight-golang-libraries/pkg/httpassert"
+	"github.com/greenbone/opensight-notification-service/pkg/web/iam"
+	"github.com/greenbone/opensight-notification-service/pkg/web/integrationTests"
+	"github.com/stretchr/testify/require"
+)
+
+func TestUpdatePushChannel(t *testing.T) {
+	t.Run("Update push channel keeps the access token if omitted", func(t *testing.T) {
+		t.Parallel()
+
+		router, db := setupTestRouter(t)
+		defer db.Close()
+
+		var pushId string
+
+		// Create push channel
+		httpassert.New(t, router).Post("/notification-channel/push").
+			AuthJwt(integrationTests.CreateJwtTokenWithRole(iam.NotificationAdmin)).
+			JsonContent(`{
+				"channelName": "push1",
+				"serverUrl": "https://gotify.example.com",
+				"server": "gotify",
+				"accessToken": "AbCdEf123"
+			}`).
+			Expect().
+			StatusCode(http.StatusCreated).
+			JsonPath("$.id", httpassert.ExtractTo(&pushId))
+		require.NotEmpty(t, pushId)
+
+		// Update push channel
+		httpassert.New(t, router).Putf("/notification-channel/push/%s", pushId).
+			AuthJwt(integrationTests.CreateJwtTokenWithRole(iam.NotificationAdmin)).
+			JsonContent(`{
+				"channelName": "push2",
+				"description": "This is a test push channel changed",
+				"serverUrl": "https://push.example.com",
+				"server": "gotify"
+			}`).
+			Expect().
+			StatusCode(http.StatusOK).
+			JsonTemplate(`{
+				"id": "fb46613b-4bf8-45c7-ad6f-e83e5ced8b81",
+				"channelName": "push2",
+				"description": "This is a test push channel changed",
+				"serverUrl": "https://push.example.com",
+				"server": "gotify",
+				"topic": "",
+				"tags": [],
+				"hasAccessToken": true
+			}`, map[string]any{
+				"$.id": pushId,
+			})
+	})
+
+	t.Run("Update gotify push channel removing the app token returns an error", func(t *testing.T) {
+		t.Parallel()
+
+		router, db := setupTestRouter(t)
+		defer db.Close()
+
+		var pushId string
+
+		// Create push channel
+		httpassert.New(t, router).Post("/notification-channel/push").
+			AuthJwt(integrationTests.CreateJwtTokenWithRole(iam.NotificationAdmin)).
+			JsonContent(`{
+				"channelName": "push1",
+				"serverUrl": "https://gotify.example.com",
+				"server": "gotify",
+				"accessToken": "AbCdEf123"
+			}`).
+			Expect().
+			StatusCode(http.StatusCreated).
+			JsonPath("$.id", httpassert.ExtractTo(&pushId))
+		require.NotEmpty(t, pushId)
+
+		// Update push channel
+		httpassert.New(t, router).Putf("/notification-channel/push/%s", pushId).
+			AuthJwt(integrationTests.CreateJwtTokenWithRole(iam.NotificationAdmin)).
+			JsonContent(`{
+				"channelName": "push1",
+				"serverUrl": "https://gotify.example.com",
+				"server": "gotify",
+				"accessToken": ""
+			}`).
+			Expect().
+			StatusCode(http.StatusBadRequest).
+			Json(`{
+				"type": "greenbone/generic-error",
+				"title": "An app token is required."
+			}`)
+	})
+}
@@ -134,6 +134,8 @@ func Test_CreateRule(t *testing.T) {
 					},
 					"recipient": "a@example.com"
 				},
+				"delivery": {"mode": "immediate"},
+				"mute": {},
 				"active": true
 			}`,
 				map[string]any{
@@ -231,6 +233,46 @@ func Test_CreateRule(t *testing.T) {
 			}`)
 	})
 
+	t.Run("failure due to invalid delivery settings", func(t *testing.T) {
+		t.Parallel()
+		ruleLimit := 10
+		origins := []entities.Origin{{Name: "origin0", Class: "serviceA/origin0"}}
+		channels := []models.NotificationChannel{{ChannelName: "channel-name", ChannelType: "mattermost"}}
+		router := setupTestEnvironment(t, origins, channels, ruleLimit)
+
+		httpassert.New(t, router).Post("/rules").
+			AuthJwt(integrationTests.CreateJwtTokenWithRole(iam.NotificationAdmin)).
+			JsonContent(fmt.Sprintf(`{
+				"name": "Test Rule",
+				"trigger": {
+					"levels": ["info"],
+					"origins": [{ "class": "serviceA/origin0" }]
+				},
+				"action": {
+					"channel": {
+						"id": "%s"
+					}
+				},
+				"delivery": {
+					"mode": "digest",
+					"digestWindowMinutes": 0,
+					"digestMaxCount": 1,
+					"suppressionWindowMinutes": -1
+				}
+			}`, channels[0].Id)).
+			Expect().
+			StatusCode(http.StatusBadRequest).
+			Json(`{
+				"type": "greenbone/validation-error",
+				"title": "",
+				"errors": {
+					"delivery.digestWindowMinutes": "The digest window must be between 1 minute and 7 days.",
+					"delivery.digestMaxCount": "The digest count threshold must be between 2 and 1000.",
+					"delivery.suppressionWindowMinutes": "The suppression window must be between 0 minutes and 7 days."
+				}
+			}`)
+	})
+
 	t.Run("failure due to non-empty recipient for non-mail channel", func(t *testing.T) {
 		t.Parallel()
 		ruleLimit := 10
@@ -77,6 +77,8 @@ func Test_GetRule(t *testing.T) {
 					},
 					"recipient": "a@example.com"
 				},
+				"delivery": {"mode": "immediate"},
+				"mute": {},
 				"active": true
 			}`,
 				map[string]any{
@@ -133,6 +135,8 @@ func Test_GetRule(t *testing.T) {
 					},
 					"recipient": "a@example.com"
 				},
+				"delivery": {"mode": "immediate"},
+				"mute": {},
 				"active": false,
 				"errors": {
 					"trigger.origins": "At least one origin is required.",
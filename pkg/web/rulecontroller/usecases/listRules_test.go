@@ -89,6 +89,8 @@ func Test_ListRules(t *testing.T) {
 								"type": "mattermost"
 							}
 						},
+						"delivery": {"mode": "immediate"},
+						"mute": {},
 						"active": false
 					},
 					{
@@ -111,6 +113,8 @@ func Test_ListRules(t *testing.T) {
 								"type": "mattermost"
 							}
 						},
+						"delivery": {"mode": "immediate"},
+						"mute": {},
 						"active": false
 					}
 				]`,
@@ -171,6 +175,8 @@ func Test_ListRules(t *testing.T) {
 							"type": ""
 						}
 					},
+					"delivery": {"mode": "immediate"},
+					"mute": {},
 					"active": false,
 					"errors": {
 						"trigger.origins": "At least one origin is required.",
@@ -101,6 +101,8 @@ func Test_UpdateRule(t *testing.T) {
 					},
 					"recipient": "test@example.org"
 				},
+				"delivery": {"mode": "immediate"},
+				"mute": {},
 				"active": true
 			}`,
 				map[string]any{
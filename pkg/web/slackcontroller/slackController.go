@@ -0,0 +1,202 @@
+package slackcontroller
+
+import (
+	"errors"
+	"net/http"
+
+	"github.com/gin-gonic/gin"
+	"github.com/greenbone/opensight-golang-libraries/pkg/errorResponses"
+	"github.com/greenbone/opensight-notification-service/pkg/models"
+	"github.com/greenbone/opensight-notification-service/pkg/services/notificationchannelservice"
+	"github.com/greenbone/opensight-notification-service/pkg/translation"
+	"github.com/greenbone/opensight-notification-service/pkg/web/errmap"
+	"github.com/greenbone/opensight-notification-service/pkg/web/ginEx"
+	"github.com/greenbone/opensight-notification-service/pkg/web/iam"
+	"github.com/greenbone/opensight-notification-service/pkg/web/middleware"
+	"github.com/greenbone/opensight-notification-service/pkg/web/slackcontroller/slackdto"
+)
+
+type SlackController struct {
+	notificationChannelServicer notificationchannelservice.NotificationChannelService
+	slackChannelService         notificationchannelservice.SlackChannelService
+}
+
+func NewSlackController(
+	router gin.IRouter,
+	notificationChannelServicer notificationchannelservice.NotificationChannelService,
+	slackChannelService notificationchannelservice.SlackChannelService,
+	auth gin.HandlerFunc,
+	registry *errmap.Registry,
+) *SlackController {
+	ctrl := &SlackController{
+		notificationChannelServicer: notificationChannelServicer,
+		slackChannelService:         slackChannelService,
+	}
+
+	group := router.Group("/notification-channel/slack").
+		Use(middleware.AuthorizeRoles(auth, iam.OsiAdmin, iam.NotificationAdmin)...)
+	group.Use(errorHandler(gin.ErrorTypePrivate))
+
+	group.POST("", ctrl.createSlackChannel)
+	group.GET("", ctrl.listSlackChannels)
+	group.PUT("/:id", ctrl.updateSlackChannel)
+	group.DELETE("/:id", ctrl.deleteSlackChannel)
+	group.POST("/check", ctrl.sendSlackTestMessage)
+
+	ctrl.configureMappings(registry)
+	return ctrl
+}
+
+func errorHandler(errorType gin.ErrorType) gin.HandlerFunc {
+	return func(c *gin.Context) {
+		c.Next()
+		for _, errorValue := range c.Errors.ByType(errorType) {
+			if errors.Is(errorValue, notificationchannelservice.ErrSlackMessageDelivery) {
+				c.AbortWithStatusJSON(http.StatusBadRequest, errorResponses.NewErrorGenericResponse(errorValue.Error()))
+				return
+			}
+		}
+	}
+}
+
+func (sc *SlackController) configureMappings(r *errmap.Registry) {
+	r.Register(
+		notificationchannelservice.ErrSlackChannelLimitReached,
+		http.StatusUnprocessableEntity,
+		errorResponses.NewErrorGenericResponse(translation.SlackChannelLimitReached),
+	)
+	r.Register(
+		notificationchannelservice.ErrListSlackChannels,
+		http.StatusInternalServerError,
+		errorResponses.ErrorInternalResponse,
+	)
+	r.Register(
+		notificationchannelservice.ErrSlackChannelNameExists,
+		http.StatusBadRequest,
+		errorResponses.NewErrorGenericResponse(translation.SlackChannelNameAlreadyExist),
+	)
+}
+
+// CreateSlackChannel
+//
+//	@Summary		Create Slack Channel
+//	@Description	Create a new slack notification channel
+//	@Tags			slack-channel
+//	@Accept			json
+//	@Produce		json
+//	@Security		KeycloakAuth
+//	@Param			SlackChannel	body		slackdto.SlackNotificationChannelRequest	true	"Slack channel to add"
+//	@Success		201			{object}	slackdto.SlackNotificationChannelRequest
+//	@Failure		400			{object}	map[string]string
+//	@Failure		500			{object}	map[string]string
+//	@Router			/notification-channel/slack [post]
+func (sc *SlackController) createSlackChannel(c *gin.Context) {
+	var channel slackdto.SlackNotificationChannelRequest
+	if !ginEx.BindAndValidateBody(c, &channel) {
+		return
+	}
+
+	slackChannel, err := sc.slackChannelService.CreateSlackChannel(c, channel)
+	if ginEx.AddError(c, err) {
+		return
+	}
+
+	c.JSON(http.StatusCreated, slackChannel)
+}
+
+// ListSlackChannels
+//
+//	@Summary		List Slack Channels
+//	@Description	List slack notification channels
+//	@Tags			slack-channel
+//	@Produce		json
+//	@Security		KeycloakAuth
+//	@Success		200		{array}		slackdto.SlackNotificationChannelRequest
+//	@Failure		500		{object}	map[string]string
+//	@Router			/notification-channel/slack [get]
+func (sc *SlackController) listSlackChannels(c *gin.Context) {
+	channels, err := sc.notificationChannelServicer.ListNotificationChannelsByType(c, models.ChannelTypeSlack)
+	if ginEx.AddError(c, err) {
+		return
+	}
+
+	c.JSON(http.StatusOK, slackdto.MapNotificationChannelsToSlack(channels))
+}
+
+// UpdateSlackChannel
+//
+//	@Summary		Update Slack Channel
+//	@Description	Update an existing slack notification channel
+//	@Tags			slack-channel
+//	@Accept			json
+//	@Produce		json
+//	@Security		KeycloakAuth
+//	@Param			id			path		string						true	"Slack channel ID"
+//	@Param			SlackChannel	body		slackdto.SlackNotificationChannelRequest	true	"Slack channel to update"
+//	@Success		200			{object}	slackdto.SlackNotificationChannelRequest
+//	@Failure		400			{object}	map[string]string
+//	@Failure		404 		{object}    map[string]string
+//	@Failure		500			{object}	map[string]string
+//	@Router			/notification-channel/slack/{id} [put]
+func (sc *SlackController) updateSlackChannel(c *gin.Context) {
+	id := c.Param("id")
+
+	var channel slackdto.SlackNotificationChannelRequest
+	if !ginEx.BindAndValidateBody(c, &channel) {
+		return
+	}
+
+	updated, err := sc.slackChannelService.UpdateSlackChannel(c, id, channel)
+	if ginEx.AddError(c, err) {
+		return
+	}
+
+	c.JSON(http.StatusOK, updated)
+}
+
+// DeleteSlackChannel
+//
+//		@Summary		Delete Slack Channel
+//		@Description	Delete a slack notification channel
+//		@Tags			slack-channel
+//		@Security		KeycloakAuth
+//		@Param			id	path	string	true	"Slack channel ID"
+//		@Success		204	"Deleted successfully"
+//		@Failure		500	{object}	map[string]string
+//	    @Failure		404 {object}    map[string]string
+//		@Router			/notification-channel/slack/{id} [delete]
+func (sc *SlackController) deleteSlackChannel(c *gin.Context) {
+	id := c.Param("id")
+
+	err := sc.notificationChannelServicer.DeleteNotificationChannel(c, id)
+	if ginEx.AddError(c, err) {
+		return
+	}
+
+	c.Status(http.StatusNoContent)
+}
+
+// SendSlackTestMessage
+//
+//	@Summary		Check slack webhook
+//	@Description	Check if a test message can be sent to the slack webhook
+//	@Tags			slack-channel
+//	@Accept			json
+//	@Produce		json
+//	@Security		KeycloakAuth
+//	@Success		204 "Slack test message sent successfully"
+//	@Failure		400			{object}	map[string]string
+//	@Router			/notification-channel/slack/check [post]
+func (sc *SlackController) sendSlackTestMessage(c *gin.Context) {
+	var channel slackdto.SlackNotificationChannelCheckRequest
+	if !ginEx.BindAndValidateBody(c, &channel) {
+		return
+	}
+
+	err := sc.slackChannelService.SendSlackTestMessage(channel.WebhookUrl)
+	if ginEx.AddError(c, err) {
+		return
+	}
+
+	c.Status(http.StatusNoContent)
+}
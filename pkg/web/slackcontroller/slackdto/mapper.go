@@ -0,0 +1,34 @@
+package slackdto
+
+import (
+	"github.com/greenbone/opensight-notification-service/pkg/helper"
+	"github.com/greenbone/opensight-notification-service/pkg/models"
+)
+
+// MapNotificationChannelToSlack maps NotificationChannel to SlackNotificationChannelResponse.
+func MapNotificationChannelToSlack(channel models.NotificationChannel) SlackNotificationChannelResponse {
+	return SlackNotificationChannelResponse{
+		Id:          channel.Id,
+		ChannelName: channel.ChannelName,
+		WebhookUrl:  helper.SafeDereference(channel.WebhookUrl),
+		Description: helper.SafeDereference(channel.Description),
+	}
+}
+
+func MapSlackToNotificationChannel(channel SlackNotificationChannelRequest) models.NotificationChannel {
+	return models.NotificationChannel{
+		ChannelType: models.ChannelTypeSlack,
+		ChannelName: channel.ChannelName,
+		WebhookUrl:  &channel.WebhookUrl,
+		Description: &channel.Description,
+	}
+}
+
+// MapNotificationChannelsToSlack maps a slice of NotificationChannel to SlackNotificationChannelResponse.
+func MapNotificationChannelsToSlack(channels []models.NotificationChannel) []SlackNotificationChannelResponse {
+	slackChannels := make([]SlackNotificationChannelResponse, 0, len(channels))
+	for _, ch := range channels {
+		slackChannels = append(slackChannels, MapNotificationChannelToSlack(ch))
+	}
+	return slackChannels
+}
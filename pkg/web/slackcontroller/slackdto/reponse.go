@@ -0,0 +1,8 @@
+package slackdto
+
+type SlackNotificationChannelResponse struct {
+	Id          string `json:"id"`
+	ChannelName string `json:"channelName"`
+	WebhookUrl  string `json:"webhookUrl"`
+	Description string `json:"description"`
+}
@@ -0,0 +1,64 @@
+package slackdto
+
+import (
+	"strings"
+
+	"github.com/greenbone/opensight-notification-service/pkg/models"
+	"github.com/greenbone/opensight-notification-service/pkg/policy"
+	"github.com/greenbone/opensight-notification-service/pkg/translation"
+)
+
+// SlackNotificationChannelRequest slack notification channel request.
+// The webhook posts to the channel chosen when it was created in the Slack app.
+type SlackNotificationChannelRequest struct {
+	ChannelName string `json:"channelName"`
+	WebhookUrl  string `json:"webhookUrl"`
+	Description string `json:"description"`
+}
+
+func (s *SlackNotificationChannelRequest) Cleanup() {
+	s.ChannelName = strings.TrimSpace(s.ChannelName)
+	s.WebhookUrl = strings.TrimSpace(s.WebhookUrl)
+	s.Description = strings.TrimSpace(s.Description)
+}
+
+func (s SlackNotificationChannelRequest) Validate() models.ValidationErrors {
+	errs := make(models.ValidationErrors)
+
+	if s.ChannelName == "" {
+		errs["channelName"] = translation.ChannelNameIsRequired
+	}
+
+	if s.WebhookUrl == "" {
+		errs["webhookUrl"] = translation.WebhookUrlIsRequired
+	} else {
+		if _, err := policy.SlackWebhookUrlPolicy(s.WebhookUrl); err != nil {
+			errs["webhookUrl"] = translation.ValidWebhookUrlIsRequired
+		}
+	}
+
+	return errs
+}
+
+// SlackNotificationChannelCheckRequest slack notification channel check request
+type SlackNotificationChannelCheckRequest struct {
+	WebhookUrl string `json:"webhookUrl"`
+}
+
+func (r *SlackNotificationChannelCheckRequest) Cleanup() {
+	r.WebhookUrl = strings.TrimSpace(r.WebhookUrl)
+}
+
+func (r *SlackNotificationChannelCheckRequest) Validate() models.ValidationErrors {
+	errs := make(models.ValidationErrors)
+
+	if r.WebhookUrl == "" {
+		errs["webhookUrl"] = translation.WebhookUrlIsRequired
+	} else {
+		if _, err := policy.SlackWebhookUrlPolicy(r.WebhookUrl); err != nil {
+			errs["webhookUrl"] = translation.ValidWebhookUrlIsRequired
+		}
+	}
+
+	return errs
+}
@@ -0,0 +1,157 @@
+package usesCases
+
+import (
+	"net/http"
+	"testing"
+
+	"github.com/gin-gonic/gin"
+	"github.com/greenbone/keycloak-client-golang/auth"
+	"github.com/greenbone/opensight-golang-libraries/pkg/httpassert"
+	"github.com/greenbone/opensight-notification-service/pkg/services/notificationchannelservice"
+	"github.com/greenbone/opensight-notification-service/pkg/web/errmap"
+	"github.com/greenbone/opensight-notification-service/pkg/web/iam"
+	"github.com/greenbone/opensight-notification-service/pkg/web/integrationTests"
+	"github.com/greenbone/opensight-notification-service/pkg/web/slackcontroller"
+	"github.com/greenbone/opensight-notification-service/pkg/web/testhelper"
+	"github.com/stretchr/testify/require"
+)
+
+type roundTripperFunc func(*http.Request) (*http.Response, error)
+
+func (f roundTripperFunc) RoundTrip(req *http.Request) (*http.Response, error) {
+	return f(req)
+}
+
+func setup(t *testing.T, transport http.Client) *gin.Engine {
+	t.Helper()
+
+	repo, db := testhelper.SetupNotificationChannelTestEnv(t)
+	svc := notificationchannelservice.NewNotificationChannelService(repo)
+	slackService := notificationchannelservice.NewSlackService(&transport)
+	slackChannelSvc := notificationchannelservice.NewSlackChannelService(svc, 20, slackService)
+	registry := errmap.NewRegistry()
+	router := testhelper.NewTestWebEngine(registry)
+
+	authMiddleware, err := auth.NewGinAuthMiddleware(integrationTests.NewTestJwtParser())
+	require.NoError(t, err)
+
+	slackcontroller.NewSlackController(router, svc, slackChannelSvc, authMiddleware, registry)
+	defer db.Close()
+	return router
+}
+
+func TestCheckSlackChannel(t *testing.T) {
+	t.Run("Check slack channel", func(t *testing.T) {
+		t.Parallel()
+
+		transport := http.Client{
+			Transport: roundTripperFunc(func(r *http.Request) (*http.Response, error) {
+				return &http.Response{
+					StatusCode: http.StatusNoContent,
+					Body:       http.NoBody,
+					Header:     make(http.Header),
+				}, nil
+			}),
+		}
+		router := setup(t, transport)
+
+		// Check slack channel
+		httpassert.New(t, router).Post("/notification-channel/slack/check").
+			AuthJwt(integrationTests.CreateJwtTokenWithRole(iam.NotificationAdmin)).
+			JsonContent(`{
+				"webhookUrl": "https://hooks.slack.com/services/T0001/B0001/id1"
+			}`).
+			Expect().
+			StatusCode(http.StatusNoContent)
+	})
+
+	t.Run("Check slack channel with invalid webhook URL returns an error", func(t *testing.T) {
+		t.Parallel()
+
+		transport := http.Client{
+			Transport: roundTripperFunc(func(r *http.Request) (*http.Response, error) {
+				return &http.Response{
+					StatusCode: http.StatusNoContent,
+					Body:       http.NoBody,
+					Header:     make(http.Header),
+				}, nil
+			}),
+		}
+		router := setup(t, transport)
+
+		// Check slack channel
+		httpassert.New(t, router).Post("/notification-channel/slack/check").
+			AuthJwt(integrationTests.CreateJwtTokenWithRole(iam.NotificationAdmin)).
+			JsonContent(`{
+				"webhookUrl": "invalid"
+			}`).
+			Expect().
+			StatusCode(http.StatusBadRequest).
+			Json(`{
+			"type": "greenbone/validation-error",
+			"title": "",
+			"errors": {
+				"webhookUrl": "Please enter a valid webhook URL."
+			}
+		}`)
+	})
+
+	t.Run("Check slack channel with slack server response 404", func(t *testing.T) {
+		t.Parallel()
+
+		transport := http.Client{
+			Transport: roundTripperFunc(func(r *http.Request) (*http.Response, error) {
+				return &http.Response{
+					StatusCode: http.StatusNotFound,
+					Body:       http.NoBody,
+					Header:     make(http.Header),
+					Status:     "404 Not Found",
+				}, nil
+			}),
+		}
+		router := setup(t, transport)
+
+		// Check slack channel
+		httpassert.New(t, router).Post("/notification-channel/slack/check").
+			AuthJwt(integrationTests.CreateJwtTokenWithRole(iam.NotificationAdmin)).
+			JsonContent(`{
+				"webhookUrl": "https://hooks.slack.com/services/T0001/B0001/id1"
+			}`).
+			Expect().
+			StatusCode(http.StatusBadRequest).
+			Json(`{
+				"type": "greenbone/generic-error",
+				"title": "slack message could not be send: http status: 404 Not Found"
+			}`)
+	})
+
+	t.Run("Check slack channel without required url", func(t *testing.T) {
+		t.Parallel()
+
+		transport := http.Client{
+			Transport: roundTripperFunc(func(r *http.Request) (*http.Response, error) {
+				return &http.Response{
+					StatusCode: http.StatusNotFound,
+					Body:       http.NoBody,
+					Header:     make(http.Header),
+					Status:     "404 Not Found",
+				}, nil
+			}),
+		}
+		router := setup(t, transport)
+
+		// Check slack channel
+		httpassert.New(t, router).Post("/notification-channel/slack/check").
+			AuthJwt(integrationTests.CreateJwtTokenWithRole(iam.NotificationAdmin)).
+			JsonContent(`{}`).
+			Expect().
+			StatusCode(http.StatusBadRequest).
+			Json(`{
+				"title":"",
+				"type":"greenbone/validation-error",
+				"errors": {
+					"webhookUrl":"A Webhook URL is required."
+				}
+			}`)
+	})
+}
@@ -0,0 +1,123 @@
+package usesCases
+
+import (
+	"net/http"
+	"testing"
+
+	"github.com/greenbone/opensight-golang-libraries/pkg/httpassert"
+	"github.com/greenbone/opensight-notification-service/pkg/web/iam"
+	"github.com/greenbone/opensight-notification-service/pkg/web/integrationTests"
+	"github.com/stretchr/testify/require"
+)
+
+func TestCreateSlackChannel(t *testing.T) {
+	t.Run("Create slack channel", func(t *testing.T) {
+		t.Parallel()
+
+		router, db := setupTestRouter(t)
+		defer db.Close()
+
+		var slackId string
+
+		// Create slack channel
+		httpassert.New(t, router).Post("/notification-channel/slack").
+			AuthJwt(integrationTests.CreateJwtTokenWithRole(iam.NotificationAdmin)).
+			JsonContent(`{
+				"channelName": "slack1",
+				"webhookUrl": "https://hooks.slack.com/services/T0001/B0001/id1",
+				"description": "This is a test slack channel"
+			}`).
+			Expect().
+			StatusCode(http.StatusCreated).
+			JsonPath("$.id", httpassert.ExtractTo(&slackId)).
+			JsonTemplate(`{
+				"id": "d9cc9be2-7b4d-4c6f-991d-a40cfe002ceb",
+				"channelName": "slack1",
+				"webhookUrl": "https://hooks.slack.com/services/T0001/B0001/id1",
+				"description": "This is a test slack channel"
+			}`, map[string]any{
+				"id": httpassert.IgnoreJsonValue,
+			})
+		require.NotEmpty(t, slackId)
+	})
+
+	t.Run("Create slack channel with invalid webhook URL returns an error", func(t *testing.T) {
+		t.Parallel()
+
+		router, db := setupTestRouter(t)
+		defer db.Close()
+
+		// Create slack channel
+		httpassert.New(t, router).Post("/notification-channel/slack").
+			AuthJwt(integrationTests.CreateJwtTokenWithRole(iam.NotificationAdmin)).
+			JsonContent(`{
+				"channelName": "a",
+				"webhookUrl": "invalid",
+				"description": "b"
+			}`).
+			Expect().
+			StatusCode(http.StatusBadRequest).
+			Json(`{
+				"type": "greenbone/validation-error",
+				"title": "",
+				"errors": {
+					"webhookUrl": "Please enter a valid webhook URL."
+				}
+			}`)
+	})
+
+	t.Run("Create slack channel without required fields returns an error", func(t *testing.T) {
+		t.Parallel()
+
+		router, db := setupTestRouter(t)
+		defer db.Close()
+
+		// Create slack channel
+		httpassert.New(t, router).Post("/notification-channel/slack").
+			AuthJwt(integrationTests.CreateJwtTokenWithRole(iam.NotificationAdmin)).
+			JsonContent(`{}`).
+			Expect().
+			StatusCode(http.StatusBadRequest).
+			Json(`{
+				"type": "greenbone/validation-error",
+				"title": "",
+				"errors": {
+					"channelName": "A channel name is required.",
+					"webhookUrl": "A Webhook URL is required."
+				}
+			}`)
+	})
+
+	t.Run("Create slack channel with an existing name return an error", func(t *testing.T) {
+		t.Parallel()
+
+		router, db := setupTestRouter(t)
+		defer db.Close()
+
+		// Create slack channel
+		httpassert.New(t, router).Post("/notification-channel/slack").
+			AuthJwt(integrationTests.CreateJwtTokenWithRole(iam.NotificationAdmin)).
+			JsonContent(`{
+				"channelName": "slack 1",
+				"webhookUrl": "https://hooks.slack.com/services/T0001/B0001/id1",
+				"description": "This is a test slack channel"
+			}`).
+			Expect().
+			StatusCode(http.StatusCreated)
+
+		// Create slack channel with the same name
+		httpassert.New(t, router).Post("/notification-channel/slack").
+			AuthJwt(integrationTests.CreateJwtTokenWithRole(iam.NotificationAdmin)).
+			JsonContent(`{
+				"channelName": "slack 1",
+				"webhookUrl": "https://hooks.slack.com/services/T0001/B0001/id1",
+				"description": "This is a test slack channel"
+			}`).
+			Expect().
+			StatusCode(http.StatusBadRequest).
+			Json(`{
+				"type": "greenbone/generic-error",
+				"title": "Slack channel name already exists."
+			}`)
+	})
+}
@@ -0,0 +1,73 @@
+package usesCases
+
+import (
+	"net/http"
+	"testing"
+	"time"
+
+	"github.com/gin-gonic/gin"
+	"github.com/greenbone/keycloak-client-golang/auth"
+	"github.com/greenbone/opensight-golang-libraries/pkg/httpassert"
+	"github.com/greenbone/opensight-notification-service/pkg/services/notificationchannelservice"
+	"github.com/greenbone/opensight-notification-service/pkg/web/errmap"
+	"github.com/greenbone/opensight-notification-service/pkg/web/iam"
+	"github.com/greenbone/opensight-notification-service/pkg/web/integrationTests"
+	"github.com/greenbone/opensight-notification-service/pkg/web/slackcontroller"
+	"github.com/greenbone/opensight-notification-service/pkg/web/testhelper"
+	"github.com/jmoiron/sqlx"
+	"github.com/stretchr/testify/require"
+)
+
+func setupTestRouter(t *testing.T) (*gin.Engine, *sqlx.DB) {
+	repo, db := testhelper.SetupNotificationChannelTestEnv(t)
+	svc := notificationchannelservice.NewNotificationChannelService(repo)
+	slackService := notificationchannelservice.NewSlackService(&http.Client{Timeout: 15 * time.Second})
+	slackSvc := notificationchannelservice.NewSlackChannelService(svc, 20, slackService)
+	registry := errmap.NewRegistry()
+	router := testhelper.NewTestWebEngine(registry)
+
+	authMiddleware, err := auth.NewGinAuthMiddleware(integrationTests.NewTestJwtParser())
+	require.NoError(t, err)
+
+	slackcontroller.NewSlackController(router, svc, slackSvc, authMiddleware, registry)
+
+	return router, db
+}
+
+func TestDeleteSlackChannel(t *testing.T) {
+	t.Run("Delete a slack channel without proper role returns error", func(t *testing.T) {
+		t.Parallel()
+
+		router, db := setupTestRouter(t)
+		defer db.Close()
+
+		var slackId string
+
+		// Create slack channel
+		httpassert.New(t, router).Post("/notification-channel/slack").
+			AuthJwt(integrationTests.CreateJwtTokenWithRole(iam.NotificationAdmin)).
+			JsonContent(`{
+				"channelName": "slack1",
+				"webhookUrl": "https://hooks.slack.com/services/T0001/B0001/id1",
+				"description": "This is a test slack channel"
+			}`).
+			Expect().
+			StatusCode(http.StatusCreated).
+			JsonPath("$.id", httpassert.ExtractTo(&slackId)).
+			JsonTemplate(`{
+				"id": "d9cc9be2-7b4d-4c6f-991d-a40cfe002ceb",
+				"channelName": "slack1",
+				"webhookUrl": "https://hooks.slack.com/services/T0001/B0001/id1",
+				"description": "This is a test slack channel"
+			}`, map[string]any{
+				"id": httpassert.IgnoreJsonValue,
+			})
+		require.NotEmpty(t, slackId)
+
+		// Delete slack channel
+		httpassert.New(t, router).Deletef("/notification-channel/slack/%s", slackId).
+			AuthJwt(integrationTests.CreateJwtTokenWithRole(iam.NotificationAdmin)).
+			Expect().
+			StatusCode(http.StatusNoContent)
+	})
+}
@@ -0,0 +1,59 @@
+package usesCases
+
+import (
+	"net/http"
+	"testing"
+
+	"github.com/greenbone/opensight-golang-libraries/pkg/httpassert"
+	"github.com/greenbone/opensight-notification-service/pkg/web/iam"
+	"github.com/greenbone/opensight-notification-service/pkg/web/integrationTests"
+	"github.com/stretchr/testify/require"
+)
+
+func TestListSlackChannels(t *testing.T) {
+	t.Run("List slack channels", func(t *testing.T) {
+		t.Parallel()
+
+		router, db := setupTestRouter(t)
+		defer db.Close()
+
+		var slackId string
+
+		// Create slack channel
+		httpassert.New(t, router).Post("/notification-channel/slack").
+			AuthJwt(integrationTests.CreateJwtTokenWithRole(iam.NotificationAdmin)).
+			JsonContent(`{
+				"channelName": "slack1",
+				"webhookUrl": "https://hooks.slack.com/services/T0001/B0001/id1",
+				"description": "This is a test slack channel"
+			}`).
+			Expect().
+			StatusCode(http.StatusCreated).
+			JsonPath("$.id", httpassert.ExtractTo(&slackId)).
+			JsonTemplate(`{
+				"id": "d9cc9be2-7b4d-4c6f-991d-a40cfe002ceb",
+				"channelName": "slack1",
+				"webhookUrl": "https://hooks.slack.com/services/T0001/B0001/id1",
+				"description": "This is a test slack channel"
+			}`, map[string]any{
+				"id": httpassert.IgnoreJsonValue,
+			})
+		require.NotEmpty(t, slackId)
+
+		// List slack channels
+		httpassert.New(t, router).Get("/notification-channel/slack").
+			AuthJwt(integrationTests.CreateJwtTokenWithRole(iam.NotificationAdmin)).
+			Expect().
+			StatusCode(http.StatusOK).
+			JsonTemplate(`[
+				{
+					"id": "fb46613b-4bf8-45c7-ad6f-e83e5ced8b81",
+					"channelName": "slack1",
+					"webhookUrl": "https://hooks.slack.com/services/T0001/B0001/id1",
+					"description": "This is a test slack channel"
+				}
+			]`, map[string]any{
+				"$.0.id": httpassert.IgnoreJsonValue,
+			})
+	})
+}
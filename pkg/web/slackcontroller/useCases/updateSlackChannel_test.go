@@ -0,0 +1,179 @@
+package usesCases
+
+import (
+	"net/http"
+	"testing"
+
+	"github.com/greenbone/opensight-golang-libraries/pkg/httpassert"
+	"github.com/greenbone/opensight-notification-service/pkg/web/iam"
+	"github.com/greenbone/opensight-notification-service/pkg/web/integrationTests"
+	"github.com/stretchr/testify/require"
+)
+
+func TestUpdateSlackChannel(t *testing.T) {
+	t.Run("Update slack channel", func(t *testing.T) {
+		t.Parallel()
+
+		router, db := setupTestRouter(t)
+		defer db.Close()
+
+		var slackId string
+
+		// Create slack channel
+		httpassert.New(t, router).Post("/notification-channel/slack").
+			AuthJwt(integrationTests.CreateJwtTokenWithRole(iam.NotificationAdmin)).
+			JsonContent(`{
+				"channelName": "slack1",
+				"webhookUrl": "https://hooks.slack.com/services/T0001/B0001/id1",
+				"description": "This is a test slack channel"
+			}`).
+			Expect().
+			StatusCode(http.StatusCreated).
+			JsonPath("$.id", httpassert.ExtractTo(&slackId))
+		require.NotEmpty(t, slackId)
+
+		// Update slack channel
+		httpassert.New(t, router).Putf("/notification-channel/slack/%s", slackId).
+			AuthJwt(integrationTests.CreateJwtTokenWithRole(iam.NotificationAdmin)).
+			JsonContent(`{
+				"channelName": "slack2",
+				"webhookUrl": "https://hooks.slack.com/services/T0001/B0001/id2",
+				"description": "This is a test slack channel changed"
+			}`).
+			Expect().
+			StatusCode(http.StatusOK).
+			JsonTemplate(`{
+				"id": "fb46613b-4bf8-45c7-ad6f-e83e5ced8b81",
+				"channelName": "slack2",
+				"webhookUrl": "https://hooks.slack.com/services/T0001/B0001/id2",
+				"description": "This is a test slack channel changed"
+			}`, map[string]any{
+				"$.id": slackId,
+			})
+	})
+
+	t.Run("Update slack channel with an invalid webhook URL returns an error", func(t *testing.T) {
+		t.Parallel()
+
+		router, db := setupTestRouter(t)
+		defer db.Close()
+
+		var slackId string
+
+		// Create slack channel
+		httpassert.New(t, router).Post("/notification-channel/slack").
+			AuthJwt(integrationTests.CreateJwtTokenWithRole(iam.NotificationAdmin)).
+			JsonContent(`{
+				"channelName": "slack1",
+				"webhookUrl": "https://hooks.slack.com/services/T0001/B0001/id1",
+				"description": "This is a test slack channel"
+			}`).
+			Expect().
+			StatusCode(http.StatusCreated).
+			JsonPath("$.id", httpassert.ExtractTo(&slackId))
+		require.NotEmpty(t, slackId)
+
+		// Update slack channel
+		httpassert.New(t, router).Putf("/notification-channel/slack/%s", slackId).
+			AuthJwt(integrationTests.CreateJwtTokenWithRole(iam.NotificationAdmin)).
+			JsonContent(`{
+				"channelName": "1",
+				"webhookUrl": "invalid",
+				"description": "b"
+			}`).
+			Expect().
+			StatusCode(http.StatusBadRequest).
+			Json(`{
+				"type": "greenbone/validation-error",
+				"title": "",
+				"errors": {
+					"webhookUrl": "Please enter a valid webhook URL."
+				}
+			}`)
+	})
+
+	t.Run("Update slack channel without required fields returns an error", func(t *testing.T) {
+		t.Parallel()
+
+		router, db := setupTestRouter(t)
+		defer db.Close()
+
+		var slackId string
+
+		// Create slack channel
+		httpassert.New(t, router).Post("/notification-channel/slack").
+			AuthJwt(integrationTests.CreateJwtTokenWithRole(iam.NotificationAdmin)).
+			JsonContent(`{
+				"channelName": "slack1",
+				"webhookUrl": "https://hooks.slack.com/services/T0001/B0001/id1",
+				"description": "This is a test slack channel"
+			}`).
+			Expect().
+			StatusCode(http.StatusCreated).
+			JsonPath("$.id", httpassert.ExtractTo(&slackId))
+		require.NotEmpty(t, slackId)
+
+		// Update slack channel
+		httpassert.New(t, router).Putf("/notification-channel/slack/%s", slackId).
+			AuthJwt(integrationTests.CreateJwtTokenWithRole(iam.NotificationAdmin)).
+			JsonContent(`{}`).
+			Expect().
+			StatusCode(http.StatusBadRequest).
+			Json(`{
+				"type": "greenbone/validation-error",
+				"title": "",
+				"errors": {
+					"channelName": "A channel name is required.",
+					"webhookUrl": "A Webhook URL is required."
+				}
+			}`)
+	})
+
+	t.Run("Update slack channel name with an existing one returns an error", func(t *testing.T) {
+		t.Parallel()
+
+		router, db := setupTestRouter(t)
+		defer db.Close()
+
+		var slackId string
+
+		// Create slack channel
+		httpassert.New(t, router).Post("/notification-channel/slack").
+			AuthJwt(integrationTests.CreateJwtTokenWithRole(iam.NotificationAdmin)).
+			JsonContent(`{
+				"channelName": "slack 1",
+				"webhookUrl": "https://hooks.slack.com/services/T0001/B0001/id1",
+				"description": "This is a test slack channel"
+			}`).
+			Expect().
+			StatusCode(http.StatusCreated)
+
+		// Create slack channel
+		httpassert.New(t, router).Post("/notification-channel/slack").
+			AuthJwt(integrationTests.CreateJwtTokenWithRole(iam.NotificationAdmin)).
+			JsonContent(`{
+				"channelName": "slack 2",
+				"webhookUrl": "https://hooks.slack.com/services/T0001/B0001/id1",
+				"description": "This is a test slack channel"
+			}`).
+			Expect().
+			StatusCode(http.StatusCreated).
+			JsonPath("$.id", httpassert.ExtractTo(&slackId))
+		require.NotEmpty(t, slackId)
+
+		// Update slack channel
+		httpassert.New(t, router).Putf("/notification-channel/slack/%s", slackId).
+			AuthJwt(integrationTests.CreateJwtTokenWithRole(iam.NotificationAdmin)).
+			JsonContent(`{
+				"channelName": "slack 1",
+				"webhookUrl": "https://hooks.slack.com/services/T0001/B0001/id1",
+				"description": "This is a test slack channel"
+			}`).
+			Expect().
+			StatusCode(http.StatusBadRequest).
+			Json(`{
+				"type": "greenbone/generic-error",
+				"title": "Slack channel name already exists."
+			}`)
+	})
+}
@@ -0,0 +1,195 @@
+package syslogcontroller
+
+import (
+	"net/http"
+
+	"github.com/gin-gonic/gin"
+	"github.com/greenbone/opensight-golang-libraries/pkg/errorResponses"
+	"github.com/greenbone/opensight-notification-service/pkg/models"
+	"github.com/greenbone/opensight-notification-service/pkg/services/notificationchannelservice"
+	"github.com/greenbone/opensight-notification-service/pkg/translation"
+	"github.com/greenbone/opensight-notification-service/pkg/web/errmap"
+	"github.com/greenbone/opensight-notification-service/pkg/web/ginEx"
+	"github.com/greenbone/opensight-notification-service/pkg/web/iam"
+	"github.com/greenbone/opensight-notification-service/pkg/web/middleware"
+	"github.com/greenbone/opensight-notification-service/pkg/web/syslogcontroller/syslogdto"
+)
+
+type SyslogController struct {
+	notificationChannelServicer notificationchannelservice.NotificationChannelService
+	syslogChannelService        notificationchannelservice.SyslogChannelService
+}
+
+func NewSyslogController(
+	router gin.IRouter,
+	notificationChannelServicer notificationchannelservice.NotificationChannelService,
+	syslogChannelService notificationchannelservice.SyslogChannelService,
+	auth gin.HandlerFunc,
+	registry *errmap.Registry,
+) *SyslogController {
+	ctrl := &SyslogController{
+		notificationChannelServicer: notificationChannelServicer,
+		syslogChannelService:        syslogChannelService,
+	}
+
+	group := router.Group("/notification-channel/syslog").
+		Use(middleware.AuthorizeRoles(auth, iam.OsiAdmin, iam.NotificationAdmin)...)
+
+	group.POST("", ctrl.createSyslogChannel)
+	group.GET("", ctrl.listSyslogChannels)
+	group.PUT("/:id", ctrl.updateSyslogChannel)
+	group.DELETE("/:id", ctrl.deleteSyslogChannel)
+	group.POST("/check", ctrl.checkSyslogServer)
+
+	ctrl.configureMappings(registry)
+	return ctrl
+}
+
+func (sc *SyslogController) configureMappings(r *errmap.Registry) {
+	r.Register(
+		notificationchannelservice.ErrSyslogChannelLimitReached,
+		http.StatusUnprocessableEntity,
+		errorResponses.NewErrorGenericResponse(translation.SyslogChannelLimitReached),
+	)
+	r.Register(
+		notificationchannelservice.ErrListSyslogChannels,
+		http.StatusInternalServerError,
+		errorResponses.ErrorInternalResponse,
+	)
+	r.Register(
+		notificationchannelservice.ErrSyslogChannelNameExists,
+		http.StatusBadRequest,
+		errorResponses.NewErrorGenericResponse(translation.SyslogChannelNameAlreadyExist),
+	)
+	r.Register(
+		notificationchannelservice.ErrSyslogServerUnreachable,
+		http.StatusUnprocessableEntity,
+		errorResponses.NewErrorGenericResponse(translation.SyslogServerUnreachable),
+	)
+}
+
+// CreateSyslogChannel
+//
+//	@Summary		Create Syslog Channel
+//	@Description	Create a new syslog notification channel
+//	@Tags			syslog-channel
+//	@Accept			json
+//	@Produce		json
+//	@Security		KeycloakAuth
+//	@Param			SyslogChannel	body		syslogdto.SyslogNotificationChannelRequest	true	"Syslog channel to add"
+//	@Success		201			{object}	syslogdto.SyslogNotificationChannelResponse
+//	@Failure		400			{object}	map[string]string
+//	@Failure		500			{object}	map[string]string
+//	@Router			/notification-channel/syslog [post]
+func (sc *SyslogController) createSyslogChannel(c *gin.Context) {
+	var channel syslogdto.SyslogNotificationChannelRequest
+	if !ginEx.BindAndValidateBody(c, &channel) {
+		return
+	}
+
+	syslogChannel, err := sc.syslogChannelService.CreateSyslogChannel(c, channel)
+	if ginEx.AddError(c, err) {
+		return
+	}
+
+	c.JSON(http.StatusCreated, syslogChannel)
+}
+
+// ListSyslogChannels
+//
+//	@Summary		List Syslog Channels
+//	@Description	List syslog notification channels
+//	@Tags			syslog-channel
+//	@Produce		json
+//	@Security		KeycloakAuth
+//	@Success		200		{array}		syslogdto.SyslogNotificationChannelResponse
+//	@Failure		500		{object}	map[string]string
+//	@Router			/notification-channel/syslog [get]
+func (sc *SyslogController) listSyslogChannels(c *gin.Context) {
+	channels, err := sc.notificationChannelServicer.ListNotificationChannelsByType(c, models.ChannelTypeSyslog)
+	if ginEx.AddError(c, err) {
+		return
+	}
+
+	c.JSON(http.StatusOK, syslogdto.MapNotificationChannelsToSyslog(channels))
+}
+
+// UpdateSyslogChannel
+//
+//	@Summary		Update Syslog Channel
+//	@Description	Update an existing syslog notification channel
+//	@Tags			syslog-channel
+//	@Accept			json
+//	@Produce		json
+//	@Security		KeycloakAuth
+//	@Param			id			path		string						true	"Syslog channel ID"
+//	@Param			SyslogChannel	body		syslogdto.SyslogNotificationChannelRequest	true	"Syslog channel to update"
+//	@Success		200			{object}	syslogdto.SyslogNotificationChannelResponse
+//	@Failure		400			{object}	map[string]string
+//	@Failure		404 		{object}    map[string]string
+//	@Failure		500			{object}	map[string]string
+//	@Router			/notification-channel/syslog/{id} [put]
+func (sc *SyslogController) updateSyslogChannel(c *gin.Context) {
+	id := c.Param("id")
+
+	var channel syslogdto.SyslogNotificationChannelRequest
+	if !ginEx.BindAndValidateBody(c, &channel) {
+		return
+	}
+
+	updated, err := sc.syslogChannelService.UpdateSyslogChannel(c, id, channel)
+	if ginEx.AddError(c, err) {
+		return
+	}
+
+	c.JSON(http.StatusOK, updated)
+}
+
+// DeleteSyslogChannel
+//
+//	@Summary		Delete Syslog Channel
+//	@Description	Delete a syslog notification channel
+//	@Tags			syslog-channel
+//	@Security		KeycloakAuth
+//	@Param			id	path	string	true	"Syslog channel ID"
+//	@Success		204	"Deleted successfully"
+//	@Failure		404 {object}    map[string]string
+//	@Failure		500	{object}	map[string]string
+//	@Router			/notification-channel/syslog/{id} [delete]
+func (sc *SyslogController) deleteSyslogChannel(c *gin.Context) {
+	id := c.Param("id")
+
+	err := sc.notificationChannelServicer.DeleteNotificationChannel(c, id)
+	if ginEx.AddError(c, err) {
+		return
+	}
+
+	c.Status(http.StatusNoContent)
+}
+
+// CheckSyslogServer
+//
+//	@Summary		Check syslog server
+//	@Description	Check if a connection to the syslog server can be established with the transport
+//	@Tags			syslog-channel
+//	@Accept			json
+//	@Produce		json
+//	@Security		KeycloakAuth
+//	@Param			SyslogChannel	body	syslogdto.SyslogNotificationChannelCheckRequest	true	"Syslog server to check"
+//	@Success		204 "Syslog server is reachable"
+//	@Failure		400			{object}	map[string]string
+//	@Failure		422			{object}	map[string]string
+//	@Router			/notification-channel/syslog/check [post]
+func (sc *SyslogController) checkSyslogServer(c *gin.Context) {
+	var channel syslogdto.SyslogNotificationChannelCheckRequest
+	if !ginEx.BindAndValidateBody(c, &channel) {
+		return
+	}
+
+	err := sc.syslogChannelService.CheckSyslogConnectivity(c, syslogdto.MapSyslogCheckToNotificationChannel(channel))
+	if ginEx.AddError(c, err) {
+		return
+	}
+
+	c.Status(http.StatusNoContent)
+}
@@ -0,0 +1,65 @@
+package syslogdto
+
+import (
+	"github.com/greenbone/opensight-notification-service/pkg/helper"
+	"github.com/greenbone/opensight-notification-service/pkg/models"
+)
+
+// MapNotificationChannelToSyslog maps NotificationChannel to SyslogNotificationChannelResponse.
+func MapNotificationChannelToSyslog(channel models.NotificationChannel) SyslogNotificationChannelResponse {
+	transport := helper.SafeDereference(channel.SyslogTransport)
+	if transport == "" {
+		transport = models.SyslogTransportUDP
+	}
+	facility := models.DefaultSyslogFacility
+	if channel.SyslogFacility != nil {
+		facility = *channel.SyslogFacility
+	}
+	appName := helper.SafeDereference(channel.SyslogAppName)
+	if appName == "" {
+		appName = models.DefaultSyslogAppName
+	}
+
+	return SyslogNotificationChannelResponse{
+		Id:          channel.Id,
+		ChannelName: channel.ChannelName,
+		Description: helper.SafeDereference(channel.Description),
+		Host:        helper.SafeDereference(channel.Domain),
+		Port:        helper.SafeDereference(channel.Port),
+		Transport:   transport,
+		Facility:    facility,
+		AppName:     appName,
+	}
+}
+
+func MapSyslogToNotificationChannel(channel SyslogNotificationChannelRequest) models.NotificationChannel {
+	return models.NotificationChannel{
+		ChannelType:     models.ChannelTypeSyslog,
+		ChannelName:     channel.ChannelName,
+		Description:     &channel.Description,
+		Domain:          &channel.Host,
+		Port:            &channel.Port,
+		SyslogTransport: &channel.Transport,
+		SyslogFacility:  channel.Facility,
+		SyslogAppName:   &channel.AppName,
+	}
+}
+
+// MapSyslogCheckToNotificationChannel maps the check request to the channel the connection is checked for.
+func MapSyslogCheckToNotificationChannel(channel SyslogNotificationChannelCheckRequest) models.NotificationChannel {
+	return models.NotificationChannel{
+		ChannelType:     models.ChannelTypeSyslog,
+		Domain:          &channel.Host,
+		Port:            &channel.Port,
+		SyslogTransport: &channel.Transport,
+	}
+}
+
+// MapNotificationChannelsToSyslog maps a slice of NotificationChannel to SyslogNotificationChannelResponse.
+func MapNotificationChannelsToSyslog(channels []models.NotificationChannel) []SyslogNotificationChannelResponse {
+	syslogChannels := make([]SyslogNotificationChannelResponse, 0, len(channels))
+	for _, ch := range channels {
+		syslogChannels = append(syslogChannels, MapNotificationChannelToSyslog(ch))
+	}
+	return syslogChannels
+}
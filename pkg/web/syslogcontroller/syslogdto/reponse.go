@@ -0,0 +1,13 @@
+package syslogdto
+
+// SyslogNotificationChannelResponse syslog notification channel response
+type SyslogNotificationChannelResponse struct {
+	Id          string `json:"id"`
+	ChannelName string `json:"channelName"`
+	Description string `json:"description"`
+	Host        string `json:"host"`
+	Port        int    `json:"port"`
+	Transport   string `json:"transport"`
+	Facility    int    `json:"facility"`
+	AppName     string `json:"appName"`
+}
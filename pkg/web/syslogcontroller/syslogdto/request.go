@@ -0,0 +1,101 @@
+package syslogdto
+
+import (
+	"slices"
+	"strings"
+
+	"github.com/greenbone/opensight-notification-service/pkg/models"
+	"github.com/greenbone/opensight-notification-service/pkg/policy"
+	"github.com/greenbone/opensight-notification-service/pkg/translation"
+)
+
+// SyslogNotificationChannelRequest syslog notification channel request.
+// Without port the port registered for the transport is used, the facility defaults to local0.
+type SyslogNotificationChannelRequest struct {
+	ChannelName string `json:"channelName"`
+	Description string `json:"description"`
+	Host        string `json:"host"`
+	Port        int    `json:"port"`
+	Transport   string `json:"transport" enums:"udp,tcp,tls"`
+	Facility    *int   `json:"facility"`
+	AppName     string `json:"appName"`
+}
+
+func (r *SyslogNotificationChannelRequest) Cleanup() {
+	r.ChannelName = strings.TrimSpace(r.ChannelName)
+	r.Description = strings.TrimSpace(r.Description)
+	r.Host = strings.TrimSpace(r.Host)
+	r.Transport = cleanupTransport(r.Transport)
+	if r.Port == 0 {
+		r.Port = models.DefaultSyslogPort(r.Transport)
+	}
+	if r.Facility == nil {
+		facility := models.DefaultSyslogFacility
+		r.Facility = &facility
+	}
+	r.AppName = strings.TrimSpace(r.AppName)
+	if r.AppName == "" {
+		r.AppName = models.DefaultSyslogAppName
+	}
+}
+
+func (r SyslogNotificationChannelRequest) Validate() models.ValidationErrors {
+	errs := make(models.ValidationErrors)
+
+	if r.ChannelName == "" {
+		errs["channelName"] = translation.ChannelNameIsRequired
+	}
+	validateSyslog(errs, r.Host, r.Port, r.Transport)
+	if r.Facility != nil && (*r.Facility < 0 || *r.Facility > models.MaxSyslogFacility) {
+		errs["facility"] = translation.InvalidSyslogFacility
+	}
+	if err := policy.SyslogAppNamePolicy(r.AppName); err != nil {
+		errs["appName"] = translation.InvalidSyslogAppName
+	}
+
+	return errs
+}
+
+// SyslogNotificationChannelCheckRequest syslog notification channel check request
+type SyslogNotificationChannelCheckRequest struct {
+	Host      string `json:"host"`
+	Port      int    `json:"port"`
+	Transport string `json:"transport" enums:"udp,tcp,tls"`
+}
+
+func (r *SyslogNotificationChannelCheckRequest) Cleanup() {
+	r.Host = strings.TrimSpace(r.Host)
+	r.Transport = cleanupTransport(r.Transport)
+	if r.Port == 0 {
+		r.Port = models.DefaultSyslogPort(r.Transport)
+	}
+}
+
+func (r *SyslogNotificationChannelCheckRequest) Validate() models.ValidationErrors {
+	errs := make(models.ValidationErrors)
+	validateSyslog(errs, r.Host, r.Port, r.Transport)
+	return errs
+}
+
+// cleanupTransport defaults the transport to UDP
+func cleanupTransport(transport string) string {
+	transport = strings.ToLower(strings.TrimSpace(transport))
+	if transport == "" {
+		return models.SyslogTransportUDP
+	}
+	return transport
+}
+
+func validateSyslog(errs models.ValidationErrors, host string, port int, transport string) {
+	if host == "" {
+		errs["host"] = translation.HostIsRequired
+	} else if err := policy.HostPolicy(host); err != nil {
+		errs["host"] = translation.ValidHostIsRequired
+	}
+	if port < 1 || port > 65535 {
+		errs["port"] = translation.PortIsRequired
+	}
+	if !slices.Contains(models.AllowedSyslogTransports, transport) {
+		errs["transport"] = translation.InvalidSyslogTransport
+	}
+}
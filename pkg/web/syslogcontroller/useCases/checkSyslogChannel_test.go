@@ -0,0 +1,86 @@
+package usesCases
+
+import (
+	"net"
+	"net/http"
+	"strconv"
+	"testing"
+
+	"github.com/greenbone/opensight-golang-libraries/pkg/httpassert"
+	"github.com/greenbone/opensight-notification-service/pkg/web/iam"
+	"github.com/greenbone/opensight-notification-service/pkg/web/integrationTests"
+	"github.com/stretchr/testify/require"
+)
+
+func TestCheckSyslogChannel(t *testing.T) {
+	t.Run("Check syslog channel", func(t *testing.T) {
+		t.Parallel()
+
+		listener, err := net.Listen("tcp", "127.0.0.1:0")
+		require.NoError(t, err)
+		defer listener.Close()
+
+		router, db := setupTestRouter(t)
+		defer db.Close()
+
+		// Check syslog channel
+		httpassert.New(t, router).Post("/notification-channel/syslog/check").
+			AuthJwt(integrationTests.CreateJwtTokenWithRole(iam.NotificationAdmin)).
+			JsonContent(`{
+				"host": "127.0.0.1",
+				"port": ` + strconv.Itoa(listener.Addr().(*net.TCPAddr).Port) + `,
+				"transport": "tcp"
+			}`).
+			Expect().
+			StatusCode(http.StatusNoContent)
+	})
+
+	t.Run("Check syslog channel with unreachable server returns an error", func(t *testing.T) {
+		t.Parallel()
+
+		// the port is closed again, so the connection is refused
+		listener, err := net.Listen("tcp", "127.0.0.1:0")
+		require.NoError(t, err)
+		port := listener.Addr().(*net.TCPAddr).Port
+		require.NoError(t, listener.Close())
+
+		router, db := setupTestRouter(t)
+		defer db.Close()
+
+		// Check syslog channel
+		httpassert.New(t, router).Post("/notification-channel/syslog/check").
+			AuthJwt(integrationTests.CreateJwtTokenWithRole(iam.NotificationAdmin)).
+			JsonContent(`{
+				"host": "127.0.0.1",
+				"port": ` + strconv.Itoa(port) + `,
+				"transport": "tcp"
+			}`).
+			Expect().
+			StatusCode(http.StatusUnprocessableEntity).
+			Json(`{
+				"type": "greenbone/generic-error",
+				"title": "Syslog server is unreachable."
+			}`)
+	})
+
+	t.Run("Check syslog channel without required host", func(t *testing.T) {
+		t.Parallel()
+
+		router, db := setupTestRouter(t)
+		defer db.Close()
+
+		// Check syslog channel
+		httpassert.New(t, router).Post("/notification-channel/syslog/check").
+			AuthJwt(integrationTests.CreateJwtTokenWithRole(iam.NotificationAdmin)).
+			JsonContent(`{}`).
+			Expect().
+			StatusCode(http.StatusBadRequest).
+			Json(`{
+				"title":"",
+				"type":"greenbone/validation-error",
+				"errors": {
+					"host":"A host is required."
+				}
+			}`)
+	})
+}
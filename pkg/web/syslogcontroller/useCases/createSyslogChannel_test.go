@@ -0,0 +1,135 @@
+package usesCases
+
+import (
+	"net/http"
+	"testing"
+
+	"github.com/greenbone/opensight-golang-libraries/pkg/httpassert"
+	"github.com/greenbone/opensight-notification-service/pkg/web/iam"
+	"github.com/greenbone/opensight-notification-service/pkg/web/integrationTests"
+	"github.com/stretchr/testify/require"
+)
+
+func TestCreateSyslogChannel(t *testing.T) {
+	t.Run("Create syslog channel", func(t *testing.T) {
+		t.Parallel()
+
+		router, db := setupTestRouter(t)
+		defer db.Close()
+
+		var syslogId string
+
+		// Create syslog channel
+		httpassert.New(t, router).Post("/notification-channel/syslog").
+			AuthJwt(integrationTests.CreateJwtTokenWithRole(iam.NotificationAdmin)).
+			JsonContent(`{
+				"channelName": "syslog1",
+				"description": "This is a test syslog channel",
+				"host": "siem.example.com",
+				"transport": "TLS",
+				"facility": 4,
+				"appName": "opensight"
+			}`).
+			Expect().
+			StatusCode(http.StatusCreated).
+			JsonPath("$.id", httpassert.ExtractTo(&syslogId)).
+			JsonTemplate(`{
+				"id": "d9cc9be2-7b4d-4c6f-991d-a40cfe002ceb",
+				"channelName": "syslog1",
+				"description": "This is a test syslog channel",
+				"host": "siem.example.com",
+				"port": 6514,
+				"transport": "tls",
+				"facility": 4,
+				"appName": "opensight"
+			}`, map[string]any{
+				"id": httpassert.IgnoreJsonValue,
+			})
+		require.NotEmpty(t, syslogId)
+	})
+
+	t.Run("Create syslog channel with invalid settings returns an error", func(t *testing.T) {
+		t.Parallel()
+
+		router, db := setupTestRouter(t)
+		defer db.Close()
+
+		// Create syslog channel
+		httpassert.New(t, router).Post("/notification-channel/syslog").
+			AuthJwt(integrationTests.CreateJwtTokenWithRole(iam.NotificationAdmin)).
+			JsonContent(`{
+				"channelName": "a",
+				"host": "siem_example.com",
+				"port": 70000,
+				"transport": "relp",
+				"facility": 24,
+				"appName": "open sight"
+			}`).
+			Expect().
+			StatusCode(http.StatusBadRequest).
+			Json(`{
+				"type": "greenbone/validation-error",
+				"title": "",
+				"errors": {
+					"host": "Please enter a valid host name or IP address.",
+					"port": "A port is required.",
+					"transport": "The transport must be udp, tcp or tls.",
+					"facility": "The facility must be between 0 and 23.",
+					"appName": "The app name must consist of at most 48 printable ASCII characters without spaces."
+				}
+			}`)
+	})
+
+	t.Run("Create syslog channel without required fields returns an error", func(t *testing.T) {
+		t.Parallel()
+
+		router, db := setupTestRouter(t)
+		defer db.Close()
+
+		// Create syslog channel
+		httpassert.New(t, router).Post("/notification-channel/syslog").
+			AuthJwt(integrationTests.CreateJwtTokenWithRole(iam.NotificationAdmin)).
+			JsonContent(`{}`).
+			Expect().
+			StatusCode(http.StatusBadRequest).
+			Json(`{
+				"type": "greenbone/validation-error",
+				"title": "",
+				"errors": {
+					"channelName": "A channel name is required.",
+					"host": "A host is required."
+				}
+			}`)
+	})
+
+	t.Run("Create syslog channel with an existing name return an error", func(t *testing.T) {
+		t.Parallel()
+
+		router, db := setupTestRouter(t)
+		defer db.Close()
+
+		// Create syslog channel
+		httpassert.New(t, router).Post("/notification-channel/syslog").
+			AuthJwt(integrationTests.CreateJwtTokenWithRole(iam.NotificationAdmin)).
+			JsonContent(`{
+				"channelName": "syslog 1",
+				"host": "siem.example.com"
+			}`).
+			Expect().
+			StatusCode(http.StatusCreated)
+
+		// Create syslog channel with the same name
+		httpassert.New(t, router).Post("/notification-channel/syslog").
+			AuthJwt(integrationTests.CreateJwtTokenWithRole(iam.NotificationAdmin)).
+			JsonContent(`{
+				"channelName": "syslog 1",
+				"host": "siem.example.com"
+			}`).
+			Expect().
+			StatusCode(http.StatusBadRequest).
+			Json(`{
+				"type": "greenbone/generic-error",
+				"title": "Syslog channel name already exists."
+			}`)
+	})
+}
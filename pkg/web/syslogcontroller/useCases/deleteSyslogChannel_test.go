@@ -0,0 +1,70 @@
+package usesCases
+
+import (
+	"net/http"
+	"testing"
+
+	"github.com/gin-gonic/gin"
+	"github.com/greenbone/keycloak-client-golang/auth"
+	"github.com/greenbone/opensight-golang-libraries/pkg/httpassert"
+	"github.com/greenbone/opensight-notification-service/pkg/services/notificationchannelservice"
+	"github.com/greenbone/opensight-notification-service/pkg/web/errmap"
+	"github.com/greenbone/opensight-notification-service/pkg/web/iam"
+	"github.com/greenbone/opensight-notification-service/pkg/web/integrationTests"
+	"github.com/greenbone/opensight-notification-service/pkg/web/syslogcontroller"
+	"github.com/greenbone/opensight-notification-service/pkg/web/testhelper"
+	"github.com/jmoiron/sqlx"
+	"github.com/stretchr/testify/require"
+)
+
+func setupTestRouter(t *testing.T) (*gin.Engine, *sqlx.DB) {
+	repo, db := testhelper.SetupNotificationChannelTestEnv(t)
+	svc := notificationchannelservice.NewNotificationChannelService(repo)
+	syslogService := notificationchannelservice.NewSyslogService(nil)
+	syslogSvc := notificationchannelservice.NewSyslogChannelService(svc, 20, syslogService)
+	registry := errmap.NewRegistry()
+	router := testhelper.NewTestWebEngine(registry)
+
+	authMiddleware, err := auth.NewGinAuthMiddleware(integrationTests.NewTestJwtParser())
+	require.NoError(t, err)
+
+	syslogcontroller.NewSyslogController(router, svc, syslogSvc, authMiddleware, registry)
+
+	return router, db
+}
+
+func TestDeleteSyslogChannel(t *testing.T) {
+	t.Run("Delete a syslog channel", func(t *testing.T) {
+		t.Parallel()
+
+		router, db := setupTestRouter(t)
+		defer db.Close()
+
+		var syslogId string
+
+		// Create syslog channel
+		httpassert.New(t, router).Post("/notification-channel/syslog").
+			AuthJwt(integrationTests.CreateJwtTokenWithRole(iam.NotificationAdmin)).
+			JsonContent(`{
+				"channelName": "syslog1",
+				"host": "siem.example.com"
+			}`).
+			Expect().
+			StatusCode(http.StatusCreated).
+			JsonPath("$.id", httpassert.ExtractTo(&syslogId))
+		require.NotEmpty(t, syslogId)
+
+		// Delete syslog channel
+		httpassert.New(t, router).Deletef("/notification-channel/syslog/%s", syslogId).
+			AuthJwt(integrationTests.CreateJwtTokenWithRole(iam.NotificationAdmin)).
+			Expect().
+			StatusCode(http.StatusNoContent)
+
+		// List syslog channels
+		httpassert.New(t, router).Get("/notification-channel/syslog").
+			AuthJwt(integrationTests.CreateJwtTokenWithRole(iam.NotificationAdmin)).
+			Expect().
+			StatusCode(http.StatusOK).
+			Json(`[]`)
+	})
+}
@@ -0,0 +1,50 @@
+package usesCases
+
+import (
+	"net/http"
+	"testing"
+
+	"github.com/greenbone/opensight-golang-libraries/pkg/httpassert"
+	"github.com/greenbone/opensight-notification-service/pkg/web/iam"
+	"github.com/greenbone/opensight-notification-service/pkg/web/integrationTests"
+)
+
+func TestListSyslogChannels(t *testing.T) {
+	t.Run("List syslog channels", func(t *testing.T) {
+		t.Parallel()
+
+		router, db := setupTestRouter(t)
+		defer db.Close()
+
+		// Create syslog channel
+		httpassert.New(t, router).Post("/notification-channel/syslog").
+			AuthJwt(integrationTests.CreateJwtTokenWithRole(iam.NotificationAdmin)).
+			JsonContent(`{
+				"channelName": "syslog1",
+				"description": "This is a test syslog channel",
+				"host": "10.0.0.1"
+			}`).
+			Expect().
+			StatusCode(http.StatusCreated)
+
+		// List syslog channels
+		httpassert.New(t, router).Get("/notification-channel/syslog").
+			AuthJwt(integrationTests.CreateJwtTokenWithRole(iam.NotificationAdmin)).
+			Expect().
+			StatusCode(http.StatusOK).
+			JsonTemplate(`[
+				{
+					"id": "fb46613b-4bf8-45c7-ad6f-e83e5ced8b81",
+					"channelName": "syslog1",
+					"description": "This is a test syslog channel",
+					"host": "10.0.0.1",
+					"port": 514,
+					"transport": "udp",
+					"facility": 16,
+					"appName": "opensight-notification"
+				}
+			]`, map[string]any{
+				"$.0.id": httpassert.IgnoreJsonValue,
+			})
+	})
+}
@@ -0,0 +1,99 @@
+package usesCases
+
+import (
+	"net/http"
+	"testing"
+
+	"github.com/greenbone/opensight-golang-libraries/pkg/httpassert"
+	"github.com/greenbone/opensight-notification-service/pkg/web/iam"
+	"github.com/greenbone/opensight-notification-service/pkg/web/integrationTests"
+	"github.com/stretchr/testify/require"
+)
+
+func TestUpdateSyslogChannel(t *testing.T) {
+	t.Run("Update syslog channel", func(t *testing.T) {
+		t.Parallel()
+
+		router, db := setupTestRouter(t)
+		defer db.Close()
+
+		var syslogId string
+
+		// Create syslog channel
+		httpassert.New(t, router).Post("/notification-channel/syslog").
+			AuthJwt(integrationTests.CreateJwtTokenWithRole(iam.NotificationAdmin)).
+			JsonContent(`{
+				"channelName": "syslog1",
+				"host": "siem.example.com"
+			}`).
+			Expect().
+			StatusCode(http.StatusCreated).
+			JsonPath("$.id", httpassert.ExtractTo(&syslogId))
+		require.NotEmpty(t, syslogId)
+
+		// Update syslog channel
+		httpassert.New(t, router).Putf("/notification-channel/syslog/%s", syslogId).
+			AuthJwt(integrationTests.CreateJwtTokenWithRole(iam.NotificationAdmin)).
+			JsonContent(`{
+				"channelName": "syslog2",
+				"description": "This is a test syslog channel changed",
+				"host": "10.0.0.2",
+				"port": 1514,
+				"transport": "tcp",
+				"facility": 0,
+				"appName": "opensight"
+			}`).
+			Expect().
+			StatusCode(http.StatusOK).
+			JsonTemplate(`{
+				"id": "fb46613b-4bf8-45c7-ad6f-e83e5ced8b81",
+				"channelName": "syslog2",
+				"description": "This is a test syslog channel changed",
+				"host": "10.0.0.2",
+				"port": 1514,
+				"transport": "tcp",
+				"facility": 0,
+				"appName": "opensight"
+			}`, map[string]any{
+				"$.id": syslogId,
+			})
+	})
+
+	t.Run("Update syslog channel with an invalid host returns an error", func(t *testing.T) {
+		t.Parallel()
+
+		router, db := setupTestRouter(t)
+		defer db.Close()
+
+		var syslogId string
+
+		// Create syslog channel
+		httpassert.New(t, router).Post("/notification-channel/syslog").
+			AuthJwt(integrationTests.CreateJwtTokenWithRole(iam.NotificationAdmin)).
+			JsonContent(`{
+				"channelName": "syslog1",
+				"host": "siem.example.com"
+			}`).
+			Expect().
+			StatusCode(http.StatusCreated).
+			JsonPath("$.id", httpassert.ExtractTo(&syslogId))
+		require.NotEmpty(t, syslogId)
+
+		// Update syslog channel
+		httpassert.New(t, router).Putf("/notification-channel/syslog/%s", syslogId).
+			AuthJwt(integrationTests.CreateJwtTokenWithRole(iam.NotificationAdmin)).
+			JsonContent(`{
+				"channelName": "syslog1",
+				"host": "-invalid-"
+			}`).
+			Expect().
+			StatusCode(http.StatusBadRequest).
+			Json(`{
+				"type": "greenbone/validation-error",
+				"title": "",
+				"errors": {
+					"host": "Please enter a valid host name or IP address."
+				}
+			}`)
+	})
+}
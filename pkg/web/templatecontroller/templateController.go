@@ -0,0 +1,209 @@
+// SPDX-FileCopyrightText: 2026 Greenbone AG <https://greenbone.net>
+//
+// SPDX-License-Identifier: AGPL-3.0-or-later
+
+package templatecontroller
+
+import (
+	"context"
+	"net/http"
+
+	"github.com/gin-gonic/gin"
+	"github.com/greenbone/opensight-golang-libraries/pkg/errorResponses"
+	"github.com/greenbone/opensight-notification-service/pkg/models"
+	"github.com/greenbone/opensight-notification-service/pkg/services/templateservice"
+	"github.com/greenbone/opensight-notification-service/pkg/translation"
+	"github.com/greenbone/opensight-notification-service/pkg/web"
+	"github.com/greenbone/opensight-notification-service/pkg/web/errmap"
+	"github.com/greenbone/opensight-notification-service/pkg/web/ginEx"
+	"github.com/greenbone/opensight-notification-service/pkg/web/iam"
+	"github.com/greenbone/opensight-notification-service/pkg/web/middleware"
+)
+
+type TemplateService interface {
+	ListTemplates(ctx context.Context) ([]models.MessageTemplate, error)
+	GetTemplate(ctx context.Context, channelType models.ChannelType) (models.MessageTemplate, error)
+	UpdateTemplate(ctx context.Context, channelType models.ChannelType, template models.MessageTemplate) (models.MessageTemplate, error)
+	ResetTemplate(ctx context.Context, channelType models.ChannelType) (models.MessageTemplate, error)
+	PreviewTemplate(template models.MessageTemplate, notification *models.Notification) (models.TemplatePreview, error)
+}
+
+// TemplateValidationRequest is a template to check before it is stored.
+type TemplateValidationRequest struct {
+	Subject string `json:"subject"`
+	Body    string `json:"body"`
+	// optional, the template is rendered with this notification instead of a sample notification
+	Notification *models.Notification `json:"notification,omitempty"`
+}
+
+func (r *TemplateValidationRequest) Validate() models.ValidationErrors {
+	return models.MessageTemplate{Subject: r.Subject, Body: r.Body}.Validate()
+}
+
+type TemplateController struct {
+	templateService TemplateService
+}
+
+func NewTemplateController(
+	router gin.IRouter,
+	templateService TemplateService,
+	auth gin.HandlerFunc,
+	registry *errmap.Registry,
+) *TemplateController {
+	ctrl := &TemplateController{
+		templateService: templateService,
+	}
+	ctrl.RegisterRoutes(router, auth)
+	ctrl.configureMappings(registry)
+
+	return ctrl
+}
+
+func (c *TemplateController) RegisterRoutes(router gin.IRouter, auth gin.HandlerFunc) {
+	group := router.Group("/templates").
+		Use(middleware.AuthorizeRoles(auth, iam.OsiAdmin, iam.NotificationAdmin)...)
+	group.GET("", c.ListTemplates)
+	group.GET("/:channelType", c.GetTemplate)
+	group.PUT("/:channelType", c.UpdateTemplate)
+	group.DELETE("/:channelType", c.ResetTemplate)
+	group.POST("/validate", c.ValidateTemplate)
+}
+
+func (c *TemplateController) configureMappings(r *errmap.Registry) {
+	r.Register(
+		templateservice.ErrRenderFailed,
+		http.StatusBadRequest,
+		errorResponses.NewErrorValidationResponse("", "",
+			map[string]string{"notification": translation.TemplateRenderFailed},
+		),
+	)
+}
+
+// ListTemplates
+//
+//	@Summary		List message templates
+//	@Description	Returns the message template of each channel type. Channel types without a changed template return the default template.
+//	@Tags			template
+//	@Produce		json
+//	@Security		KeycloakAuth
+//	@Success		200	{object}	[]models.MessageTemplate
+//	@Header			all	{string}	api-version	"API version"
+//	@Router			/templates [get]
+func (c *TemplateController) ListTemplates(gc *gin.Context) {
+	gc.Header(web.APIVersionKey, web.APIVersion)
+
+	templates, err := c.templateService.ListTemplates(gc.Request.Context())
+	if ginEx.AddError(gc, err) {
+		return
+	}
+
+	gc.JSON(http.StatusOK, templates)
+}
+
+// GetTemplate
+//
+//	@Summary		Get message template
+//	@Description	Returns the message template of the channel type.
+//	@Tags			template
+//	@Produce		json
+//	@Security		KeycloakAuth
+//	@Param			channelType	path		string	true	"channel type"	Enums(mail, mattermost, teams, slack, webhook, syslog, incident, push, matrix)
+//	@Success		200			{object}	models.MessageTemplate
+//	@Failure		404			{object}	errorResponses.ErrorResponse	"unknown channel type"
+//	@Header			all			{string}	api-version	"API version"
+//	@Router			/templates/{channelType} [get]
+func (c *TemplateController) GetTemplate(gc *gin.Context) {
+	gc.Header(web.APIVersionKey, web.APIVersion)
+
+	template, err := c.templateService.GetTemplate(gc.Request.Context(), models.ChannelType(gc.Param("channelType")))
+	if ginEx.AddError(gc, err) {
+		return
+	}
+
+	gc.JSON(http.StatusOK, template)
+}
+
+// UpdateTemplate
+//
+//	@Summary		Update message template
+//	@Description	Replaces the message template of the channel type. It applies to all rules which don't override it. The template must be valid, see the validation endpoint.
+//	@Tags			template
+//	@Accept			json
+//	@Produce		json
+//	@Security		KeycloakAuth
+//	@Param			channelType	path		string					true	"channel type"	Enums(mail, mattermost, teams, slack, webhook, syslog, incident, push, matrix)
+//	@Param			template	body		models.MessageTemplate	true	"new template"
+//	@Success		200			{object}	models.MessageTemplate
+//	@Failure		400			{object}	errorResponses.ErrorResponse
+//	@Failure		404			{object}	errorResponses.ErrorResponse	"unknown channel type"
+//	@Header			all			{string}	api-version	"API version"
+//	@Router			/templates/{channelType} [put]
+func (c *TemplateController) UpdateTemplate(gc *gin.Context) {
+	gc.Header(web.APIVersionKey, web.APIVersion)
+
+	var template models.MessageTemplate
+	if !ginEx.BindAndValidateBody(gc, &template) {
+		return
+	}
+
+	updated, err := c.templateService.UpdateTemplate(gc.Request.Context(), models.ChannelType(gc.Param("channelType")), template)
+	if ginEx.AddError(gc, err) {
+		return
+	}
+
+	gc.JSON(http.StatusOK, updated)
+}
+
+// ResetTemplate
+//
+//	@Summary		Reset message template
+//	@Description	Restores the default message template of the channel type.
+//	@Tags			template
+//	@Produce		json
+//	@Security		KeycloakAuth
+//	@Param			channelType	path		string	true	"channel type"	Enums(mail, mattermost, teams, slack, webhook, syslog, incident, push, matrix)
+//	@Success		200			{object}	models.MessageTemplate
+//	@Failure		404			{object}	errorResponses.ErrorResponse	"unknown channel type"
+//	@Header			all			{string}	api-version	"API version"
+//	@Router			/templates/{channelType} [delete]
+func (c *TemplateController) ResetTemplate(gc *gin.Context) {
+	gc.Header(web.APIVersionKey, web.APIVersion)
+
+	template, err := c.templateService.ResetTemplate(gc.Request.Context(), models.ChannelType(gc.Param("channelType")))
+	if ginEx.AddError(gc, err) {
+		return
+	}
+
+	gc.JSON(http.StatusOK, template)
+}
+
+// ValidateTemplate
+//
+//	@Summary		Validate message template
+//	@Description	Checks that the template can be parsed and rendered, the rendered template is returned as preview.
+//	@Description	Without a notification in the request a sample notification is used.
+//	@Tags			template
+//	@Accept			json
+//	@Produce		json
+//	@Security		KeycloakAuth
+//	@Param			template	body		TemplateValidationRequest	true	"template to validate"
+//	@Success		200			{object}	models.TemplatePreview
+//	@Failure		400			{object}	errorResponses.ErrorResponse	"invalid template"
+//	@Header			all			{string}	api-version	"API version"
+//	@Router			/templates/validate [post]
+func (c *TemplateController) ValidateTemplate(gc *gin.Context) {
+	gc.Header(web.APIVersionKey, web.APIVersion)
+
+	var request TemplateValidationRequest
+	if !ginEx.BindAndValidateBody(gc, &request) {
+		return
+	}
+
+	template := models.MessageTemplate{Subject: request.Subject, Body: request.Body}
+	preview, err := c.templateService.PreviewTemplate(template, request.Notification)
+	if ginEx.AddError(gc, err) {
+		return
+	}
+
+	gc.JSON(http.StatusOK, preview)
+}
@@ -0,0 +1,146 @@
+// SPDX-FileCopyrightText: 2026 Greenbone AG <https://greenbone.net>
+//
+// SPDX-License-Identifier: AGPL-3.0-or-later
+
+package templatecontroller
+
+import (
+	"net/http"
+	"net/http/httptest"
+	"testing"
+
+	"github.com/gin-gonic/gin"
+	"github.com/greenbone/keycloak-client-golang/auth"
+	"github.com/greenbone/opensight-golang-libraries/pkg/httpassert"
+	"github.com/greenbone/opensight-notification-service/pkg/models"
+	"github.com/greenbone/opensight-notification-service/pkg/services/templateservice"
+	"github.com/greenbone/opensight-notification-service/pkg/web/errmap"
+	"github.com/greenbone/opensight-notification-service/pkg/web/iam"
+	"github.com/greenbone/opensight-notification-service/pkg/web/integrationTests"
+	"github.com/greenbone/opensight-notification-service/pkg/web/templatecontroller/mocks"
+	"github.com/greenbone/opensight-notification-service/pkg/web/testhelper"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/mock"
+	"github.com/stretchr/testify/require"
+)
+
+func setupWithAuth(t *testing.T, templateService *mocks.TemplateService) *gin.Engine {
+	registry := errmap.NewRegistry()
+	router := testhelper.NewTestWebEngine(registry)
+
+	authMiddleware, err := auth.NewGinAuthMiddleware(integrationTests.NewTestJwtParser())
+	require.NoError(t, err)
+
+	NewTemplateController(router, templateService, authMiddleware, registry)
+	return router
+}
+
+func TestTemplateController_Permissions(t *testing.T) {
+	t.Parallel()
+
+	var endpoints = []struct {
+		name   string
+		method string
+		path   string
+	}{
+		{"List templates", http.MethodGet, "/templates"},
+		{"Get template", http.MethodGet, "/templates/mail"},
+		{"Reset template", http.MethodDelete, "/templates/mail"},
+		{"Validate template", http.MethodPost, "/templates/validate"},
+	}
+
+	tests := []struct {
+		role      string
+		wantAllow bool
+	}{
+		// ensure this is the same as in iam/roles.go
+		{iam.OsiViewer, false},
+		{iam.OsiUser, false},
+		{iam.OsiAdmin, true},
+		{iam.NotificationAdmin, true},
+		{iam.Notification, false},
+	}
+
+	for _, tt := range tests {
+		for _, ep := range endpoints {
+			t.Run(ep.name+" as "+tt.role, func(t *testing.T) {
+				t.Parallel()
+
+				templateService := mocks.NewTemplateService(t)
+				templateService.EXPECT().ListTemplates(mock.Anything).Maybe().Return([]models.MessageTemplate{}, nil)
+				templateService.EXPECT().GetTemplate(mock.Anything, mock.Anything).Maybe().Return(models.MessageTemplate{}, nil)
+				templateService.EXPECT().ResetTemplate(mock.Anything, mock.Anything).Maybe().Return(models.MessageTemplate{}, nil)
+				router := setupWithAuth(t, templateService)
+
+				req, _ := http.NewRequest(ep.method, ep.path, nil)
+				req.Header.Set("Authorization", "Bearer "+integrationTests.CreateJwtTokenWithRole(tt.role))
+				w := httptest.NewRecorder()
+				router.ServeHTTP(w, req)
+
+				if tt.wantAllow {
+					require.NotEqual(t, http.StatusUnauthorized, w.Code)
+					require.NotEqual(t, http.StatusForbidden, w.Code)
+				} else {
+					require.Equal(t, http.StatusForbidden, w.Code)
+				}
+			})
+		}
+	}
+}
+
+func TestTemplateController_ValidateTemplate(t *testing.T) {
+	tests := map[string]struct {
+		request          TemplateValidationRequest
+		serviceErr       error
+		wantServiceCall  bool
+		wantResponseCode int
+		wantBodyContains string
+	}{
+		"valid template": {
+			request:          TemplateValidationRequest{Subject: "{{.OriginResourceID}} {{.Title}}", Body: "{{.Detail}}"},
+			wantServiceCall:  true,
+			wantResponseCode: http.StatusOK,
+			wantBodyContains: "rendered subject",
+		},
+		"syntax error": {
+			request:          TemplateValidationRequest{Subject: "{{.Title", Body: "{{.Detail}}"},
+			wantResponseCode: http.StatusBadRequest,
+			wantBodyContains: "The template can not be parsed",
+		},
+		"unknown field": {
+			request:          TemplateValidationRequest{Subject: "{{.Title}}", Body: "{{.Unknown}}"},
+			wantResponseCode: http.StatusBadRequest,
+			wantBodyContains: "The template can not be rendered",
+		},
+		"not renderable with given notification": {
+			request: TemplateValidationRequest{
+				Subject:      "{{.Title}}",
+				Body:         "{{with .CustomFields.tags}}{{index . 0}}{{end}}",
+				Notification: &models.Notification{CustomFields: map[string]any{"tags": 5}},
+			},
+			serviceErr:       templateservice.ErrRenderFailed,
+			wantServiceCall:  true,
+			wantResponseCode: http.StatusBadRequest,
+			wantBodyContains: "The template can not be rendered",
+		},
+	}
+
+	for name, tt := range tests {
+		t.Run(name, func(t *testing.T) {
+			templateService := mocks.NewTemplateService(t)
+			if tt.wantServiceCall {
+				wantTemplate := models.MessageTemplate{Subject: tt.request.Subject, Body: tt.request.Body}
+				templateService.EXPECT().PreviewTemplate(wantTemplate, mock.Anything).
+					Return(models.TemplatePreview{Subject: "rendered subject"}, tt.serviceErr).Once()
+			}
+			router := setupWithAuth(t, templateService)
+
+			resp := httpassert.New(t, router).Post("/templates/validate").
+				AuthJwt(integrationTests.CreateJwtTokenWithRole(iam.NotificationAdmin)).
+				JsonContentObject(tt.request).
+				Expect().
+				StatusCode(tt.wantResponseCode)
+			assert.Contains(t, resp.GetBody(), tt.wantBodyContains)
+		})
+	}
+}
@@ -11,6 +11,7 @@ import (
 	"github.com/greenbone/opensight-golang-libraries/pkg/errorResponses"
 	"github.com/greenbone/opensight-notification-service/pkg/config"
 	"github.com/greenbone/opensight-notification-service/pkg/helper"
+	"github.com/greenbone/opensight-notification-service/pkg/models"
 	"github.com/greenbone/opensight-notification-service/pkg/pgtesting"
 	"github.com/greenbone/opensight-notification-service/pkg/repository/notificationrepository"
 	"github.com/greenbone/opensight-notification-service/pkg/security"
@@ -79,7 +80,8 @@ func GetValidMailNotificationChannel() maildto.MailNotificationChannelRequest {
 		Domain:                   "example.com",
 		Port:                     25,
 		IsAuthenticationRequired: true,
-		IsTlsEnforced:            false,
+		TlsMode:                  models.MailTlsStartTls,
+		TlsMinVersion:            models.MailTlsVersion12,
 		Username:                 helper.ToPtr("user"),
 		Password:                 helper.ToPtr("pass"),
 		MaxEmailAttachmentSizeMb: helper.ToPtr(10),
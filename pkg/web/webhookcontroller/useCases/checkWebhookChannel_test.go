@@ -0,0 +1,168 @@
+package usesCases
+
+import (
+	"net/http"
+	"testing"
+
+	"github.com/gin-gonic/gin"
+	"github.com/greenbone/keycloak-client-golang/auth"
+	"github.com/greenbone/opensight-golang-libraries/pkg/httpassert"
+	"github.com/greenbone/opensight-notification-service/pkg/services/notificationchannelservice"
+	"github.com/greenbone/opensight-notification-service/pkg/web/errmap"
+	"github.com/greenbone/opensight-notification-service/pkg/web/iam"
+	"github.com/greenbone/opensight-notification-service/pkg/web/integrationTests"
+	"github.com/greenbone/opensight-notification-service/pkg/web/testhelper"
+	"github.com/greenbone/opensight-notification-service/pkg/web/webhookcontroller"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+type roundTripperFunc func(*http.Request) (*http.Response, error)
+
+func (f roundTripperFunc) RoundTrip(req *http.Request) (*http.Response, error) {
+	return f(req)
+}
+
+func setup(t *testing.T, transport http.Client) *gin.Engine {
+	t.Helper()
+
+	repo, db := testhelper.SetupNotificationChannelTestEnv(t)
+	svc := notificationchannelservice.NewNotificationChannelService(repo)
+	outboundWebhookService := notificationchannelservice.NewOutboundWebhookService(&transport)
+	webhookChannelSvc := notificationchannelservice.NewWebhookChannelService(svc, 20, outboundWebhookService)
+	registry := errmap.NewRegistry()
+	router := testhelper.NewTestWebEngine(registry)
+
+	authMiddleware, err := auth.NewGinAuthMiddleware(integrationTests.NewTestJwtParser())
+	require.NoError(t, err)
+
+	webhookcontroller.NewWebhookController(router, svc, webhookChannelSvc, authMiddleware, registry)
+	defer db.Close()
+	return router
+}
+
+func TestCheckWebhookChannel(t *testing.T) {
+	t.Run("Check webhook channel", func(t *testing.T) {
+		t.Parallel()
+
+		var gotRequest *http.Request
+		transport := http.Client{
+			Transport: roundTripperFunc(func(r *http.Request) (*http.Response, error) {
+				gotRequest = r
+				return &http.Response{
+					StatusCode: http.StatusNoContent,
+					Body:       http.NoBody,
+					Header:     make(http.Header),
+				}, nil
+			}),
+		}
+		router := setup(t, transport)
+
+		// Check webhook channel
+		httpassert.New(t, router).Post("/notification-channel/webhook/check").
+			AuthJwt(integrationTests.CreateJwtTokenWithRole(iam.NotificationAdmin)).
+			JsonContent(`{
+				"webhookUrl": "https://tickets.example.com/api/events/id1",
+				"method": "PUT",
+				"headers": {"Authorization": "Bearer token"},
+				"secret": "0123456789abcdef"
+			}`).
+			Expect().
+			StatusCode(http.StatusNoContent)
+
+		require.NotNil(t, gotRequest)
+		assert.Equal(t, http.MethodPut, gotRequest.Method)
+		assert.Equal(t, "Bearer token", gotRequest.Header.Get("Authorization"))
+		assert.NotEmpty(t, gotRequest.Header.Get("Webhook-Signature"))
+	})
+
+	t.Run("Check webhook channel with invalid webhook URL returns an error", func(t *testing.T) {
+		t.Parallel()
+
+		transport := http.Client{
+			Transport: roundTripperFunc(func(r *http.Request) (*http.Response, error) {
+				return &http.Response{
+					StatusCode: http.StatusNoContent,
+					Body:       http.NoBody,
+					Header:     make(http.Header),
+				}, nil
+			}),
+		}
+		router := setup(t, transport)
+
+		// Check webhook channel
+		httpassert.New(t, router).Post("/notification-channel/webhook/check").
+			AuthJwt(integrationTests.CreateJwtTokenWithRole(iam.NotificationAdmin)).
+			JsonContent(`{
+				"webhookUrl": "invalid"
+			}`).
+			Expect().
+			StatusCode(http.StatusBadRequest).
+			Json(`{
+			"type": "greenbone/validation-error",
+			"title": "",
+			"errors": {
+				"webhookUrl": "Please enter a valid webhook URL."
+			}
+		}`)
+	})
+
+	t.Run("Check webhook channel with webhook server response 404", func(t *testing.T) {
+		t.Parallel()
+
+		transport := http.Client{
+			Transport: roundTripperFunc(func(r *http.Request) (*http.Response, error) {
+				return &http.Response{
+					StatusCode: http.StatusNotFound,
+					Body:       http.NoBody,
+					Header:     make(http.Header),
+					Status:     "404 Not Found",
+				}, nil
+			}),
+		}
+		router := setup(t, transport)
+
+		// Check webhook channel
+		httpassert.New(t, router).Post("/notification-channel/webhook/check").
+			AuthJwt(integrationTests.CreateJwtTokenWithRole(iam.NotificationAdmin)).
+			JsonContent(`{
+				"webhookUrl": "https://tickets.example.com/api/events/id1"
+			}`).
+			Expect().
+			StatusCode(http.StatusBadRequest).
+			Json(`{
+				"type": "greenbone/generic-error",
+				"title": "webhook message could not be send: http status: 404 Not Found"
+			}`)
+	})
+
+	t.Run("Check webhook channel without required url", func(t *testing.T) {
+		t.Parallel()
+
+		transport := http.Client{
+			Transport: roundTripperFunc(func(r *http.Request) (*http.Response, error) {
+				return &http.Response{
+					StatusCode: http.StatusNotFound,
+					Body:       http.NoBody,
+					Header:     make(http.Header),
+					Status:     "404 Not Found",
+				}, nil
+			}),
+		}
+		router := setup(t, transport)
+
+		// Check webhook channel
+		httpassert.New(t, router).Post("/notification-channel/webhook/check").
+			AuthJwt(integrationTests.CreateJwtTokenWithRole(iam.NotificationAdmin)).
+			JsonContent(`{}`).
+			Expect().
+			StatusCode(http.StatusBadRequest).
+			Json(`{
+				"title":"",
+				"type":"greenbone/validation-error",
+				"errors": {
+					"webhookUrl":"A Webhook URL is required."
+				}
+			}`)
+	})
+}
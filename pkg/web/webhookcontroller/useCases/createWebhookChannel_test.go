@@ -0,0 +1,162 @@
+package usesCases
+
+import (
+	"net/http"
+	"testing"
+
+	"github.com/greenbone/opensight-golang-libraries/pkg/httpassert"
+	"github.com/greenbone/opensight-notification-service/pkg/web/iam"
+	"github.com/greenbone/opensight-notification-service/pkg/web/integrationTests"
+	"github.com/stretchr/testify/require"
+)
+
+func TestCreateWebhookChannel(t *testing.T) {
+	t.Run("Create webhook channel", func(t *testing.T) {
+		t.Parallel()
+
+		router, db := setupTestRouter(t)
+		defer db.Close()
+
+		var webhookId string
+
+		// Create webhook channel
+		httpassert.New(t, router).Post("/notification-channel/webhook").
+			AuthJwt(integrationTests.CreateJwtTokenWithRole(iam.NotificationAdmin)).
+			JsonContent(`{
+				"channelName": "webhook1",
+				"webhookUrl": "https://tickets.example.com/api/events/id1",
+				"description": "This is a test webhook channel",
+				"method": "put",
+				"headers": {"Authorization": "Bearer token"},
+				"bodyTemplate": "{\"summary\": {{json .Title}}}",
+				"secret": "whsec_MDEyMzQ1Njc4OWFiY2RlZg=="
+			}`).
+			Expect().
+			StatusCode(http.StatusCreated).
+			JsonPath("$.id", httpassert.ExtractTo(&webhookId)).
+			JsonTemplate(`{
+				"id": "d9cc9be2-7b4d-4c6f-991d-a40cfe002ceb",
+				"channelName": "webhook1",
+				"webhookUrl": "https://tickets.example.com/api/events/id1",
+				"description": "This is a test webhook channel",
+				"method": "PUT",
+				"headers": {"Authorization": ""},
+				"bodyTemplate": "{\"summary\": {{json .Title}}}",
+				"hasSecret": true
+			}`, map[string]any{
+				"id": httpassert.IgnoreJsonValue,
+			})
+		require.NotEmpty(t, webhookId)
+	})
+
+	t.Run("Create webhook channel with invalid webhook URL returns an error", func(t *testing.T) {
+		t.Parallel()
+
+		router, db := setupTestRouter(t)
+		defer db.Close()
+
+		// Create webhook channel
+		httpassert.New(t, router).Post("/notification-channel/webhook").
+			AuthJwt(integrationTests.CreateJwtTokenWithRole(iam.NotificationAdmin)).
+			JsonContent(`{
+				"channelName": "a",
+				"webhookUrl": "invalid",
+				"description": "b"
+			}`).
+			Expect().
+			StatusCode(http.StatusBadRequest).
+			Json(`{
+				"type": "greenbone/validation-error",
+				"title": "",
+				"errors": {
+					"webhookUrl": "Please enter a valid webhook URL."
+				}
+			}`)
+	})
+
+	t.Run("Create webhook channel with invalid settings returns an error", func(t *testing.T) {
+		t.Parallel()
+
+		router, db := setupTestRouter(t)
+		defer db.Close()
+
+		// Create webhook channel
+		httpassert.New(t, router).Post("/notification-channel/webhook").
+			AuthJwt(integrationTests.CreateJwtTokenWithRole(iam.NotificationAdmin)).
+			JsonContent(`{
+				"channelName": "a",
+				"webhookUrl": "https://tickets.example.com/api/events/id1",
+				"method": "GET",
+				"headers": {"Webhook-Signature": "v1,forged"},
+				"bodyTemplate": "{{.Title}}",
+				"secret": "short"
+			}`).
+			Expect().
+			StatusCode(http.StatusBadRequest).
+			Json(`{
+				"type": "greenbone/validation-error",
+				"title": "",
+				"errors": {
+					"method": "The HTTP method must be POST, PUT or PATCH.",
+					"headers": "Invalid header name, headers set by the service can not be overridden.",
+					"bodyTemplate": "The body template must render valid JSON.",
+					"secret": "The secret must have between 16 and 512 characters, a secret with whsec_ prefix must be base64 encoded."
+				}
+			}`)
+	})
+
+	t.Run("Create webhook channel without required fields returns an error", func(t *testing.T) {
+		t.Parallel()
+
+		router, db := setupTestRouter(t)
+		defer db.Close()
+
+		// Create webhook channel
+		httpassert.New(t, router).Post("/notification-channel/webhook").
+			AuthJwt(integrationTests.CreateJwtTokenWithRole(iam.NotificationAdmin)).
+			JsonContent(`{}`).
+			Expect().
+			StatusCode(http.StatusBadRequest).
+			Json(`{
+				"type": "greenbone/validation-error",
+				"title": "",
+				"errors": {
+					"channelName": "A channel name is required.",
+					"webhookUrl": "A Webhook URL is required."
+				}
+			}`)
+	})
+
+	t.Run("Create webhook channel with an existing name return an error", func(t *testing.T) {
+		t.Parallel()
+
+		router, db := setupTestRouter(t)
+		defer db.Close()
+
+		// Create webhook channel
+		httpassert.New(t, router).Post("/notification-channel/webhook").
+			AuthJwt(integrationTests.CreateJwtTokenWithRole(iam.NotificationAdmin)).
+			JsonContent(`{
+				"channelName": "webhook 1",
+				"webhookUrl": "https://tickets.example.com/api/events/id1",
+				"description": "This is a test webhook channel"
+			}`).
+			Expect().
+			StatusCode(http.StatusCreated)
+
+		// Create webhook channel with the same name
+		httpassert.New(t, router).Post("/notification-channel/webhook").
+			AuthJwt(integrationTests.CreateJwtTokenWithRole(iam.NotificationAdmin)).
+			JsonContent(`{
+				"channelName": "webhook 1",
+				"webhookUrl": "https://tickets.example.com/api/events/id1",
+				"description": "This is a test webhook channel"
+			}`).
+			Expect().
+			StatusCode(http.StatusBadRequest).
+			Json(`{
+				"type": "greenbone/generic-error",
+				"title": "Webhook channel name already exists."
+			}`)
+	})
+}
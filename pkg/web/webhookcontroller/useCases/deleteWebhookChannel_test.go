@@ -0,0 +1,77 @@
+package usesCases
+
+import (
+	"net/http"
+	"testing"
+	"time"
+
+	"github.com/gin-gonic/gin"
+	"github.com/greenbone/keycloak-client-golang/auth"
+	"github.com/greenbone/opensight-golang-libraries/pkg/httpassert"
+	"github.com/greenbone/opensight-notification-service/pkg/services/notificationchannelservice"
+	"github.com/greenbone/opensight-notification-service/pkg/web/errmap"
+	"github.com/greenbone/opensight-notification-service/pkg/web/iam"
+	"github.com/greenbone/opensight-notification-service/pkg/web/integrationTests"
+	"github.com/greenbone/opensight-notification-service/pkg/web/testhelper"
+	"github.com/greenbone/opensight-notification-service/pkg/web/webhookcontroller"
+	"github.com/jmoiron/sqlx"
+	"github.com/stretchr/testify/require"
+)
+
+func setupTestRouter(t *testing.T) (*gin.Engine, *sqlx.DB) {
+	repo, db := testhelper.SetupNotificationChannelTestEnv(t)
+	svc := notificationchannelservice.NewNotificationChannelService(repo)
+	outboundWebhookService := notificationchannelservice.NewOutboundWebhookService(&http.Client{Timeout: 15 * time.Second})
+	webhookSvc := notificationchannelservice.NewWebhookChannelService(svc, 20, outboundWebhookService)
+	registry := errmap.NewRegistry()
+	router := testhelper.NewTestWebEngine(registry)
+
+	authMiddleware, err := auth.NewGinAuthMiddleware(integrationTests.NewTestJwtParser())
+	require.NoError(t, err)
+
+	webhookcontroller.NewWebhookController(router, svc, webhookSvc, authMiddleware, registry)
+
+	return router, db
+}
+
+func TestDeleteWebhookChannel(t *testing.T) {
+	t.Run("Delete a webhook channel without proper role returns error", func(t *testing.T) {
+		t.Parallel()
+
+		router, db := setupTestRouter(t)
+		defer db.Close()
+
+		var webhookId string
+
+		// Create webhook channel
+		httpassert.New(t, router).Post("/notification-channel/webhook").
+			AuthJwt(integrationTests.CreateJwtTokenWithRole(iam.NotificationAdmin)).
+			JsonContent(`{
+				"channelName": "webhook1",
+				"webhookUrl": "https://tickets.example.com/api/events/id1",
+				"description": "This is a test webhook channel"
+			}`).
+			Expect().
+			StatusCode(http.StatusCreated).
+			JsonPath("$.id", httpassert.ExtractTo(&webhookId)).
+			JsonTemplate(`{
+				"id": "d9cc9be2-7b4d-4c6f-991d-a40cfe002ceb",
+				"channelName": "webhook1",
+				"webhookUrl": "https://tickets.example.com/api/events/id1",
+				"description": "This is a test webhook channel",
+				"method": "POST",
+				"headers": {},
+				"bodyTemplate": "",
+				"hasSecret": false
+			}`, map[string]any{
+				"id": httpassert.IgnoreJsonValue,
+			})
+		require.NotEmpty(t, webhookId)
+
+		// Delete webhook channel
+		httpassert.New(t, router).Deletef("/notification-channel/webhook/%s", webhookId).
+			AuthJwt(integrationTests.CreateJwtTokenWithRole(iam.NotificationAdmin)).
+			Expect().
+			StatusCode(http.StatusNoContent)
+	})
+}
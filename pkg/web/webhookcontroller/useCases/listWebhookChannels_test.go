@@ -0,0 +1,67 @@
+package usesCases
+
+import (
+	"net/http"
+	"testing"
+
+	"github.com/greenbone/opensight-golang-libraries/pkg/httpassert"
+	"github.com/greenbone/opensight-notification-service/pkg/web/iam"
+	"github.com/greenbone/opensight-notification-service/pkg/web/integrationTests"
+	"github.com/stretchr/testify/require"
+)
+
+func TestListWebhookChannels(t *testing.T) {
+	t.Run("List webhook channels", func(t *testing.T) {
+		t.Parallel()
+
+		router, db := setupTestRouter(t)
+		defer db.Close()
+
+		var webhookId string
+
+		// Create webhook channel
+		httpassert.New(t, router).Post("/notification-channel/webhook").
+			AuthJwt(integrationTests.CreateJwtTokenWithRole(iam.NotificationAdmin)).
+			JsonContent(`{
+				"channelName": "webhook1",
+				"webhookUrl": "https://tickets.example.com/api/events/id1",
+				"description": "This is a test webhook channel"
+			}`).
+			Expect().
+			StatusCode(http.StatusCreated).
+			JsonPath("$.id", httpassert.ExtractTo(&webhookId)).
+			JsonTemplate(`{
+				"id": "d9cc9be2-7b4d-4c6f-991d-a40cfe002ceb",
+				"channelName": "webhook1",
+				"webhookUrl": "https://tickets.example.com/api/events/id1",
+				"description": "This is a test webhook channel",
+				"method": "POST",
+				"headers": {},
+				"bodyTemplate": "",
+				"hasSecret": false
+			}`, map[string]any{
+				"id": httpassert.IgnoreJsonValue,
+			})
+		require.NotEmpty(t, webhookId)
+
+		// List webhook channels
+		httpassert.New(t, router).Get("/notification-channel/webhook").
+			AuthJwt(integrationTests.CreateJwtTokenWithRole(iam.NotificationAdmin)).
+			Expect().
+			StatusCode(http.StatusOK).
+			JsonTemplate(`[
+				{
+					"id": "fb46613b-4bf8-45c7-ad6f-e83e5ced8b81",
+					"channelName": "webhook1",
+					"webhookUrl": "https://tickets.example.com/api/events/id1",
+					"description": "This is a test webhook channel",
+					"method": "POST",
+					"headers": {},
+					"bodyTemplate": "",
+					"hasSecret": false
+				}
+			]`, map[string]any{
+				"$.0.id": httpassert.IgnoreJsonValue,
+			})
+	})
+}
@@ -0,0 +1,211 @@
+package usesCases
+
+import (
+	"net/http"
+	"testing"
+
+	"github.com/greenbone/opensight-golang-libraries/pkg/httpassert"
+	"github.com/greenbone/opensight-notification-service/pkg/web/iam"
+	"github.com/greenbone/opensight-notification-service/pkg/web/integrationTests"
+	"github.com/stretchr/testify/require"
+)
+
+func TestUpdateWebhookChannel(t *testing.T) {
+	t.Run("Update webhook channel", func(t *testing.T) {
+		t.Parallel()
+
+		router, db := setupTestRouter(t)
+		defer db.Close()
+
+		var webhookId string
+
+		// Create webhook channel
+		httpassert.New(t, router).Post("/notification-channel/webhook").
+			AuthJwt(integrationTests.CreateJwtTokenWithRole(iam.NotificationAdmin)).
+			JsonContent(`{
+				"channelName": "webhook1",
+				"webhookUrl": "https://tickets.example.com/api/events/id1",
+				"description": "This is a test webhook channel",
+				"headers": {"Authorization": "Bearer token"},
+				"secret": "0123456789abcdef"
+			}`).
+			Expect().
+			StatusCode(http.StatusCreated).
+			JsonPath("$.id", httpassert.ExtractTo(&webhookId))
+		require.NotEmpty(t, webhookId)
+
+		// Update webhook channel, the header values are not returned and an empty value keeps the stored one
+		httpassert.New(t, router).Putf("/notification-channel/webhook/%s", webhookId).
+			AuthJwt(integrationTests.CreateJwtTokenWithRole(iam.NotificationAdmin)).
+			JsonContent(`{
+				"channelName": "webhook2",
+				"webhookUrl": "https://tickets.example.com/api/events/id2",
+				"headers": {"Authorization": ""}
+			}`).
+			Expect().
+			StatusCode(http.StatusOK).
+			JsonPath("$.headers", httpassert.HasSize(1)).
+			JsonPath("$.headers.Authorization", "")
+
+		// Update webhook channel, the secret is kept if omitted
+		httpassert.New(t, router).Putf("/notification-channel/webhook/%s", webhookId).
+			AuthJwt(integrationTests.CreateJwtTokenWithRole(iam.NotificationAdmin)).
+			JsonContent(`{
+				"channelName": "webhook2",
+				"webhookUrl": "https://tickets.example.com/api/events/id2",
+				"description": "This is a test webhook channel changed",
+				"method": "PATCH"
+			}`).
+			Expect().
+			StatusCode(http.StatusOK).
+			JsonTemplate(`{
+				"id": "fb46613b-4bf8-45c7-ad6f-e83e5ced8b81",
+				"channelName": "webhook2",
+				"webhookUrl": "https://tickets.example.com/api/events/id2",
+				"description": "This is a test webhook channel changed",
+				"method": "PATCH",
+				"headers": {},
+				"bodyTemplate": "",
+				"hasSecret": true
+			}`, map[string]any{
+				"$.id": webhookId,
+			})
+
+		// Update webhook channel, an empty secret removes it
+		httpassert.New(t, router).Putf("/notification-channel/webhook/%s", webhookId).
+			AuthJwt(integrationTests.CreateJwtTokenWithRole(iam.NotificationAdmin)).
+			JsonContent(`{
+				"channelName": "webhook2",
+				"webhookUrl": "https://tickets.example.com/api/events/id2",
+				"secret": ""
+			}`).
+			Expect().
+			StatusCode(http.StatusOK).
+			JsonPath("$.hasSecret", false)
+	})
+
+	t.Run("Update webhook channel with an invalid webhook URL returns an error", func(t *testing.T) {
+		t.Parallel()
+
+		router, db := setupTestRouter(t)
+		defer db.Close()
+
+		var webhookId string
+
+		// Create webhook channel
+		httpassert.New(t, router).Post("/notification-channel/webhook").
+			AuthJwt(integrationTests.CreateJwtTokenWithRole(iam.NotificationAdmin)).
+			JsonContent(`{
+				"channelName": "webhook1",
+				"webhookUrl": "https://tickets.example.com/api/events/id1",
+				"description": "This is a test webhook channel"
+			}`).
+			Expect().
+			StatusCode(http.StatusCreated).
+			JsonPath("$.id", httpassert.ExtractTo(&webhookId))
+		require.NotEmpty(t, webhookId)
+
+		// Update webhook channel
+		httpassert.New(t, router).Putf("/notification-channel/webhook/%s", webhookId).
+			AuthJwt(integrationTests.CreateJwtTokenWithRole(iam.NotificationAdmin)).
+			JsonContent(`{
+				"channelName": "1",
+				"webhookUrl": "invalid",
+				"description": "b"
+			}`).
+			Expect().
+			StatusCode(http.StatusBadRequest).
+			Json(`{
+				"type": "greenbone/validation-error",
+				"title": "",
+				"errors": {
+					"webhookUrl": "Please enter a valid webhook URL."
+				}
+			}`)
+	})
+
+	t.Run("Update webhook channel without required fields returns an error", func(t *testing.T) {
+		t.Parallel()
+
+		router, db := setupTestRouter(t)
+		defer db.Close()
+
+		var webhookId string
+
+		// Create webhook channel
+		httpassert.New(t, router).Post("/notification-channel/webhook").
+			AuthJwt(integrationTests.CreateJwtTokenWithRole(iam.NotificationAdmin)).
+			JsonContent(`{
+				"channelName": "webhook1",
+				"webhookUrl": "https://tickets.example.com/api/events/id1",
+				"description": "This is a test webhook channel"
+			}`).
+			Expect().
+			StatusCode(http.StatusCreated).
+			JsonPath("$.id", httpassert.ExtractTo(&webhookId))
+		require.NotEmpty(t, webhookId)
+
+		// Update webhook channel
+		httpassert.New(t, router).Putf("/notification-channel/webhook/%s", webhookId).
+			AuthJwt(integrationTests.CreateJwtTokenWithRole(iam.NotificationAdmin)).
+			JsonContent(`{}`).
+			Expect().
+			StatusCode(http.StatusBadRequest).
+			Json(`{
+				"type": "greenbone/validation-error",
+				"title": "",
+				"errors": {
+					"channelName": "A channel name is required.",
+					"webhookUrl": "A Webhook URL is required."
+				}
+			}`)
+	})
+
+	t.Run("Update webhook channel name with an existing one returns an error", func(t *testing.T) {
+		t.Parallel()
+
+		router, db := setupTestRouter(t)
+		defer db.Close()
+
+		var webhookId string
+
+		// Create webhook channel
+		httpassert.New(t, router).Post("/notification-channel/webhook").
+			AuthJwt(integrationTests.CreateJwtTokenWithRole(iam.NotificationAdmin)).
+			JsonContent(`{
+				"channelName": "webhook 1",
+				"webhookUrl": "https://tickets.example.com/api/events/id1",
+				"description": "This is a test webhook channel"
+			}`).
+			Expect().
+			StatusCode(http.StatusCreated)
+
+		// Create webhook channel
+		httpassert.New(t, router).Post("/notification-channel/webhook").
+			AuthJwt(integrationTests.CreateJwtTokenWithRole(iam.NotificationAdmin)).
+			JsonContent(`{
+				"channelName": "webhook 2",
+				"webhookUrl": "https://tickets.example.com/api/events/id1",
+				"description": "This is a test webhook channel"
+			}`).
+			Expect().
+			StatusCode(http.StatusCreated).
+			JsonPath("$.id", httpassert.ExtractTo(&webhookId))
+		require.NotEmpty(t, webhookId)
+
+		// Update webhook channel
+		httpassert.New(t, router).Putf("/notification-channel/webhook/%s", webhookId).
+			AuthJwt(integrationTests.CreateJwtTokenWithRole(iam.NotificationAdmin)).
+			JsonContent(`{
+				"channelName": "webhook 1",
+				"webhookUrl": "https://tickets.example.com/api/events/id1",
+				"description": "This is a test webhook channel"
+			}`).
+			Expect().
+			StatusCode(http.StatusBadRequest).
+			Json(`{
+				"type": "greenbone/generic-error",
+				"title": "Webhook channel name already exists."
+			}`)
+	})
+}
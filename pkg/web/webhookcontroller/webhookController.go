@@ -0,0 +1,203 @@
+package webhookcontroller
+
+import (
+	"errors"
+	"net/http"
+
+	"github.com/gin-gonic/gin"
+	"github.com/greenbone/opensight-golang-libraries/pkg/errorResponses"
+	"github.com/greenbone/opensight-notification-service/pkg/models"
+	"github.com/greenbone/opensight-notification-service/pkg/services/notificationchannelservice"
+	"github.com/greenbone/opensight-notification-service/pkg/translation"
+	"github.com/greenbone/opensight-notification-service/pkg/web/errmap"
+	"github.com/greenbone/opensight-notification-service/pkg/web/ginEx"
+	"github.com/greenbone/opensight-notification-service/pkg/web/iam"
+	"github.com/greenbone/opensight-notification-service/pkg/web/middleware"
+	"github.com/greenbone/opensight-notification-service/pkg/web/webhookcontroller/webhookdto"
+)
+
+type WebhookController struct {
+	notificationChannelServicer notificationchannelservice.NotificationChannelService
+	webhookChannelService       notificationchannelservice.WebhookChannelService
+}
+
+func NewWebhookController(
+	router gin.IRouter,
+	notificationChannelServicer notificationchannelservice.NotificationChannelService,
+	webhookChannelService notificationchannelservice.WebhookChannelService,
+	auth gin.HandlerFunc,
+	registry *errmap.Registry,
+) *WebhookController {
+	ctrl := &WebhookController{
+		notificationChannelServicer: notificationChannelServicer,
+		webhookChannelService:       webhookChannelService,
+	}
+
+	group := router.Group("/notification-channel/webhook").
+		Use(middleware.AuthorizeRoles(auth, iam.OsiAdmin, iam.NotificationAdmin)...)
+	group.Use(errorHandler(gin.ErrorTypePrivate))
+
+	group.POST("", ctrl.createWebhookChannel)
+	group.GET("", ctrl.listWebhookChannels)
+	group.PUT("/:id", ctrl.updateWebhookChannel)
+	group.DELETE("/:id", ctrl.deleteWebhookChannel)
+	group.POST("/check", ctrl.sendWebhookTestMessage)
+
+	ctrl.configureMappings(registry)
+	return ctrl
+}
+
+func errorHandler(errorType gin.ErrorType) gin.HandlerFunc {
+	return func(c *gin.Context) {
+		c.Next()
+		for _, errorValue := range c.Errors.ByType(errorType) {
+			if errors.Is(errorValue, notificationchannelservice.ErrWebhookMessageDelivery) {
+				c.AbortWithStatusJSON(http.StatusBadRequest, errorResponses.NewErrorGenericResponse(errorValue.Error()))
+				return
+			}
+		}
+	}
+}
+
+func (wc *WebhookController) configureMappings(r *errmap.Registry) {
+	r.Register(
+		notificationchannelservice.ErrWebhookChannelLimitReached,
+		http.StatusUnprocessableEntity,
+		errorResponses.NewErrorGenericResponse(translation.WebhookChannelLimitReached),
+	)
+	r.Register(
+		notificationchannelservice.ErrListWebhookChannels,
+		http.StatusInternalServerError,
+		errorResponses.ErrorInternalResponse,
+	)
+	r.Register(
+		notificationchannelservice.ErrWebhookChannelNameExists,
+		http.StatusBadRequest,
+		errorResponses.NewErrorGenericResponse(translation.WebhookChannelNameAlreadyExist),
+	)
+}
+
+// CreateWebhookChannel
+//
+//	@Summary		Create Webhook Channel
+//	@Description	Create a new generic webhook notification channel
+//	@Tags			webhook-channel
+//	@Accept			json
+//	@Produce		json
+//	@Security		KeycloakAuth
+//	@Param			WebhookChannel	body		webhookdto.WebhookNotificationChannelRequest	true	"Webhook channel to add"
+//	@Success		201			{object}	webhookdto.WebhookNotificationChannelResponse
+//	@Failure		400			{object}	map[string]string
+//	@Failure		500			{object}	map[string]string
+//	@Router			/notification-channel/webhook [post]
+func (wc *WebhookController) createWebhookChannel(c *gin.Context) {
+	var channel webhookdto.WebhookNotificationChannelRequest
+	if !ginEx.BindAndValidateBody(c, &channel) {
+		return
+	}
+
+	webhookChannel, err := wc.webhookChannelService.CreateWebhookChannel(c, channel)
+	if ginEx.AddError(c, err) {
+		return
+	}
+
+	c.JSON(http.StatusCreated, webhookChannel)
+}
+
+// ListWebhookChannels
+//
+//	@Summary		List Webhook Channels
+//	@Description	List generic webhook notification channels
+//	@Tags			webhook-channel
+//	@Produce		json
+//	@Security		KeycloakAuth
+//	@Success		200		{array}		webhookdto.WebhookNotificationChannelResponse
+//	@Failure		500		{object}	map[string]string
+//	@Router			/notification-channel/webhook [get]
+func (wc *WebhookController) listWebhookChannels(c *gin.Context) {
+	channels, err := wc.notificationChannelServicer.ListNotificationChannelsByType(c, models.ChannelTypeWebhook)
+	if ginEx.AddError(c, err) {
+		return
+	}
+
+	c.JSON(http.StatusOK, webhookdto.MapNotificationChannelsToWebhook(channels))
+}
+
+// UpdateWebhookChannel
+//
+//	@Summary		Update Webhook Channel
+//	@Description	Update an existing generic webhook notification channel
+//	@Tags			webhook-channel
+//	@Accept			json
+//	@Produce		json
+//	@Security		KeycloakAuth
+//	@Param			id			path		string						true	"Webhook channel ID"
+//	@Param			WebhookChannel	body		webhookdto.WebhookNotificationChannelRequest	true	"Webhook channel to update"
+//	@Success		200			{object}	webhookdto.WebhookNotificationChannelResponse
+//	@Failure		400			{object}	map[string]string
+//	@Failure		404 		{object}    map[string]string
+//	@Failure		500			{object}	map[string]string
+//	@Router			/notification-channel/webhook/{id} [put]
+func (wc *WebhookController) updateWebhookChannel(c *gin.Context) {
+	id := c.Param("id")
+
+	var channel webhookdto.WebhookNotificationChannelRequest
+	if !ginEx.BindAndValidateBody(c, &channel) {
+		return
+	}
+
+	updated, err := wc.webhookChannelService.UpdateWebhookChannel(c, id, channel)
+	if ginEx.AddError(c, err) {
+		return
+	}
+
+	c.JSON(http.StatusOK, updated)
+}
+
+// DeleteWebhookChannel
+//
+//		@Summary		Delete Webhook Channel
+//		@Description	Delete a generic webhook notification channel
+//		@Tags			webhook-channel
+//		@Security		KeycloakAuth
+//		@Param			id	path	string	true	"Webhook channel ID"
+//		@Success		204	"Deleted successfully"
+//		@Failure		500	{object}	map[string]string
+//	    @Failure		404 {object}    map[string]string
+//		@Router			/notification-channel/webhook/{id} [delete]
+func (wc *WebhookController) deleteWebhookChannel(c *gin.Context) {
+	id := c.Param("id")
+
+	err := wc.notificationChannelServicer.DeleteNotificationChannel(c, id)
+	if ginEx.AddError(c, err) {
+		return
+	}
+
+	c.Status(http.StatusNoContent)
+}
+
+// SendWebhookTestMessage
+//
+//	@Summary		Check generic webhook
+//	@Description	Check if the sample notification can be sent to the generic webhook
+//	@Tags			webhook-channel
+//	@Accept			json
+//	@Produce		json
+//	@Security		KeycloakAuth
+//	@Param			WebhookChannel	body	webhookdto.WebhookNotificationChannelCheckRequest	true	"Webhook to check"
+//	@Success		204 "Webhook test message sent successfully"
+//	@Failure		400			{object}	map[string]string
+//	@Router			/notification-channel/webhook/check [post]
+func (wc *WebhookController) sendWebhookTestMessage(c *gin.Context) {
+	var channel webhookdto.WebhookNotificationChannelCheckRequest
+	if !ginEx.BindAndValidateBody(c, &channel) {
+		return
+	}
+
+	err := wc.webhookChannelService.SendWebhookTestMessage(webhookdto.MapWebhookCheckToNotificationChannel(channel))
+	if ginEx.AddError(c, err) {
+		return
+	}
+
+	c.Status(http.StatusNoContent)
+}
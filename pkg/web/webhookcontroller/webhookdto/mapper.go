@@ -0,0 +1,65 @@
+package webhookdto
+
+import (
+	"net/http"
+
+	"github.com/greenbone/opensight-notification-service/pkg/helper"
+	"github.com/greenbone/opensight-notification-service/pkg/models"
+)
+
+// MapNotificationChannelToWebhook maps NotificationChannel to WebhookNotificationChannelResponse.
+func MapNotificationChannelToWebhook(channel models.NotificationChannel) WebhookNotificationChannelResponse {
+	method := helper.SafeDereference(channel.WebhookMethod)
+	if method == "" {
+		method = http.MethodPost
+	}
+	headers := make(map[string]string, len(channel.WebhookHeaders))
+	for name := range channel.WebhookHeaders {
+		headers[name] = ""
+	}
+
+	return WebhookNotificationChannelResponse{
+		Id:           channel.Id,
+		ChannelName:  channel.ChannelName,
+		WebhookUrl:   helper.SafeDereference(channel.WebhookUrl),
+		Description:  helper.SafeDereference(channel.Description),
+		Method:       method,
+		Headers:      headers,
+		BodyTemplate: helper.SafeDereference(channel.WebhookBodyTemplate),
+		HasSecret:    helper.SafeDereference(channel.WebhookSecret) != "",
+	}
+}
+
+func MapWebhookToNotificationChannel(channel WebhookNotificationChannelRequest) models.NotificationChannel {
+	return models.NotificationChannel{
+		ChannelType:         models.ChannelTypeWebhook,
+		ChannelName:         channel.ChannelName,
+		WebhookUrl:          &channel.WebhookUrl,
+		Description:         &channel.Description,
+		WebhookMethod:       &channel.Method,
+		WebhookHeaders:      channel.Headers,
+		WebhookBodyTemplate: helper.ToNullablePtr(channel.BodyTemplate),
+		WebhookSecret:       channel.Secret,
+	}
+}
+
+// MapWebhookCheckToNotificationChannel maps the check request to the channel the test message is sent to.
+func MapWebhookCheckToNotificationChannel(channel WebhookNotificationChannelCheckRequest) models.NotificationChannel {
+	return models.NotificationChannel{
+		ChannelType:         models.ChannelTypeWebhook,
+		WebhookUrl:          &channel.WebhookUrl,
+		WebhookMethod:       &channel.Method,
+		WebhookHeaders:      channel.Headers,
+		WebhookBodyTemplate: helper.ToNullablePtr(channel.BodyTemplate),
+		WebhookSecret:       helper.ToNullablePtr(channel.Secret),
+	}
+}
+
+// MapNotificationChannelsToWebhook maps a slice of NotificationChannel to WebhookNotificationChannelResponse.
+func MapNotificationChannelsToWebhook(channels []models.NotificationChannel) []WebhookNotificationChannelResponse {
+	webhookChannels := make([]WebhookNotificationChannelResponse, 0, len(channels))
+	for _, ch := range channels {
+		webhookChannels = append(webhookChannels, MapNotificationChannelToWebhook(ch))
+	}
+	return webhookChannels
+}
@@ -0,0 +1,15 @@
+package webhookdto
+
+// WebhookNotificationChannelResponse generic webhook notification channel response.
+// The secret is never returned, hasSecret tells whether requests are signed.
+// The header values usually hold credentials as well, only the names are returned with empty values.
+type WebhookNotificationChannelResponse struct {
+	Id           string            `json:"id"`
+	ChannelName  string            `json:"channelName"`
+	WebhookUrl   string            `json:"webhookUrl"`
+	Description  string            `json:"description"`
+	Method       string            `json:"method"`
+	Headers      map[string]string `json:"headers"`
+	BodyTemplate string            `json:"bodyTemplate"`
+	HasSecret    bool              `json:"hasSecret"`
+}
@@ -0,0 +1,132 @@
+package webhookdto
+
+import (
+	"net/http"
+	"strings"
+
+	"github.com/greenbone/opensight-notification-service/pkg/models"
+	"github.com/greenbone/opensight-notification-service/pkg/policy"
+	"github.com/greenbone/opensight-notification-service/pkg/translation"
+)
+
+// WebhookNotificationChannelRequest generic webhook notification channel request.
+// The secret is write-only: it is not changed if omitted on update, an empty secret removes it.
+// Like the secret, header values are write-only: an empty value keeps the stored value of the header on update.
+type WebhookNotificationChannelRequest struct {
+	ChannelName  string            `json:"channelName"`
+	WebhookUrl   string            `json:"webhookUrl"`
+	Description  string            `json:"description"`
+	Method       string            `json:"method" enums:"POST,PUT,PATCH"`
+	Headers      map[string]string `json:"headers"`
+	BodyTemplate string            `json:"bodyTemplate"`
+	Secret       *string           `json:"secret"`
+}
+
+func (r *WebhookNotificationChannelRequest) Cleanup() {
+	r.ChannelName = strings.TrimSpace(r.ChannelName)
+	r.WebhookUrl = strings.TrimSpace(r.WebhookUrl)
+	r.Description = strings.TrimSpace(r.Description)
+	r.Method = cleanupMethod(r.Method)
+	r.BodyTemplate = strings.TrimSpace(r.BodyTemplate)
+	if r.Secret != nil {
+		secret := strings.TrimSpace(*r.Secret)
+		r.Secret = &secret
+	}
+}
+
+func (r WebhookNotificationChannelRequest) Validate() models.ValidationErrors {
+	errs := make(models.ValidationErrors)
+
+	if r.ChannelName == "" {
+		errs["channelName"] = translation.ChannelNameIsRequired
+	}
+
+	validateWebhook(errs, r.WebhookUrl, r.Method, r.Headers, r.BodyTemplate)
+
+	if r.Secret != nil && *r.Secret != "" {
+		if err := policy.WebhookSecretPolicy(*r.Secret); err != nil {
+			errs["secret"] = translation.InvalidWebhookSecret
+		}
+	}
+
+	return errs
+}
+
+// WebhookNotificationChannelCheckRequest generic webhook notification channel check request
+type WebhookNotificationChannelCheckRequest struct {
+	WebhookUrl   string            `json:"webhookUrl"`
+	Method       string            `json:"method" enums:"POST,PUT,PATCH"`
+	Headers      map[string]string `json:"headers"`
+	BodyTemplate string            `json:"bodyTemplate"`
+	Secret       string            `json:"secret"`
+}
+
+func (r *WebhookNotificationChannelCheckRequest) Cleanup() {
+	r.WebhookUrl = strings.TrimSpace(r.WebhookUrl)
+	r.Method = cleanupMethod(r.Method)
+	r.BodyTemplate = strings.TrimSpace(r.BodyTemplate)
+	r.Secret = strings.TrimSpace(r.Secret)
+}
+
+func (r *WebhookNotificationChannelCheckRequest) Validate() models.ValidationErrors {
+	errs := make(models.ValidationErrors)
+
+	validateWebhook(errs, r.WebhookUrl, r.Method, r.Headers, r.BodyTemplate)
+
+	if r.Secret != "" {
+		if err := policy.WebhookSecretPolicy(r.Secret); err != nil {
+			errs["secret"] = translation.InvalidWebhookSecret
+		}
+	}
+
+	return errs
+}
+
+// cleanupMethod defaults the HTTP method to POST
+func cleanupMethod(method string) string {
+	method = strings.ToUpper(strings.TrimSpace(method))
+	if method == "" {
+		return http.MethodPost
+	}
+	return method
+}
+
+func validateWebhook(
+	errs models.ValidationErrors,
+	webhookUrl string,
+	method string,
+	headers map[string]string,
+	bodyTemplate string,
+) {
+	if webhookUrl == "" {
+		errs["webhookUrl"] = translation.WebhookUrlIsRequired
+	} else {
+		if _, err := policy.WebhookUrlPolicy(webhookUrl); err != nil {
+			errs["webhookUrl"] = translation.ValidWebhookUrlIsRequired
+		}
+	}
+
+	if err := policy.WebhookMethodPolicy(method); err != nil {
+		errs["method"] = translation.InvalidWebhookMethod
+	}
+
+	if len(headers) > policy.MaxWebhookHeaders {
+		errs["headers"] = translation.TooManyWebhookHeaders
+	}
+	for name, value := range headers {
+		if err := policy.WebhookHeaderNamePolicy(name); err != nil {
+			errs["headers"] = translation.InvalidWebhookHeaderName
+			break
+		}
+		if err := policy.WebhookHeaderValuePolicy(value); err != nil {
+			errs["headers"] = translation.InvalidWebhookHeaderValue
+			break
+		}
+	}
+
+	if bodyTemplate != "" {
+		if issue := models.ValidateWebhookBodyTemplate(bodyTemplate); issue != "" {
+			errs["bodyTemplate"] = issue
+		}
+	}
+}